@@ -1,6 +1,7 @@
 package ag
 
 import (
+	"strings"
 	"time"
 )
 
@@ -22,16 +23,68 @@ func (m Assignment) SinceDeadline(now time.Time) (time.Duration, error) {
 	return now.Sub(deadline), nil
 }
 
+// LatePenaltyAt returns the percentage to deduct from the score of a submission
+// built at the given time. The penalty increases by the assignment's late penalty
+// for each day after the deadline, up to the assignment's maximum late penalty.
+// As for slip days, submissions within the grace period of a day are not penalized.
+func (m Assignment) LatePenaltyAt(built time.Time) (uint32, error) {
+	if m.GetLatePenalty() == 0 {
+		return 0, nil
+	}
+	sinceDeadline, err := m.SinceDeadline(built)
+	if err != nil || sinceDeadline <= 0 {
+		return 0, err
+	}
+	daysLate, hoursLate := uint32(sinceDeadline/days), sinceDeadline%days
+	if hoursLate > gracePeriod {
+		daysLate++
+	}
+	maxPenalty := m.GetMaxLatePenalty()
+	if maxPenalty == 0 || maxPenalty > 100 {
+		maxPenalty = 100
+	}
+	penalty := daysLate * m.GetLatePenalty()
+	if penalty > maxPenalty {
+		penalty = maxPenalty
+	}
+	return penalty, nil
+}
+
 // IsApproved returns true if this assignment is already approved for the
 // latest submission, or if the score of the latest submission is sufficient
-// to autoapprove the assignment.
+// to autoapprove the assignment. A submission rejected by a teacher is not
+// autoapproved; the teacher's manual decision overrides autoapproval.
 func (m Assignment) IsApproved(latest *Submission, score uint32) bool {
-	// keep approved status if already approved
-	approved := latest.GetStatus() == Submission_APPROVED
-	if m.GetAutoApprove() && score >= m.GetScoreLimit() {
-		approved = true
+	switch latest.GetStatus() {
+	case Submission_APPROVED:
+		// keep approved status if already approved
+		return true
+	case Submission_REJECTED:
+		return false
 	}
-	return approved
+	return m.GetAutoApprove() && score >= m.GetScoreLimit()
+}
+
+// AttemptsExhausted returns true if the given latest submission has used all
+// the attempts allowed for this assignment, including any extra attempts
+// granted by a teacher. Assignments without a max attempts limit are never exhausted.
+func (m Assignment) AttemptsExhausted(latest *Submission) bool {
+	if m.GetMaxAttempts() == 0 {
+		return false
+	}
+	return latest.GetAttempts() >= m.GetMaxAttempts()+latest.GetExtraAttempts()
+}
+
+// MatchesBranch returns true if the given branch name refers to this assignment.
+// Used to find the assignment submitted with a pull request from the given branch.
+func (m Assignment) MatchesBranch(branch string) bool {
+	return strings.EqualFold(m.GetName(), branch)
+}
+
+// InCategory returns true if the assignment belongs to the given category,
+// ignoring case. Every assignment belongs to the empty category.
+func (m Assignment) InCategory(category string) bool {
+	return category == "" || strings.EqualFold(m.GetCategory(), category)
 }
 
 // CloneWithoutSubmissions returns a deep copy of the given assignment
@@ -50,5 +103,10 @@ func (a Assignment) CloneWithoutSubmissions() *Assignment {
 		Reviewers:         a.Reviewers,
 		SkipTests:         a.SkipTests,
 		GradingBenchmarks: a.GradingBenchmarks,
+		LatePenalty:       a.LatePenalty,
+		MaxLatePenalty:    a.MaxLatePenalty,
+		Prerequisite:      a.Prerequisite,
+		MaxAttempts:       a.MaxAttempts,
+		Category:          a.Category,
 	}
 }
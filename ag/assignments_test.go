@@ -0,0 +1,54 @@
+package ag_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestAssignmentIsApproved(t *testing.T) {
+	tests := []struct {
+		name        string
+		autoApprove bool
+		status      pb.Submission_Status
+		score       uint32
+		want        bool
+	}{
+		{"manual below limit", false, pb.Submission_NONE, 50, false},
+		{"manual full score", false, pb.Submission_NONE, 100, false},
+		{"manual already approved", false, pb.Submission_APPROVED, 50, true},
+		{"auto below limit", true, pb.Submission_NONE, 79, false},
+		{"auto at limit", true, pb.Submission_NONE, 80, true},
+		{"auto already approved", true, pb.Submission_APPROVED, 10, true},
+		{"auto rejected by teacher", true, pb.Submission_REJECTED, 100, false},
+	}
+	for _, test := range tests {
+		assignment := pb.Assignment{AutoApprove: test.autoApprove, ScoreLimit: 80}
+		latest := &pb.Submission{Status: test.status}
+		if got := assignment.IsApproved(latest, test.score); got != test.want {
+			t.Errorf("%s: IsApproved() = %t, want %t", test.name, got, test.want)
+		}
+	}
+}
+
+func TestAssignmentAttemptsExhausted(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxAttempts   uint32
+		latest        *pb.Submission
+		wantExhausted bool
+	}{
+		{"no limit", 0, &pb.Submission{Attempts: 100}, false},
+		{"no submission", 2, nil, false},
+		{"below limit", 2, &pb.Submission{Attempts: 1}, false},
+		{"at limit", 2, &pb.Submission{Attempts: 2}, true},
+		{"extra attempts left", 2, &pb.Submission{Attempts: 2, ExtraAttempts: 1}, false},
+		{"extra attempts used", 2, &pb.Submission{Attempts: 3, ExtraAttempts: 1}, true},
+	}
+	for _, test := range tests {
+		assignment := pb.Assignment{MaxAttempts: test.maxAttempts}
+		if got := assignment.AttemptsExhausted(test.latest); got != test.wantExhausted {
+			t.Errorf("%s: AttemptsExhausted() = %t, want %t", test.name, got, test.wantExhausted)
+		}
+	}
+}
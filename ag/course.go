@@ -1,5 +1,11 @@
 package ag
 
+import (
+	"crypto/subtle"
+	"strings"
+	"time"
+)
+
 // cache of access tokens for courses; they are cached here when fetching from database
 var accessTokens = make(map[uint64]string)
 
@@ -13,6 +19,31 @@ func (course *Course) GetAccessToken() string {
 	return accessTokens[course.GetID()]
 }
 
+// HasFeature returns true if the given feature is enabled for the course.
+func (course *Course) HasFeature(feature Course_Feature) bool {
+	return course.GetFeatures()&uint32(feature) != 0
+}
+
+// SetFeature enables or disables the given feature for the course.
+func (course *Course) SetFeature(feature Course_Feature, enabled bool) {
+	if enabled {
+		course.Features |= uint32(feature)
+	} else {
+		course.Features &^= uint32(feature)
+	}
+}
+
+// UpdatesFlag returns true if the course's update mask lists the given flag,
+// e.g. private or archived, such that an update sets the flag to the course's value.
+func (course *Course) UpdatesFlag(flag string) bool {
+	for _, path := range course.GetUpdateMask() {
+		if path == flag {
+			return true
+		}
+	}
+	return false
+}
+
 // SetSlipDays sets number of remaining slip days for each course enrollment
 func (course Course) SetSlipDays() {
 	for _, e := range course.Enrollments {
@@ -23,3 +54,104 @@ func (course Course) SetSlipDays() {
 		g.SetSlipDays(&course)
 	}
 }
+
+// AllowsEmail returns true if users with the given email address may enroll
+// in the course by themselves. All email addresses are allowed if the course
+// has no email domain allowlist.
+func (course *Course) AllowsEmail(email string) bool {
+	allowlist := course.GetEmailDomainAllowlist()
+	if strings.TrimSpace(allowlist) == "" {
+		return true
+	}
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	domain := email[at+1:]
+	for _, allowed := range strings.Split(allowlist, ",") {
+		allowed = strings.TrimPrefix(strings.TrimSpace(allowed), "@")
+		if allowed != "" && strings.EqualFold(domain, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// GradesBranch returns true if pushes to the given branch of a student repository
+// should be graded. Only the repository's default branch is graded if the course
+// has no graded branches.
+func (course *Course) GradesBranch(branch, defaultBranch string) bool {
+	gradedBranches := course.GetGradedBranches()
+	if strings.TrimSpace(gradedBranches) == "" {
+		return branch == defaultBranch
+	}
+	for _, graded := range strings.Split(gradedBranches, ",") {
+		if strings.TrimSpace(graded) == branch {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidEnrollmentCode returns true if the course has an enrollment code
+// and the given code matches it.
+func (course *Course) ValidEnrollmentCode(code string) bool {
+	courseCode := course.GetEnrollmentCode()
+	if courseCode == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(courseCode), []byte(code)) == 1
+}
+
+// RemoveEnrollmentCode removes the course's enrollment code, which should
+// only be visible to the course's teachers.
+func (course *Course) RemoveEnrollmentCode() {
+	course.EnrollmentCode = ""
+}
+
+// RemoveEnrollmentCodes removes the enrollment code from every course
+// for which the user is not enrolled as a teacher.
+func (c *Courses) RemoveEnrollmentCodes() {
+	for _, course := range c.GetCourses() {
+		if course.GetEnrolled() != Enrollment_TEACHER {
+			course.RemoveEnrollmentCode()
+		}
+	}
+}
+
+// IsFull returns true if the course has a student limit and
+// the given number of enrolled students has reached it.
+func (course *Course) IsFull(numStudents uint32) bool {
+	return course.GetMaxStudents() > 0 && numStudents >= course.GetMaxStudents()
+}
+
+// IsGroupFull returns true if the course has a group size limit and
+// the given number of group members has reached it.
+func (course *Course) IsGroupFull(numMembers uint32) bool {
+	return course.GetMaxGroupSize() > 0 && numMembers >= course.GetMaxGroupSize()
+}
+
+// AcceptsSubmissionsAt returns true if a submission made at the given time
+// falls within the course's start and end dates. Both dates are inclusive,
+// and a course without a start or end date is open in that direction.
+func (course *Course) AcceptsSubmissionsAt(t time.Time) (bool, error) {
+	if start := course.GetStartDate(); start != "" {
+		startDate, err := time.ParseInLocation(layout, start, t.Location())
+		if err != nil {
+			return false, err
+		}
+		if t.Before(startDate) {
+			return false, nil
+		}
+	}
+	if end := course.GetEndDate(); end != "" {
+		endDate, err := time.ParseInLocation(layout, end, t.Location())
+		if err != nil {
+			return false, err
+		}
+		if t.After(endDate) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
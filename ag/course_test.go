@@ -0,0 +1,143 @@
+package ag_test
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestCourseAcceptsSubmissionsAt(t *testing.T) {
+	const layout = "2006-01-02T15:04:05"
+	start := time.Date(2021, time.January, 10, 8, 0, 0, 0, time.Local)
+	end := time.Date(2021, time.June, 1, 23, 59, 0, 0, time.Local)
+	course := &pb.Course{StartDate: start.Format(layout), EndDate: end.Format(layout)}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"before start", start.Add(-time.Second), false},
+		{"at start", start, true},
+		{"after start", start.Add(time.Second), true},
+		{"before end", end.Add(-time.Second), true},
+		{"at end", end, true},
+		{"after end", end.Add(time.Second), false},
+	}
+	for _, test := range tests {
+		got, err := course.AcceptsSubmissionsAt(test.at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("%s: AcceptsSubmissionsAt(%v) = %t, want %t", test.name, test.at, got, test.want)
+		}
+	}
+
+	// a course without dates accepts submissions at any time
+	if got, err := (&pb.Course{}).AcceptsSubmissionsAt(start.Add(-time.Hour)); err != nil || !got {
+		t.Errorf("AcceptsSubmissionsAt() = %t, %v, want true, <nil>", got, err)
+	}
+	// only the start date is set
+	if got, err := (&pb.Course{StartDate: start.Format(layout)}).AcceptsSubmissionsAt(end.Add(time.Hour)); err != nil || !got {
+		t.Errorf("AcceptsSubmissionsAt() = %t, %v, want true, <nil>", got, err)
+	}
+	if _, err := (&pb.Course{EndDate: "June 1st"}).AcceptsSubmissionsAt(start); err == nil {
+		t.Error("AcceptsSubmissionsAt() with invalid end date: expected error")
+	}
+}
+
+func TestCourseIsFull(t *testing.T) {
+	tests := []struct {
+		maxStudents uint32
+		numStudents uint32
+		want        bool
+	}{
+		{maxStudents: 0, numStudents: 100, want: false},
+		{maxStudents: 2, numStudents: 1, want: false},
+		{maxStudents: 2, numStudents: 2, want: true},
+		{maxStudents: 2, numStudents: 3, want: true},
+	}
+	for _, test := range tests {
+		course := &pb.Course{MaxStudents: test.maxStudents}
+		if got := course.IsFull(test.numStudents); got != test.want {
+			t.Errorf("Course{MaxStudents: %d}.IsFull(%d) = %t, want %t", test.maxStudents, test.numStudents, got, test.want)
+		}
+	}
+}
+
+func TestCourseUpdatesFlag(t *testing.T) {
+	tests := []struct {
+		updateMask []string
+		flag       string
+		want       bool
+	}{
+		{updateMask: nil, flag: "private", want: false},
+		{updateMask: []string{"archived"}, flag: "private", want: false},
+		{updateMask: []string{"archived", "private"}, flag: "private", want: true},
+	}
+	for _, test := range tests {
+		course := &pb.Course{UpdateMask: test.updateMask}
+		if got := course.UpdatesFlag(test.flag); got != test.want {
+			t.Errorf("Course{UpdateMask: %v}.UpdatesFlag(%q) = %t, want %t", test.updateMask, test.flag, got, test.want)
+		}
+	}
+}
+
+func TestCourseIsGroupFull(t *testing.T) {
+	tests := []struct {
+		maxGroupSize uint32
+		numMembers   uint32
+		want         bool
+	}{
+		{maxGroupSize: 0, numMembers: 10, want: false},
+		{maxGroupSize: 3, numMembers: 2, want: false},
+		{maxGroupSize: 3, numMembers: 3, want: true},
+		{maxGroupSize: 3, numMembers: 4, want: true},
+	}
+	for _, test := range tests {
+		course := &pb.Course{MaxGroupSize: test.maxGroupSize}
+		if got := course.IsGroupFull(test.numMembers); got != test.want {
+			t.Errorf("Course{MaxGroupSize: %d}.IsGroupFull(%d) = %t, want %t", test.maxGroupSize, test.numMembers, got, test.want)
+		}
+	}
+}
+
+func TestCourseGradesBranch(t *testing.T) {
+	tests := []struct {
+		gradedBranches string
+		branch         string
+		want           bool
+	}{
+		{gradedBranches: "", branch: "master", want: true},
+		{gradedBranches: "", branch: "solutions", want: false},
+		{gradedBranches: "main, solutions", branch: "solutions", want: true},
+		{gradedBranches: "main, solutions", branch: "main", want: true},
+		{gradedBranches: "main, solutions", branch: "master", want: false},
+		{gradedBranches: "main", branch: "notmain", want: false},
+	}
+	for _, test := range tests {
+		course := &pb.Course{GradedBranches: test.gradedBranches}
+		if got := course.GradesBranch(test.branch, "master"); got != test.want {
+			t.Errorf("Course{GradedBranches: %q}.GradesBranch(%q) = %t, want %t", test.gradedBranches, test.branch, got, test.want)
+		}
+	}
+}
+
+func TestSubmissionPredatesGradingConfig(t *testing.T) {
+	course := &pb.Course{GradingConfigVersion: 2}
+	for _, tt := range []struct {
+		version uint32
+		want    bool
+	}{
+		{0, true},
+		{1, true},
+		{2, false},
+	} {
+		submission := &pb.Submission{GradingConfigVersion: tt.version}
+		if got := submission.PredatesGradingConfig(course); got != tt.want {
+			t.Errorf("PredatesGradingConfig(version %d) = %t, want %t", tt.version, got, tt.want)
+		}
+	}
+}
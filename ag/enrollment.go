@@ -86,3 +86,13 @@ func (m Enrollment) IsTeacher() bool {
 func (m Enrollment) IsStudent() bool {
 	return m.GetStatus() == Enrollment_STUDENT
 }
+
+// HasLeft returns true if the user has left the course.
+func (m Enrollment) HasLeft() bool {
+	return m.GetStatus() == Enrollment_LEFT
+}
+
+// HasRepoAccess returns true if the enrollment gives access to the course repositories.
+func (m Enrollment) HasRepoAccess() bool {
+	return m.IsStudent() || m.IsTeacher()
+}
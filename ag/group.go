@@ -26,6 +26,22 @@ func (g *Group) ContainsAll(group *Group) bool {
 	return reflect.DeepEqual(g.Users, group.Users)
 }
 
+// MemberChanges returns the members of the given group that are not members
+// of this group, and the members of this group that are not in the given group.
+func (g *Group) MemberChanges(group *Group) (added, removed []*User) {
+	for _, user := range group.GetUsers() {
+		if !g.Contains(user) {
+			added = append(added, user)
+		}
+	}
+	for _, user := range g.GetUsers() {
+		if !group.Contains(user) {
+			removed = append(removed, user)
+		}
+	}
+	return added, removed
+}
+
 // SetSlipDays sets number of remaining slip days for each enrollment
 func (g Group) SetSlipDays(c *Course) {
 	for _, e := range g.Enrollments {
@@ -42,6 +42,47 @@ func (r *Review) UnmarshalReviewString() error {
 	return nil
 }
 
+// ComputeScore sets the review's score from the grades of its criteria.
+// If the criteria have points, the score is the sum of the points of the passed
+// criteria. Otherwise, the score is the percentage of the criteria that passed.
+func (r *Review) ComputeScore() {
+	var passed, total, points, maxPoints uint64
+	for _, bm := range r.Benchmarks {
+		for _, c := range bm.Criteria {
+			total++
+			maxPoints += c.GetPoints()
+			if c.GetGrade() == GradingCriterion_PASSED {
+				passed++
+				points += c.GetPoints()
+			}
+		}
+	}
+	switch {
+	case maxPoints > 0:
+		r.Score = points
+	case total > 0:
+		r.Score = passed * 100 / total
+	default:
+		r.Score = 0
+	}
+}
+
+// ReviewScore returns the average score of the submission's ready reviews,
+// and false if no review is ready.
+func (s Submission) ReviewScore() (uint32, bool) {
+	var sum, ready uint64
+	for _, r := range s.Reviews {
+		if r.GetReady() {
+			sum += r.GetScore()
+			ready++
+		}
+	}
+	if ready == 0 {
+		return 0, false
+	}
+	return uint32(sum / ready), true
+}
+
 // MakeSubmissionReviews unmarshalls review string for a submission
 func (s Submission) MakeSubmissionReviews() error {
 	for _, r := range s.Reviews {
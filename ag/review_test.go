@@ -80,3 +80,51 @@ func TestReviewUnmarshalStringProtobufJsonPB(t *testing.T) {
 		t.Errorf("r.UnmarshalReviewString() mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestReviewComputeScore(t *testing.T) {
+	benchmarks := func(grades ...ag.GradingCriterion_Grade) []*ag.GradingBenchmark {
+		var criteria []*ag.GradingCriterion
+		for _, grade := range grades {
+			criteria = append(criteria, &ag.GradingCriterion{Grade: grade})
+		}
+		return []*ag.GradingBenchmark{{Criteria: criteria}}
+	}
+	withPoints := func(bms []*ag.GradingBenchmark, points ...uint64) []*ag.GradingBenchmark {
+		for i, c := range bms[0].Criteria {
+			c.Points = points[i]
+		}
+		return bms
+	}
+	tests := []struct {
+		name       string
+		benchmarks []*ag.GradingBenchmark
+		want       uint64
+	}{
+		{"NoCriteria", nil, 0},
+		{"AllFailed", benchmarks(ag.GradingCriterion_FAILED, ag.GradingCriterion_NONE), 0},
+		{"PercentPassed", benchmarks(ag.GradingCriterion_PASSED, ag.GradingCriterion_FAILED, ag.GradingCriterion_PASSED), 66},
+		{"PointsPassed", withPoints(benchmarks(ag.GradingCriterion_PASSED, ag.GradingCriterion_FAILED, ag.GradingCriterion_PASSED), 10, 30, 20), 30},
+	}
+	for _, tt := range tests {
+		r := &ag.Review{Benchmarks: tt.benchmarks, Score: 99}
+		r.ComputeScore()
+		if r.Score != tt.want {
+			t.Errorf("%s: ComputeScore() = %d, want %d", tt.name, r.Score, tt.want)
+		}
+	}
+}
+
+func TestSubmissionReviewScore(t *testing.T) {
+	s := ag.Submission{}
+	if _, ok := s.ReviewScore(); ok {
+		t.Error("ReviewScore() = true, want false for submission without reviews")
+	}
+	s.Reviews = []*ag.Review{
+		{Ready: true, Score: 80},
+		{Ready: false, Score: 10},
+		{Ready: true, Score: 60},
+	}
+	if score, ok := s.ReviewScore(); !ok || score != 70 {
+		t.Errorf("ReviewScore() = %d, %t, want 70, true", score, ok)
+	}
+}
@@ -3,3 +3,22 @@ package ag
 func (s *Submission) IsApproved() bool {
 	return s.GetStatus() == Submission_APPROVED
 }
+
+// PredatesGradingConfig returns true if the submission was graded
+// with an older version of the given course's tests.
+func (s *Submission) PredatesGradingConfig(course *Course) bool {
+	return s.GetGradingConfigVersion() < course.GetGradingConfigVersion()
+}
+
+// ApplyLatePenalty sets the submission's score to its raw score reduced
+// by the given penalty percentage. Submissions recorded without a raw score
+// use the current score as the raw score.
+func (s *Submission) ApplyLatePenalty(penalty uint32) {
+	if s.GetRawScore() == 0 {
+		s.RawScore = s.GetScore()
+	}
+	if penalty > 100 {
+		penalty = 100
+	}
+	s.Score = s.GetRawScore() * (100 - penalty) / 100
+}
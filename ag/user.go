@@ -29,6 +29,16 @@ func (u User) GetAccessToken(provider string) (string, error) {
 	return remoteID.GetAccessToken(), nil
 }
 
+// Redacted returns a copy of the user containing only the ID, name and login.
+// Used when user information is shown to other students.
+func (u User) Redacted() *User {
+	return &User{
+		ID:    u.GetID(),
+		Name:  u.GetName(),
+		Login: u.GetLogin(),
+	}
+}
+
 // SetSlipDays sets number of remaining slip days for each enrollment
 func (u User) SetSlipDays(c *Course) {
 	for _, e := range u.Enrollments {
@@ -80,11 +80,21 @@ func (c Course) IsValid() bool {
 	return c.GetName() != "" &&
 		c.GetCode() != "" &&
 		(c.GetProvider() == "github" || c.GetProvider() == "gitlab" || c.GetProvider() == "fake") &&
-		c.GetOrganizationID() != 0 &&
+		(c.GetOrganizationID() != 0 || c.GetOrganizationPath() != "") &&
 		c.GetYear() != 0 &&
 		c.GetTag() != ""
 }
 
+// IsValid checks required fields of the course to update.
+func (req UpdateCourseRequest) IsValid() bool {
+	return req.GetCourse() != nil && req.GetCourse().IsValid() && req.GetCourse().GetID() > 0
+}
+
+// IsValid ensures that course ID and a feature are set
+func (req CourseFeatureRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetFeature() > Course_NONE
+}
+
 // IsValid checks required fields of a user request
 func (u User) IsValid() bool {
 	return u.GetID() > 0
@@ -106,6 +116,87 @@ func (req CourseRequest) IsValid() bool {
 	return req.GetCourseID() > 0
 }
 
+// IsValid ensures that course ID is set
+func (req EnrollmentDetailsRequest) IsValid() bool {
+	return req.GetCourseID() > 0
+}
+
+// IsValid ensures that course ID is set
+func (req RejectEnrollmentsRequest) IsValid() bool {
+	return req.GetCourseID() > 0
+}
+
+// IsValid ensures that course ID is set, and that since is empty or a valid date
+func (req CourseActivityRequest) IsValid() bool {
+	if req.GetSince() != "" {
+		if _, err := time.Parse(layout, req.GetSince()); err != nil {
+			return false
+		}
+	}
+	return req.GetCourseID() > 0
+}
+
+// IsValid ensures that at least one course ID is given, and that no course ID is zero
+func (req CoursesRequest) IsValid() bool {
+	for _, courseID := range req.GetCourseIDs() {
+		if courseID == 0 {
+			return false
+		}
+	}
+	return len(req.GetCourseIDs()) > 0
+}
+
+// IsValid ensures that both course and assignment IDs are set
+func (req AssignmentSubmissionRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0
+}
+
+// IsValid ensures that both course and assignment IDs are set, and that the score limit is a percentage
+func (req AutoApproveRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0 && req.GetScoreLimit() <= 100
+}
+
+// IsValid ensures that both course and assignment IDs are set
+func (req AssignmentRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0
+}
+
+// IsValid ensures that course and repository IDs, and the commit hash are set
+func (req CommitSubmissionRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetRepositoryID() > 0 && req.GetCommitHash() != ""
+}
+
+// IsValid ensures that submission ID is set
+func (req SubmissionIDRequest) IsValid() bool {
+	return req.GetSubmissionID() > 0
+}
+
+// IsValid ensures that course ID and a valid since date are set
+func (req ReplayRequest) IsValid() bool {
+	_, err := time.Parse(layout, req.GetSince())
+	return err == nil && req.GetCourseID() > 0
+}
+
+// IsValid ensures that submission ID is set
+func (req RubricScoreRequest) IsValid() bool {
+	return req.GetSubmissionID() > 0
+}
+
+// IsValid ensures that submission ID and comment text are set
+func (c SubmissionComment) IsValid() bool {
+	return c.GetSubmissionID() > 0 && strings.TrimSpace(c.GetText()) != ""
+}
+
+// IsValid ensures that course, user, and assignment IDs are set
+func (req SubmissionHistoryRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetUserID() > 0 && req.GetAssignmentID() > 0
+}
+
+// IsValid ensures that course and assignment IDs, and at least one submission ID are set
+func (req ApproveSubmissionsRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0 && len(req.GetSubmissionIDs()) > 0
+}
+
 // IsValid ensures that user ID is set
 func (req EnrollmentStatusRequest) IsValid() bool {
 	return req.GetUserID() > 0
@@ -166,7 +257,7 @@ func (req GroupRequest) IsValid() bool {
 
 // IsValid checks that course ID is positive.
 func (req EnrollmentRequest) IsValid() bool {
-	return req.GetCourseID() > 0
+	return req.GetCourseID() > 0 && !(req.GetIgnoreGroupMembers() && req.GetOnlyGroupMembers())
 }
 
 // IsValid ensures that provider string is one of implemented providers
@@ -182,6 +273,11 @@ func (req SubmissionsForCourseRequest) IsValid() bool {
 	return req.GetCourseID() != 0
 }
 
+// IsValid ensures that course and grader IDs are set
+func (req AssignGraderRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetGraderID() > 0
+}
+
 // IsValid ensures that both course and submission IDs are set
 func (req RebuildRequest) IsValid() bool {
 	aid, sid := req.GetAssignmentID(), req.GetSubmissionID()
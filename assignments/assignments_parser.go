@@ -33,6 +33,11 @@ type assignmentData struct {
 	Reviewers        uint   `yaml:"reviewers"`
 	ContainerTimeout uint   `yaml:"containertimeout"`
 	SkipTests        bool   `yaml:"skiptests"`
+	LatePenalty      uint   `yaml:"latepenalty"`
+	MaxLatePenalty   uint   `yaml:"maxlatepenalty"`
+	Prerequisite     uint   `yaml:"prerequisite"`
+	MaxAttempts      uint   `yaml:"maxattempts"`
+	Category         string `yaml:"category"`
 }
 
 // ParseAssignments recursively walks the given directory and parses
@@ -80,6 +85,11 @@ func parseAssignments(dir string, courseID uint64) ([]*pb.Assignment, error) {
 					Reviewers:        uint32(newAssignment.Reviewers),
 					ContainerTimeout: uint32(newAssignment.ContainerTimeout),
 					SkipTests:        newAssignment.SkipTests,
+					LatePenalty:      uint32(newAssignment.LatePenalty),
+					MaxLatePenalty:   uint32(newAssignment.MaxLatePenalty),
+					Prerequisite:     uint32(newAssignment.Prerequisite),
+					MaxAttempts:      uint32(newAssignment.MaxAttempts),
+					Category:         newAssignment.Category,
 				}
 
 				assignments = append(assignments, assignment)
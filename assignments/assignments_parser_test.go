@@ -32,6 +32,10 @@ name: "Nested loops"
 scriptfile: "java.sh"
 deadline: "27-08-2018 12:00"
 autoapprove: false
+latepenalty: 10
+maxlatepenalty: 50
+prerequisite: 1
+category: "projects"
 `
 
 	yUnknownFields = `assignmentid: 1
@@ -85,12 +89,16 @@ func TestParse(t *testing.T) {
 	}
 
 	wantAssignment2 := &pb.Assignment{
-		Name:        "lab2",
-		ScriptFile:  "java.sh",
-		Deadline:    "2018-08-27T12:00:00",
-		AutoApprove: false,
-		Order:       2,
-		ScoreLimit:  80,
+		Name:           "lab2",
+		ScriptFile:     "java.sh",
+		Deadline:       "2018-08-27T12:00:00",
+		AutoApprove:    false,
+		Order:          2,
+		ScoreLimit:     80,
+		LatePenalty:    10,
+		MaxLatePenalty: 50,
+		Prerequisite:   1,
+		Category:       "projects",
 	}
 
 	assignments, err := parseAssignments(testsDir, 0)
@@ -19,6 +19,7 @@ type AssignmentInfo struct {
 	GetURL             string
 	TestURL            string
 	RandomSecret       string
+	Checkout           string // commit to check out after cloning the student repository
 }
 
 func newAssignmentInfo(course *pb.Course, assignment *pb.Assignment, cloneURL, testURL string) *AssignmentInfo {
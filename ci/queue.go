@@ -0,0 +1,118 @@
+package ci
+
+import (
+	"runtime"
+	"sync"
+	"time"
+)
+
+// Priority is the priority of a queued build.
+// Builds of higher priority are started before builds of lower priority.
+type Priority uint32
+
+const (
+	// PriorityDefault is the priority of builds of students' pushes.
+	PriorityDefault Priority = iota
+	// PriorityHigh is the priority of builds requested by a teacher.
+	PriorityHigh
+)
+
+// buildQueue limits the number of builds running at the same time.
+var buildQueue = newQueue(runtime.NumCPU())
+
+// SetMaxConcurrentBuilds sets the number of builds that may run at the same time.
+// Further builds wait in the queue until a running build completes.
+func SetMaxConcurrentBuilds(n int) {
+	buildQueue.setLimit(n)
+}
+
+// queue admits up to limit builds at a time. Waiting builds are admitted
+// in order of priority, and in the order they were queued for equal priority.
+type queue struct {
+	mu      sync.Mutex
+	limit   int
+	running int
+	waiting []*waiter
+}
+
+// waiter is a build waiting in the queue; ready is closed when the build is admitted.
+type waiter struct {
+	priority Priority
+	queued   time.Time
+	ready    chan struct{}
+}
+
+// QueueStats returns the number of builds waiting in the build queue
+// and the time the longest waiting build was queued, if any.
+func QueueStats() (int, time.Time) {
+	return buildQueue.stats()
+}
+
+func newQueue(limit int) *queue {
+	if limit < 1 {
+		limit = 1
+	}
+	return &queue{limit: limit}
+}
+
+// acquire blocks until a build of the given priority is admitted.
+func (q *queue) acquire(priority Priority) {
+	q.mu.Lock()
+	if q.running < q.limit && len(q.waiting) == 0 {
+		q.running++
+		q.mu.Unlock()
+		return
+	}
+	w := &waiter{priority: priority, queued: time.Now(), ready: make(chan struct{})}
+	q.waiting = append(q.waiting, w)
+	q.mu.Unlock()
+	<-w.ready
+}
+
+// release marks a build as completed, admitting the next waiting build, if any.
+func (q *queue) release() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.running--
+	q.admit()
+}
+
+// stats returns the number of waiting builds and the time the longest waiting build was queued.
+func (q *queue) stats() (int, time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.waiting) == 0 {
+		return 0, time.Time{}
+	}
+	// waiters are appended in queue order, so the first has waited the longest
+	return len(q.waiting), q.waiting[0].queued
+}
+
+// setLimit changes the number of builds that may run at the same time.
+func (q *queue) setLimit(limit int) {
+	if limit < 1 {
+		limit = 1
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.limit = limit
+	q.admit()
+}
+
+// admit starts waiting builds while fewer than limit builds are running.
+// The caller must hold the queue's lock.
+func (q *queue) admit() {
+	for q.running < q.limit && len(q.waiting) > 0 {
+		next := 0
+		for i, w := range q.waiting {
+			// waiters are appended in queue order, so the first of the highest priority is next
+			if w.priority > q.waiting[next].priority {
+				next = i
+			}
+		}
+		w := q.waiting[next]
+		q.waiting = append(q.waiting[:next], q.waiting[next+1:]...)
+		q.running++
+		close(w.ready)
+	}
+}
@@ -0,0 +1,98 @@
+package ci
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQueueAdmitsHigherPriorityFirst(t *testing.T) {
+	q := newQueue(1)
+	q.acquire(PriorityDefault)
+
+	admitted := make(chan string)
+	queued := []struct {
+		name     string
+		priority Priority
+	}{
+		{"push1", PriorityDefault},
+		{"push2", PriorityDefault},
+		{"rebuild", PriorityHigh},
+	}
+	for i, build := range queued {
+		go func(name string, priority Priority) {
+			q.acquire(priority)
+			admitted <- name
+		}(build.name, build.priority)
+		// wait for the build to be queued, to queue the builds in order
+		for waiting := 0; waiting != i+1; {
+			time.Sleep(time.Millisecond)
+			q.mu.Lock()
+			waiting = len(q.waiting)
+			q.mu.Unlock()
+		}
+	}
+
+	want := []string{"rebuild", "push1", "push2"}
+	for _, wantName := range want {
+		q.release()
+		if name := <-admitted; name != wantName {
+			t.Errorf("admitted %s, want %s", name, wantName)
+		}
+	}
+	q.release()
+	if q.running != 0 || len(q.waiting) != 0 {
+		t.Errorf("have %d running and %d waiting builds, want none", q.running, len(q.waiting))
+	}
+}
+
+func TestQueueSetLimitAdmitsWaiting(t *testing.T) {
+	q := newQueue(1)
+	q.acquire(PriorityDefault)
+	done := make(chan struct{})
+	go func() {
+		q.acquire(PriorityDefault)
+		close(done)
+	}()
+	for waiting := 0; waiting != 1; {
+		time.Sleep(time.Millisecond)
+		q.mu.Lock()
+		waiting = len(q.waiting)
+		q.mu.Unlock()
+	}
+	q.setLimit(2)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("waiting build not admitted after raising the limit")
+	}
+}
+
+func TestQueueStats(t *testing.T) {
+	q := newQueue(1)
+	if depth, oldest := q.stats(); depth != 0 || !oldest.IsZero() {
+		t.Errorf("have %d waiting builds queued at %v, want none", depth, oldest)
+	}
+	q.acquire(PriorityDefault)
+	before := time.Now()
+	for i := 0; i < 2; i++ {
+		go q.acquire(PriorityDefault)
+		for waiting := 0; waiting != i+1; {
+			time.Sleep(time.Millisecond)
+			q.mu.Lock()
+			waiting = len(q.waiting)
+			q.mu.Unlock()
+		}
+	}
+	// the running build is not counted
+	depth, oldest := q.stats()
+	q.mu.Lock()
+	first := q.waiting[0].queued
+	q.mu.Unlock()
+	if depth != 2 || oldest.Before(before) || oldest != first {
+		t.Errorf("have %d waiting builds queued at %v, want 2 queued at %v", depth, oldest, first)
+	}
+	q.release()
+	if depth, _ := q.stats(); depth != 1 {
+		t.Errorf("have %d waiting builds, want 1", depth)
+	}
+}
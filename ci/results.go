@@ -28,6 +28,21 @@ type BuildInfo struct {
 
 var globalBuildID = new(int64)
 
+// testsDir is where the course's tests repository is cloned in the build container.
+const testsDir = "/quickfeed/tests"
+
+// StudentBuildLog returns the build log without the lines that refer to the
+// course's tests repository, hiding the test setup from students.
+func (b BuildInfo) StudentBuildLog() string {
+	var filteredLog []string
+	for _, line := range strings.Split(b.BuildLog, "\n") {
+		if !strings.Contains(line, testsDir) {
+			filteredLog = append(filteredLog, line)
+		}
+	}
+	return strings.Join(filteredLog, "\n")
+}
+
 // ExtractResult returns a result struct for the given log.
 func ExtractResult(logger *zap.SugaredLogger, out, secret string, execTime time.Duration) (*Result, error) {
 	var filteredLog []string
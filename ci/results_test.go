@@ -30,6 +30,19 @@ Here are some more logs for the student.
 	}
 }
 
+func TestStudentBuildLog(t *testing.T) {
+	buildInfo := BuildInfo{BuildLog: `*** Preparing for Test Execution ***
+Cloning into '/quickfeed/tests'...
+=== RUN   TestFibonacci
+--- PASS: TestFibonacci (0.00s)`}
+	want := `*** Preparing for Test Execution ***
+=== RUN   TestFibonacci
+--- PASS: TestFibonacci (0.00s)`
+	if got := buildInfo.StudentBuildLog(); got != want {
+		t.Errorf("StudentBuildLog() = %q, want %q", got, want)
+	}
+}
+
 func TestExtractResultWithWhitespace(t *testing.T) {
 	out := `here is some output in the log with whitespace before the JSON string below.
 
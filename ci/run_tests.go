@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/sha1"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -16,6 +17,9 @@ import (
 const (
 	scriptPath = "ci/scripts"
 	layout     = "2006-01-02T15:04:05"
+
+	maxAttemptsReached = "Max attempts reached: the submission was not built. Ask your teacher for extra attempts."
+	noTests            = "No automated tests for this assignment"
 )
 
 // RunData stores CI data
@@ -25,6 +29,13 @@ type RunData struct {
 	Repo       *pb.Repository
 	CommitID   string
 	JobOwner   string
+	// Checkout is the commit to test; if empty, the head of the default branch is tested.
+	Checkout string
+	// Rebuild is true for builds requested by a teacher. Rebuilds are not
+	// limited by the assignment's max attempts, and do not count as attempts.
+	Rebuild bool
+	// Priority is the priority of the build in the build queue.
+	Priority Priority
 }
 
 // String returns a string representation of the run data structure
@@ -32,13 +43,29 @@ func (r RunData) String(secret string) string {
 	return fmt.Sprintf("%s-%s-%s-%s", r.Course.GetCode(), r.Assignment.GetName(), r.JobOwner, secret)
 }
 
+// submissionQuery returns a query for the submissions of the run data's assignment and repository owner.
+func (r RunData) submissionQuery() *pb.Submission {
+	return &pb.Submission{
+		AssignmentID: r.Assignment.GetID(),
+		UserID:       r.Repo.GetUserID(),
+		GroupID:      r.Repo.GetGroupID(),
+	}
+}
+
 // RunTests runs the assignment specified in the provided RunData structure.
 func RunTests(logger *zap.SugaredLogger, db database.Database, runner Runner, rData *RunData) {
+	if recordMaxAttemptsReached(logger, db, rData) {
+		return
+	}
+	buildQueue.acquire(rData.Priority)
+	defer buildQueue.release()
+
 	info := newAssignmentInfo(rData.Course, rData.Assignment, rData.Repo.GetHTMLURL(), rData.Repo.GetTestURL())
+	info.Checkout = rData.Checkout
 	logger.Debugf("Running tests for %s", rData.JobOwner)
 	ed, err := runTests(scriptPath, runner, info, rData)
 	if err != nil {
-		logger.Errorf("Failed to run tests: %w", err)
+		logger.Errorf("Failed to run tests: %v", err)
 		if ed == nil {
 			return
 		}
@@ -46,12 +73,87 @@ func RunTests(logger *zap.SugaredLogger, db database.Database, runner Runner, rD
 	}
 	result, err := ExtractResult(logger, ed.out, info.RandomSecret, ed.execTime)
 	if err != nil {
-		logger.Errorf("Failed to extract results from log: %w", err)
+		logger.Errorf("Failed to extract results from log: %v", err)
 		return
 	}
 	recordResults(logger, db, rData, result)
 }
 
+// RecordWithoutTests saves a new submission for the run data's commit without
+// running any tests, for an assignment that is reviewed manually.
+func RecordWithoutTests(logger *zap.SugaredLogger, db database.Database, rData *RunData) {
+	buildInfo, err := json.Marshal(&BuildInfo{
+		BuildID:   0,
+		BuildDate: time.Now().Format(layout),
+		BuildLog:  noTests,
+		ExecTime:  1,
+	})
+	if err != nil {
+		logger.Errorf("Error marshalling build info for %s of course %s for student %s: %s", rData.Course.Name, rData.Assignment.Name, rData.JobOwner, err)
+		return
+	}
+	submission := &pb.Submission{
+		AssignmentID:         rData.Assignment.ID,
+		BuildInfo:            string(buildInfo),
+		CommitHash:           rData.CommitID,
+		UserID:               rData.Repo.UserID,
+		GroupID:              rData.Repo.GroupID,
+		GradingConfigVersion: rData.Course.GetGradingConfigVersion(),
+	}
+	if err := db.CreateSubmission(submission); err != nil {
+		logger.Errorf("Failed to save submission for user ID %s for assignment ID %d: %s", rData.JobOwner, rData.Assignment.ID, err)
+		return
+	}
+	logger.Debugf("Saved manual review submission for user %s for assignment %d", rData.JobOwner, rData.Assignment.ID)
+}
+
+// recordMaxAttemptsReached records a submission reporting that the maximum number
+// of attempts has been reached, if the run data's assignment has no attempts left
+// for the repository owner. Returns true if the submission should not be built.
+// The score and status of the previous submission are kept.
+func recordMaxAttemptsReached(logger *zap.SugaredLogger, db database.Database, rData *RunData) bool {
+	if rData.Rebuild || rData.Assignment.GetMaxAttempts() == 0 {
+		return false
+	}
+	latest, err := db.GetSubmission(rData.submissionQuery())
+	if err != nil && err != gorm.ErrRecordNotFound {
+		logger.Errorf("Failed to get submission data from database: %v", err)
+		return false
+	}
+	if !rData.Assignment.AttemptsExhausted(latest) {
+		return false
+	}
+	buildInfo, err := json.Marshal(&BuildInfo{
+		BuildDate: time.Now().Format(layout),
+		BuildLog:  maxAttemptsReached,
+	})
+	if err != nil {
+		logger.Errorf("Failed to marshal build info: %v", err)
+		return true
+	}
+	submission := &pb.Submission{
+		AssignmentID:  rData.Assignment.GetID(),
+		UserID:        rData.Repo.GetUserID(),
+		GroupID:       rData.Repo.GetGroupID(),
+		BuildInfo:     string(buildInfo),
+		CommitHash:    rData.CommitID,
+		Score:         latest.GetScore(),
+		RawScore:      latest.GetRawScore(),
+		ScoreObjects:  latest.GetScoreObjects(),
+		Status:        latest.GetStatus(),
+		Attempts:      latest.GetAttempts(),
+		ExtraAttempts: latest.GetExtraAttempts(),
+		// the submission is not graded, so it keeps the tests version of the latest build
+		GradingConfigVersion: latest.GetGradingConfigVersion(),
+	}
+	if err := db.CreateSubmission(submission); err != nil {
+		logger.Errorf("Failed to add submission to database: %v", err)
+		return true
+	}
+	logger.Debugf("Max attempts reached for assignment '%s' by %s", rData.Assignment.GetName(), rData.JobOwner)
+	return true
+}
+
 type execData struct {
 	out      string
 	execTime time.Duration
@@ -89,44 +191,45 @@ func runTests(path string, runner Runner, info *AssignmentInfo, rData *RunData)
 func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunData, result *Result) {
 	buildInfo, scores, err := result.Marshal()
 	if err != nil {
-		logger.Errorf("Failed to marshal build info and scores: %w", err)
+		logger.Errorf("Failed to marshal build info and scores: %v", err)
 		return
 	}
 
 	logger.Debugf("Fetching most recent submission for assignment %d", rData.Assignment.GetID())
-	submissionQuery := &pb.Submission{
-		AssignmentID: rData.Assignment.GetID(),
-		UserID:       rData.Repo.GetUserID(),
-		GroupID:      rData.Repo.GetGroupID(),
-	}
-	newest, err := db.GetSubmission(submissionQuery)
+	newest, err := db.GetSubmission(rData.submissionQuery())
 	if err != nil && err != gorm.ErrRecordNotFound {
-		logger.Errorf("Failed to get submission data from database: %w", err)
+		logger.Errorf("Failed to get submission data from database: %v", err)
 		return
 	}
-	// keep approved status if already approved
-	approvedStatus := newest.GetStatus()
-	if rData.Assignment.AutoApprove && result.TotalScore() >= rData.Assignment.GetScoreLimit() {
-		approvedStatus = pb.Submission_APPROVED
+	newSubmission := &pb.Submission{
+		AssignmentID:         rData.Assignment.ID,
+		BuildInfo:            buildInfo,
+		CommitHash:           rData.CommitID,
+		RawScore:             result.TotalScore(),
+		ScoreObjects:         scores,
+		UserID:               rData.Repo.GetUserID(),
+		GroupID:              rData.Repo.GetGroupID(),
+		GradingConfigVersion: rData.Course.GetGradingConfigVersion(),
 	}
+	applyLatePenalty(logger, rData.Assignment, newSubmission, result.BuildInfo.BuildDate)
 
-	score := result.TotalScore()
-	newSubmission := &pb.Submission{
-		AssignmentID: rData.Assignment.ID,
-		BuildInfo:    buildInfo,
-		CommitHash:   rData.CommitID,
-		Score:        score,
-		ScoreObjects: scores,
-		UserID:       rData.Repo.GetUserID(),
-		GroupID:      rData.Repo.GetGroupID(),
-		Status:       approvedStatus,
+	newSubmission.Attempts = newest.GetAttempts()
+	newSubmission.ExtraAttempts = newest.GetExtraAttempts()
+	if !rData.Rebuild {
+		newSubmission.Attempts++
+	}
+
+	// keep approved or rejected status set by a teacher
+	newSubmission.Status = newest.GetStatus()
+	if !rData.Course.HasFeature(pb.Course_MANUAL_GRADING) && rData.Assignment.IsApproved(newest, newSubmission.GetScore()) {
+		newSubmission.Status = pb.Submission_APPROVED
 	}
 	err = db.CreateSubmission(newSubmission)
 	if err != nil {
-		logger.Errorf("Failed to add submission to database: %w", err)
+		logger.Errorf("Failed to add submission to database: %v", err)
 		return
 	}
-	logger.Debugf("Created submission for assignment '%s' with status %s", rData.Assignment.GetName(), approvedStatus)
+	logger.Debugf("Created submission for assignment '%s' with status %s", rData.Assignment.GetName(), newSubmission.GetStatus())
 	updateSlipDays(logger, db, rData.Assignment, newSubmission, result.BuildInfo.BuildDate)
 }
 
@@ -139,6 +242,20 @@ func randomSecret() string {
 	return fmt.Sprintf("%x", sha1.Sum(randomness))
 }
 
+// applyLatePenalty sets the submission's score to its raw score reduced by
+// the assignment's late penalty for submissions built after the deadline.
+func applyLatePenalty(logger *zap.SugaredLogger, assignment *pb.Assignment, submission *pb.Submission, buildDate string) {
+	buildTime, err := time.Parse(layout, buildDate)
+	if err != nil {
+		logger.Errorf("Failed to parse time from string (%s)", buildDate)
+	}
+	penalty, err := assignment.LatePenaltyAt(buildTime)
+	if err != nil {
+		logger.Errorf("Failed to compute late penalty for assignment %d: %v", assignment.GetID(), err)
+	}
+	submission.ApplyLatePenalty(penalty)
+}
+
 func updateSlipDays(logger *zap.SugaredLogger, db database.Database, assignment *pb.Assignment, submission *pb.Submission, buildDate string) {
 	buildTime, err := time.Parse(layout, buildDate)
 	if err != nil {
@@ -149,14 +266,14 @@ func updateSlipDays(logger *zap.SugaredLogger, db database.Database, assignment
 	if submission.GroupID > 0 {
 		group, err := db.GetGroup(submission.GroupID)
 		if err != nil {
-			logger.Errorf("Failed to get group %d: %w", submission.GroupID, err)
+			logger.Errorf("Failed to get group %d: %v", submission.GroupID, err)
 			return
 		}
 		enrollments = append(enrollments, group.Enrollments...)
 	} else {
 		enrol, err := db.GetEnrollmentByCourseAndUser(assignment.CourseID, submission.UserID)
 		if err != nil {
-			logger.Errorf("Failed to get enrollment for user %d: %w", submission.UserID, err)
+			logger.Errorf("Failed to get enrollment for user %d: %v", submission.UserID, err)
 			return
 		}
 		enrollments = append(enrollments, enrol)
@@ -164,11 +281,11 @@ func updateSlipDays(logger *zap.SugaredLogger, db database.Database, assignment
 
 	for _, enrol := range enrollments {
 		if err := enrol.UpdateSlipDays(buildTime, assignment, submission); err != nil {
-			logger.Errorf("Failed updating slip days for submission ID (%d): %w", submission.ID, err)
+			logger.Errorf("Failed updating slip days for submission ID (%d): %v", submission.ID, err)
 			return
 		}
 		if err := db.UpdateSlipDays(enrol.UsedSlipDays); err != nil {
-			logger.Errorf("Failed to update slip days (enrollment ID %d): %w", enrol.GetID(), err)
+			logger.Errorf("Failed to update slip days (enrollment ID %d): %v", enrol.GetID(), err)
 			return
 		}
 	}
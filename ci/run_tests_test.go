@@ -4,10 +4,16 @@ import (
 	"crypto/rand"
 	"crypto/sha1"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"go.uber.org/zap"
+
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
 )
 
 const (
@@ -59,3 +65,60 @@ func TestRunTests(t *testing.T) {
 	}
 	t.Logf("\n%s\nExecTime: %v\nSecret: %v\n", ed.out, ed.execTime, info.RandomSecret)
 }
+
+func TestRunTestsMaxAttemptsReached(t *testing.T) {
+	f, err := ioutil.TempFile(os.TempDir(), "testdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+	db, err := database.NewGormDB("sqlite3", f.Name(), database.NewGormLogger(database.BuildLogger()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	user := &pb.User{}
+	if err := db.CreateUserFromRemoteIdentity(user, &pb.RemoteIdentity{Provider: "fake", RemoteID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	course := &pb.Course{}
+	if err := db.CreateCourse(user.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, MaxAttempts: 2}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateSubmission(&pb.Submission{
+		AssignmentID: assignment.ID,
+		UserID:       user.ID,
+		Score:        60,
+		CommitHash:   "abc",
+		Attempts:     2,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the runner must not be used when no attempts are left
+	rData := &RunData{
+		Course:     course,
+		Assignment: assignment,
+		Repo:       &pb.Repository{UserID: user.ID},
+		CommitID:   "def",
+		JobOwner:   "student",
+	}
+	RunTests(zap.NewNop().Sugar(), db, nil, rData)
+
+	submission, err := db.GetSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: user.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if submission.GetCommitHash() != "def" || submission.GetScore() != 60 || submission.GetAttempts() != 2 {
+		t.Errorf("have submission %+v, want commit 'def', score 60 and 2 attempts", submission)
+	}
+	if !strings.Contains(submission.GetBuildInfo(), maxAttemptsReached) {
+		t.Errorf("have build info %q, want it to contain %q", submission.GetBuildInfo(), maxAttemptsReached)
+	}
+}
@@ -11,6 +11,7 @@ ASSIGNDIR=$ASSIGNMENTS/{{ .AssignmentName }}/
 
 # Fetch student and test repos
 git clone {{ .GetURL }} $ASSIGNMENTS
+{{ if .Checkout }}git -C $ASSIGNMENTS checkout {{ .Checkout }}{{ end }}
 git clone {{ .TestURL }} $TESTDIR
 
 if [ ! -d "$ASSIGNDIR" ]; then
@@ -7,6 +7,7 @@ git config --global url."https://{{ .CreatorAccessToken }}:x-oauth-basic@github.
 ls
 
 git clone  {{ .GetURL }} /home/gradle/user  
+{{ if .Checkout }}git -C /home/gradle/user checkout {{ .Checkout }}{{ end }}
 git clone  {{ .TestURL }} /home/gradle/test
 
 cat <<EOF> /home/gradle/.gradle/gradle.properties
@@ -7,6 +7,7 @@ cd /root/
 export PYTHONPATH="/root"
 
 git clone {{ .GetURL }} user
+{{ if .Checkout }}git -C user checkout {{ .Checkout }}{{ end }}
 git clone {{ .TestURL }} test
 
 history -c
@@ -407,7 +407,7 @@ func createHook(client *scm.SCM) cli.ActionFunc {
 	ctx := context.Background()
 
 	return func(c *cli.Context) error {
-		return (*client).CreateHook(ctx, &scm.CreateHookOptions{
+		hook, err := (*client).CreateHook(ctx, &scm.CreateHookOptions{
 			URL:    c.String("url"),
 			Secret: c.String("secret"),
 			Repository: &scm.Repository{
@@ -416,6 +416,11 @@ func createHook(client *scm.SCM) cli.ActionFunc {
 				Owner: c.String("owner"),
 			},
 		})
+		if err != nil {
+			return err
+		}
+		log.Printf("Created hook %d: %s", hook.ID, hook.URL)
+		return nil
 	}
 }
 
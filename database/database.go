@@ -36,29 +36,74 @@ type Database interface {
 	GetCourse(uint64, bool) (*pb.Course, error)
 	// GetCourseByOrganizationID fetches course by organization ID.
 	GetCourseByOrganizationID(organizationID uint64) (*pb.Course, error)
+	// GetCourseBySlug fetches course by slug.
+	GetCourseBySlug(slug string) (*pb.Course, error)
 	// GetCourses returns a list of courses. If one or more course IDs are provided,
 	// the corresponding courses are returned. Otherwise, all courses are returned.
 	GetCourses(...uint64) ([]*pb.Course, error)
+	// GetPublicCourses returns the courses that are neither private nor archived, ordered by year and name.
+	GetPublicCourses() ([]*pb.Course, error)
 	// GetCoursesByUser returns all courses (with enrollment status)
 	// for the given user id.
 	// If enrollment statuses is provided, the set of courses returned
 	// is filtered according to these enrollment statuses.
 	GetCoursesByUser(userID uint64, statuses ...pb.Enrollment_UserStatus) ([]*pb.Course, error)
-	// UpdateCourse updates course information.
+	// UpdateCourse updates the course information given by the non-zero fields of the course.
 	UpdateCourse(*pb.Course) error
+	// UpdateCoursePrivate updates whether the given course is private.
+	UpdateCoursePrivate(courseID uint64, private bool) error
+	// UpdateCourseArchived updates whether the given course is archived.
+	UpdateCourseArchived(courseID uint64, archived bool) error
+	// UpdateCourseFeatures updates the feature flags of the given course.
+	UpdateCourseFeatures(courseID uint64, features uint32) error
+	// UpdateCourseHookID updates the organization webhook ID of the given course.
+	UpdateCourseHookID(courseID, hookID uint64) error
+	// BumpGradingConfigVersion increments the grading configuration version
+	// of the given course and returns the new version.
+	BumpGradingConfigVersion(courseID uint64) (uint32, error)
 
 	// CreateEnrollment creates a new pending enrollment.
 	CreateEnrollment(*pb.Enrollment) error
 	// RejectEnrollment removes the user enrollment from the database
 	RejectEnrollment(userID, courseID uint64) error
+	// RejectEnrollmentWithReason keeps the enrollment with status NONE and the given reason,
+	// so that the user can see why the enrollment was rejected.
+	RejectEnrollmentWithReason(userID, courseID uint64, reason string) error
+	// RejectPendingEnrollments rejects all pending enrollments in the given course with the given reason,
+	// and returns the number of rejected enrollments.
+	RejectPendingEnrollments(courseID uint64, reason string) (uint32, error)
 	// UpdateEnrollmentStatus changes status of the course enrollment for the given user and course.
 	UpdateEnrollment(*pb.Enrollment) error
+	// UpdateEnrollmentGroup moves the user's enrollment in the given course to the given group.
+	UpdateEnrollmentGroup(courseID, userID, groupID uint64) error
+	// EnrollStudent stores the student's repository and updated enrollment in a single transaction.
+	EnrollStudent(enrol *pb.Enrollment, repo *pb.Repository) error
 	// GetEnrollmentByCourseAndUser returns a user enrollment for the given course ID.
 	GetEnrollmentByCourseAndUser(courseID uint64, userID uint64) (*pb.Enrollment, error)
 	// GetEnrollmentsByCourse fetches all course enrollments with given statuses.
 	GetEnrollmentsByCourse(courseID uint64, statuses ...pb.Enrollment_UserStatus) ([]*pb.Enrollment, error)
+	// GetEnrollmentsByCourseSince fetches the pending, student and teacher enrollments
+	// of the given course that were made at or after since, without preloading associations.
+	GetEnrollmentsByCourseSince(courseID uint64, since string) ([]*pb.Enrollment, error)
+	// GetGroupMemberEnrollmentsByCourse fetches the course enrollments with given statuses
+	// of users that are members of a group, ordered by group.
+	GetGroupMemberEnrollmentsByCourse(courseID uint64, statuses ...pb.Enrollment_UserStatus) ([]*pb.Enrollment, error)
+	// GetEnrollmentCountsByCourse returns the number of course enrollments for each enrollment status.
+	GetEnrollmentCountsByCourse(courseID uint64) (map[pb.Enrollment_UserStatus]uint32, error)
+	// GetEnrollmentCountByCourse returns the number of course enrollments with the given status.
+	GetEnrollmentCountByCourse(courseID uint64, status pb.Enrollment_UserStatus) (uint32, error)
 	// GetEnrollmentsByUser fetches all enrollments for the given user
 	GetEnrollmentsByUser(userID uint64, statuses ...pb.Enrollment_UserStatus) ([]*pb.Enrollment, error)
+	// AssignGrader assigns the given students to the given grader in the given course,
+	// replacing the students' previous grader.
+	AssignGrader(courseID, graderID uint64, studentIDs []uint64) error
+	// GetGraderStudents returns the IDs of the students assigned to the given grader in the given course.
+	GetGraderStudents(courseID, graderID uint64) ([]uint64, error)
+
+	// CreateSCMAuditEntry records an SCM operation performed for a course.
+	CreateSCMAuditEntry(*pb.SCMAuditEntry) error
+	// GetSCMAuditLog returns the SCM operations performed for the given course, oldest first.
+	GetSCMAuditLog(courseID uint64) ([]*pb.SCMAuditEntry, error)
 
 	// CreateGroup creates a new group and assign users to newly created group.
 	CreateGroup(*pb.Group) error
@@ -103,8 +148,29 @@ type Database interface {
 	GetSubmission(query *pb.Submission) (*pb.Submission, error)
 	// GetLastSubmissions returns a list of submission entries for the given course, matching the given query.
 	GetLastSubmissions(courseID uint64, query *pb.Submission) ([]*pb.Submission, error)
+	// GetFilteredLastSubmissions is like GetLastSubmissions, but returns only
+	// approved or unapproved submissions, as specified by the filter, in the given order.
+	GetFilteredLastSubmissions(courseID uint64, query *pb.Submission, filter pb.SubmissionRequest_Filter, order pb.SubmissionRequest_Order) ([]*pb.Submission, error)
+	// GetAssignmentLastSubmissions returns the latest submission of each user and group for the given assignment.
+	GetAssignmentLastSubmissions(assignmentID uint64) ([]*pb.Submission, error)
+	// GetStudentLastSubmissions returns the user's latest submission for each individual assignment
+	// of the given course, and the group's latest submission for each group assignment.
+	GetStudentLastSubmissions(courseID, userID, groupID uint64) ([]*pb.Submission, error)
 	// GetSubmissions returns all submissions matching the query.
 	GetSubmissions(*pb.Submission) ([]*pb.Submission, error)
+	// GetSubmissionHistory returns the builds of the submissions matching the query, oldest first.
+	GetSubmissionHistory(*pb.Submission) ([]*pb.SubmissionBuild, error)
+	// GetSubmissionsByCourseSince returns the submissions for the given course's assignments
+	// that were built or approved at or after since, without their reviews.
+	GetSubmissionsByCourseSince(courseID uint64, since string) ([]*pb.Submission, error)
+	// GetSubmissionsNeedingReview returns the submissions for the given course's assignments
+	// that are flagged for manual review, ordered by assignment.
+	GetSubmissionsNeedingReview(courseID uint64) ([]*pb.Submission, error)
+	// GetSubmissionsByCommit returns the submissions of the query's user and group for the given
+	// course's assignments whose commit hash starts with the given prefix.
+	GetSubmissionsByCommit(courseID uint64, query *pb.Submission, commitPrefix string) ([]*pb.Submission, error)
+	// GetCourseGrades returns the grades of all students in the given course.
+	GetCourseGrades(courseID uint64) ([]*pb.Grade, error)
 	// GetCourseAssignment returns a list of all the latest submissions
 	// for every active course assignment for the given course ID
 	GetCourseAssignmentsWithSubmissions(uint64, pb.SubmissionsForCourseRequest_Type) ([]*pb.Assignment, error)
@@ -112,12 +178,19 @@ type Database interface {
 	UpdateSubmission(*pb.Submission) error
 	// UpdateSubmissions releases and/or approves all submissions with a certain score
 	UpdateSubmissions(uint64, *pb.Submission) error
+	// ApproveSubmissions approves the submissions with the given IDs for the given assignment
+	// in a single transaction, and returns the IDs of the submissions that were found.
+	ApproveSubmissions(assignmentID uint64, submissionIDs []uint64, approvedDate string) ([]uint64, error)
 	// CreateReview adds a new submission review.
 	CreateReview(*pb.Review) error
 	// UpdateReview updates the given review.
 	UpdateReview(*pb.Review) error
 	// DeleteReview removes all review records matching the query.
 	DeleteReview(*pb.Review) error
+	// CreateSubmissionComment adds a new comment to a submission.
+	CreateSubmissionComment(*pb.SubmissionComment) error
+	// GetSubmissionComments returns the comments on the given submission, oldest first.
+	GetSubmissionComments(submissionID uint64) ([]*pb.SubmissionComment, error)
 
 	// CreateRepository creates a new repository.
 	CreateRepository(repo *pb.Repository) error
@@ -125,6 +198,8 @@ type Database interface {
 	GetRepositoryByRemoteID(uint64) (*pb.Repository, error)
 	// GetRepositories returns repositories that match the given query.
 	GetRepositories(query *pb.Repository) ([]*pb.Repository, error)
+	// UpdateRepository updates the given repository.
+	UpdateRepository(*pb.Repository) error
 	// DeleteRepository deletes repository by the given provider's ID
 	DeleteRepositoryByRemoteID(uint64) error
 
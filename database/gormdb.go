@@ -23,6 +23,9 @@ var (
 	// ErrCourseExists is returned when trying to create an association in
 	// the database for a DirectoryId that already exists in the database.
 	ErrCourseExists = errors.New("course already exists on git provider")
+	// ErrDuplicateCourseSlug is returned when trying to create or update a course
+	// with the same slug as a previously registered course.
+	ErrDuplicateCourseSlug = status.Error(codes.InvalidArgument, "course with this slug already registered")
 	// ErrInsufficientAccess is returned when trying to update database
 	// with insufficient access privileges.
 	ErrInsufficientAccess = errors.New("user must be admin to perform this operation")
@@ -57,15 +60,25 @@ func NewGormDB(driver, path string, logger GormLogger) (*GormDB, error) {
 		&pb.Enrollment{},
 		&pb.Assignment{},
 		&pb.Submission{},
+		&pb.SubmissionBuild{},
 		&pb.Group{},
 		&pb.Repository{},
 		&pb.UsedSlipDays{},
 		&pb.GradingBenchmark{},
 		&pb.GradingCriterion{},
 		&pb.Review{},
+		&pb.SubmissionComment{},
+		&pb.GraderAssignment{},
+		&pb.SCMAuditEntry{},
 	).Error; err != nil {
 		return nil, err
 	}
+	if err := backfillBuildDates(conn); err != nil {
+		return nil, err
+	}
+	if err := backfillSubmissionBuilds(conn); err != nil {
+		return nil, err
+	}
 
 	return &GormDB{conn}, nil
 }
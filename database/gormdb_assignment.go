@@ -42,6 +42,11 @@ func (db *GormDB) CreateAssignment(assignment *pb.Assignment) error {
 			"reviewers":         assignment.Reviewers,
 			"container_timeout": assignment.ContainerTimeout,
 			"skip_tests":        assignment.SkipTests,
+			"late_penalty":      assignment.LatePenalty,
+			"max_late_penalty":  assignment.MaxLatePenalty,
+			"prerequisite":      assignment.Prerequisite,
+			"max_attempts":      assignment.MaxAttempts,
+			"category":          assignment.Category,
 		}).FirstOrCreate(assignment).Error
 }
 
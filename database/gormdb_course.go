@@ -2,6 +2,7 @@ package database
 
 import (
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/jinzhu/gorm"
 )
 
 // CreateCourse creates a new course if user with given ID is admin, enrolls user as course teacher.
@@ -23,6 +24,9 @@ func (db *GormDB) CreateCourse(userID uint64, course *pb.Course) error {
 	if courses > 0 {
 		return ErrCourseExists
 	}
+	if err := db.checkCourseSlug(course); err != nil {
+		return err
+	}
 
 	//TODO(meling) these db updates should be done as a transaction
 	if err := db.conn.Create(course).Error; err != nil {
@@ -98,6 +102,18 @@ func (db *GormDB) GetCourses(courseIDs ...uint64) ([]*pb.Course, error) {
 	return courses, nil
 }
 
+// GetPublicCourses returns the courses that are neither private nor archived,
+// ordered from the most recent year, and by name within each year.
+func (db *GormDB) GetPublicCourses() ([]*pb.Course, error) {
+	var courses []*pb.Course
+	if err := db.conn.Where("private = ? AND archived = ?", false, false).
+		Order("year DESC").Order("name").
+		Find(&courses).Error; err != nil {
+		return nil, err
+	}
+	return courses, nil
+}
+
 // GetCoursesByUser returns all courses (with enrollment status)
 // for the given user id.
 // If enrollment statuses is provided, the set of courses returned
@@ -135,7 +151,104 @@ func (db *GormDB) GetCoursesByUser(userID uint64, statuses ...pb.Enrollment_User
 	return courses, nil
 }
 
-// UpdateCourse updates course information.
+// UpdateCourse updates the course information given by the non-zero fields of the course.
+// The private and archived flags are only changed by UpdateCoursePrivate and UpdateCourseArchived,
+// such that a partial update does not make a private course public or unarchive a course.
 func (db *GormDB) UpdateCourse(course *pb.Course) error {
-	return db.conn.Model(&pb.Course{}).Updates(course).Error
+	if err := db.checkCourseSlug(course); err != nil {
+		return err
+	}
+	// the grading configuration version is only changed by BumpGradingConfigVersion,
+	// and the organization hook ID is only changed by UpdateCourseHookID
+	return db.conn.Model(&pb.Course{}).Omit("grading_config_version", "hook_id", "private", "archived").Updates(course).Error
+}
+
+// UpdateCoursePrivate updates whether the given course is private.
+func (db *GormDB) UpdateCoursePrivate(courseID uint64, private bool) error {
+	// GORM doesn't update zero value fields, unless forced:
+	return db.conn.Model(&pb.Course{ID: courseID}).Update("private", private).Error
+}
+
+// UpdateCourseArchived updates whether the given course is archived.
+func (db *GormDB) UpdateCourseArchived(courseID uint64, archived bool) error {
+	// GORM doesn't update zero value fields, unless forced:
+	return db.conn.Model(&pb.Course{ID: courseID}).Update("archived", archived).Error
+}
+
+// GetCourseBySlug fetches course by slug.
+func (db *GormDB) GetCourseBySlug(slug string) (*pb.Course, error) {
+	var course pb.Course
+	if err := db.conn.First(&course, &pb.Course{Slug: slug}).Error; err != nil {
+		return nil, err
+	}
+	db.updateAccessTokenCache(&course)
+	return &course, nil
+}
+
+// checkCourseSlug returns ErrDuplicateCourseSlug if another course
+// is registered with the same slug as the given course.
+func (db *GormDB) checkCourseSlug(course *pb.Course) error {
+	if course.GetSlug() == "" {
+		return nil
+	}
+	var courses uint64
+	if err := db.conn.Model(&pb.Course{}).
+		Where("slug = ? AND id <> ?", course.GetSlug(), course.GetID()).
+		Count(&courses).Error; err != nil {
+		return err
+	}
+	if courses > 0 {
+		return ErrDuplicateCourseSlug
+	}
+	return nil
+}
+
+// UpdateCourseFeatures updates the feature flags of the given course.
+func (db *GormDB) UpdateCourseFeatures(courseID uint64, features uint32) error {
+	// GORM doesn't update zero value fields, unless forced:
+	return db.conn.Model(&pb.Course{ID: courseID}).Update("features", features).Error
+}
+
+// UpdateCourseHookID updates the organization webhook ID of the given course.
+func (db *GormDB) UpdateCourseHookID(courseID, hookID uint64) error {
+	// GORM doesn't update zero value fields, unless forced:
+	return db.conn.Model(&pb.Course{ID: courseID}).Update("hook_id", hookID).Error
+}
+
+// BumpGradingConfigVersion increments the grading configuration version
+// of the given course and returns the new version.
+func (db *GormDB) BumpGradingConfigVersion(courseID uint64) (uint32, error) {
+	tx := db.conn.Begin()
+	query := tx.Model(&pb.Course{ID: courseID}).
+		Update("grading_config_version", gorm.Expr("grading_config_version + ?", 1))
+	if query.Error != nil {
+		tx.Rollback()
+		return 0, query.Error
+	}
+	if query.RowsAffected != 1 {
+		tx.Rollback()
+		return 0, gorm.ErrRecordNotFound
+	}
+	var course pb.Course
+	if err := tx.Select("grading_config_version").First(&course, courseID).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	return course.GetGradingConfigVersion(), tx.Commit().Error
+}
+
+// CreateSCMAuditEntry records an SCM operation performed for a course.
+func (db *GormDB) CreateSCMAuditEntry(entry *pb.SCMAuditEntry) error {
+	return withRetry(func() error {
+		return db.conn.Create(entry).Error
+	})
+}
+
+// GetSCMAuditLog returns the SCM operations performed for the given course, oldest first.
+func (db *GormDB) GetSCMAuditLog(courseID uint64) ([]*pb.SCMAuditEntry, error) {
+	var entries []*pb.SCMAuditEntry
+	if err := db.conn.Where(&pb.SCMAuditEntry{CourseID: courseID}).Order("id").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
 }
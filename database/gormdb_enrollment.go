@@ -24,7 +24,24 @@ func (db *GormDB) CreateEnrollment(enrollment *pb.Enrollment) error {
 
 	enrollment.Status = pb.Enrollment_PENDING
 	enrollment.State = pb.Enrollment_VISIBLE
-	return db.conn.Create(&enrollment).Error
+
+	// reuse an enrollment that was rejected with a reason, or that the user left
+	var rejected pb.Enrollment
+	err := db.conn.Where(&pb.Enrollment{CourseID: enrollment.CourseID, UserID: enrollment.UserID}).First(&rejected).Error
+	if err == nil && (rejected.Status == pb.Enrollment_NONE || rejected.Status == pb.Enrollment_LEFT) {
+		enrollment.ID = rejected.ID
+		return withRetry(func() error {
+			return db.conn.Model(&rejected).Updates(map[string]interface{}{
+				"status":        enrollment.Status,
+				"state":         enrollment.State,
+				"reject_reason": "",
+				"enrolled_date": enrollment.EnrolledDate,
+			}).Error
+		})
+	}
+	return withRetry(func() error {
+		return db.conn.Create(&enrollment).Error
+	})
 }
 
 // RejectEnrollment removes the user enrollment from the database.
@@ -33,16 +50,98 @@ func (db *GormDB) RejectEnrollment(userID, courseID uint64) error {
 	if err != nil {
 		return err
 	}
-	return db.conn.Delete(enrol).Error
+	return withRetry(func() error {
+		return db.conn.Delete(enrol).Error
+	})
+}
+
+// RejectEnrollmentWithReason sets the status of the user enrollment to NONE
+// and records the reason for rejecting the enrollment.
+func (db *GormDB) RejectEnrollmentWithReason(userID, courseID uint64, reason string) error {
+	enrol, err := db.GetEnrollmentByCourseAndUser(courseID, userID)
+	if err != nil {
+		return err
+	}
+	// GORM doesn't update zero value fields, unless forced:
+	return withRetry(func() error {
+		return db.conn.Model(enrol).Updates(map[string]interface{}{
+			"status":        pb.Enrollment_NONE,
+			"reject_reason": reason,
+		}).Error
+	})
+}
+
+// RejectPendingEnrollments sets the status of all pending enrollments in the given course
+// to NONE and records the reason for rejecting them. The enrollments are updated by
+// a single statement, such that either all or none of them are rejected.
+func (db *GormDB) RejectPendingEnrollments(courseID uint64, reason string) (uint32, error) {
+	var rejected int64
+	err := withRetry(func() error {
+		// GORM doesn't update zero value fields, unless forced:
+		result := db.conn.Model(&pb.Enrollment{}).
+			Where("course_id = ? AND status = ?", courseID, pb.Enrollment_PENDING).
+			Updates(map[string]interface{}{
+				"status":        pb.Enrollment_NONE,
+				"reject_reason": reason,
+			})
+		rejected = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return uint32(rejected), nil
 }
 
 // UpdateEnrollment changes status and display state of the given enrollment.
 func (db *GormDB) UpdateEnrollment(enrol *pb.Enrollment) error {
-	return db.conn.Model(&pb.Enrollment{}).
+	return withRetry(func() error {
+		return updateEnrollment(db.conn, enrol)
+	})
+}
+
+// updateEnrollment changes status and display state of the given enrollment
+// using the given connection, which may be a transaction.
+func updateEnrollment(conn *gorm.DB, enrol *pb.Enrollment) error {
+	return conn.Model(&pb.Enrollment{}).
 		Where(&pb.Enrollment{CourseID: enrol.CourseID, UserID: enrol.UserID}).
 		Update(&pb.Enrollment{State: enrol.State, Status: enrol.Status, LastActivityDate: enrol.LastActivityDate}).Error
 }
 
+// UpdateEnrollmentGroup moves the user's enrollment in the given course to the given group.
+func (db *GormDB) UpdateEnrollmentGroup(courseID, userID, groupID uint64) error {
+	return withRetry(func() error {
+		query := db.conn.Model(&pb.Enrollment{}).
+			Where(&pb.Enrollment{CourseID: courseID, UserID: userID}).
+			Update("group_id", groupID)
+		if query.Error != nil {
+			return query.Error
+		}
+		if query.RowsAffected != 1 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// EnrollStudent creates the record of the student's repository and updates
+// the student's enrollment in a single transaction, such that either both
+// or neither are stored.
+func (db *GormDB) EnrollStudent(enrol *pb.Enrollment, repo *pb.Repository) error {
+	return withRetry(func() error {
+		tx := db.conn.Begin()
+		if err := createRepository(tx, repo); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := updateEnrollment(tx, enrol); err != nil {
+			tx.Rollback()
+			return err
+		}
+		return tx.Commit().Error
+	})
+}
+
 // GetEnrollmentByCourseAndUser returns a user enrollment for the given course ID.
 func (db *GormDB) GetEnrollmentByCourseAndUser(courseID uint64, userID uint64) (*pb.Enrollment, error) {
 	var enrollment pb.Enrollment
@@ -63,11 +162,121 @@ func (db *GormDB) GetEnrollmentsByCourse(courseID uint64, statuses ...pb.Enrollm
 	return db.getEnrollments(&pb.Course{ID: courseID}, statuses...)
 }
 
+// GetEnrollmentsByCourseSince fetches the pending, student and teacher enrollments
+// of the given course that were made at or after since, without preloading associations.
+func (db *GormDB) GetEnrollmentsByCourseSince(courseID uint64, since string) ([]*pb.Enrollment, error) {
+	var enrollments []*pb.Enrollment
+	if err := db.conn.
+		Where("course_id = ? AND enrolled_date >= ?", courseID, since).
+		Where("status in (?)", []pb.Enrollment_UserStatus{pb.Enrollment_PENDING, pb.Enrollment_STUDENT, pb.Enrollment_TEACHER}).
+		Find(&enrollments).Error; err != nil {
+		return nil, err
+	}
+	return enrollments, nil
+}
+
+// GetGroupMemberEnrollmentsByCourse fetches the course enrollments with given statuses
+// of users that are members of a group, ordered by group, and by enrollment within each group.
+func (db *GormDB) GetGroupMemberEnrollmentsByCourse(courseID uint64, statuses ...pb.Enrollment_UserStatus) ([]*pb.Enrollment, error) {
+	if len(statuses) == 0 {
+		statuses = []pb.Enrollment_UserStatus{
+			pb.Enrollment_PENDING,
+			pb.Enrollment_STUDENT,
+			pb.Enrollment_TEACHER,
+		}
+	}
+	var enrollments []*pb.Enrollment
+	if err := db.conn.Preload("User").
+		Preload("Course").
+		Preload("Group").
+		Preload("UsedSlipDays").
+		Where("course_id = ? AND group_id > 0 AND status in (?)", courseID, statuses).
+		Order("group_id").Order("id").
+		Find(&enrollments).Error; err != nil {
+		return nil, err
+	}
+	return enrollments, nil
+}
+
+// GetEnrollmentCountsByCourse returns the number of course enrollments for each enrollment status.
+func (db *GormDB) GetEnrollmentCountsByCourse(courseID uint64) (map[pb.Enrollment_UserStatus]uint32, error) {
+	rows, err := db.conn.Model(&pb.Enrollment{}).
+		Select("status, count(*)").
+		Where(&pb.Enrollment{CourseID: courseID}).
+		Group("status").
+		Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[pb.Enrollment_UserStatus]uint32)
+	for rows.Next() {
+		var status pb.Enrollment_UserStatus
+		var count uint32
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, err
+		}
+		counts[status] = count
+	}
+	return counts, rows.Err()
+}
+
+// GetEnrollmentCountByCourse returns the number of course enrollments with the given status.
+func (db *GormDB) GetEnrollmentCountByCourse(courseID uint64, status pb.Enrollment_UserStatus) (uint32, error) {
+	var count uint32
+	if err := db.conn.Model(&pb.Enrollment{}).
+		Where("course_id = ? AND status = ?", courseID, status).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 // GetEnrollmentsByUser returns all existing enrollments for the given user
 func (db *GormDB) GetEnrollmentsByUser(userID uint64, statuses ...pb.Enrollment_UserStatus) ([]*pb.Enrollment, error) {
 	return db.getEnrollments(&pb.User{ID: userID}, statuses...)
 }
 
+// AssignGrader assigns the given students to the given grader in the given course,
+// replacing the students' previous grader, in a single transaction.
+func (db *GormDB) AssignGrader(courseID, graderID uint64, studentIDs []uint64) error {
+	if len(studentIDs) == 0 {
+		return nil
+	}
+	return withRetry(func() error {
+		tx := db.conn.Begin()
+		if err := tx.Where("course_id = ? AND student_id IN (?)", courseID, studentIDs).
+			Delete(&pb.GraderAssignment{}).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+		for _, studentID := range studentIDs {
+			if err := tx.Create(&pb.GraderAssignment{
+				CourseID:  courseID,
+				GraderID:  graderID,
+				StudentID: studentID,
+			}).Error; err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+		return tx.Commit().Error
+	})
+}
+
+// GetGraderStudents returns the IDs of the students assigned to the given grader in the given course.
+func (db *GormDB) GetGraderStudents(courseID, graderID uint64) ([]uint64, error) {
+	var studentIDs []uint64
+	if err := db.conn.Model(&pb.GraderAssignment{}).
+		Where(&pb.GraderAssignment{CourseID: courseID, GraderID: graderID}).
+		Order("student_id").
+		Pluck("student_id", &studentIDs).Error; err != nil {
+		return nil, err
+	}
+	return studentIDs, nil
+}
+
 // getEnrollments is generic helper function that return enrollments for either course and user.
 func (db *GormDB) getEnrollments(model interface{}, statuses ...pb.Enrollment_UserStatus) ([]*pb.Enrollment, error) {
 	if len(statuses) == 0 {
@@ -2,12 +2,21 @@ package database
 
 import (
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/jinzhu/gorm"
 )
 
 /// Repositories ///
 
 // CreateRepository creates a new repository record.
 func (db *GormDB) CreateRepository(repo *pb.Repository) error {
+	return withRetry(func() error {
+		return createRepository(db.conn, repo)
+	})
+}
+
+// createRepository creates a new repository record using the given connection,
+// which may be a transaction.
+func createRepository(conn *gorm.DB, repo *pb.Repository) error {
 	if repo.OrganizationID == 0 || repo.RepositoryID == 0 {
 		// both organization and repository must be non-zero
 		return ErrCreateRepo
@@ -16,13 +25,13 @@ func (db *GormDB) CreateRepository(repo *pb.Repository) error {
 	switch {
 	case repo.UserID > 0:
 		// check that user exists before creating repo in database
-		err := db.conn.First(&pb.User{}, repo.UserID).Error
+		err := conn.First(&pb.User{}, repo.UserID).Error
 		if err != nil {
 			return err
 		}
 	case repo.GroupID > 0:
 		// check that group exists before creating repo in database
-		err := db.conn.First(&pb.Group{}, repo.GroupID).Error
+		err := conn.First(&pb.Group{}, repo.GroupID).Error
 		if err != nil {
 			return err
 		}
@@ -31,7 +40,7 @@ func (db *GormDB) CreateRepository(repo *pb.Repository) error {
 		return ErrCreateRepo
 	}
 
-	return db.conn.Create(repo).Error
+	return conn.Create(repo).Error
 }
 
 // GetRepositoryByRemoteID fetches repository by provider's ID.
@@ -52,13 +61,25 @@ func (db *GormDB) GetRepositories(query *pb.Repository) ([]*pb.Repository, error
 	return repos, nil
 }
 
+// UpdateRepository updates the repository record.
+func (db *GormDB) UpdateRepository(repo *pb.Repository) error {
+	if err := db.conn.First(&pb.Repository{}, repo.GetID()).Error; err != nil {
+		return err
+	}
+	return withRetry(func() error {
+		return db.conn.Save(repo).Error
+	})
+}
+
 // DeleteRepositoryByRemoteID deletes repository by provider's ID
 func (db *GormDB) DeleteRepositoryByRemoteID(rid uint64) error {
 	repo, err := db.GetRepositoryByRemoteID(rid)
 	if err != nil {
 		return err
 	}
-	return db.conn.Delete(repo).Error
+	return withRetry(func() error {
+		return db.conn.Delete(repo).Error
+	})
 }
 
 // Close closes the gorm database.
@@ -1,6 +1,8 @@
 package database
 
 import (
+	"encoding/json"
+
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/jinzhu/gorm"
 )
@@ -9,6 +11,7 @@ import (
 // recent submission, as defined by the provided submissionQuery.
 // The submissionQuery must always specify the assignment, and may specify the ID of
 // either an individual student or a group, but not both.
+// Each call also records the submission's score as a new build in its history.
 func (db *GormDB) CreateSubmission(submission *pb.Submission) error {
 	// Primary key must be greater than 0.
 	if submission.AssignmentID < 1 {
@@ -63,6 +66,9 @@ func (db *GormDB) CreateSubmission(submission *pb.Submission) error {
 		return err
 	}
 
+	// The build date is kept in its own column, so that submissions can be queried by it.
+	submission.BuildDate = buildDate(submission.GetBuildInfo())
+
 	// If a submission for the given assignment and student/group already exists, update it.
 	// Otherwise create a new submission record
 	var labSubmission pb.Submission
@@ -72,8 +78,23 @@ func (db *GormDB) CreateSubmission(submission *pb.Submission) error {
 		// GORM doesn't update zero value fields, unless forced:
 		err = db.conn.Model(submission).Where(query).Updates(map[string]interface{}{"Score": 0}).Error
 	}
+	if err == nil && submission.GetRawScore() == 0 {
+		// reset raw score left over from a previous submission
+		err = db.conn.Model(submission).Where(query).Updates(map[string]interface{}{"RawScore": 0}).Error
+	}
 	submission.ID = labSubmission.GetID()
-	return err
+	if err != nil {
+		return err
+	}
+	return db.conn.Create(&pb.SubmissionBuild{
+		SubmissionID: submission.GetID(),
+		AssignmentID: submission.GetAssignmentID(),
+		UserID:       submission.GetUserID(),
+		GroupID:      submission.GetGroupID(),
+		Score:        submission.GetScore(),
+		CommitHash:   submission.GetCommitHash(),
+		BuildDate:    submission.GetBuildDate(),
+	}).Error
 }
 
 // GetSubmission fetches a submission record.
@@ -88,22 +109,77 @@ func (db *GormDB) GetSubmission(query *pb.Submission) (*pb.Submission, error) {
 // GetLastSubmissions returns all submissions for the active assignment for the given course.
 // The query may specify both UserID and GroupID to fetch both user and group submissions.
 func (db *GormDB) GetLastSubmissions(courseID uint64, query *pb.Submission) ([]*pb.Submission, error) {
+	return db.GetFilteredLastSubmissions(courseID, query, pb.SubmissionRequest_ALL, pb.SubmissionRequest_OLDEST)
+}
+
+// GetFilteredLastSubmissions returns the submissions for the given course matching the query,
+// like GetLastSubmissions, but only approved or unapproved submissions, as specified by the filter.
+// Submissions are ordered by when their record was created, newest or oldest first, as specified by order.
+func (db *GormDB) GetFilteredLastSubmissions(courseID uint64, query *pb.Submission, filter pb.SubmissionRequest_Filter, order pb.SubmissionRequest_Order) ([]*pb.Submission, error) {
 	var course pb.Course
 	if err := db.conn.Preload("Assignments").First(&course, courseID).Error; err != nil {
 		return nil, err
 	}
+	assignmentIDs := make([]uint64, len(course.Assignments))
+	for i, a := range course.Assignments {
+		assignmentIDs[i] = a.GetID()
+	}
+
+	// the most recent submission for each assignment has the highest ID
+	m := db.conn.Model(&pb.Submission{}).Select("MAX(id)").Where(query).Where("assignment_id IN (?)", assignmentIDs)
+	switch filter {
+	case pb.SubmissionRequest_APPROVED:
+		m = m.Where("status = ?", pb.Submission_APPROVED)
+	case pb.SubmissionRequest_UNAPPROVED:
+		m = m.Where("status <> ?", pb.Submission_APPROVED)
+	}
+	latest := m.Group("assignment_id").SubQuery()
 
+	orderBy := "id DESC"
+	if order == pb.SubmissionRequest_OLDEST {
+		orderBy = "id"
+	}
 	var latestSubs []*pb.Submission
-	for _, a := range course.Assignments {
-		query.AssignmentID = a.GetID()
-		temp, err := db.GetSubmission(query)
-		if err != nil {
-			if err == gorm.ErrRecordNotFound {
-				continue
-			}
-			return nil, err
-		}
-		latestSubs = append(latestSubs, temp)
+	if err := db.conn.Preload("Reviews").Where("id IN ?", latest).Order(orderBy).Find(&latestSubs).Error; err != nil {
+		return nil, err
+	}
+	return latestSubs, nil
+}
+
+// GetAssignmentLastSubmissions returns the latest submission of each user and group
+// for the given assignment. The submissions are fetched in a single query.
+func (db *GormDB) GetAssignmentLastSubmissions(assignmentID uint64) ([]*pb.Submission, error) {
+	// the most recent submission of each user and group has the highest ID
+	latest := db.conn.Table("submissions").
+		Select("MAX(id)").
+		Where("assignment_id = ?", assignmentID).
+		Group("user_id, group_id").
+		SubQuery()
+
+	var latestSubs []*pb.Submission
+	if err := db.conn.Preload("Reviews").Where("id IN ?", latest).Order("id").Find(&latestSubs).Error; err != nil {
+		return nil, err
+	}
+	return latestSubs, nil
+}
+
+// GetStudentLastSubmissions returns the user's latest submission for each individual assignment
+// of the given course, and the group's latest submission for each group assignment, if the
+// group ID is set. The submissions are fetched in a single query.
+func (db *GormDB) GetStudentLastSubmissions(courseID, userID, groupID uint64) ([]*pb.Submission, error) {
+	// the most recent submission for each assignment has the highest ID
+	latest := db.conn.Table("submissions").
+		Select("MAX(submissions.id)").
+		Joins("JOIN assignments ON assignments.id = submissions.assignment_id").
+		Where("assignments.course_id = ?", courseID).
+		Where("(assignments.is_group_lab = ? AND submissions.user_id = ?) OR (assignments.is_group_lab = ? AND submissions.group_id = ? AND submissions.group_id > 0)",
+			false, userID, true, groupID).
+		Group("submissions.assignment_id").
+		SubQuery()
+
+	var latestSubs []*pb.Submission
+	if err := db.conn.Preload("Reviews").Where("id IN ?", latest).Order("id").Find(&latestSubs).Error; err != nil {
+		return nil, err
 	}
 	return latestSubs, nil
 }
@@ -117,6 +193,124 @@ func (db *GormDB) GetSubmissions(query *pb.Submission) ([]*pb.Submission, error)
 	return submissions, nil
 }
 
+// GetSubmissionHistory returns the builds of the submissions matching the query,
+// ordered from the oldest to the most recent build. The query may specify the
+// assignment, user and group of the submissions.
+func (db *GormDB) GetSubmissionHistory(query *pb.Submission) ([]*pb.SubmissionBuild, error) {
+	var builds []*pb.SubmissionBuild
+	if err := db.conn.Where(&pb.SubmissionBuild{
+		AssignmentID: query.GetAssignmentID(),
+		UserID:       query.GetUserID(),
+		GroupID:      query.GetGroupID(),
+	}).Order("id").Find(&builds).Error; err != nil {
+		return nil, err
+	}
+	return builds, nil
+}
+
+// GetSubmissionsByCourseSince returns the submissions for the given course's assignments
+// that were built or approved at or after since, without their reviews.
+func (db *GormDB) GetSubmissionsByCourseSince(courseID uint64, since string) ([]*pb.Submission, error) {
+	var submissions []*pb.Submission
+	if err := db.conn.
+		Where("assignment_id IN (?)", db.conn.Table("assignments").Select("id").Where("course_id = ?", courseID).QueryExpr()).
+		Where("build_date >= ? OR approved_date >= ?", since, since).
+		Find(&submissions).Error; err != nil {
+		return nil, err
+	}
+	return submissions, nil
+}
+
+// GetSubmissionsNeedingReview returns the submissions for the given course's assignments
+// that are flagged for manual review, ordered by assignment, without their reviews.
+func (db *GormDB) GetSubmissionsNeedingReview(courseID uint64) ([]*pb.Submission, error) {
+	var submissions []*pb.Submission
+	if err := db.conn.
+		Joins("JOIN assignments ON assignments.id = submissions.assignment_id").
+		Where("assignments.course_id = ? AND submissions.needs_review = ?", courseID, true).
+		Order(`assignments."order"`).Order("submissions.id").
+		Find(&submissions).Error; err != nil {
+		return nil, err
+	}
+	return submissions, nil
+}
+
+// GetSubmissionsByCommit returns the submissions of the query's user and group for the given
+// course's assignments whose commit hash starts with the given prefix, without their reviews.
+func (db *GormDB) GetSubmissionsByCommit(courseID uint64, query *pb.Submission, commitPrefix string) ([]*pb.Submission, error) {
+	var submissions []*pb.Submission
+	if err := db.conn.
+		Where("assignment_id IN (?)", db.conn.Table("assignments").Select("id").Where("course_id = ?", courseID).QueryExpr()).
+		Where("user_id = ? AND group_id = ?", query.GetUserID(), query.GetGroupID()).
+		Where("commit_hash LIKE ? || '%'", commitPrefix).
+		Find(&submissions).Error; err != nil {
+		return nil, err
+	}
+	return submissions, nil
+}
+
+// buildDate returns the build date recorded in the given build info,
+// or the empty string if the build info has no valid build date.
+func buildDate(buildInfo string) string {
+	var info struct {
+		BuildDate string `json:"builddate"`
+	}
+	if err := json.Unmarshal([]byte(buildInfo), &info); err != nil {
+		return ""
+	}
+	return info.BuildDate
+}
+
+// backfillSubmissionBuilds records the current score of submissions that were stored
+// before their builds were kept as history, as the first build of each submission.
+func backfillSubmissionBuilds(conn *gorm.DB) error {
+	return conn.Exec(`INSERT INTO submission_builds (submission_id, assignment_id, user_id, group_id, score, commit_hash, build_date)
+		SELECT id, assignment_id, user_id, group_id, score, commit_hash, build_date FROM submissions
+		WHERE id NOT IN (SELECT submission_id FROM submission_builds)`).Error
+}
+
+// backfillBuildDates copies the build date from the build info of submissions
+// that were stored before the build date was kept in its own column.
+func backfillBuildDates(conn *gorm.DB) error {
+	var submissions []*pb.Submission
+	if err := conn.Select("id, build_info").
+		Where("build_date = '' OR build_date IS NULL").
+		Where("build_info <> ''").
+		Find(&submissions).Error; err != nil {
+		return err
+	}
+	for _, submission := range submissions {
+		date := buildDate(submission.GetBuildInfo())
+		if date == "" {
+			continue
+		}
+		if err := conn.Model(submission).Update("build_date", date).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetCourseGrades returns the grades of the latest submissions of all students
+// in the given course, ordered from the oldest to the most recent submission.
+// Group submissions are graded for each member of the group. Students
+// without submissions for an assignment have no grade for it.
+func (db *GormDB) GetCourseGrades(courseID uint64) ([]*pb.Grade, error) {
+	var grades []*pb.Grade
+	if err := db.conn.Table("enrollments").
+		Select("enrollments.user_id, submissions.assignment_id, submissions.score, submissions.status").
+		Joins("JOIN assignments ON assignments.course_id = enrollments.course_id").
+		Joins(`JOIN submissions ON submissions.assignment_id = assignments.id AND (
+			(assignments.is_group_lab = ? AND submissions.user_id = enrollments.user_id) OR
+			(assignments.is_group_lab = ? AND enrollments.group_id > 0 AND submissions.group_id = enrollments.group_id))`, false, true).
+		Where("enrollments.course_id = ? AND enrollments.status = ?", courseID, pb.Enrollment_STUDENT).
+		Order("submissions.id").
+		Scan(&grades).Error; err != nil {
+		return nil, err
+	}
+	return grades, nil
+}
+
 // UpdateSubmission updates submission with the given approved status.
 func (db *GormDB) UpdateSubmission(query *pb.Submission) error {
 	return db.conn.Save(query).Error
@@ -135,6 +329,42 @@ func (db *GormDB) UpdateSubmissions(courseID uint64, query *pb.Submission) error
 		}).Error
 }
 
+// ApproveSubmissions approves the submissions with the given IDs for the given assignment
+// in a single transaction, and returns the IDs of the submissions that were found.
+// Submissions of other assignments are left unchanged. Submissions that are already
+// approved keep their approval date.
+func (db *GormDB) ApproveSubmissions(assignmentID uint64, submissionIDs []uint64, approvedDate string) ([]uint64, error) {
+	if len(submissionIDs) == 0 {
+		return nil, nil
+	}
+	var found []uint64
+	err := withRetry(func() error {
+		found = nil
+		tx := db.conn.Begin()
+		if err := tx.Model(&pb.Submission{}).
+			Where("assignment_id = ? AND id IN (?)", assignmentID, submissionIDs).
+			Order("id").
+			Pluck("id", &found).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Model(&pb.Submission{}).
+			Where("assignment_id = ? AND id IN (?) AND status <> ?", assignmentID, submissionIDs, pb.Submission_APPROVED).
+			Updates(map[string]interface{}{
+				"status":        pb.Submission_APPROVED,
+				"approved_date": approvedDate,
+			}).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+		return tx.Commit().Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return found, nil
+}
+
 // CreateReview creates a new submission review
 func (db *GormDB) CreateReview(query *pb.Review) error {
 	return db.conn.Create(query).Error
@@ -156,3 +386,22 @@ func (db *GormDB) UpdateReview(query *pb.Review) error {
 func (db *GormDB) DeleteReview(query *pb.Review) error {
 	return db.conn.Delete(&pb.Review{}, &query).Error
 }
+
+// CreateSubmissionComment creates a new submission comment.
+func (db *GormDB) CreateSubmissionComment(comment *pb.SubmissionComment) error {
+	if comment.GetSubmissionID() == 0 || comment.GetUserID() == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return db.conn.Create(comment).Error
+}
+
+// GetSubmissionComments returns the comments on the given submission, oldest first.
+func (db *GormDB) GetSubmissionComments(submissionID uint64) ([]*pb.SubmissionComment, error) {
+	var comments []*pb.SubmissionComment
+	if err := db.conn.Where(&pb.SubmissionComment{SubmissionID: submissionID}).
+		Order("id").
+		Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
@@ -187,6 +187,61 @@ func TestGormDBGetNonExistingSubmissions(t *testing.T) {
 	}
 }
 
+func TestGormDBGetFilteredLastSubmissions(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 10)
+	var course pb.Course
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	user := createFakeUser(t, db, 11)
+	statuses := []pb.Submission_Status{pb.Submission_APPROVED, pb.Submission_NONE, pb.Submission_REVISION}
+	assignmentIDs := make([]uint64, len(statuses))
+	for i, status := range statuses {
+		assignment := pb.Assignment{CourseID: course.ID, Order: uint32(i + 1)}
+		if err := db.CreateAssignment(&assignment); err != nil {
+			t.Fatal(err)
+		}
+		assignmentIDs[i] = assignment.ID
+		if err := db.CreateSubmission(&pb.Submission{
+			AssignmentID: assignment.ID,
+			UserID:       user.ID,
+			Status:       status,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	newestFirst := []uint64{assignmentIDs[2], assignmentIDs[1], assignmentIDs[0]}
+
+	tests := []struct {
+		filter          pb.SubmissionRequest_Filter
+		order           pb.SubmissionRequest_Order
+		wantAssignments []uint64
+	}{
+		{pb.SubmissionRequest_ALL, pb.SubmissionRequest_OLDEST, assignmentIDs},
+		{pb.SubmissionRequest_APPROVED, pb.SubmissionRequest_OLDEST, assignmentIDs[:1]},
+		{pb.SubmissionRequest_UNAPPROVED, pb.SubmissionRequest_OLDEST, assignmentIDs[1:]},
+		{pb.SubmissionRequest_ALL, pb.SubmissionRequest_NEWEST, newestFirst},
+		{pb.SubmissionRequest_UNAPPROVED, pb.SubmissionRequest_NEWEST, newestFirst[:2]},
+	}
+	for _, test := range tests {
+		submissions, err := db.GetFilteredLastSubmissions(course.ID, &pb.Submission{UserID: user.ID}, test.filter, test.order)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var gotAssignments []uint64
+		for _, submission := range submissions {
+			gotAssignments = append(gotAssignments, submission.GetAssignmentID())
+		}
+		if !reflect.DeepEqual(gotAssignments, test.wantAssignments) {
+			t.Errorf("GetFilteredLastSubmissions(%s, %s) returned submissions for assignments %v, want %v", test.filter, test.order, gotAssignments, test.wantAssignments)
+		}
+	}
+}
+
 func TestGormDBInsertSubmissions(t *testing.T) {
 	db, cleanup := setup(t)
 	defer cleanup()
@@ -382,3 +437,97 @@ func TestGormDBGetInsertSubmissions(t *testing.T) {
 		t.Errorf("Expected '%v' elements in the array, got '%v'", 0, len(data))
 	}
 }
+
+func TestGormDBGetSubmissionsByCourseSince(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+	user, course, assignment := setupCourseAssignment(t, db)
+
+	assignment2 := &pb.Assignment{CourseID: course.ID, Order: 2}
+	if err := db.CreateAssignment(assignment2); err != nil {
+		t.Fatal(err)
+	}
+	built := &pb.Submission{AssignmentID: assignment.ID, UserID: user.ID, BuildInfo: `{"builddate": "2021-01-20T12:00:00"}`}
+	if err := db.CreateSubmission(built); err != nil {
+		t.Fatal(err)
+	}
+	approved := &pb.Submission{
+		AssignmentID: assignment2.ID,
+		UserID:       user.ID,
+		BuildInfo:    `{"builddate": "2021-01-10T12:00:00"}`,
+		Status:       pb.Submission_APPROVED,
+		ApprovedDate: "2021-01-25T12:00:00",
+	}
+	if err := db.CreateSubmission(approved); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		since string
+		want  []uint64
+	}{
+		{"", []uint64{built.ID, approved.ID}},
+		{"2021-01-20T12:00:00", []uint64{built.ID, approved.ID}},
+		{"2021-01-20T12:00:01", []uint64{approved.ID}},
+		{"2021-01-25T12:00:01", nil},
+	} {
+		submissions, err := db.GetSubmissionsByCourseSince(course.ID, test.since)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []uint64
+		for _, submission := range submissions {
+			got = append(got, submission.GetID())
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("GetSubmissionsByCourseSince(%q) mismatch (-want +got):\n%s", test.since, diff)
+		}
+	}
+	if built.GetBuildDate() != "2021-01-20T12:00:00" {
+		t.Errorf("have build date %q want %q", built.GetBuildDate(), "2021-01-20T12:00:00")
+	}
+}
+
+func TestGormDBGetSubmissionsNeedingReview(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+	user, course, assignment := setupCourseAssignment(t, db)
+
+	assignment2 := &pb.Assignment{CourseID: course.ID, Order: 2}
+	teachers, err := db.GetEnrollmentsByCourse(course.ID, pb.Enrollment_TEACHER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherCourse := &pb.Course{OrganizationID: 2, Code: "other"}
+	if err := db.CreateCourse(teachers[0].GetUserID(), otherCourse); err != nil {
+		t.Fatal(err)
+	}
+	otherAssignment := &pb.Assignment{CourseID: otherCourse.ID, Order: 1}
+	for _, a := range []*pb.Assignment{assignment2, otherAssignment} {
+		if err := db.CreateAssignment(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the submission for the later assignment is created first
+	later := &pb.Submission{AssignmentID: assignment2.ID, UserID: user.ID, NeedsReview: true}
+	earlier := &pb.Submission{AssignmentID: assignment.ID, UserID: user.ID, NeedsReview: true}
+	unflagged := &pb.Submission{AssignmentID: assignment.ID, UserID: createFakeUser(t, db, 12).ID}
+	otherCourseSubmission := &pb.Submission{AssignmentID: otherAssignment.ID, UserID: user.ID, NeedsReview: true}
+	for _, submission := range []*pb.Submission{later, earlier, unflagged, otherCourseSubmission} {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	submissions, err := db.GetSubmissionsNeedingReview(course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []uint64
+	for _, submission := range submissions {
+		got = append(got, submission.GetID())
+	}
+	if diff := cmp.Diff([]uint64{earlier.ID, later.ID}, got); diff != "" {
+		t.Errorf("GetSubmissionsNeedingReview() mismatch (-want +got):\n%s", diff)
+	}
+}
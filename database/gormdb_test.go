@@ -439,6 +439,108 @@ func TestGormDBAcceptRejectEnrollment(t *testing.T) {
 	}
 }
 
+func TestGormDBGetEnrollmentCountsByCourse(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	var course pb.Course
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+
+	statuses := []pb.Enrollment_UserStatus{
+		pb.Enrollment_STUDENT,
+		pb.Enrollment_STUDENT,
+		pb.Enrollment_STUDENT,
+		pb.Enrollment_PENDING,
+		pb.Enrollment_PENDING,
+	}
+	for i, status := range statuses {
+		user := createFakeUser(t, db, uint64(10+i))
+		if err := db.CreateEnrollment(&pb.Enrollment{
+			UserID:   user.ID,
+			CourseID: course.ID,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{
+			UserID:   user.ID,
+			CourseID: course.ID,
+			Status:   status,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	counts, err := db.GetEnrollmentCountsByCourse(course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[pb.Enrollment_UserStatus]uint32{
+		pb.Enrollment_STUDENT: 3,
+		pb.Enrollment_PENDING: 2,
+		pb.Enrollment_TEACHER: 1,
+	}
+	if !reflect.DeepEqual(counts, want) {
+		t.Errorf("have enrollment counts %v want %v", counts, want)
+	}
+
+	for _, status := range []pb.Enrollment_UserStatus{pb.Enrollment_PENDING, pb.Enrollment_NONE} {
+		count, err := db.GetEnrollmentCountByCourse(course.ID, status)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != want[status] {
+			t.Errorf("have %d %s enrollments want %d", count, status, want[status])
+		}
+	}
+}
+
+func TestGormDBGetPublicCourses(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	user := createFakeUser(t, db, 10)
+	c1 := pb.Course{OrganizationID: 1, Name: "B", Year: 2020}
+	c2 := pb.Course{OrganizationID: 2, Name: "A", Year: 2020}
+	c3 := pb.Course{OrganizationID: 3, Name: "C", Year: 2021}
+	private := pb.Course{OrganizationID: 4, Name: "D", Year: 2021, Private: true}
+	archived := pb.Course{OrganizationID: 5, Name: "E", Year: 2019, Archived: true}
+	for _, course := range []*pb.Course{&c1, &c2, &c3, &private, &archived} {
+		if err := db.CreateCourse(user.ID, course); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	courses, err := db.GetPublicCourses()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantCourses := []*pb.Course{&c3, &c2, &c1}
+	if !reflect.DeepEqual(courses, wantCourses) {
+		t.Errorf("have %v want %v", courses, wantCourses)
+	}
+
+	// archiving a course removes it from the catalog, and unarchiving restores it
+	c3.Archived = true
+	if err := db.UpdateCourseArchived(c3.ID, true); err != nil {
+		t.Fatal(err)
+	}
+	archived.Archived = false
+	if err := db.UpdateCourseArchived(archived.ID, false); err != nil {
+		t.Fatal(err)
+	}
+	courses, err = db.GetPublicCourses()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantCourses = []*pb.Course{&c2, &c1, &archived}
+	if !reflect.DeepEqual(courses, wantCourses) {
+		t.Errorf("have %v want %v", courses, wantCourses)
+	}
+}
+
 func TestGormDBGetCoursesByUser(t *testing.T) {
 	db, cleanup := setup(t)
 	defer cleanup()
@@ -788,6 +890,48 @@ func TestGormDBGetCourseByOrganization(t *testing.T) {
 	}
 }
 
+func TestGormDBGetCourseBySlug(t *testing.T) {
+	course := &pb.Course{
+		Name:           "Test Course",
+		Code:           "DAT100",
+		Year:           2017,
+		OrganizationID: 1234,
+		Slug:           "dat100-2017",
+	}
+
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	user := createFakeUser(t, db, 10)
+	if err := db.CreateCourse(user.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	createdCourse, err := db.GetCourseBySlug(course.Slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(createdCourse, course) {
+		t.Errorf("have course %+v want %+v", createdCourse, course)
+	}
+
+	// slugs must be unique across courses
+	duplicate := &pb.Course{OrganizationID: 4321, Slug: course.Slug}
+	if err := db.CreateCourse(user.ID, duplicate); err != database.ErrDuplicateCourseSlug {
+		t.Errorf("have error '%v' wanted '%v'", err, database.ErrDuplicateCourseSlug)
+	}
+	other := &pb.Course{OrganizationID: 4321, Slug: "dat100-2018"}
+	if err := db.CreateCourse(user.ID, other); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateCourse(&pb.Course{ID: other.ID, Slug: course.Slug}); err != database.ErrDuplicateCourseSlug {
+		t.Errorf("have error '%v' wanted '%v'", err, database.ErrDuplicateCourseSlug)
+	}
+	// updating a course with its own slug is allowed
+	if err := db.UpdateCourse(&pb.Course{ID: course.ID, Slug: course.Slug, Name: "Renamed Course"}); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestGormDBGetCourseNoRecord(t *testing.T) {
 	db, cleanup := setup(t)
 	defer cleanup()
@@ -839,6 +983,147 @@ func TestGormDBUpdateCourse(t *testing.T) {
 	if !reflect.DeepEqual(updatedCourse, updates) {
 		t.Errorf("have course %+v want %+v", updatedCourse, course)
 	}
+
+	// a partial update keeps the course private and archived
+	if err := db.UpdateCoursePrivate(course.ID, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateCourseArchived(course.ID, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateCourse(&pb.Course{ID: course.ID, Name: "Renamed Course"}); err != nil {
+		t.Fatal(err)
+	}
+	updatedCourse, err = db.GetCourse(course.ID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !updatedCourse.GetPrivate() || !updatedCourse.GetArchived() || updatedCourse.GetName() != "Renamed Course" {
+		t.Errorf("have course %+v, want private and archived course named %q", updatedCourse, "Renamed Course")
+	}
+}
+
+func TestGormDBBumpGradingConfigVersion(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	user := createFakeUser(t, db, 10)
+	course := &pb.Course{Name: "Test Course", OrganizationID: 1234}
+	if err := db.CreateCourse(user.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	for want := uint32(1); want <= 2; want++ {
+		version, err := db.BumpGradingConfigVersion(course.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if version != want {
+			t.Errorf("BumpGradingConfigVersion() = %d, want %d", version, want)
+		}
+	}
+
+	// updating the course with a stale version must not reset the version
+	course.Name = "Test Course Edit"
+	if err := db.UpdateCourse(course); err != nil {
+		t.Fatal(err)
+	}
+	updatedCourse, err := db.GetCourse(course.ID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updatedCourse.GetGradingConfigVersion() != 2 || updatedCourse.GetName() != course.Name {
+		t.Errorf("have course %+v, want name %s and grading config version 2", updatedCourse, course.Name)
+	}
+
+	if _, err := db.BumpGradingConfigVersion(123); err != gorm.ErrRecordNotFound {
+		t.Errorf("BumpGradingConfigVersion(unknown course) = %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+}
+
+func TestGormDBEnrollStudent(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 10)
+	course := &pb.Course{OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 11)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	enrol := &pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}
+
+	// an invalid repository must not enroll the student
+	if err := db.EnrollStudent(enrol, &pb.Repository{OrganizationID: 1, UserID: student.ID, RepoType: pb.Repository_USER}); err != database.ErrCreateRepo {
+		t.Errorf("EnrollStudent(invalid repo) = %v, want %v", err, database.ErrCreateRepo)
+	}
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetStatus() != pb.Enrollment_PENDING {
+		t.Errorf("have status %s, want %s", enrollment.GetStatus(), pb.Enrollment_PENDING)
+	}
+
+	repo := &pb.Repository{OrganizationID: 1, RepositoryID: 2, UserID: student.ID, RepoType: pb.Repository_USER}
+	if err := db.EnrollStudent(enrol, repo); err != nil {
+		t.Fatal(err)
+	}
+	enrollment, err = db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetStatus() != pb.Enrollment_STUDENT {
+		t.Errorf("have status %s, want %s", enrollment.GetStatus(), pb.Enrollment_STUDENT)
+	}
+	repos, err := db.GetRepositories(&pb.Repository{UserID: student.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 1 || repos[0].GetRepositoryID() != repo.RepositoryID {
+		t.Errorf("have repositories %v, want repository %d", repos, repo.RepositoryID)
+	}
+}
+
+func TestGormDBAssignGrader(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	const courseID, otherCourseID, grader1, grader2 = 1, 2, 10, 11
+	if err := db.AssignGrader(courseID, grader1, []uint64{3, 1, 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AssignGrader(otherCourseID, grader2, []uint64{1}); err != nil {
+		t.Fatal(err)
+	}
+	// reassigning a student replaces the student's grader in the same course only
+	if err := db.AssignGrader(courseID, grader2, []uint64{2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AssignGrader(courseID, grader2, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		courseID, graderID uint64
+		want               []uint64
+	}{
+		{courseID, grader1, []uint64{1, 3}},
+		{courseID, grader2, []uint64{2}},
+		{otherCourseID, grader1, nil},
+		{otherCourseID, grader2, []uint64{1}},
+	}
+	for _, test := range tests {
+		got, err := db.GetGraderStudents(test.courseID, test.graderID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(test.want) || (len(got) > 0 && !reflect.DeepEqual(got, test.want)) {
+			t.Errorf("GetGraderStudents(%d, %d) = %v, want %v", test.courseID, test.graderID, got, test.want)
+		}
+	}
 }
 
 func TestGormDBGetEmptyRepo(t *testing.T) {
@@ -0,0 +1,55 @@
+package database
+
+import (
+	"errors"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+const (
+	// maxRetries is the number of times a write is retried after a transient error.
+	maxRetries = 3
+	// retryBackoff is the delay before the first retry; it doubles for every retry.
+	retryBackoff = 50 * time.Millisecond
+
+	// Postgres error codes of transactions that were aborted because of
+	// concurrent transactions; repeating the transaction may succeed.
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+)
+
+// sqlStateError is implemented by errors of Postgres drivers, such as lib/pq and pgx,
+// and returns the error's SQLSTATE code.
+type sqlStateError interface {
+	SQLState() string
+}
+
+// withRetry calls fn, and calls it again with exponential backoff while it fails
+// with a transient error, e.g., because another connection holds a lock on the
+// database. Other errors, such as constraint violations, are returned immediately.
+func withRetry(fn func() error) error {
+	backoff := retryBackoff
+	err := fn()
+	for i := 0; i < maxRetries && isRetryable(err); i++ {
+		time.Sleep(backoff)
+		backoff *= 2
+		err = fn()
+	}
+	return err
+}
+
+// isRetryable returns true if the given error is transient,
+// such that repeating the failed write may succeed.
+func isRetryable(err error) bool {
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked
+	}
+	var pgErr sqlStateError
+	if errors.As(err, &pgErr) {
+		code := pgErr.SQLState()
+		return code == pgSerializationFailure || code == pgDeadlockDetected
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// pgError mimics the errors of Postgres drivers, which report their SQLSTATE code.
+type pgError string
+
+func (e pgError) Error() string    { return "pq: error " + string(e) }
+func (e pgError) SQLState() string { return string(e) }
+
+func TestWithRetry(t *testing.T) {
+	busy := sqlite3.Error{Code: sqlite3.ErrBusy}
+	constraint := sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintUnique}
+	deadlock := pgError(pgDeadlockDetected)
+	serialization := pgError(pgSerializationFailure)
+	uniqueViolation := pgError("23505")
+	tests := []struct {
+		name      string
+		errs      []error // errors returned by consecutive calls; nil after the last error
+		wantErr   error
+		wantCalls int
+	}{
+		{"Success", nil, nil, 1},
+		{"RetriedUntilSuccess", []error{busy, busy}, nil, 3},
+		{"GiveUpAfterMaxRetries", []error{busy, busy, busy, busy, busy}, busy, maxRetries + 1},
+		{"ConstraintNotRetried", []error{constraint}, constraint, 1},
+		{"PostgresDeadlockRetried", []error{deadlock}, nil, 2},
+		{"PostgresSerializationFailureRetried", []error{serialization, serialization}, nil, 3},
+		{"PostgresWrappedDeadlockRetried", []error{fmt.Errorf("update failed: %w", deadlock)}, nil, 2},
+		{"PostgresUniqueViolationNotRetried", []error{uniqueViolation}, uniqueViolation, 1},
+		{"OtherErrorNotRetried", []error{errors.New("record not found")}, errors.New("record not found"), 1},
+	}
+	for _, tt := range tests {
+		calls := 0
+		err := withRetry(func() error {
+			calls++
+			if calls <= len(tt.errs) {
+				return tt.errs[calls-1]
+			}
+			return nil
+		})
+		if (err == nil) != (tt.wantErr == nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+			t.Errorf("%s: withRetry() = %v, want %v", tt.name, err, tt.wantErr)
+		}
+		if calls != tt.wantCalls {
+			t.Errorf("%s: withRetry() made %d calls, want %d", tt.name, calls, tt.wantCalls)
+		}
+	}
+}
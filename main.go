@@ -8,9 +8,11 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"runtime"
 
 	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/envoy"
+	"github.com/autograde/quickfeed/scm"
 	"github.com/autograde/quickfeed/web"
 	"github.com/autograde/quickfeed/web/auth"
 	"go.uber.org/zap"
@@ -67,6 +69,7 @@ func main() {
 		grpcAddr   = flag.String("grpc.addr", ":9090", "gRPC listen address")
 		scriptPath = flag.String("script.path", "ci/scripts", "path to continuous integration scripts")
 		fake       = flag.Bool("provider.fake", false, "enable fake provider")
+		maxBuilds  = flag.Int("ci.builds", runtime.NumCPU(), "maximum number of concurrent builds")
 	)
 	flag.Parse()
 
@@ -107,8 +110,13 @@ func main() {
 		log.Fatalf("failed to set up docker client: %v\n", err)
 	}
 	defer runner.Close()
+	ci.SetMaxConcurrentBuilds(*maxBuilds)
 
 	agService := web.NewAutograderService(logger, db, scms, bh, runner)
+	reg.MustRegister(web.SubmissionQueueMetrics()...)
+	scmMetrics := web.NewSCMMetrics()
+	scm.SetMetrics(scmMetrics)
+	reg.MustRegister(scmMetrics.Collectors()...)
 	go web.New(agService, *public, *httpAddr, *scriptPath, *fake)
 
 	lis, err := net.Listen("tcp", *grpcAddr)
@@ -46,30 +46,66 @@ var AutograderServiceClient = /** @class */ (function () {
         this.methodInfoDeleteGroup = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Void.deserializeBinary);
+        this.methodInfoReassignGroup = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Void.deserializeBinary);
         this.methodInfoGetCourse = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Course, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Course.deserializeBinary);
         this.methodInfoGetCourses = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Courses, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Courses.deserializeBinary);
+        this.methodInfoGetCoursesByIDs = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Courses, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Courses.deserializeBinary);
+        this.methodInfoGetCourseActivity = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.CourseActivity, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.CourseActivity.deserializeBinary);
+        this.methodInfoGetPublicCourses = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Courses, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Courses.deserializeBinary);
         this.methodInfoGetCoursesByUser = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Courses, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Courses.deserializeBinary);
+        this.methodInfoGetCoursesWithEnrollment = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.CourseEnrollments, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.CourseEnrollments.deserializeBinary);
+        this.methodInfoGetMyActiveCourses = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Courses, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Courses.deserializeBinary);
         this.methodInfoCreateCourse = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Course, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Course.deserializeBinary);
         this.methodInfoUpdateCourse = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Void.deserializeBinary);
+        this.methodInfoUpdateCourseWithWarnings = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.UpdateCourseWarnings, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.UpdateCourseWarnings.deserializeBinary);
         this.methodInfoUpdateCourseVisibility = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Void.deserializeBinary);
+        this.methodInfoSetCourseFeature = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Void.deserializeBinary);
         this.methodInfoGetAssignments = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Assignments, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Assignments.deserializeBinary);
+        this.methodInfoGetAvailableAssignments = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Assignments, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Assignments.deserializeBinary);
+        this.methodInfoGetCourseCalendar = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.CourseCalendar, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.CourseCalendar.deserializeBinary);
         this.methodInfoUpdateAssignments = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Void.deserializeBinary);
+        this.methodInfoUpdateAutoApprove = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Void.deserializeBinary);
+        this.methodInfoGetEnrollment = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Enrollment, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Enrollment.deserializeBinary);
         this.methodInfoGetEnrollmentsByUser = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Enrollments, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Enrollments.deserializeBinary);
@@ -85,21 +121,84 @@ var AutograderServiceClient = /** @class */ (function () {
         this.methodInfoUpdateEnrollments = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Void.deserializeBinary);
+        this.methodInfoReconcileEnrollments = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Enrollments, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Enrollments.deserializeBinary);
+        this.methodInfoImportEnrollments = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.EnrollmentImport, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.EnrollmentImport.deserializeBinary);
+        this.methodInfoLeaveCourse = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Void.deserializeBinary);
+        this.methodInfoRejectEnrollments = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.EnrollmentCount, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.EnrollmentCount.deserializeBinary);
+        this.methodInfoGetPendingEnrollmentCount = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.EnrollmentCount, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.EnrollmentCount.deserializeBinary);
         this.methodInfoGetSubmissions = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Submissions, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Submissions.deserializeBinary);
+        this.methodInfoGetSubmission = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Submission, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Submission.deserializeBinary);
+        this.methodInfoGetSubmissionByID = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Submission, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Submission.deserializeBinary);
+        this.methodInfoGetSubmissionByCommit = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Submission, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Submission.deserializeBinary);
+        this.methodInfoGetSubmissionHistory = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.SubmissionBuilds, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.SubmissionBuilds.deserializeBinary);
+        this.methodInfoGetSubmissionBuildLog = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.BuildLog, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.BuildLog.deserializeBinary);
+        this.methodInfoAddSubmissionComment = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.SubmissionComment, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.SubmissionComment.deserializeBinary);
+        this.methodInfoGetSubmissionComments = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.SubmissionComments, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.SubmissionComments.deserializeBinary);
+        this.methodInfoGetCourseProgress = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.EnrollmentLink, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.EnrollmentLink.deserializeBinary);
         this.methodInfoGetSubmissionsByCourse = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.CourseSubmissions, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.CourseSubmissions.deserializeBinary);
+        this.methodInfoGetGroupSubmissions = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Submissions, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Submissions.deserializeBinary);
+        this.methodInfoExportCourseGrades = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.CourseGrades, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.CourseGrades.deserializeBinary);
+        this.methodInfoExportEnrollments = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.CourseRoster, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.CourseRoster.deserializeBinary);
         this.methodInfoUpdateSubmission = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Void.deserializeBinary);
         this.methodInfoUpdateSubmissions = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Void.deserializeBinary);
+        this.methodInfoApproveSubmissions = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.SubmissionApprovals, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.SubmissionApprovals.deserializeBinary);
+        this.methodInfoApproveSubmissionsAfterDeadline = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Void.deserializeBinary);
         this.methodInfoRebuildSubmission = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Submission, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Submission.deserializeBinary);
+        this.methodInfoApplyLatePenalty = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Submission, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Submission.deserializeBinary);
+        this.methodInfoSubmitPullRequests = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Void.deserializeBinary);
+        this.methodInfoReplayMissedSubmissions = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.SubmissionCount, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.SubmissionCount.deserializeBinary);
         this.methodInfoCreateBenchmark = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.GradingBenchmark, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.GradingBenchmark.deserializeBinary);
@@ -124,9 +223,24 @@ var AutograderServiceClient = /** @class */ (function () {
         this.methodInfoUpdateReview = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Void.deserializeBinary);
+        this.methodInfoScoreSubmissionByRubric = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Review, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Review.deserializeBinary);
         this.methodInfoGetReviewers = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Reviewers, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Reviewers.deserializeBinary);
+        this.methodInfoAssignGrader = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Void.deserializeBinary);
+        this.methodInfoFlagForReview = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Void.deserializeBinary);
+        this.methodInfoGetSubmissionsNeedingReview = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Submissions, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Submissions.deserializeBinary);
+        this.methodInfoGetSubmissionSimilarity = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.SubmissionSimilarities, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.SubmissionSimilarities.deserializeBinary);
         this.methodInfoLoadCriteria = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Benchmarks, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Benchmarks.deserializeBinary);
@@ -142,6 +256,12 @@ var AutograderServiceClient = /** @class */ (function () {
         this.methodInfoIsEmptyRepo = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Void.deserializeBinary);
+        this.methodInfoCreateRepositoryAccessToken = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.RepositoryAccessToken, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.RepositoryAccessToken.deserializeBinary);
+        this.methodInfoGetSCMAuditLog = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.SCMAuditLog, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.SCMAuditLog.deserializeBinary);
         if (!options)
             options = {};
         if (!credentials)
@@ -229,6 +349,13 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/AutograderService/DeleteGroup', request, metadata || {}, this.methodInfoDeleteGroup);
     };
+    AutograderServiceClient.prototype.reassignGroup = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/ReassignGroup', this.hostname_).toString(), request, metadata || {}, this.methodInfoReassignGroup, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/ReassignGroup', request, metadata || {}, this.methodInfoReassignGroup);
+    };
     AutograderServiceClient.prototype.getCourse = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/AutograderService/GetCourse', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetCourse, callback);
@@ -243,6 +370,27 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/AutograderService/GetCourses', request, metadata || {}, this.methodInfoGetCourses);
     };
+    AutograderServiceClient.prototype.getCoursesByIDs = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetCoursesByIDs', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetCoursesByIDs, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetCoursesByIDs', request, metadata || {}, this.methodInfoGetCoursesByIDs);
+    };
+    AutograderServiceClient.prototype.getCourseActivity = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetCourseActivity', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetCourseActivity, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetCourseActivity', request, metadata || {}, this.methodInfoGetCourseActivity);
+    };
+    AutograderServiceClient.prototype.getPublicCourses = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetPublicCourses', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetPublicCourses, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetPublicCourses', request, metadata || {}, this.methodInfoGetPublicCourses);
+    };
     AutograderServiceClient.prototype.getCoursesByUser = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/AutograderService/GetCoursesByUser', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetCoursesByUser, callback);
@@ -250,6 +398,20 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/AutograderService/GetCoursesByUser', request, metadata || {}, this.methodInfoGetCoursesByUser);
     };
+    AutograderServiceClient.prototype.getCoursesWithEnrollment = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetCoursesWithEnrollment', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetCoursesWithEnrollment, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetCoursesWithEnrollment', request, metadata || {}, this.methodInfoGetCoursesWithEnrollment);
+    };
+    AutograderServiceClient.prototype.getMyActiveCourses = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetMyActiveCourses', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetMyActiveCourses, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetMyActiveCourses', request, metadata || {}, this.methodInfoGetMyActiveCourses);
+    };
     AutograderServiceClient.prototype.createCourse = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/AutograderService/CreateCourse', this.hostname_).toString(), request, metadata || {}, this.methodInfoCreateCourse, callback);
@@ -264,6 +426,13 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/AutograderService/UpdateCourse', request, metadata || {}, this.methodInfoUpdateCourse);
     };
+    AutograderServiceClient.prototype.updateCourseWithWarnings = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/UpdateCourseWithWarnings', this.hostname_).toString(), request, metadata || {}, this.methodInfoUpdateCourseWithWarnings, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/UpdateCourseWithWarnings', request, metadata || {}, this.methodInfoUpdateCourseWithWarnings);
+    };
     AutograderServiceClient.prototype.updateCourseVisibility = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/AutograderService/UpdateCourseVisibility', this.hostname_).toString(), request, metadata || {}, this.methodInfoUpdateCourseVisibility, callback);
@@ -271,6 +440,13 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/AutograderService/UpdateCourseVisibility', request, metadata || {}, this.methodInfoUpdateCourseVisibility);
     };
+    AutograderServiceClient.prototype.setCourseFeature = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/SetCourseFeature', this.hostname_).toString(), request, metadata || {}, this.methodInfoSetCourseFeature, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/SetCourseFeature', request, metadata || {}, this.methodInfoSetCourseFeature);
+    };
     AutograderServiceClient.prototype.getAssignments = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/AutograderService/GetAssignments', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetAssignments, callback);
@@ -278,6 +454,20 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/AutograderService/GetAssignments', request, metadata || {}, this.methodInfoGetAssignments);
     };
+    AutograderServiceClient.prototype.getAvailableAssignments = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetAvailableAssignments', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetAvailableAssignments, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetAvailableAssignments', request, metadata || {}, this.methodInfoGetAvailableAssignments);
+    };
+    AutograderServiceClient.prototype.getCourseCalendar = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetCourseCalendar', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetCourseCalendar, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetCourseCalendar', request, metadata || {}, this.methodInfoGetCourseCalendar);
+    };
     AutograderServiceClient.prototype.updateAssignments = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/AutograderService/UpdateAssignments', this.hostname_).toString(), request, metadata || {}, this.methodInfoUpdateAssignments, callback);
@@ -285,6 +475,20 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/AutograderService/UpdateAssignments', request, metadata || {}, this.methodInfoUpdateAssignments);
     };
+    AutograderServiceClient.prototype.updateAutoApprove = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/UpdateAutoApprove', this.hostname_).toString(), request, metadata || {}, this.methodInfoUpdateAutoApprove, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/UpdateAutoApprove', request, metadata || {}, this.methodInfoUpdateAutoApprove);
+    };
+    AutograderServiceClient.prototype.getEnrollment = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetEnrollment', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetEnrollment, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetEnrollment', request, metadata || {}, this.methodInfoGetEnrollment);
+    };
     AutograderServiceClient.prototype.getEnrollmentsByUser = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/AutograderService/GetEnrollmentsByUser', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetEnrollmentsByUser, callback);
@@ -320,6 +524,41 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/AutograderService/UpdateEnrollments', request, metadata || {}, this.methodInfoUpdateEnrollments);
     };
+    AutograderServiceClient.prototype.reconcileEnrollments = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/ReconcileEnrollments', this.hostname_).toString(), request, metadata || {}, this.methodInfoReconcileEnrollments, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/ReconcileEnrollments', request, metadata || {}, this.methodInfoReconcileEnrollments);
+    };
+    AutograderServiceClient.prototype.importEnrollments = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/ImportEnrollments', this.hostname_).toString(), request, metadata || {}, this.methodInfoImportEnrollments, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/ImportEnrollments', request, metadata || {}, this.methodInfoImportEnrollments);
+    };
+    AutograderServiceClient.prototype.leaveCourse = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/LeaveCourse', this.hostname_).toString(), request, metadata || {}, this.methodInfoLeaveCourse, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/LeaveCourse', request, metadata || {}, this.methodInfoLeaveCourse);
+    };
+    AutograderServiceClient.prototype.rejectEnrollments = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/RejectEnrollments', this.hostname_).toString(), request, metadata || {}, this.methodInfoRejectEnrollments, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/RejectEnrollments', request, metadata || {}, this.methodInfoRejectEnrollments);
+    };
+    AutograderServiceClient.prototype.getPendingEnrollmentCount = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetPendingEnrollmentCount', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetPendingEnrollmentCount, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetPendingEnrollmentCount', request, metadata || {}, this.methodInfoGetPendingEnrollmentCount);
+    };
     AutograderServiceClient.prototype.getSubmissions = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/AutograderService/GetSubmissions', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetSubmissions, callback);
@@ -327,6 +566,62 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/AutograderService/GetSubmissions', request, metadata || {}, this.methodInfoGetSubmissions);
     };
+    AutograderServiceClient.prototype.getSubmission = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetSubmission', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetSubmission, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetSubmission', request, metadata || {}, this.methodInfoGetSubmission);
+    };
+    AutograderServiceClient.prototype.getSubmissionByID = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetSubmissionByID', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetSubmissionByID, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetSubmissionByID', request, metadata || {}, this.methodInfoGetSubmissionByID);
+    };
+    AutograderServiceClient.prototype.getSubmissionByCommit = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetSubmissionByCommit', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetSubmissionByCommit, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetSubmissionByCommit', request, metadata || {}, this.methodInfoGetSubmissionByCommit);
+    };
+    AutograderServiceClient.prototype.getSubmissionHistory = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetSubmissionHistory', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetSubmissionHistory, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetSubmissionHistory', request, metadata || {}, this.methodInfoGetSubmissionHistory);
+    };
+    AutograderServiceClient.prototype.getSubmissionBuildLog = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetSubmissionBuildLog', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetSubmissionBuildLog, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetSubmissionBuildLog', request, metadata || {}, this.methodInfoGetSubmissionBuildLog);
+    };
+    AutograderServiceClient.prototype.addSubmissionComment = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/AddSubmissionComment', this.hostname_).toString(), request, metadata || {}, this.methodInfoAddSubmissionComment, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/AddSubmissionComment', request, metadata || {}, this.methodInfoAddSubmissionComment);
+    };
+    AutograderServiceClient.prototype.getSubmissionComments = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetSubmissionComments', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetSubmissionComments, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetSubmissionComments', request, metadata || {}, this.methodInfoGetSubmissionComments);
+    };
+    AutograderServiceClient.prototype.getCourseProgress = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetCourseProgress', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetCourseProgress, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetCourseProgress', request, metadata || {}, this.methodInfoGetCourseProgress);
+    };
     AutograderServiceClient.prototype.getSubmissionsByCourse = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/AutograderService/GetSubmissionsByCourse', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetSubmissionsByCourse, callback);
@@ -334,6 +629,27 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/AutograderService/GetSubmissionsByCourse', request, metadata || {}, this.methodInfoGetSubmissionsByCourse);
     };
+    AutograderServiceClient.prototype.getGroupSubmissions = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetGroupSubmissions', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetGroupSubmissions, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetGroupSubmissions', request, metadata || {}, this.methodInfoGetGroupSubmissions);
+    };
+    AutograderServiceClient.prototype.exportCourseGrades = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/ExportCourseGrades', this.hostname_).toString(), request, metadata || {}, this.methodInfoExportCourseGrades, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/ExportCourseGrades', request, metadata || {}, this.methodInfoExportCourseGrades);
+    };
+    AutograderServiceClient.prototype.exportEnrollments = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/ExportEnrollments', this.hostname_).toString(), request, metadata || {}, this.methodInfoExportEnrollments, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/ExportEnrollments', request, metadata || {}, this.methodInfoExportEnrollments);
+    };
     AutograderServiceClient.prototype.updateSubmission = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/AutograderService/UpdateSubmission', this.hostname_).toString(), request, metadata || {}, this.methodInfoUpdateSubmission, callback);
@@ -348,6 +664,20 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/AutograderService/UpdateSubmissions', request, metadata || {}, this.methodInfoUpdateSubmissions);
     };
+    AutograderServiceClient.prototype.approveSubmissions = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/ApproveSubmissions', this.hostname_).toString(), request, metadata || {}, this.methodInfoApproveSubmissions, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/ApproveSubmissions', request, metadata || {}, this.methodInfoApproveSubmissions);
+    };
+    AutograderServiceClient.prototype.approveSubmissionsAfterDeadline = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/ApproveSubmissionsAfterDeadline', this.hostname_).toString(), request, metadata || {}, this.methodInfoApproveSubmissionsAfterDeadline, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/ApproveSubmissionsAfterDeadline', request, metadata || {}, this.methodInfoApproveSubmissionsAfterDeadline);
+    };
     AutograderServiceClient.prototype.rebuildSubmission = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/AutograderService/RebuildSubmission', this.hostname_).toString(), request, metadata || {}, this.methodInfoRebuildSubmission, callback);
@@ -355,6 +685,27 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/AutograderService/RebuildSubmission', request, metadata || {}, this.methodInfoRebuildSubmission);
     };
+    AutograderServiceClient.prototype.applyLatePenalty = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/ApplyLatePenalty', this.hostname_).toString(), request, metadata || {}, this.methodInfoApplyLatePenalty, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/ApplyLatePenalty', request, metadata || {}, this.methodInfoApplyLatePenalty);
+    };
+    AutograderServiceClient.prototype.submitPullRequests = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/SubmitPullRequests', this.hostname_).toString(), request, metadata || {}, this.methodInfoSubmitPullRequests, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/SubmitPullRequests', request, metadata || {}, this.methodInfoSubmitPullRequests);
+    };
+    AutograderServiceClient.prototype.replayMissedSubmissions = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/ReplayMissedSubmissions', this.hostname_).toString(), request, metadata || {}, this.methodInfoReplayMissedSubmissions, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/ReplayMissedSubmissions', request, metadata || {}, this.methodInfoReplayMissedSubmissions);
+    };
     AutograderServiceClient.prototype.createBenchmark = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/AutograderService/CreateBenchmark', this.hostname_).toString(), request, metadata || {}, this.methodInfoCreateBenchmark, callback);
@@ -411,6 +762,13 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/AutograderService/UpdateReview', request, metadata || {}, this.methodInfoUpdateReview);
     };
+    AutograderServiceClient.prototype.scoreSubmissionByRubric = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/ScoreSubmissionByRubric', this.hostname_).toString(), request, metadata || {}, this.methodInfoScoreSubmissionByRubric, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/ScoreSubmissionByRubric', request, metadata || {}, this.methodInfoScoreSubmissionByRubric);
+    };
     AutograderServiceClient.prototype.getReviewers = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/AutograderService/GetReviewers', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetReviewers, callback);
@@ -418,6 +776,34 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/AutograderService/GetReviewers', request, metadata || {}, this.methodInfoGetReviewers);
     };
+    AutograderServiceClient.prototype.assignGrader = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/AssignGrader', this.hostname_).toString(), request, metadata || {}, this.methodInfoAssignGrader, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/AssignGrader', request, metadata || {}, this.methodInfoAssignGrader);
+    };
+    AutograderServiceClient.prototype.flagForReview = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/FlagForReview', this.hostname_).toString(), request, metadata || {}, this.methodInfoFlagForReview, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/FlagForReview', request, metadata || {}, this.methodInfoFlagForReview);
+    };
+    AutograderServiceClient.prototype.getSubmissionsNeedingReview = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetSubmissionsNeedingReview', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetSubmissionsNeedingReview, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetSubmissionsNeedingReview', request, metadata || {}, this.methodInfoGetSubmissionsNeedingReview);
+    };
+    AutograderServiceClient.prototype.getSubmissionSimilarity = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetSubmissionSimilarity', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetSubmissionSimilarity, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetSubmissionSimilarity', request, metadata || {}, this.methodInfoGetSubmissionSimilarity);
+    };
     AutograderServiceClient.prototype.loadCriteria = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/AutograderService/LoadCriteria', this.hostname_).toString(), request, metadata || {}, this.methodInfoLoadCriteria, callback);
@@ -453,6 +839,20 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/AutograderService/IsEmptyRepo', request, metadata || {}, this.methodInfoIsEmptyRepo);
     };
+    AutograderServiceClient.prototype.createRepositoryAccessToken = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/CreateRepositoryAccessToken', this.hostname_).toString(), request, metadata || {}, this.methodInfoCreateRepositoryAccessToken, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/CreateRepositoryAccessToken', request, metadata || {}, this.methodInfoCreateRepositoryAccessToken);
+    };
+    AutograderServiceClient.prototype.getSCMAuditLog = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetSCMAuditLog', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetSCMAuditLog, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetSCMAuditLog', request, metadata || {}, this.methodInfoGetSCMAuditLog);
+    };
     return AutograderServiceClient;
 }());
 exports.AutograderServiceClient = AutograderServiceClient;
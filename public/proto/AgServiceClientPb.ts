@@ -15,15 +15,34 @@ import * as grpcWeb from 'grpc-web';
 
 
 import {
+  ApproveSubmissionsRequest,
+  AssignGraderRequest,
+  AssignmentRequest,
+  AssignmentSubmissionRequest,
   Assignments,
   AuthorizationResponse,
+  AutoApproveRequest,
   Benchmarks,
+  BuildLog,
+  CommitSubmissionRequest,
   Course,
+  CourseActivity,
+  CourseActivityRequest,
+  CourseCalendar,
+  CourseEnrollments,
+  CourseFeatureRequest,
+  CourseGrades,
   CourseRequest,
+  CourseRoster,
   CourseSubmissions,
   CourseUserRequest,
   Courses,
+  CoursesRequest,
   Enrollment,
+  EnrollmentCount,
+  EnrollmentDetailsRequest,
+  EnrollmentImport,
+  EnrollmentLink,
   EnrollmentRequest,
   EnrollmentStatusRequest,
   Enrollments,
@@ -38,17 +57,32 @@ import {
   Organization,
   Providers,
   RebuildRequest,
+  RejectEnrollmentsRequest,
+  ReplayRequest,
   Repositories,
+  RepositoryAccessToken,
   RepositoryRequest,
   Review,
   ReviewRequest,
   Reviewers,
+  RubricScoreRequest,
+  SCMAuditLog,
   Submission,
+  SubmissionApprovals,
+  SubmissionBuilds,
+  SubmissionComment,
+  SubmissionComments,
+  SubmissionCount,
+  SubmissionHistoryRequest,
+  SubmissionIDRequest,
   SubmissionRequest,
   SubmissionReviewersRequest,
+  SubmissionSimilarities,
   Submissions,
   SubmissionsForCourseRequest,
   URLRequest,
+  UpdateCourseRequest,
+  UpdateCourseWarnings,
   UpdateSubmissionRequest,
   UpdateSubmissionsRequest,
   User,
@@ -503,6 +537,45 @@ export class AutograderServiceClient {
     this.methodInfoDeleteGroup);
   }
 
+  methodInfoReassignGroup = new grpcWeb.AbstractClientBase.MethodInfo(
+    Void,
+    (request: GroupRequest) => {
+      return request.serializeBinary();
+    },
+    Void.deserializeBinary
+  );
+
+  reassignGroup(
+    request: GroupRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Void>;
+
+  reassignGroup(
+    request: GroupRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Void) => void): grpcWeb.ClientReadableStream<Void>;
+
+  reassignGroup(
+    request: GroupRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Void) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/ReassignGroup', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoReassignGroup,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/ReassignGroup',
+    request,
+    metadata || {},
+    this.methodInfoReassignGroup);
+  }
+
   methodInfoGetCourse = new grpcWeb.AbstractClientBase.MethodInfo(
     Course,
     (request: CourseRequest) => {
@@ -581,6 +654,123 @@ export class AutograderServiceClient {
     this.methodInfoGetCourses);
   }
 
+  methodInfoGetCoursesByIDs = new grpcWeb.AbstractClientBase.MethodInfo(
+    Courses,
+    (request: CoursesRequest) => {
+      return request.serializeBinary();
+    },
+    Courses.deserializeBinary
+  );
+
+  getCoursesByIDs(
+    request: CoursesRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Courses>;
+
+  getCoursesByIDs(
+    request: CoursesRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Courses) => void): grpcWeb.ClientReadableStream<Courses>;
+
+  getCoursesByIDs(
+    request: CoursesRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Courses) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetCoursesByIDs', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetCoursesByIDs,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetCoursesByIDs',
+    request,
+    metadata || {},
+    this.methodInfoGetCoursesByIDs);
+  }
+
+  methodInfoGetCourseActivity = new grpcWeb.AbstractClientBase.MethodInfo(
+    CourseActivity,
+    (request: CourseActivityRequest) => {
+      return request.serializeBinary();
+    },
+    CourseActivity.deserializeBinary
+  );
+
+  getCourseActivity(
+    request: CourseActivityRequest,
+    metadata: grpcWeb.Metadata | null): Promise<CourseActivity>;
+
+  getCourseActivity(
+    request: CourseActivityRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: CourseActivity) => void): grpcWeb.ClientReadableStream<CourseActivity>;
+
+  getCourseActivity(
+    request: CourseActivityRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: CourseActivity) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetCourseActivity', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetCourseActivity,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetCourseActivity',
+    request,
+    metadata || {},
+    this.methodInfoGetCourseActivity);
+  }
+
+  methodInfoGetPublicCourses = new grpcWeb.AbstractClientBase.MethodInfo(
+    Courses,
+    (request: Void) => {
+      return request.serializeBinary();
+    },
+    Courses.deserializeBinary
+  );
+
+  getPublicCourses(
+    request: Void,
+    metadata: grpcWeb.Metadata | null): Promise<Courses>;
+
+  getPublicCourses(
+    request: Void,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Courses) => void): grpcWeb.ClientReadableStream<Courses>;
+
+  getPublicCourses(
+    request: Void,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Courses) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetPublicCourses', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetPublicCourses,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetPublicCourses',
+    request,
+    metadata || {},
+    this.methodInfoGetPublicCourses);
+  }
+
   methodInfoGetCoursesByUser = new grpcWeb.AbstractClientBase.MethodInfo(
     Courses,
     (request: EnrollmentStatusRequest) => {
@@ -620,6 +810,84 @@ export class AutograderServiceClient {
     this.methodInfoGetCoursesByUser);
   }
 
+  methodInfoGetCoursesWithEnrollment = new grpcWeb.AbstractClientBase.MethodInfo(
+    CourseEnrollments,
+    (request: EnrollmentStatusRequest) => {
+      return request.serializeBinary();
+    },
+    CourseEnrollments.deserializeBinary
+  );
+
+  getCoursesWithEnrollment(
+    request: EnrollmentStatusRequest,
+    metadata: grpcWeb.Metadata | null): Promise<CourseEnrollments>;
+
+  getCoursesWithEnrollment(
+    request: EnrollmentStatusRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: CourseEnrollments) => void): grpcWeb.ClientReadableStream<CourseEnrollments>;
+
+  getCoursesWithEnrollment(
+    request: EnrollmentStatusRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: CourseEnrollments) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetCoursesWithEnrollment', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetCoursesWithEnrollment,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetCoursesWithEnrollment',
+    request,
+    metadata || {},
+    this.methodInfoGetCoursesWithEnrollment);
+  }
+
+  methodInfoGetMyActiveCourses = new grpcWeb.AbstractClientBase.MethodInfo(
+    Courses,
+    (request: Void) => {
+      return request.serializeBinary();
+    },
+    Courses.deserializeBinary
+  );
+
+  getMyActiveCourses(
+    request: Void,
+    metadata: grpcWeb.Metadata | null): Promise<Courses>;
+
+  getMyActiveCourses(
+    request: Void,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Courses) => void): grpcWeb.ClientReadableStream<Courses>;
+
+  getMyActiveCourses(
+    request: Void,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Courses) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetMyActiveCourses', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetMyActiveCourses,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetMyActiveCourses',
+    request,
+    metadata || {},
+    this.methodInfoGetMyActiveCourses);
+  }
+
   methodInfoCreateCourse = new grpcWeb.AbstractClientBase.MethodInfo(
     Course,
     (request: Course) => {
@@ -698,6 +966,45 @@ export class AutograderServiceClient {
     this.methodInfoUpdateCourse);
   }
 
+  methodInfoUpdateCourseWithWarnings = new grpcWeb.AbstractClientBase.MethodInfo(
+    UpdateCourseWarnings,
+    (request: UpdateCourseRequest) => {
+      return request.serializeBinary();
+    },
+    UpdateCourseWarnings.deserializeBinary
+  );
+
+  updateCourseWithWarnings(
+    request: UpdateCourseRequest,
+    metadata: grpcWeb.Metadata | null): Promise<UpdateCourseWarnings>;
+
+  updateCourseWithWarnings(
+    request: UpdateCourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: UpdateCourseWarnings) => void): grpcWeb.ClientReadableStream<UpdateCourseWarnings>;
+
+  updateCourseWithWarnings(
+    request: UpdateCourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: UpdateCourseWarnings) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/UpdateCourseWithWarnings', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoUpdateCourseWithWarnings,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/UpdateCourseWithWarnings',
+    request,
+    metadata || {},
+    this.methodInfoUpdateCourseWithWarnings);
+  }
+
   methodInfoUpdateCourseVisibility = new grpcWeb.AbstractClientBase.MethodInfo(
     Void,
     (request: Enrollment) => {
@@ -737,6 +1044,45 @@ export class AutograderServiceClient {
     this.methodInfoUpdateCourseVisibility);
   }
 
+  methodInfoSetCourseFeature = new grpcWeb.AbstractClientBase.MethodInfo(
+    Void,
+    (request: CourseFeatureRequest) => {
+      return request.serializeBinary();
+    },
+    Void.deserializeBinary
+  );
+
+  setCourseFeature(
+    request: CourseFeatureRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Void>;
+
+  setCourseFeature(
+    request: CourseFeatureRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Void) => void): grpcWeb.ClientReadableStream<Void>;
+
+  setCourseFeature(
+    request: CourseFeatureRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Void) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/SetCourseFeature', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoSetCourseFeature,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/SetCourseFeature',
+    request,
+    metadata || {},
+    this.methodInfoSetCourseFeature);
+  }
+
   methodInfoGetAssignments = new grpcWeb.AbstractClientBase.MethodInfo(
     Assignments,
     (request: CourseRequest) => {
@@ -776,82 +1122,238 @@ export class AutograderServiceClient {
     this.methodInfoGetAssignments);
   }
 
-  methodInfoUpdateAssignments = new grpcWeb.AbstractClientBase.MethodInfo(
-    Void,
+  methodInfoGetAvailableAssignments = new grpcWeb.AbstractClientBase.MethodInfo(
+    Assignments,
     (request: CourseRequest) => {
       return request.serializeBinary();
     },
-    Void.deserializeBinary
+    Assignments.deserializeBinary
   );
 
-  updateAssignments(
+  getAvailableAssignments(
     request: CourseRequest,
-    metadata: grpcWeb.Metadata | null): Promise<Void>;
+    metadata: grpcWeb.Metadata | null): Promise<Assignments>;
 
-  updateAssignments(
+  getAvailableAssignments(
     request: CourseRequest,
     metadata: grpcWeb.Metadata | null,
     callback: (err: grpcWeb.Error,
-               response: Void) => void): grpcWeb.ClientReadableStream<Void>;
+               response: Assignments) => void): grpcWeb.ClientReadableStream<Assignments>;
 
-  updateAssignments(
+  getAvailableAssignments(
     request: CourseRequest,
     metadata: grpcWeb.Metadata | null,
     callback?: (err: grpcWeb.Error,
-               response: Void) => void) {
+               response: Assignments) => void) {
     if (callback !== undefined) {
       return this.client_.rpcCall(
-        new URL('/AutograderService/UpdateAssignments', this.hostname_).toString(),
+        new URL('/AutograderService/GetAvailableAssignments', this.hostname_).toString(),
         request,
         metadata || {},
-        this.methodInfoUpdateAssignments,
+        this.methodInfoGetAvailableAssignments,
         callback);
     }
     return this.client_.unaryCall(
     this.hostname_ +
-      '/AutograderService/UpdateAssignments',
+      '/AutograderService/GetAvailableAssignments',
     request,
     metadata || {},
-    this.methodInfoUpdateAssignments);
+    this.methodInfoGetAvailableAssignments);
   }
 
-  methodInfoGetEnrollmentsByUser = new grpcWeb.AbstractClientBase.MethodInfo(
-    Enrollments,
-    (request: EnrollmentStatusRequest) => {
+  methodInfoGetCourseCalendar = new grpcWeb.AbstractClientBase.MethodInfo(
+    CourseCalendar,
+    (request: CourseRequest) => {
       return request.serializeBinary();
     },
-    Enrollments.deserializeBinary
+    CourseCalendar.deserializeBinary
   );
 
-  getEnrollmentsByUser(
-    request: EnrollmentStatusRequest,
-    metadata: grpcWeb.Metadata | null): Promise<Enrollments>;
+  getCourseCalendar(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null): Promise<CourseCalendar>;
 
-  getEnrollmentsByUser(
-    request: EnrollmentStatusRequest,
+  getCourseCalendar(
+    request: CourseRequest,
     metadata: grpcWeb.Metadata | null,
     callback: (err: grpcWeb.Error,
-               response: Enrollments) => void): grpcWeb.ClientReadableStream<Enrollments>;
+               response: CourseCalendar) => void): grpcWeb.ClientReadableStream<CourseCalendar>;
 
-  getEnrollmentsByUser(
-    request: EnrollmentStatusRequest,
+  getCourseCalendar(
+    request: CourseRequest,
     metadata: grpcWeb.Metadata | null,
     callback?: (err: grpcWeb.Error,
-               response: Enrollments) => void) {
+               response: CourseCalendar) => void) {
     if (callback !== undefined) {
       return this.client_.rpcCall(
-        new URL('/AutograderService/GetEnrollmentsByUser', this.hostname_).toString(),
+        new URL('/AutograderService/GetCourseCalendar', this.hostname_).toString(),
         request,
         metadata || {},
-        this.methodInfoGetEnrollmentsByUser,
+        this.methodInfoGetCourseCalendar,
         callback);
     }
     return this.client_.unaryCall(
     this.hostname_ +
-      '/AutograderService/GetEnrollmentsByUser',
+      '/AutograderService/GetCourseCalendar',
     request,
     metadata || {},
-    this.methodInfoGetEnrollmentsByUser);
+    this.methodInfoGetCourseCalendar);
+  }
+
+  methodInfoUpdateAssignments = new grpcWeb.AbstractClientBase.MethodInfo(
+    Void,
+    (request: CourseRequest) => {
+      return request.serializeBinary();
+    },
+    Void.deserializeBinary
+  );
+
+  updateAssignments(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Void>;
+
+  updateAssignments(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Void) => void): grpcWeb.ClientReadableStream<Void>;
+
+  updateAssignments(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Void) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/UpdateAssignments', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoUpdateAssignments,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/UpdateAssignments',
+    request,
+    metadata || {},
+    this.methodInfoUpdateAssignments);
+  }
+
+  methodInfoUpdateAutoApprove = new grpcWeb.AbstractClientBase.MethodInfo(
+    Void,
+    (request: AutoApproveRequest) => {
+      return request.serializeBinary();
+    },
+    Void.deserializeBinary
+  );
+
+  updateAutoApprove(
+    request: AutoApproveRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Void>;
+
+  updateAutoApprove(
+    request: AutoApproveRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Void) => void): grpcWeb.ClientReadableStream<Void>;
+
+  updateAutoApprove(
+    request: AutoApproveRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Void) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/UpdateAutoApprove', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoUpdateAutoApprove,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/UpdateAutoApprove',
+    request,
+    metadata || {},
+    this.methodInfoUpdateAutoApprove);
+  }
+
+  methodInfoGetEnrollment = new grpcWeb.AbstractClientBase.MethodInfo(
+    Enrollment,
+    (request: EnrollmentDetailsRequest) => {
+      return request.serializeBinary();
+    },
+    Enrollment.deserializeBinary
+  );
+
+  getEnrollment(
+    request: EnrollmentDetailsRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Enrollment>;
+
+  getEnrollment(
+    request: EnrollmentDetailsRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Enrollment) => void): grpcWeb.ClientReadableStream<Enrollment>;
+
+  getEnrollment(
+    request: EnrollmentDetailsRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Enrollment) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetEnrollment', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetEnrollment,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetEnrollment',
+    request,
+    metadata || {},
+    this.methodInfoGetEnrollment);
+  }
+
+  methodInfoGetEnrollmentsByUser = new grpcWeb.AbstractClientBase.MethodInfo(
+    Enrollments,
+    (request: EnrollmentStatusRequest) => {
+      return request.serializeBinary();
+    },
+    Enrollments.deserializeBinary
+  );
+
+  getEnrollmentsByUser(
+    request: EnrollmentStatusRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Enrollments>;
+
+  getEnrollmentsByUser(
+    request: EnrollmentStatusRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Enrollments) => void): grpcWeb.ClientReadableStream<Enrollments>;
+
+  getEnrollmentsByUser(
+    request: EnrollmentStatusRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Enrollments) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetEnrollmentsByUser', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetEnrollmentsByUser,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetEnrollmentsByUser',
+    request,
+    metadata || {},
+    this.methodInfoGetEnrollmentsByUser);
   }
 
   methodInfoGetEnrollmentsByCourse = new grpcWeb.AbstractClientBase.MethodInfo(
@@ -1010,199 +1512,1018 @@ export class AutograderServiceClient {
     this.methodInfoUpdateEnrollments);
   }
 
-  methodInfoGetSubmissions = new grpcWeb.AbstractClientBase.MethodInfo(
-    Submissions,
-    (request: SubmissionRequest) => {
+  methodInfoReconcileEnrollments = new grpcWeb.AbstractClientBase.MethodInfo(
+    Enrollments,
+    (request: CourseRequest) => {
       return request.serializeBinary();
     },
-    Submissions.deserializeBinary
+    Enrollments.deserializeBinary
   );
 
-  getSubmissions(
-    request: SubmissionRequest,
-    metadata: grpcWeb.Metadata | null): Promise<Submissions>;
+  reconcileEnrollments(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Enrollments>;
 
-  getSubmissions(
-    request: SubmissionRequest,
+  reconcileEnrollments(
+    request: CourseRequest,
     metadata: grpcWeb.Metadata | null,
     callback: (err: grpcWeb.Error,
-               response: Submissions) => void): grpcWeb.ClientReadableStream<Submissions>;
+               response: Enrollments) => void): grpcWeb.ClientReadableStream<Enrollments>;
 
-  getSubmissions(
-    request: SubmissionRequest,
+  reconcileEnrollments(
+    request: CourseRequest,
     metadata: grpcWeb.Metadata | null,
     callback?: (err: grpcWeb.Error,
-               response: Submissions) => void) {
+               response: Enrollments) => void) {
     if (callback !== undefined) {
       return this.client_.rpcCall(
-        new URL('/AutograderService/GetSubmissions', this.hostname_).toString(),
+        new URL('/AutograderService/ReconcileEnrollments', this.hostname_).toString(),
         request,
         metadata || {},
-        this.methodInfoGetSubmissions,
+        this.methodInfoReconcileEnrollments,
         callback);
     }
     return this.client_.unaryCall(
     this.hostname_ +
-      '/AutograderService/GetSubmissions',
+      '/AutograderService/ReconcileEnrollments',
     request,
     metadata || {},
-    this.methodInfoGetSubmissions);
+    this.methodInfoReconcileEnrollments);
   }
 
-  methodInfoGetSubmissionsByCourse = new grpcWeb.AbstractClientBase.MethodInfo(
-    CourseSubmissions,
-    (request: SubmissionsForCourseRequest) => {
+  methodInfoImportEnrollments = new grpcWeb.AbstractClientBase.MethodInfo(
+    EnrollmentImport,
+    (request: CourseRequest) => {
       return request.serializeBinary();
     },
-    CourseSubmissions.deserializeBinary
+    EnrollmentImport.deserializeBinary
   );
 
-  getSubmissionsByCourse(
-    request: SubmissionsForCourseRequest,
-    metadata: grpcWeb.Metadata | null): Promise<CourseSubmissions>;
+  importEnrollments(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null): Promise<EnrollmentImport>;
 
-  getSubmissionsByCourse(
-    request: SubmissionsForCourseRequest,
+  importEnrollments(
+    request: CourseRequest,
     metadata: grpcWeb.Metadata | null,
     callback: (err: grpcWeb.Error,
-               response: CourseSubmissions) => void): grpcWeb.ClientReadableStream<CourseSubmissions>;
+               response: EnrollmentImport) => void): grpcWeb.ClientReadableStream<EnrollmentImport>;
+
+  importEnrollments(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: EnrollmentImport) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/ImportEnrollments', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoImportEnrollments,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/ImportEnrollments',
+    request,
+    metadata || {},
+    this.methodInfoImportEnrollments);
+  }
+
+  methodInfoLeaveCourse = new grpcWeb.AbstractClientBase.MethodInfo(
+    Void,
+    (request: CourseRequest) => {
+      return request.serializeBinary();
+    },
+    Void.deserializeBinary
+  );
+
+  leaveCourse(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Void>;
+
+  leaveCourse(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Void) => void): grpcWeb.ClientReadableStream<Void>;
+
+  leaveCourse(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Void) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/LeaveCourse', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoLeaveCourse,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/LeaveCourse',
+    request,
+    metadata || {},
+    this.methodInfoLeaveCourse);
+  }
+
+  methodInfoRejectEnrollments = new grpcWeb.AbstractClientBase.MethodInfo(
+    EnrollmentCount,
+    (request: RejectEnrollmentsRequest) => {
+      return request.serializeBinary();
+    },
+    EnrollmentCount.deserializeBinary
+  );
+
+  rejectEnrollments(
+    request: RejectEnrollmentsRequest,
+    metadata: grpcWeb.Metadata | null): Promise<EnrollmentCount>;
+
+  rejectEnrollments(
+    request: RejectEnrollmentsRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: EnrollmentCount) => void): grpcWeb.ClientReadableStream<EnrollmentCount>;
+
+  rejectEnrollments(
+    request: RejectEnrollmentsRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: EnrollmentCount) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/RejectEnrollments', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoRejectEnrollments,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/RejectEnrollments',
+    request,
+    metadata || {},
+    this.methodInfoRejectEnrollments);
+  }
+
+  methodInfoGetPendingEnrollmentCount = new grpcWeb.AbstractClientBase.MethodInfo(
+    EnrollmentCount,
+    (request: CourseRequest) => {
+      return request.serializeBinary();
+    },
+    EnrollmentCount.deserializeBinary
+  );
+
+  getPendingEnrollmentCount(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null): Promise<EnrollmentCount>;
+
+  getPendingEnrollmentCount(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: EnrollmentCount) => void): grpcWeb.ClientReadableStream<EnrollmentCount>;
+
+  getPendingEnrollmentCount(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: EnrollmentCount) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetPendingEnrollmentCount', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetPendingEnrollmentCount,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetPendingEnrollmentCount',
+    request,
+    metadata || {},
+    this.methodInfoGetPendingEnrollmentCount);
+  }
+
+  methodInfoGetSubmissions = new grpcWeb.AbstractClientBase.MethodInfo(
+    Submissions,
+    (request: SubmissionRequest) => {
+      return request.serializeBinary();
+    },
+    Submissions.deserializeBinary
+  );
+
+  getSubmissions(
+    request: SubmissionRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Submissions>;
+
+  getSubmissions(
+    request: SubmissionRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Submissions) => void): grpcWeb.ClientReadableStream<Submissions>;
+
+  getSubmissions(
+    request: SubmissionRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Submissions) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetSubmissions', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetSubmissions,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetSubmissions',
+    request,
+    metadata || {},
+    this.methodInfoGetSubmissions);
+  }
+
+  methodInfoGetSubmission = new grpcWeb.AbstractClientBase.MethodInfo(
+    Submission,
+    (request: AssignmentSubmissionRequest) => {
+      return request.serializeBinary();
+    },
+    Submission.deserializeBinary
+  );
+
+  getSubmission(
+    request: AssignmentSubmissionRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Submission>;
+
+  getSubmission(
+    request: AssignmentSubmissionRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Submission) => void): grpcWeb.ClientReadableStream<Submission>;
+
+  getSubmission(
+    request: AssignmentSubmissionRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Submission) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetSubmission', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetSubmission,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetSubmission',
+    request,
+    metadata || {},
+    this.methodInfoGetSubmission);
+  }
+
+  methodInfoGetSubmissionByID = new grpcWeb.AbstractClientBase.MethodInfo(
+    Submission,
+    (request: SubmissionIDRequest) => {
+      return request.serializeBinary();
+    },
+    Submission.deserializeBinary
+  );
+
+  getSubmissionByID(
+    request: SubmissionIDRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Submission>;
+
+  getSubmissionByID(
+    request: SubmissionIDRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Submission) => void): grpcWeb.ClientReadableStream<Submission>;
+
+  getSubmissionByID(
+    request: SubmissionIDRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Submission) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetSubmissionByID', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetSubmissionByID,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetSubmissionByID',
+    request,
+    metadata || {},
+    this.methodInfoGetSubmissionByID);
+  }
+
+  methodInfoGetSubmissionByCommit = new grpcWeb.AbstractClientBase.MethodInfo(
+    Submission,
+    (request: CommitSubmissionRequest) => {
+      return request.serializeBinary();
+    },
+    Submission.deserializeBinary
+  );
+
+  getSubmissionByCommit(
+    request: CommitSubmissionRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Submission>;
+
+  getSubmissionByCommit(
+    request: CommitSubmissionRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Submission) => void): grpcWeb.ClientReadableStream<Submission>;
+
+  getSubmissionByCommit(
+    request: CommitSubmissionRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Submission) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetSubmissionByCommit', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetSubmissionByCommit,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetSubmissionByCommit',
+    request,
+    metadata || {},
+    this.methodInfoGetSubmissionByCommit);
+  }
+
+  methodInfoGetSubmissionHistory = new grpcWeb.AbstractClientBase.MethodInfo(
+    SubmissionBuilds,
+    (request: SubmissionHistoryRequest) => {
+      return request.serializeBinary();
+    },
+    SubmissionBuilds.deserializeBinary
+  );
+
+  getSubmissionHistory(
+    request: SubmissionHistoryRequest,
+    metadata: grpcWeb.Metadata | null): Promise<SubmissionBuilds>;
+
+  getSubmissionHistory(
+    request: SubmissionHistoryRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: SubmissionBuilds) => void): grpcWeb.ClientReadableStream<SubmissionBuilds>;
+
+  getSubmissionHistory(
+    request: SubmissionHistoryRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: SubmissionBuilds) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetSubmissionHistory', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetSubmissionHistory,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetSubmissionHistory',
+    request,
+    metadata || {},
+    this.methodInfoGetSubmissionHistory);
+  }
+
+  methodInfoGetSubmissionBuildLog = new grpcWeb.AbstractClientBase.MethodInfo(
+    BuildLog,
+    (request: SubmissionIDRequest) => {
+      return request.serializeBinary();
+    },
+    BuildLog.deserializeBinary
+  );
+
+  getSubmissionBuildLog(
+    request: SubmissionIDRequest,
+    metadata: grpcWeb.Metadata | null): Promise<BuildLog>;
+
+  getSubmissionBuildLog(
+    request: SubmissionIDRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: BuildLog) => void): grpcWeb.ClientReadableStream<BuildLog>;
+
+  getSubmissionBuildLog(
+    request: SubmissionIDRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: BuildLog) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetSubmissionBuildLog', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetSubmissionBuildLog,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetSubmissionBuildLog',
+    request,
+    metadata || {},
+    this.methodInfoGetSubmissionBuildLog);
+  }
+
+  methodInfoAddSubmissionComment = new grpcWeb.AbstractClientBase.MethodInfo(
+    SubmissionComment,
+    (request: SubmissionComment) => {
+      return request.serializeBinary();
+    },
+    SubmissionComment.deserializeBinary
+  );
+
+  addSubmissionComment(
+    request: SubmissionComment,
+    metadata: grpcWeb.Metadata | null): Promise<SubmissionComment>;
+
+  addSubmissionComment(
+    request: SubmissionComment,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: SubmissionComment) => void): grpcWeb.ClientReadableStream<SubmissionComment>;
+
+  addSubmissionComment(
+    request: SubmissionComment,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: SubmissionComment) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/AddSubmissionComment', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoAddSubmissionComment,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/AddSubmissionComment',
+    request,
+    metadata || {},
+    this.methodInfoAddSubmissionComment);
+  }
+
+  methodInfoGetSubmissionComments = new grpcWeb.AbstractClientBase.MethodInfo(
+    SubmissionComments,
+    (request: SubmissionIDRequest) => {
+      return request.serializeBinary();
+    },
+    SubmissionComments.deserializeBinary
+  );
+
+  getSubmissionComments(
+    request: SubmissionIDRequest,
+    metadata: grpcWeb.Metadata | null): Promise<SubmissionComments>;
+
+  getSubmissionComments(
+    request: SubmissionIDRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: SubmissionComments) => void): grpcWeb.ClientReadableStream<SubmissionComments>;
+
+  getSubmissionComments(
+    request: SubmissionIDRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: SubmissionComments) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetSubmissionComments', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetSubmissionComments,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetSubmissionComments',
+    request,
+    metadata || {},
+    this.methodInfoGetSubmissionComments);
+  }
+
+  methodInfoGetCourseProgress = new grpcWeb.AbstractClientBase.MethodInfo(
+    EnrollmentLink,
+    (request: CourseRequest) => {
+      return request.serializeBinary();
+    },
+    EnrollmentLink.deserializeBinary
+  );
+
+  getCourseProgress(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null): Promise<EnrollmentLink>;
+
+  getCourseProgress(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: EnrollmentLink) => void): grpcWeb.ClientReadableStream<EnrollmentLink>;
+
+  getCourseProgress(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: EnrollmentLink) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetCourseProgress', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetCourseProgress,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetCourseProgress',
+    request,
+    metadata || {},
+    this.methodInfoGetCourseProgress);
+  }
+
+  methodInfoGetSubmissionsByCourse = new grpcWeb.AbstractClientBase.MethodInfo(
+    CourseSubmissions,
+    (request: SubmissionsForCourseRequest) => {
+      return request.serializeBinary();
+    },
+    CourseSubmissions.deserializeBinary
+  );
+
+  getSubmissionsByCourse(
+    request: SubmissionsForCourseRequest,
+    metadata: grpcWeb.Metadata | null): Promise<CourseSubmissions>;
+
+  getSubmissionsByCourse(
+    request: SubmissionsForCourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: CourseSubmissions) => void): grpcWeb.ClientReadableStream<CourseSubmissions>;
+
+  getSubmissionsByCourse(
+    request: SubmissionsForCourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: CourseSubmissions) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetSubmissionsByCourse', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetSubmissionsByCourse,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetSubmissionsByCourse',
+    request,
+    metadata || {},
+    this.methodInfoGetSubmissionsByCourse);
+  }
+
+  methodInfoGetGroupSubmissions = new grpcWeb.AbstractClientBase.MethodInfo(
+    Submissions,
+    (request: AssignmentRequest) => {
+      return request.serializeBinary();
+    },
+    Submissions.deserializeBinary
+  );
+
+  getGroupSubmissions(
+    request: AssignmentRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Submissions>;
+
+  getGroupSubmissions(
+    request: AssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Submissions) => void): grpcWeb.ClientReadableStream<Submissions>;
+
+  getGroupSubmissions(
+    request: AssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Submissions) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetGroupSubmissions', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetGroupSubmissions,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetGroupSubmissions',
+    request,
+    metadata || {},
+    this.methodInfoGetGroupSubmissions);
+  }
+
+  methodInfoExportCourseGrades = new grpcWeb.AbstractClientBase.MethodInfo(
+    CourseGrades,
+    (request: CourseRequest) => {
+      return request.serializeBinary();
+    },
+    CourseGrades.deserializeBinary
+  );
+
+  exportCourseGrades(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null): Promise<CourseGrades>;
+
+  exportCourseGrades(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: CourseGrades) => void): grpcWeb.ClientReadableStream<CourseGrades>;
+
+  exportCourseGrades(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: CourseGrades) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/ExportCourseGrades', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoExportCourseGrades,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/ExportCourseGrades',
+    request,
+    metadata || {},
+    this.methodInfoExportCourseGrades);
+  }
+
+  methodInfoExportEnrollments = new grpcWeb.AbstractClientBase.MethodInfo(
+    CourseRoster,
+    (request: CourseRequest) => {
+      return request.serializeBinary();
+    },
+    CourseRoster.deserializeBinary
+  );
+
+  exportEnrollments(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null): Promise<CourseRoster>;
+
+  exportEnrollments(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: CourseRoster) => void): grpcWeb.ClientReadableStream<CourseRoster>;
+
+  exportEnrollments(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: CourseRoster) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/ExportEnrollments', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoExportEnrollments,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/ExportEnrollments',
+    request,
+    metadata || {},
+    this.methodInfoExportEnrollments);
+  }
+
+  methodInfoUpdateSubmission = new grpcWeb.AbstractClientBase.MethodInfo(
+    Void,
+    (request: UpdateSubmissionRequest) => {
+      return request.serializeBinary();
+    },
+    Void.deserializeBinary
+  );
+
+  updateSubmission(
+    request: UpdateSubmissionRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Void>;
+
+  updateSubmission(
+    request: UpdateSubmissionRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Void) => void): grpcWeb.ClientReadableStream<Void>;
+
+  updateSubmission(
+    request: UpdateSubmissionRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Void) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/UpdateSubmission', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoUpdateSubmission,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/UpdateSubmission',
+    request,
+    metadata || {},
+    this.methodInfoUpdateSubmission);
+  }
+
+  methodInfoUpdateSubmissions = new grpcWeb.AbstractClientBase.MethodInfo(
+    Void,
+    (request: UpdateSubmissionsRequest) => {
+      return request.serializeBinary();
+    },
+    Void.deserializeBinary
+  );
+
+  updateSubmissions(
+    request: UpdateSubmissionsRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Void>;
+
+  updateSubmissions(
+    request: UpdateSubmissionsRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Void) => void): grpcWeb.ClientReadableStream<Void>;
+
+  updateSubmissions(
+    request: UpdateSubmissionsRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Void) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/UpdateSubmissions', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoUpdateSubmissions,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/UpdateSubmissions',
+    request,
+    metadata || {},
+    this.methodInfoUpdateSubmissions);
+  }
+
+  methodInfoApproveSubmissions = new grpcWeb.AbstractClientBase.MethodInfo(
+    SubmissionApprovals,
+    (request: ApproveSubmissionsRequest) => {
+      return request.serializeBinary();
+    },
+    SubmissionApprovals.deserializeBinary
+  );
+
+  approveSubmissions(
+    request: ApproveSubmissionsRequest,
+    metadata: grpcWeb.Metadata | null): Promise<SubmissionApprovals>;
+
+  approveSubmissions(
+    request: ApproveSubmissionsRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: SubmissionApprovals) => void): grpcWeb.ClientReadableStream<SubmissionApprovals>;
+
+  approveSubmissions(
+    request: ApproveSubmissionsRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: SubmissionApprovals) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/ApproveSubmissions', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoApproveSubmissions,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/ApproveSubmissions',
+    request,
+    metadata || {},
+    this.methodInfoApproveSubmissions);
+  }
+
+  methodInfoApproveSubmissionsAfterDeadline = new grpcWeb.AbstractClientBase.MethodInfo(
+    Void,
+    (request: AssignmentRequest) => {
+      return request.serializeBinary();
+    },
+    Void.deserializeBinary
+  );
+
+  approveSubmissionsAfterDeadline(
+    request: AssignmentRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Void>;
+
+  approveSubmissionsAfterDeadline(
+    request: AssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Void) => void): grpcWeb.ClientReadableStream<Void>;
+
+  approveSubmissionsAfterDeadline(
+    request: AssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Void) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/ApproveSubmissionsAfterDeadline', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoApproveSubmissionsAfterDeadline,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/ApproveSubmissionsAfterDeadline',
+    request,
+    metadata || {},
+    this.methodInfoApproveSubmissionsAfterDeadline);
+  }
+
+  methodInfoRebuildSubmission = new grpcWeb.AbstractClientBase.MethodInfo(
+    Submission,
+    (request: RebuildRequest) => {
+      return request.serializeBinary();
+    },
+    Submission.deserializeBinary
+  );
+
+  rebuildSubmission(
+    request: RebuildRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Submission>;
+
+  rebuildSubmission(
+    request: RebuildRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Submission) => void): grpcWeb.ClientReadableStream<Submission>;
 
-  getSubmissionsByCourse(
-    request: SubmissionsForCourseRequest,
+  rebuildSubmission(
+    request: RebuildRequest,
     metadata: grpcWeb.Metadata | null,
     callback?: (err: grpcWeb.Error,
-               response: CourseSubmissions) => void) {
+               response: Submission) => void) {
     if (callback !== undefined) {
       return this.client_.rpcCall(
-        new URL('/AutograderService/GetSubmissionsByCourse', this.hostname_).toString(),
+        new URL('/AutograderService/RebuildSubmission', this.hostname_).toString(),
         request,
         metadata || {},
-        this.methodInfoGetSubmissionsByCourse,
+        this.methodInfoRebuildSubmission,
         callback);
     }
     return this.client_.unaryCall(
     this.hostname_ +
-      '/AutograderService/GetSubmissionsByCourse',
+      '/AutograderService/RebuildSubmission',
     request,
     metadata || {},
-    this.methodInfoGetSubmissionsByCourse);
+    this.methodInfoRebuildSubmission);
   }
 
-  methodInfoUpdateSubmission = new grpcWeb.AbstractClientBase.MethodInfo(
-    Void,
-    (request: UpdateSubmissionRequest) => {
+  methodInfoApplyLatePenalty = new grpcWeb.AbstractClientBase.MethodInfo(
+    Submission,
+    (request: SubmissionIDRequest) => {
       return request.serializeBinary();
     },
-    Void.deserializeBinary
+    Submission.deserializeBinary
   );
 
-  updateSubmission(
-    request: UpdateSubmissionRequest,
-    metadata: grpcWeb.Metadata | null): Promise<Void>;
+  applyLatePenalty(
+    request: SubmissionIDRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Submission>;
 
-  updateSubmission(
-    request: UpdateSubmissionRequest,
+  applyLatePenalty(
+    request: SubmissionIDRequest,
     metadata: grpcWeb.Metadata | null,
     callback: (err: grpcWeb.Error,
-               response: Void) => void): grpcWeb.ClientReadableStream<Void>;
+               response: Submission) => void): grpcWeb.ClientReadableStream<Submission>;
 
-  updateSubmission(
-    request: UpdateSubmissionRequest,
+  applyLatePenalty(
+    request: SubmissionIDRequest,
     metadata: grpcWeb.Metadata | null,
     callback?: (err: grpcWeb.Error,
-               response: Void) => void) {
+               response: Submission) => void) {
     if (callback !== undefined) {
       return this.client_.rpcCall(
-        new URL('/AutograderService/UpdateSubmission', this.hostname_).toString(),
+        new URL('/AutograderService/ApplyLatePenalty', this.hostname_).toString(),
         request,
         metadata || {},
-        this.methodInfoUpdateSubmission,
+        this.methodInfoApplyLatePenalty,
         callback);
     }
     return this.client_.unaryCall(
     this.hostname_ +
-      '/AutograderService/UpdateSubmission',
+      '/AutograderService/ApplyLatePenalty',
     request,
     metadata || {},
-    this.methodInfoUpdateSubmission);
+    this.methodInfoApplyLatePenalty);
   }
 
-  methodInfoUpdateSubmissions = new grpcWeb.AbstractClientBase.MethodInfo(
+  methodInfoSubmitPullRequests = new grpcWeb.AbstractClientBase.MethodInfo(
     Void,
-    (request: UpdateSubmissionsRequest) => {
+    (request: CourseRequest) => {
       return request.serializeBinary();
     },
     Void.deserializeBinary
   );
 
-  updateSubmissions(
-    request: UpdateSubmissionsRequest,
+  submitPullRequests(
+    request: CourseRequest,
     metadata: grpcWeb.Metadata | null): Promise<Void>;
 
-  updateSubmissions(
-    request: UpdateSubmissionsRequest,
+  submitPullRequests(
+    request: CourseRequest,
     metadata: grpcWeb.Metadata | null,
     callback: (err: grpcWeb.Error,
                response: Void) => void): grpcWeb.ClientReadableStream<Void>;
 
-  updateSubmissions(
-    request: UpdateSubmissionsRequest,
+  submitPullRequests(
+    request: CourseRequest,
     metadata: grpcWeb.Metadata | null,
     callback?: (err: grpcWeb.Error,
                response: Void) => void) {
     if (callback !== undefined) {
       return this.client_.rpcCall(
-        new URL('/AutograderService/UpdateSubmissions', this.hostname_).toString(),
+        new URL('/AutograderService/SubmitPullRequests', this.hostname_).toString(),
         request,
         metadata || {},
-        this.methodInfoUpdateSubmissions,
+        this.methodInfoSubmitPullRequests,
         callback);
     }
     return this.client_.unaryCall(
     this.hostname_ +
-      '/AutograderService/UpdateSubmissions',
+      '/AutograderService/SubmitPullRequests',
     request,
     metadata || {},
-    this.methodInfoUpdateSubmissions);
+    this.methodInfoSubmitPullRequests);
   }
 
-  methodInfoRebuildSubmission = new grpcWeb.AbstractClientBase.MethodInfo(
-    Submission,
-    (request: RebuildRequest) => {
+  methodInfoReplayMissedSubmissions = new grpcWeb.AbstractClientBase.MethodInfo(
+    SubmissionCount,
+    (request: ReplayRequest) => {
       return request.serializeBinary();
     },
-    Submission.deserializeBinary
+    SubmissionCount.deserializeBinary
   );
 
-  rebuildSubmission(
-    request: RebuildRequest,
-    metadata: grpcWeb.Metadata | null): Promise<Submission>;
+  replayMissedSubmissions(
+    request: ReplayRequest,
+    metadata: grpcWeb.Metadata | null): Promise<SubmissionCount>;
 
-  rebuildSubmission(
-    request: RebuildRequest,
+  replayMissedSubmissions(
+    request: ReplayRequest,
     metadata: grpcWeb.Metadata | null,
     callback: (err: grpcWeb.Error,
-               response: Submission) => void): grpcWeb.ClientReadableStream<Submission>;
+               response: SubmissionCount) => void): grpcWeb.ClientReadableStream<SubmissionCount>;
 
-  rebuildSubmission(
-    request: RebuildRequest,
+  replayMissedSubmissions(
+    request: ReplayRequest,
     metadata: grpcWeb.Metadata | null,
     callback?: (err: grpcWeb.Error,
-               response: Submission) => void) {
+               response: SubmissionCount) => void) {
     if (callback !== undefined) {
       return this.client_.rpcCall(
-        new URL('/AutograderService/RebuildSubmission', this.hostname_).toString(),
+        new URL('/AutograderService/ReplayMissedSubmissions', this.hostname_).toString(),
         request,
         metadata || {},
-        this.methodInfoRebuildSubmission,
+        this.methodInfoReplayMissedSubmissions,
         callback);
     }
     return this.client_.unaryCall(
     this.hostname_ +
-      '/AutograderService/RebuildSubmission',
+      '/AutograderService/ReplayMissedSubmissions',
     request,
     metadata || {},
-    this.methodInfoRebuildSubmission);
+    this.methodInfoReplayMissedSubmissions);
   }
 
   methodInfoCreateBenchmark = new grpcWeb.AbstractClientBase.MethodInfo(
@@ -1517,6 +2838,45 @@ export class AutograderServiceClient {
     this.methodInfoUpdateReview);
   }
 
+  methodInfoScoreSubmissionByRubric = new grpcWeb.AbstractClientBase.MethodInfo(
+    Review,
+    (request: RubricScoreRequest) => {
+      return request.serializeBinary();
+    },
+    Review.deserializeBinary
+  );
+
+  scoreSubmissionByRubric(
+    request: RubricScoreRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Review>;
+
+  scoreSubmissionByRubric(
+    request: RubricScoreRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Review) => void): grpcWeb.ClientReadableStream<Review>;
+
+  scoreSubmissionByRubric(
+    request: RubricScoreRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Review) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/ScoreSubmissionByRubric', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoScoreSubmissionByRubric,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/ScoreSubmissionByRubric',
+    request,
+    metadata || {},
+    this.methodInfoScoreSubmissionByRubric);
+  }
+
   methodInfoGetReviewers = new grpcWeb.AbstractClientBase.MethodInfo(
     Reviewers,
     (request: SubmissionReviewersRequest) => {
@@ -1556,6 +2916,162 @@ export class AutograderServiceClient {
     this.methodInfoGetReviewers);
   }
 
+  methodInfoAssignGrader = new grpcWeb.AbstractClientBase.MethodInfo(
+    Void,
+    (request: AssignGraderRequest) => {
+      return request.serializeBinary();
+    },
+    Void.deserializeBinary
+  );
+
+  assignGrader(
+    request: AssignGraderRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Void>;
+
+  assignGrader(
+    request: AssignGraderRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Void) => void): grpcWeb.ClientReadableStream<Void>;
+
+  assignGrader(
+    request: AssignGraderRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Void) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/AssignGrader', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoAssignGrader,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/AssignGrader',
+    request,
+    metadata || {},
+    this.methodInfoAssignGrader);
+  }
+
+  methodInfoFlagForReview = new grpcWeb.AbstractClientBase.MethodInfo(
+    Void,
+    (request: SubmissionIDRequest) => {
+      return request.serializeBinary();
+    },
+    Void.deserializeBinary
+  );
+
+  flagForReview(
+    request: SubmissionIDRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Void>;
+
+  flagForReview(
+    request: SubmissionIDRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Void) => void): grpcWeb.ClientReadableStream<Void>;
+
+  flagForReview(
+    request: SubmissionIDRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Void) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/FlagForReview', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoFlagForReview,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/FlagForReview',
+    request,
+    metadata || {},
+    this.methodInfoFlagForReview);
+  }
+
+  methodInfoGetSubmissionsNeedingReview = new grpcWeb.AbstractClientBase.MethodInfo(
+    Submissions,
+    (request: CourseRequest) => {
+      return request.serializeBinary();
+    },
+    Submissions.deserializeBinary
+  );
+
+  getSubmissionsNeedingReview(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Submissions>;
+
+  getSubmissionsNeedingReview(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Submissions) => void): grpcWeb.ClientReadableStream<Submissions>;
+
+  getSubmissionsNeedingReview(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Submissions) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetSubmissionsNeedingReview', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetSubmissionsNeedingReview,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetSubmissionsNeedingReview',
+    request,
+    metadata || {},
+    this.methodInfoGetSubmissionsNeedingReview);
+  }
+
+  methodInfoGetSubmissionSimilarity = new grpcWeb.AbstractClientBase.MethodInfo(
+    SubmissionSimilarities,
+    (request: AssignmentRequest) => {
+      return request.serializeBinary();
+    },
+    SubmissionSimilarities.deserializeBinary
+  );
+
+  getSubmissionSimilarity(
+    request: AssignmentRequest,
+    metadata: grpcWeb.Metadata | null): Promise<SubmissionSimilarities>;
+
+  getSubmissionSimilarity(
+    request: AssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: SubmissionSimilarities) => void): grpcWeb.ClientReadableStream<SubmissionSimilarities>;
+
+  getSubmissionSimilarity(
+    request: AssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: SubmissionSimilarities) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetSubmissionSimilarity', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetSubmissionSimilarity,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetSubmissionSimilarity',
+    request,
+    metadata || {},
+    this.methodInfoGetSubmissionSimilarity);
+  }
+
   methodInfoLoadCriteria = new grpcWeb.AbstractClientBase.MethodInfo(
     Benchmarks,
     (request: LoadCriteriaRequest) => {
@@ -1751,5 +3267,83 @@ export class AutograderServiceClient {
     this.methodInfoIsEmptyRepo);
   }
 
+  methodInfoCreateRepositoryAccessToken = new grpcWeb.AbstractClientBase.MethodInfo(
+    RepositoryAccessToken,
+    (request: RepositoryRequest) => {
+      return request.serializeBinary();
+    },
+    RepositoryAccessToken.deserializeBinary
+  );
+
+  createRepositoryAccessToken(
+    request: RepositoryRequest,
+    metadata: grpcWeb.Metadata | null): Promise<RepositoryAccessToken>;
+
+  createRepositoryAccessToken(
+    request: RepositoryRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: RepositoryAccessToken) => void): grpcWeb.ClientReadableStream<RepositoryAccessToken>;
+
+  createRepositoryAccessToken(
+    request: RepositoryRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: RepositoryAccessToken) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/CreateRepositoryAccessToken', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoCreateRepositoryAccessToken,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/CreateRepositoryAccessToken',
+    request,
+    metadata || {},
+    this.methodInfoCreateRepositoryAccessToken);
+  }
+
+  methodInfoGetSCMAuditLog = new grpcWeb.AbstractClientBase.MethodInfo(
+    SCMAuditLog,
+    (request: CourseRequest) => {
+      return request.serializeBinary();
+    },
+    SCMAuditLog.deserializeBinary
+  );
+
+  getSCMAuditLog(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null): Promise<SCMAuditLog>;
+
+  getSCMAuditLog(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: SCMAuditLog) => void): grpcWeb.ClientReadableStream<SCMAuditLog>;
+
+  getSCMAuditLog(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: SCMAuditLog) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetSCMAuditLog', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetSCMAuditLog,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetSCMAuditLog',
+    request,
+    metadata || {},
+    this.methodInfoGetSCMAuditLog);
+  }
+
 }
 
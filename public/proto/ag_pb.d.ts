@@ -229,6 +229,62 @@ export class Course extends jspb.Message {
   clearGroupsList(): Course;
   addGroups(value?: Group, index?: number): Group;
 
+  getNumstudents(): number;
+  setNumstudents(value: number): Course;
+
+  getNumteachers(): number;
+  setNumteachers(value: number): Course;
+
+  getNumpending(): number;
+  setNumpending(value: number): Course;
+
+  getFeatures(): number;
+  setFeatures(value: number): Course;
+
+  getEmaildomainallowlist(): string;
+  setEmaildomainallowlist(value: string): Course;
+
+  getEnrollmentcode(): string;
+  setEnrollmentcode(value: string): Course;
+
+  getStartdate(): string;
+  setStartdate(value: string): Course;
+
+  getEnddate(): string;
+  setEnddate(value: string): Course;
+
+  getSlug(): string;
+  setSlug(value: string): Course;
+
+  getMaxstudents(): number;
+  setMaxstudents(value: number): Course;
+
+  getPrivate(): boolean;
+  setPrivate(value: boolean): Course;
+
+  getGradedbranches(): string;
+  setGradedbranches(value: string): Course;
+
+  getGradingconfigversion(): number;
+  setGradingconfigversion(value: number): Course;
+
+  getTemplaterepo(): string;
+  setTemplaterepo(value: string): Course;
+
+  getArchived(): boolean;
+  setArchived(value: boolean): Course;
+
+  getMaxgroupsize(): number;
+  setMaxgroupsize(value: number): Course;
+
+  getHookid(): number;
+  setHookid(value: number): Course;
+
+  getUpdatemaskList(): Array<string>;
+  setUpdatemaskList(value: Array<string>): Course;
+  clearUpdatemaskList(): Course;
+  addUpdatemask(value: string, index?: number): Course;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Course.AsObject;
   static toObject(includeInstance: boolean, msg: Course): Course.AsObject;
@@ -253,6 +309,34 @@ export namespace Course {
     enrollmentsList: Array<Enrollment.AsObject>,
     assignmentsList: Array<Assignment.AsObject>,
     groupsList: Array<Group.AsObject>,
+    numstudents: number,
+    numteachers: number,
+    numpending: number,
+    features: number,
+    emaildomainallowlist: string,
+    enrollmentcode: string,
+    startdate: string,
+    enddate: string,
+    slug: string,
+    maxstudents: number,
+    private: boolean,
+    gradedbranches: string,
+    gradingconfigversion: number,
+    templaterepo: string,
+    archived: boolean,
+    maxgroupsize: number,
+    hookid: number,
+    updatemaskList: Array<string>,
+  }
+
+  export enum Feature { 
+    NONE = 0,
+    AUTO_ENROLL = 1,
+    GROUPS_DISABLED = 2,
+    MANUAL_GRADING = 4,
+    PULL_REQUEST_SUBMISSIONS = 8,
+    ALLOW_FORCE_PUSH = 16,
+    GRADE_ON_ENROLL = 32,
   }
 }
 
@@ -276,6 +360,124 @@ export namespace Courses {
   }
 }
 
+export class ActivityEvent extends jspb.Message {
+  getType(): ActivityEvent.Type;
+  setType(value: ActivityEvent.Type): ActivityEvent;
+
+  getDate(): string;
+  setDate(value: string): ActivityEvent;
+
+  getCourseid(): number;
+  setCourseid(value: number): ActivityEvent;
+
+  getUserid(): number;
+  setUserid(value: number): ActivityEvent;
+
+  getGroupid(): number;
+  setGroupid(value: number): ActivityEvent;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): ActivityEvent;
+
+  getSubmissionid(): number;
+  setSubmissionid(value: number): ActivityEvent;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ActivityEvent.AsObject;
+  static toObject(includeInstance: boolean, msg: ActivityEvent): ActivityEvent.AsObject;
+  static serializeBinaryToWriter(message: ActivityEvent, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ActivityEvent;
+  static deserializeBinaryFromReader(message: ActivityEvent, reader: jspb.BinaryReader): ActivityEvent;
+}
+
+export namespace ActivityEvent {
+  export type AsObject = {
+    type: ActivityEvent.Type,
+    date: string,
+    courseid: number,
+    userid: number,
+    groupid: number,
+    assignmentid: number,
+    submissionid: number,
+  }
+
+  export enum Type { 
+    ENROLLMENT = 0,
+    SUBMISSION = 1,
+    APPROVAL = 2,
+  }
+}
+
+export class CourseActivity extends jspb.Message {
+  getEventsList(): Array<ActivityEvent>;
+  setEventsList(value: Array<ActivityEvent>): CourseActivity;
+  clearEventsList(): CourseActivity;
+  addEvents(value?: ActivityEvent, index?: number): ActivityEvent;
+
+  getNextcursor(): string;
+  setNextcursor(value: string): CourseActivity;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CourseActivity.AsObject;
+  static toObject(includeInstance: boolean, msg: CourseActivity): CourseActivity.AsObject;
+  static serializeBinaryToWriter(message: CourseActivity, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CourseActivity;
+  static deserializeBinaryFromReader(message: CourseActivity, reader: jspb.BinaryReader): CourseActivity;
+}
+
+export namespace CourseActivity {
+  export type AsObject = {
+    eventsList: Array<ActivityEvent.AsObject>,
+    nextcursor: string,
+  }
+}
+
+export class CourseEnrollment extends jspb.Message {
+  getCourse(): Course | undefined;
+  setCourse(value?: Course): CourseEnrollment;
+  hasCourse(): boolean;
+  clearCourse(): CourseEnrollment;
+
+  getEnrollment(): Enrollment | undefined;
+  setEnrollment(value?: Enrollment): CourseEnrollment;
+  hasEnrollment(): boolean;
+  clearEnrollment(): CourseEnrollment;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CourseEnrollment.AsObject;
+  static toObject(includeInstance: boolean, msg: CourseEnrollment): CourseEnrollment.AsObject;
+  static serializeBinaryToWriter(message: CourseEnrollment, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CourseEnrollment;
+  static deserializeBinaryFromReader(message: CourseEnrollment, reader: jspb.BinaryReader): CourseEnrollment;
+}
+
+export namespace CourseEnrollment {
+  export type AsObject = {
+    course?: Course.AsObject,
+    enrollment?: Enrollment.AsObject,
+  }
+}
+
+export class CourseEnrollments extends jspb.Message {
+  getCourseenrollmentsList(): Array<CourseEnrollment>;
+  setCourseenrollmentsList(value: Array<CourseEnrollment>): CourseEnrollments;
+  clearCourseenrollmentsList(): CourseEnrollments;
+  addCourseenrollments(value?: CourseEnrollment, index?: number): CourseEnrollment;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CourseEnrollments.AsObject;
+  static toObject(includeInstance: boolean, msg: CourseEnrollments): CourseEnrollments.AsObject;
+  static serializeBinaryToWriter(message: CourseEnrollments, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CourseEnrollments;
+  static deserializeBinaryFromReader(message: CourseEnrollments, reader: jspb.BinaryReader): CourseEnrollments;
+}
+
+export namespace CourseEnrollments {
+  export type AsObject = {
+    courseenrollmentsList: Array<CourseEnrollment.AsObject>,
+  }
+}
+
 export class Repository extends jspb.Message {
   getId(): number;
   setId(value: number): Repository;
@@ -298,6 +500,9 @@ export class Repository extends jspb.Message {
   getRepotype(): Repository.Type;
   setRepotype(value: Repository.Type): Repository;
 
+  getHookid(): number;
+  setHookid(value: number): Repository;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Repository.AsObject;
   static toObject(includeInstance: boolean, msg: Repository): Repository.AsObject;
@@ -315,6 +520,7 @@ export namespace Repository {
     groupid: number,
     htmlurl: string,
     repotype: Repository.Type,
+    hookid: number,
   }
 
   export enum Type { 
@@ -378,6 +584,21 @@ export class Enrollment extends jspb.Message {
   clearUsedslipdaysList(): Enrollment;
   addUsedslipdays(value?: UsedSlipDays, index?: number): UsedSlipDays;
 
+  getRejectreason(): string;
+  setRejectreason(value: string): Enrollment;
+
+  getEnrollmentcode(): string;
+  setEnrollmentcode(value: string): Enrollment;
+
+  getEnrolleddate(): string;
+  setEnrolleddate(value: string): Enrollment;
+
+  getRepositoryurl(): string;
+  setRepositoryurl(value: string): Enrollment;
+
+  getLastactivityat(): string;
+  setLastactivityat(value: string): Enrollment;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Enrollment.AsObject;
   static toObject(includeInstance: boolean, msg: Enrollment): Enrollment.AsObject;
@@ -402,6 +623,11 @@ export namespace Enrollment {
     lastactivitydate: string,
     totalapproved: number,
     usedslipdaysList: Array<UsedSlipDays.AsObject>,
+    rejectreason: string,
+    enrollmentcode: string,
+    enrolleddate: string,
+    repositoryurl: string,
+    lastactivityat: string,
   }
 
   export enum UserStatus { 
@@ -409,6 +635,7 @@ export namespace Enrollment {
     PENDING = 1,
     STUDENT = 2,
     TEACHER = 3,
+    LEFT = 4,
   }
 
   export enum DisplayState { 
@@ -469,6 +696,68 @@ export namespace Enrollments {
   }
 }
 
+export class EnrollmentImport extends jspb.Message {
+  getImportedList(): Array<Enrollment>;
+  setImportedList(value: Array<Enrollment>): EnrollmentImport;
+  clearImportedList(): EnrollmentImport;
+  addImported(value?: Enrollment, index?: number): Enrollment;
+
+  getUnmatchedList(): Array<string>;
+  setUnmatchedList(value: Array<string>): EnrollmentImport;
+  clearUnmatchedList(): EnrollmentImport;
+  addUnmatched(value: string, index?: number): EnrollmentImport;
+
+  getNorepositoryList(): Array<string>;
+  setNorepositoryList(value: Array<string>): EnrollmentImport;
+  clearNorepositoryList(): EnrollmentImport;
+  addNorepository(value: string, index?: number): EnrollmentImport;
+
+  getRejectedList(): Array<string>;
+  setRejectedList(value: Array<string>): EnrollmentImport;
+  clearRejectedList(): EnrollmentImport;
+  addRejected(value: string, index?: number): EnrollmentImport;
+
+  getCoursefullList(): Array<string>;
+  setCoursefullList(value: Array<string>): EnrollmentImport;
+  clearCoursefullList(): EnrollmentImport;
+  addCoursefull(value: string, index?: number): EnrollmentImport;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnrollmentImport.AsObject;
+  static toObject(includeInstance: boolean, msg: EnrollmentImport): EnrollmentImport.AsObject;
+  static serializeBinaryToWriter(message: EnrollmentImport, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnrollmentImport;
+  static deserializeBinaryFromReader(message: EnrollmentImport, reader: jspb.BinaryReader): EnrollmentImport;
+}
+
+export namespace EnrollmentImport {
+  export type AsObject = {
+    importedList: Array<Enrollment.AsObject>,
+    unmatchedList: Array<string>,
+    norepositoryList: Array<string>,
+    rejectedList: Array<string>,
+    coursefullList: Array<string>,
+  }
+}
+
+export class EnrollmentCount extends jspb.Message {
+  getCount(): number;
+  setCount(value: number): EnrollmentCount;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnrollmentCount.AsObject;
+  static toObject(includeInstance: boolean, msg: EnrollmentCount): EnrollmentCount.AsObject;
+  static serializeBinaryToWriter(message: EnrollmentCount, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnrollmentCount;
+  static deserializeBinaryFromReader(message: EnrollmentCount, reader: jspb.BinaryReader): EnrollmentCount;
+}
+
+export namespace EnrollmentCount {
+  export type AsObject = {
+    count: number,
+  }
+}
+
 export class SubmissionLink extends jspb.Message {
   getAssignment(): Assignment | undefined;
   setAssignment(value?: Assignment): SubmissionLink;
@@ -594,6 +883,21 @@ export class Assignment extends jspb.Message {
   getContainertimeout(): number;
   setContainertimeout(value: number): Assignment;
 
+  getLatepenalty(): number;
+  setLatepenalty(value: number): Assignment;
+
+  getMaxlatepenalty(): number;
+  setMaxlatepenalty(value: number): Assignment;
+
+  getPrerequisite(): number;
+  setPrerequisite(value: number): Assignment;
+
+  getMaxattempts(): number;
+  setMaxattempts(value: number): Assignment;
+
+  getCategory(): string;
+  setCategory(value: string): Assignment;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Assignment.AsObject;
   static toObject(includeInstance: boolean, msg: Assignment): Assignment.AsObject;
@@ -618,6 +922,11 @@ export namespace Assignment {
     submissionsList: Array<Submission.AsObject>,
     gradingbenchmarksList: Array<GradingBenchmark.AsObject>,
     containertimeout: number,
+    latepenalty: number,
+    maxlatepenalty: number,
+    prerequisite: number,
+    maxattempts: number,
+    category: string,
   }
 }
 
@@ -641,6 +950,24 @@ export namespace Assignments {
   }
 }
 
+export class CourseCalendar extends jspb.Message {
+  getIcs(): string;
+  setIcs(value: string): CourseCalendar;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CourseCalendar.AsObject;
+  static toObject(includeInstance: boolean, msg: CourseCalendar): CourseCalendar.AsObject;
+  static serializeBinaryToWriter(message: CourseCalendar, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CourseCalendar;
+  static deserializeBinaryFromReader(message: CourseCalendar, reader: jspb.BinaryReader): CourseCalendar;
+}
+
+export namespace CourseCalendar {
+  export type AsObject = {
+    ics: string,
+  }
+}
+
 export class Submission extends jspb.Message {
   getId(): number;
   setId(value: number): Submission;
@@ -680,6 +1007,24 @@ export class Submission extends jspb.Message {
   clearReviewsList(): Submission;
   addReviews(value?: Review, index?: number): Review;
 
+  getRawscore(): number;
+  setRawscore(value: number): Submission;
+
+  getAttempts(): number;
+  setAttempts(value: number): Submission;
+
+  getExtraattempts(): number;
+  setExtraattempts(value: number): Submission;
+
+  getNeedsreview(): boolean;
+  setNeedsreview(value: boolean): Submission;
+
+  getGradingconfigversion(): number;
+  setGradingconfigversion(value: number): Submission;
+
+  getBuilddate(): string;
+  setBuilddate(value: string): Submission;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Submission.AsObject;
   static toObject(includeInstance: boolean, msg: Submission): Submission.AsObject;
@@ -702,6 +1047,12 @@ export namespace Submission {
     status: Submission.Status,
     approveddate: string,
     reviewsList: Array<Review.AsObject>,
+    rawscore: number,
+    attempts: number,
+    extraattempts: number,
+    needsreview: boolean,
+    gradingconfigversion: number,
+    builddate: string,
   }
 
   export enum Status { 
@@ -732,6 +1083,138 @@ export namespace Submissions {
   }
 }
 
+export class SubmissionBuild extends jspb.Message {
+  getId(): number;
+  setId(value: number): SubmissionBuild;
+
+  getSubmissionid(): number;
+  setSubmissionid(value: number): SubmissionBuild;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): SubmissionBuild;
+
+  getUserid(): number;
+  setUserid(value: number): SubmissionBuild;
+
+  getGroupid(): number;
+  setGroupid(value: number): SubmissionBuild;
+
+  getScore(): number;
+  setScore(value: number): SubmissionBuild;
+
+  getCommithash(): string;
+  setCommithash(value: string): SubmissionBuild;
+
+  getBuilddate(): string;
+  setBuilddate(value: string): SubmissionBuild;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SubmissionBuild.AsObject;
+  static toObject(includeInstance: boolean, msg: SubmissionBuild): SubmissionBuild.AsObject;
+  static serializeBinaryToWriter(message: SubmissionBuild, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubmissionBuild;
+  static deserializeBinaryFromReader(message: SubmissionBuild, reader: jspb.BinaryReader): SubmissionBuild;
+}
+
+export namespace SubmissionBuild {
+  export type AsObject = {
+    id: number,
+    submissionid: number,
+    assignmentid: number,
+    userid: number,
+    groupid: number,
+    score: number,
+    commithash: string,
+    builddate: string,
+  }
+}
+
+export class SubmissionBuilds extends jspb.Message {
+  getBuildsList(): Array<SubmissionBuild>;
+  setBuildsList(value: Array<SubmissionBuild>): SubmissionBuilds;
+  clearBuildsList(): SubmissionBuilds;
+  addBuilds(value?: SubmissionBuild, index?: number): SubmissionBuild;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SubmissionBuilds.AsObject;
+  static toObject(includeInstance: boolean, msg: SubmissionBuilds): SubmissionBuilds.AsObject;
+  static serializeBinaryToWriter(message: SubmissionBuilds, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubmissionBuilds;
+  static deserializeBinaryFromReader(message: SubmissionBuilds, reader: jspb.BinaryReader): SubmissionBuilds;
+}
+
+export namespace SubmissionBuilds {
+  export type AsObject = {
+    buildsList: Array<SubmissionBuild.AsObject>,
+  }
+}
+
+export class Grade extends jspb.Message {
+  getUserid(): number;
+  setUserid(value: number): Grade;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): Grade;
+
+  getScore(): number;
+  setScore(value: number): Grade;
+
+  getStatus(): Submission.Status;
+  setStatus(value: Submission.Status): Grade;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Grade.AsObject;
+  static toObject(includeInstance: boolean, msg: Grade): Grade.AsObject;
+  static serializeBinaryToWriter(message: Grade, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Grade;
+  static deserializeBinaryFromReader(message: Grade, reader: jspb.BinaryReader): Grade;
+}
+
+export namespace Grade {
+  export type AsObject = {
+    userid: number,
+    assignmentid: number,
+    score: number,
+    status: Submission.Status,
+  }
+}
+
+export class CourseGrades extends jspb.Message {
+  getCsv(): string;
+  setCsv(value: string): CourseGrades;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CourseGrades.AsObject;
+  static toObject(includeInstance: boolean, msg: CourseGrades): CourseGrades.AsObject;
+  static serializeBinaryToWriter(message: CourseGrades, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CourseGrades;
+  static deserializeBinaryFromReader(message: CourseGrades, reader: jspb.BinaryReader): CourseGrades;
+}
+
+export namespace CourseGrades {
+  export type AsObject = {
+    csv: string,
+  }
+}
+
+export class CourseRoster extends jspb.Message {
+  getCsv(): string;
+  setCsv(value: string): CourseRoster;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CourseRoster.AsObject;
+  static toObject(includeInstance: boolean, msg: CourseRoster): CourseRoster.AsObject;
+  static serializeBinaryToWriter(message: CourseRoster, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CourseRoster;
+  static deserializeBinaryFromReader(message: CourseRoster, reader: jspb.BinaryReader): CourseRoster;
+}
+
+export namespace CourseRoster {
+  export type AsObject = {
+    csv: string,
+  }
+}
+
 export class GradingBenchmark extends jspb.Message {
   getId(): number;
   setId(value: number): GradingBenchmark;
@@ -884,81 +1367,467 @@ export namespace Review {
   }
 }
 
-export class Reviewers extends jspb.Message {
-  getReviewersList(): Array<User>;
-  setReviewersList(value: Array<User>): Reviewers;
-  clearReviewersList(): Reviewers;
-  addReviewers(value?: User, index?: number): User;
+export class SubmissionComment extends jspb.Message {
+  getId(): number;
+  setId(value: number): SubmissionComment;
+
+  getSubmissionid(): number;
+  setSubmissionid(value: number): SubmissionComment;
+
+  getUserid(): number;
+  setUserid(value: number): SubmissionComment;
+
+  getText(): string;
+  setText(value: string): SubmissionComment;
+
+  getDate(): string;
+  setDate(value: string): SubmissionComment;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): Reviewers.AsObject;
-  static toObject(includeInstance: boolean, msg: Reviewers): Reviewers.AsObject;
-  static serializeBinaryToWriter(message: Reviewers, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): Reviewers;
-  static deserializeBinaryFromReader(message: Reviewers, reader: jspb.BinaryReader): Reviewers;
+  toObject(includeInstance?: boolean): SubmissionComment.AsObject;
+  static toObject(includeInstance: boolean, msg: SubmissionComment): SubmissionComment.AsObject;
+  static serializeBinaryToWriter(message: SubmissionComment, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubmissionComment;
+  static deserializeBinaryFromReader(message: SubmissionComment, reader: jspb.BinaryReader): SubmissionComment;
 }
 
-export namespace Reviewers {
+export namespace SubmissionComment {
   export type AsObject = {
-    reviewersList: Array<User.AsObject>,
+    id: number,
+    submissionid: number,
+    userid: number,
+    text: string,
+    date: string,
   }
 }
 
-export class ReviewRequest extends jspb.Message {
-  getCourseid(): number;
-  setCourseid(value: number): ReviewRequest;
-
-  getReview(): Review | undefined;
-  setReview(value?: Review): ReviewRequest;
-  hasReview(): boolean;
-  clearReview(): ReviewRequest;
+export class SubmissionComments extends jspb.Message {
+  getCommentsList(): Array<SubmissionComment>;
+  setCommentsList(value: Array<SubmissionComment>): SubmissionComments;
+  clearCommentsList(): SubmissionComments;
+  addComments(value?: SubmissionComment, index?: number): SubmissionComment;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ReviewRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: ReviewRequest): ReviewRequest.AsObject;
-  static serializeBinaryToWriter(message: ReviewRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ReviewRequest;
-  static deserializeBinaryFromReader(message: ReviewRequest, reader: jspb.BinaryReader): ReviewRequest;
+  toObject(includeInstance?: boolean): SubmissionComments.AsObject;
+  static toObject(includeInstance: boolean, msg: SubmissionComments): SubmissionComments.AsObject;
+  static serializeBinaryToWriter(message: SubmissionComments, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubmissionComments;
+  static deserializeBinaryFromReader(message: SubmissionComments, reader: jspb.BinaryReader): SubmissionComments;
 }
 
-export namespace ReviewRequest {
+export namespace SubmissionComments {
   export type AsObject = {
-    courseid: number,
-    review?: Review.AsObject,
+    commentsList: Array<SubmissionComment.AsObject>,
   }
 }
 
-export class CourseRequest extends jspb.Message {
-  getCourseid(): number;
-  setCourseid(value: number): CourseRequest;
+export class SubmissionSimilarity extends jspb.Message {
+  getSubmissionid1(): number;
+  setSubmissionid1(value: number): SubmissionSimilarity;
+
+  getSubmissionid2(): number;
+  setSubmissionid2(value: number): SubmissionSimilarity;
+
+  getSimilarity(): number;
+  setSimilarity(value: number): SubmissionSimilarity;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): CourseRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: CourseRequest): CourseRequest.AsObject;
-  static serializeBinaryToWriter(message: CourseRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): CourseRequest;
-  static deserializeBinaryFromReader(message: CourseRequest, reader: jspb.BinaryReader): CourseRequest;
+  toObject(includeInstance?: boolean): SubmissionSimilarity.AsObject;
+  static toObject(includeInstance: boolean, msg: SubmissionSimilarity): SubmissionSimilarity.AsObject;
+  static serializeBinaryToWriter(message: SubmissionSimilarity, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubmissionSimilarity;
+  static deserializeBinaryFromReader(message: SubmissionSimilarity, reader: jspb.BinaryReader): SubmissionSimilarity;
 }
 
-export namespace CourseRequest {
+export namespace SubmissionSimilarity {
   export type AsObject = {
-    courseid: number,
+    submissionid1: number,
+    submissionid2: number,
+    similarity: number,
   }
 }
 
-export class UserRequest extends jspb.Message {
-  getUserid(): number;
-  setUserid(value: number): UserRequest;
+export class SubmissionSimilarities extends jspb.Message {
+  getSimilaritiesList(): Array<SubmissionSimilarity>;
+  setSimilaritiesList(value: Array<SubmissionSimilarity>): SubmissionSimilarities;
+  clearSimilaritiesList(): SubmissionSimilarities;
+  addSimilarities(value?: SubmissionSimilarity, index?: number): SubmissionSimilarity;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): UserRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: UserRequest): UserRequest.AsObject;
-  static serializeBinaryToWriter(message: UserRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): UserRequest;
-  static deserializeBinaryFromReader(message: UserRequest, reader: jspb.BinaryReader): UserRequest;
+  toObject(includeInstance?: boolean): SubmissionSimilarities.AsObject;
+  static toObject(includeInstance: boolean, msg: SubmissionSimilarities): SubmissionSimilarities.AsObject;
+  static serializeBinaryToWriter(message: SubmissionSimilarities, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubmissionSimilarities;
+  static deserializeBinaryFromReader(message: SubmissionSimilarities, reader: jspb.BinaryReader): SubmissionSimilarities;
 }
 
-export namespace UserRequest {
+export namespace SubmissionSimilarities {
+  export type AsObject = {
+    similaritiesList: Array<SubmissionSimilarity.AsObject>,
+  }
+}
+
+export class Reviewers extends jspb.Message {
+  getReviewersList(): Array<User>;
+  setReviewersList(value: Array<User>): Reviewers;
+  clearReviewersList(): Reviewers;
+  addReviewers(value?: User, index?: number): User;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Reviewers.AsObject;
+  static toObject(includeInstance: boolean, msg: Reviewers): Reviewers.AsObject;
+  static serializeBinaryToWriter(message: Reviewers, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Reviewers;
+  static deserializeBinaryFromReader(message: Reviewers, reader: jspb.BinaryReader): Reviewers;
+}
+
+export namespace Reviewers {
+  export type AsObject = {
+    reviewersList: Array<User.AsObject>,
+  }
+}
+
+export class GraderAssignment extends jspb.Message {
+  getId(): number;
+  setId(value: number): GraderAssignment;
+
+  getCourseid(): number;
+  setCourseid(value: number): GraderAssignment;
+
+  getGraderid(): number;
+  setGraderid(value: number): GraderAssignment;
+
+  getStudentid(): number;
+  setStudentid(value: number): GraderAssignment;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GraderAssignment.AsObject;
+  static toObject(includeInstance: boolean, msg: GraderAssignment): GraderAssignment.AsObject;
+  static serializeBinaryToWriter(message: GraderAssignment, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GraderAssignment;
+  static deserializeBinaryFromReader(message: GraderAssignment, reader: jspb.BinaryReader): GraderAssignment;
+}
+
+export namespace GraderAssignment {
+  export type AsObject = {
+    id: number,
+    courseid: number,
+    graderid: number,
+    studentid: number,
+  }
+}
+
+export class SCMAuditEntry extends jspb.Message {
+  getId(): number;
+  setId(value: number): SCMAuditEntry;
+
+  getCourseid(): number;
+  setCourseid(value: number): SCMAuditEntry;
+
+  getMethod(): string;
+  setMethod(value: string): SCMAuditEntry;
+
+  getOrganization(): string;
+  setOrganization(value: string): SCMAuditEntry;
+
+  getUser(): string;
+  setUser(value: string): SCMAuditEntry;
+
+  getResource(): string;
+  setResource(value: string): SCMAuditEntry;
+
+  getError(): string;
+  setError(value: string): SCMAuditEntry;
+
+  getDate(): string;
+  setDate(value: string): SCMAuditEntry;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SCMAuditEntry.AsObject;
+  static toObject(includeInstance: boolean, msg: SCMAuditEntry): SCMAuditEntry.AsObject;
+  static serializeBinaryToWriter(message: SCMAuditEntry, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SCMAuditEntry;
+  static deserializeBinaryFromReader(message: SCMAuditEntry, reader: jspb.BinaryReader): SCMAuditEntry;
+}
+
+export namespace SCMAuditEntry {
+  export type AsObject = {
+    id: number,
+    courseid: number,
+    method: string,
+    organization: string,
+    user: string,
+    resource: string,
+    error: string,
+    date: string,
+  }
+}
+
+export class SCMAuditLog extends jspb.Message {
+  getEntriesList(): Array<SCMAuditEntry>;
+  setEntriesList(value: Array<SCMAuditEntry>): SCMAuditLog;
+  clearEntriesList(): SCMAuditLog;
+  addEntries(value?: SCMAuditEntry, index?: number): SCMAuditEntry;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SCMAuditLog.AsObject;
+  static toObject(includeInstance: boolean, msg: SCMAuditLog): SCMAuditLog.AsObject;
+  static serializeBinaryToWriter(message: SCMAuditLog, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SCMAuditLog;
+  static deserializeBinaryFromReader(message: SCMAuditLog, reader: jspb.BinaryReader): SCMAuditLog;
+}
+
+export namespace SCMAuditLog {
+  export type AsObject = {
+    entriesList: Array<SCMAuditEntry.AsObject>,
+  }
+}
+
+export class ReviewRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): ReviewRequest;
+
+  getReview(): Review | undefined;
+  setReview(value?: Review): ReviewRequest;
+  hasReview(): boolean;
+  clearReview(): ReviewRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ReviewRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ReviewRequest): ReviewRequest.AsObject;
+  static serializeBinaryToWriter(message: ReviewRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ReviewRequest;
+  static deserializeBinaryFromReader(message: ReviewRequest, reader: jspb.BinaryReader): ReviewRequest;
+}
+
+export namespace ReviewRequest {
+  export type AsObject = {
+    courseid: number,
+    review?: Review.AsObject,
+  }
+}
+
+export class RubricScoreRequest extends jspb.Message {
+  getSubmissionid(): number;
+  setSubmissionid(value: number): RubricScoreRequest;
+
+  getGradesMap(): jspb.Map<number, GradingCriterion.Grade>;
+  clearGradesMap(): RubricScoreRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RubricScoreRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RubricScoreRequest): RubricScoreRequest.AsObject;
+  static serializeBinaryToWriter(message: RubricScoreRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RubricScoreRequest;
+  static deserializeBinaryFromReader(message: RubricScoreRequest, reader: jspb.BinaryReader): RubricScoreRequest;
+}
+
+export namespace RubricScoreRequest {
+  export type AsObject = {
+    submissionid: number,
+    gradesMap: Array<[number, GradingCriterion.Grade]>,
+  }
+}
+
+export class CourseRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): CourseRequest;
+
+  getWithstats(): boolean;
+  setWithstats(value: boolean): CourseRequest;
+
+  getSlug(): string;
+  setSlug(value: string): CourseRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CourseRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CourseRequest): CourseRequest.AsObject;
+  static serializeBinaryToWriter(message: CourseRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CourseRequest;
+  static deserializeBinaryFromReader(message: CourseRequest, reader: jspb.BinaryReader): CourseRequest;
+}
+
+export namespace CourseRequest {
+  export type AsObject = {
+    courseid: number,
+    withstats: boolean,
+    slug: string,
+  }
+}
+
+export class CourseActivityRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): CourseActivityRequest;
+
+  getSince(): string;
+  setSince(value: string): CourseActivityRequest;
+
+  getLimit(): number;
+  setLimit(value: number): CourseActivityRequest;
+
+  getCursor(): string;
+  setCursor(value: string): CourseActivityRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CourseActivityRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CourseActivityRequest): CourseActivityRequest.AsObject;
+  static serializeBinaryToWriter(message: CourseActivityRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CourseActivityRequest;
+  static deserializeBinaryFromReader(message: CourseActivityRequest, reader: jspb.BinaryReader): CourseActivityRequest;
+}
+
+export namespace CourseActivityRequest {
+  export type AsObject = {
+    courseid: number,
+    since: string,
+    limit: number,
+    cursor: string,
+  }
+}
+
+export class ReplayRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): ReplayRequest;
+
+  getSince(): string;
+  setSince(value: string): ReplayRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ReplayRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ReplayRequest): ReplayRequest.AsObject;
+  static serializeBinaryToWriter(message: ReplayRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ReplayRequest;
+  static deserializeBinaryFromReader(message: ReplayRequest, reader: jspb.BinaryReader): ReplayRequest;
+}
+
+export namespace ReplayRequest {
+  export type AsObject = {
+    courseid: number,
+    since: string,
+  }
+}
+
+export class SubmissionCount extends jspb.Message {
+  getCount(): number;
+  setCount(value: number): SubmissionCount;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SubmissionCount.AsObject;
+  static toObject(includeInstance: boolean, msg: SubmissionCount): SubmissionCount.AsObject;
+  static serializeBinaryToWriter(message: SubmissionCount, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubmissionCount;
+  static deserializeBinaryFromReader(message: SubmissionCount, reader: jspb.BinaryReader): SubmissionCount;
+}
+
+export namespace SubmissionCount {
+  export type AsObject = {
+    count: number,
+  }
+}
+
+export class CoursesRequest extends jspb.Message {
+  getCourseidsList(): Array<number>;
+  setCourseidsList(value: Array<number>): CoursesRequest;
+  clearCourseidsList(): CoursesRequest;
+  addCourseids(value: number, index?: number): CoursesRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CoursesRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CoursesRequest): CoursesRequest.AsObject;
+  static serializeBinaryToWriter(message: CoursesRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CoursesRequest;
+  static deserializeBinaryFromReader(message: CoursesRequest, reader: jspb.BinaryReader): CoursesRequest;
+}
+
+export namespace CoursesRequest {
+  export type AsObject = {
+    courseidsList: Array<number>,
+  }
+}
+
+export class UpdateCourseRequest extends jspb.Message {
+  getCourse(): Course | undefined;
+  setCourse(value?: Course): UpdateCourseRequest;
+  hasCourse(): boolean;
+  clearCourse(): UpdateCourseRequest;
+
+  getSkiporganizationcheck(): boolean;
+  setSkiporganizationcheck(value: boolean): UpdateCourseRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UpdateCourseRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: UpdateCourseRequest): UpdateCourseRequest.AsObject;
+  static serializeBinaryToWriter(message: UpdateCourseRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UpdateCourseRequest;
+  static deserializeBinaryFromReader(message: UpdateCourseRequest, reader: jspb.BinaryReader): UpdateCourseRequest;
+}
+
+export namespace UpdateCourseRequest {
+  export type AsObject = {
+    course?: Course.AsObject,
+    skiporganizationcheck: boolean,
+  }
+}
+
+export class CourseFeatureRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): CourseFeatureRequest;
+
+  getFeature(): Course.Feature;
+  setFeature(value: Course.Feature): CourseFeatureRequest;
+
+  getEnabled(): boolean;
+  setEnabled(value: boolean): CourseFeatureRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CourseFeatureRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CourseFeatureRequest): CourseFeatureRequest.AsObject;
+  static serializeBinaryToWriter(message: CourseFeatureRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CourseFeatureRequest;
+  static deserializeBinaryFromReader(message: CourseFeatureRequest, reader: jspb.BinaryReader): CourseFeatureRequest;
+}
+
+export namespace CourseFeatureRequest {
+  export type AsObject = {
+    courseid: number,
+    feature: Course.Feature,
+    enabled: boolean,
+  }
+}
+
+export class UpdateCourseWarnings extends jspb.Message {
+  getWarningsList(): Array<string>;
+  setWarningsList(value: Array<string>): UpdateCourseWarnings;
+  clearWarningsList(): UpdateCourseWarnings;
+  addWarnings(value: string, index?: number): UpdateCourseWarnings;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UpdateCourseWarnings.AsObject;
+  static toObject(includeInstance: boolean, msg: UpdateCourseWarnings): UpdateCourseWarnings.AsObject;
+  static serializeBinaryToWriter(message: UpdateCourseWarnings, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UpdateCourseWarnings;
+  static deserializeBinaryFromReader(message: UpdateCourseWarnings, reader: jspb.BinaryReader): UpdateCourseWarnings;
+}
+
+export namespace UpdateCourseWarnings {
+  export type AsObject = {
+    warningsList: Array<string>,
+  }
+}
+
+export class UserRequest extends jspb.Message {
+  getUserid(): number;
+  setUserid(value: number): UserRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UserRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: UserRequest): UserRequest.AsObject;
+  static serializeBinaryToWriter(message: UserRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UserRequest;
+  static deserializeBinaryFromReader(message: UserRequest, reader: jspb.BinaryReader): UserRequest;
+}
+
+export namespace UserRequest {
   export type AsObject = {
     userid: number,
   }
@@ -1109,6 +1978,9 @@ export class EnrollmentRequest extends jspb.Message {
   clearStatusesList(): EnrollmentRequest;
   addStatuses(value: Enrollment.UserStatus, index?: number): EnrollmentRequest;
 
+  getOnlygroupmembers(): boolean;
+  setOnlygroupmembers(value: boolean): EnrollmentRequest;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): EnrollmentRequest.AsObject;
   static toObject(includeInstance: boolean, msg: EnrollmentRequest): EnrollmentRequest.AsObject;
@@ -1123,6 +1995,7 @@ export namespace EnrollmentRequest {
     ignoregroupmembers: boolean,
     withactivity: boolean,
     statusesList: Array<Enrollment.UserStatus>,
+    onlygroupmembers: boolean,
   }
 }
 
@@ -1150,6 +2023,176 @@ export namespace EnrollmentStatusRequest {
   }
 }
 
+export class RejectEnrollmentsRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): RejectEnrollmentsRequest;
+
+  getReason(): string;
+  setReason(value: string): RejectEnrollmentsRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RejectEnrollmentsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RejectEnrollmentsRequest): RejectEnrollmentsRequest.AsObject;
+  static serializeBinaryToWriter(message: RejectEnrollmentsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RejectEnrollmentsRequest;
+  static deserializeBinaryFromReader(message: RejectEnrollmentsRequest, reader: jspb.BinaryReader): RejectEnrollmentsRequest;
+}
+
+export namespace RejectEnrollmentsRequest {
+  export type AsObject = {
+    courseid: number,
+    reason: string,
+  }
+}
+
+export class EnrollmentDetailsRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): EnrollmentDetailsRequest;
+
+  getWithdetails(): boolean;
+  setWithdetails(value: boolean): EnrollmentDetailsRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnrollmentDetailsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: EnrollmentDetailsRequest): EnrollmentDetailsRequest.AsObject;
+  static serializeBinaryToWriter(message: EnrollmentDetailsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnrollmentDetailsRequest;
+  static deserializeBinaryFromReader(message: EnrollmentDetailsRequest, reader: jspb.BinaryReader): EnrollmentDetailsRequest;
+}
+
+export namespace EnrollmentDetailsRequest {
+  export type AsObject = {
+    courseid: number,
+    withdetails: boolean,
+  }
+}
+
+export class AssignmentSubmissionRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): AssignmentSubmissionRequest;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): AssignmentSubmissionRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AssignmentSubmissionRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: AssignmentSubmissionRequest): AssignmentSubmissionRequest.AsObject;
+  static serializeBinaryToWriter(message: AssignmentSubmissionRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AssignmentSubmissionRequest;
+  static deserializeBinaryFromReader(message: AssignmentSubmissionRequest, reader: jspb.BinaryReader): AssignmentSubmissionRequest;
+}
+
+export namespace AssignmentSubmissionRequest {
+  export type AsObject = {
+    courseid: number,
+    assignmentid: number,
+  }
+}
+
+export class AutoApproveRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): AutoApproveRequest;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): AutoApproveRequest;
+
+  getEnabled(): boolean;
+  setEnabled(value: boolean): AutoApproveRequest;
+
+  getScorelimit(): number;
+  setScorelimit(value: number): AutoApproveRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AutoApproveRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: AutoApproveRequest): AutoApproveRequest.AsObject;
+  static serializeBinaryToWriter(message: AutoApproveRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AutoApproveRequest;
+  static deserializeBinaryFromReader(message: AutoApproveRequest, reader: jspb.BinaryReader): AutoApproveRequest;
+}
+
+export namespace AutoApproveRequest {
+  export type AsObject = {
+    courseid: number,
+    assignmentid: number,
+    enabled: boolean,
+    scorelimit: number,
+  }
+}
+
+export class AssignmentRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): AssignmentRequest;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): AssignmentRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AssignmentRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: AssignmentRequest): AssignmentRequest.AsObject;
+  static serializeBinaryToWriter(message: AssignmentRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AssignmentRequest;
+  static deserializeBinaryFromReader(message: AssignmentRequest, reader: jspb.BinaryReader): AssignmentRequest;
+}
+
+export namespace AssignmentRequest {
+  export type AsObject = {
+    courseid: number,
+    assignmentid: number,
+  }
+}
+
+export class CommitSubmissionRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): CommitSubmissionRequest;
+
+  getRepositoryid(): number;
+  setRepositoryid(value: number): CommitSubmissionRequest;
+
+  getCommithash(): string;
+  setCommithash(value: string): CommitSubmissionRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CommitSubmissionRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CommitSubmissionRequest): CommitSubmissionRequest.AsObject;
+  static serializeBinaryToWriter(message: CommitSubmissionRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CommitSubmissionRequest;
+  static deserializeBinaryFromReader(message: CommitSubmissionRequest, reader: jspb.BinaryReader): CommitSubmissionRequest;
+}
+
+export namespace CommitSubmissionRequest {
+  export type AsObject = {
+    courseid: number,
+    repositoryid: number,
+    commithash: string,
+  }
+}
+
+export class SubmissionHistoryRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): SubmissionHistoryRequest;
+
+  getUserid(): number;
+  setUserid(value: number): SubmissionHistoryRequest;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): SubmissionHistoryRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SubmissionHistoryRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SubmissionHistoryRequest): SubmissionHistoryRequest.AsObject;
+  static serializeBinaryToWriter(message: SubmissionHistoryRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubmissionHistoryRequest;
+  static deserializeBinaryFromReader(message: SubmissionHistoryRequest, reader: jspb.BinaryReader): SubmissionHistoryRequest;
+}
+
+export namespace SubmissionHistoryRequest {
+  export type AsObject = {
+    courseid: number,
+    userid: number,
+    assignmentid: number,
+  }
+}
+
 export class SubmissionRequest extends jspb.Message {
   getUserid(): number;
   setUserid(value: number): SubmissionRequest;
@@ -1160,6 +2203,15 @@ export class SubmissionRequest extends jspb.Message {
   getCourseid(): number;
   setCourseid(value: number): SubmissionRequest;
 
+  getFilter(): SubmissionRequest.Filter;
+  setFilter(value: SubmissionRequest.Filter): SubmissionRequest;
+
+  getOrder(): SubmissionRequest.Order;
+  setOrder(value: SubmissionRequest.Order): SubmissionRequest;
+
+  getCategory(): string;
+  setCategory(value: string): SubmissionRequest;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): SubmissionRequest.AsObject;
   static toObject(includeInstance: boolean, msg: SubmissionRequest): SubmissionRequest.AsObject;
@@ -1173,6 +2225,20 @@ export namespace SubmissionRequest {
     userid: number,
     groupid: number,
     courseid: number,
+    filter: SubmissionRequest.Filter,
+    order: SubmissionRequest.Order,
+    category: string,
+  }
+
+  export enum Filter { 
+    ALL = 0,
+    APPROVED = 1,
+    UNAPPROVED = 2,
+  }
+
+  export enum Order { 
+    NEWEST = 0,
+    OLDEST = 1,
   }
 }
 
@@ -1192,6 +2258,9 @@ export class UpdateSubmissionRequest extends jspb.Message {
   getStatus(): Submission.Status;
   setStatus(value: Submission.Status): UpdateSubmissionRequest;
 
+  getExtraattempts(): number;
+  setExtraattempts(value: number): UpdateSubmissionRequest;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): UpdateSubmissionRequest.AsObject;
   static toObject(includeInstance: boolean, msg: UpdateSubmissionRequest): UpdateSubmissionRequest.AsObject;
@@ -1207,6 +2276,7 @@ export namespace UpdateSubmissionRequest {
     score: number,
     released: boolean,
     status: Submission.Status,
+    extraattempts: number,
   }
 }
 
@@ -1244,6 +2314,80 @@ export namespace UpdateSubmissionsRequest {
   }
 }
 
+export class ApproveSubmissionsRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): ApproveSubmissionsRequest;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): ApproveSubmissionsRequest;
+
+  getSubmissionidsList(): Array<number>;
+  setSubmissionidsList(value: Array<number>): ApproveSubmissionsRequest;
+  clearSubmissionidsList(): ApproveSubmissionsRequest;
+  addSubmissionids(value: number, index?: number): ApproveSubmissionsRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ApproveSubmissionsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ApproveSubmissionsRequest): ApproveSubmissionsRequest.AsObject;
+  static serializeBinaryToWriter(message: ApproveSubmissionsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ApproveSubmissionsRequest;
+  static deserializeBinaryFromReader(message: ApproveSubmissionsRequest, reader: jspb.BinaryReader): ApproveSubmissionsRequest;
+}
+
+export namespace ApproveSubmissionsRequest {
+  export type AsObject = {
+    courseid: number,
+    assignmentid: number,
+    submissionidsList: Array<number>,
+  }
+}
+
+export class SubmissionApproval extends jspb.Message {
+  getSubmissionid(): number;
+  setSubmissionid(value: number): SubmissionApproval;
+
+  getApproved(): boolean;
+  setApproved(value: boolean): SubmissionApproval;
+
+  getError(): string;
+  setError(value: string): SubmissionApproval;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SubmissionApproval.AsObject;
+  static toObject(includeInstance: boolean, msg: SubmissionApproval): SubmissionApproval.AsObject;
+  static serializeBinaryToWriter(message: SubmissionApproval, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubmissionApproval;
+  static deserializeBinaryFromReader(message: SubmissionApproval, reader: jspb.BinaryReader): SubmissionApproval;
+}
+
+export namespace SubmissionApproval {
+  export type AsObject = {
+    submissionid: number,
+    approved: boolean,
+    error: string,
+  }
+}
+
+export class SubmissionApprovals extends jspb.Message {
+  getApprovalsList(): Array<SubmissionApproval>;
+  setApprovalsList(value: Array<SubmissionApproval>): SubmissionApprovals;
+  clearApprovalsList(): SubmissionApprovals;
+  addApprovals(value?: SubmissionApproval, index?: number): SubmissionApproval;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SubmissionApprovals.AsObject;
+  static toObject(includeInstance: boolean, msg: SubmissionApprovals): SubmissionApprovals.AsObject;
+  static serializeBinaryToWriter(message: SubmissionApprovals, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubmissionApprovals;
+  static deserializeBinaryFromReader(message: SubmissionApprovals, reader: jspb.BinaryReader): SubmissionApprovals;
+}
+
+export namespace SubmissionApprovals {
+  export type AsObject = {
+    approvalsList: Array<SubmissionApproval.AsObject>,
+  }
+}
+
 export class SubmissionReviewersRequest extends jspb.Message {
   getSubmissionid(): number;
   setSubmissionid(value: number): SubmissionReviewersRequest;
@@ -1266,6 +2410,42 @@ export namespace SubmissionReviewersRequest {
   }
 }
 
+export class SubmissionIDRequest extends jspb.Message {
+  getSubmissionid(): number;
+  setSubmissionid(value: number): SubmissionIDRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SubmissionIDRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SubmissionIDRequest): SubmissionIDRequest.AsObject;
+  static serializeBinaryToWriter(message: SubmissionIDRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubmissionIDRequest;
+  static deserializeBinaryFromReader(message: SubmissionIDRequest, reader: jspb.BinaryReader): SubmissionIDRequest;
+}
+
+export namespace SubmissionIDRequest {
+  export type AsObject = {
+    submissionid: number,
+  }
+}
+
+export class BuildLog extends jspb.Message {
+  getLog(): string;
+  setLog(value: string): BuildLog;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BuildLog.AsObject;
+  static toObject(includeInstance: boolean, msg: BuildLog): BuildLog.AsObject;
+  static serializeBinaryToWriter(message: BuildLog, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BuildLog;
+  static deserializeBinaryFromReader(message: BuildLog, reader: jspb.BinaryReader): BuildLog;
+}
+
+export namespace BuildLog {
+  export type AsObject = {
+    log: string,
+  }
+}
+
 export class Providers extends jspb.Message {
   getProvidersList(): Array<string>;
   setProvidersList(value: Array<string>): Providers;
@@ -1295,6 +2475,9 @@ export class URLRequest extends jspb.Message {
   clearRepotypesList(): URLRequest;
   addRepotypes(value: Repository.Type, index?: number): URLRequest;
 
+  getUserid(): number;
+  setUserid(value: number): URLRequest;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): URLRequest.AsObject;
   static toObject(includeInstance: boolean, msg: URLRequest): URLRequest.AsObject;
@@ -1307,6 +2490,7 @@ export namespace URLRequest {
   export type AsObject = {
     courseid: number,
     repotypesList: Array<Repository.Type>,
+    userid: number,
   }
 }
 
@@ -1354,6 +2538,32 @@ export namespace Repositories {
   }
 }
 
+export class RepositoryAccessToken extends jspb.Message {
+  getToken(): string;
+  setToken(value: string): RepositoryAccessToken;
+
+  getExpiresat(): string;
+  setExpiresat(value: string): RepositoryAccessToken;
+
+  getRepositoryurl(): string;
+  setRepositoryurl(value: string): RepositoryAccessToken;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RepositoryAccessToken.AsObject;
+  static toObject(includeInstance: boolean, msg: RepositoryAccessToken): RepositoryAccessToken.AsObject;
+  static serializeBinaryToWriter(message: RepositoryAccessToken, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RepositoryAccessToken;
+  static deserializeBinaryFromReader(message: RepositoryAccessToken, reader: jspb.BinaryReader): RepositoryAccessToken;
+}
+
+export namespace RepositoryAccessToken {
+  export type AsObject = {
+    token: string,
+    expiresat: string,
+    repositoryurl: string,
+  }
+}
+
 export class AuthorizationResponse extends jspb.Message {
   getIsauthorized(): boolean;
   setIsauthorized(value: boolean): AuthorizationResponse;
@@ -1404,6 +2614,12 @@ export class SubmissionsForCourseRequest extends jspb.Message {
   getSkipbuildinfo(): boolean;
   setSkipbuildinfo(value: boolean): SubmissionsForCourseRequest;
 
+  getGraderid(): number;
+  setGraderid(value: number): SubmissionsForCourseRequest;
+
+  getCategory(): string;
+  setCategory(value: string): SubmissionsForCourseRequest;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): SubmissionsForCourseRequest.AsObject;
   static toObject(includeInstance: boolean, msg: SubmissionsForCourseRequest): SubmissionsForCourseRequest.AsObject;
@@ -1417,6 +2633,8 @@ export namespace SubmissionsForCourseRequest {
     courseid: number,
     type: SubmissionsForCourseRequest.Type,
     skipbuildinfo: boolean,
+    graderid: number,
+    category: string,
   }
 
   export enum Type { 
@@ -1426,6 +2644,34 @@ export namespace SubmissionsForCourseRequest {
   }
 }
 
+export class AssignGraderRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): AssignGraderRequest;
+
+  getGraderid(): number;
+  setGraderid(value: number): AssignGraderRequest;
+
+  getStudentidsList(): Array<number>;
+  setStudentidsList(value: Array<number>): AssignGraderRequest;
+  clearStudentidsList(): AssignGraderRequest;
+  addStudentids(value: number, index?: number): AssignGraderRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AssignGraderRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: AssignGraderRequest): AssignGraderRequest.AsObject;
+  static serializeBinaryToWriter(message: AssignGraderRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AssignGraderRequest;
+  static deserializeBinaryFromReader(message: AssignGraderRequest, reader: jspb.BinaryReader): AssignGraderRequest;
+}
+
+export namespace AssignGraderRequest {
+  export type AsObject = {
+    courseid: number,
+    graderid: number,
+    studentidsList: Array<number>,
+  }
+}
+
 export class RebuildRequest extends jspb.Message {
   getSubmissionid(): number;
   setSubmissionid(value: number): RebuildRequest;
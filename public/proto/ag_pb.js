@@ -15,23 +15,47 @@ var jspb = require('google-protobuf');
 var goog = jspb;
 var global = Function('return this')();
 
+goog.exportSymbol('proto.ActivityEvent', null, global);
+goog.exportSymbol('proto.ActivityEvent.Type', null, global);
+goog.exportSymbol('proto.ApproveSubmissionsRequest', null, global);
+goog.exportSymbol('proto.AssignGraderRequest', null, global);
 goog.exportSymbol('proto.Assignment', null, global);
+goog.exportSymbol('proto.AssignmentRequest', null, global);
+goog.exportSymbol('proto.AssignmentSubmissionRequest', null, global);
 goog.exportSymbol('proto.Assignments', null, global);
 goog.exportSymbol('proto.AuthorizationResponse', null, global);
+goog.exportSymbol('proto.AutoApproveRequest', null, global);
 goog.exportSymbol('proto.Benchmarks', null, global);
+goog.exportSymbol('proto.BuildLog', null, global);
+goog.exportSymbol('proto.CommitSubmissionRequest', null, global);
 goog.exportSymbol('proto.Course', null, global);
+goog.exportSymbol('proto.Course.Feature', null, global);
+goog.exportSymbol('proto.CourseActivity', null, global);
+goog.exportSymbol('proto.CourseActivityRequest', null, global);
+goog.exportSymbol('proto.CourseCalendar', null, global);
+goog.exportSymbol('proto.CourseEnrollment', null, global);
+goog.exportSymbol('proto.CourseEnrollments', null, global);
+goog.exportSymbol('proto.CourseFeatureRequest', null, global);
+goog.exportSymbol('proto.CourseGrades', null, global);
 goog.exportSymbol('proto.CourseRequest', null, global);
+goog.exportSymbol('proto.CourseRoster', null, global);
 goog.exportSymbol('proto.CourseSubmissions', null, global);
 goog.exportSymbol('proto.CourseUserRequest', null, global);
 goog.exportSymbol('proto.Courses', null, global);
+goog.exportSymbol('proto.CoursesRequest', null, global);
 goog.exportSymbol('proto.Enrollment', null, global);
 goog.exportSymbol('proto.Enrollment.DisplayState', null, global);
 goog.exportSymbol('proto.Enrollment.UserStatus', null, global);
+goog.exportSymbol('proto.EnrollmentCount', null, global);
+goog.exportSymbol('proto.EnrollmentDetailsRequest', null, global);
+goog.exportSymbol('proto.EnrollmentImport', null, global);
 goog.exportSymbol('proto.EnrollmentLink', null, global);
 goog.exportSymbol('proto.EnrollmentRequest', null, global);
 goog.exportSymbol('proto.EnrollmentStatusRequest', null, global);
 goog.exportSymbol('proto.Enrollments', null, global);
 goog.exportSymbol('proto.GetGroupRequest', null, global);
+goog.exportSymbol('proto.Grade', null, global);
+goog.exportSymbol('proto.GraderAssignment', null, global);
 goog.exportSymbol('proto.GradingBenchmark', null, global);
 goog.exportSymbol('proto.GradingCriterion', null, global);
 goog.exportSymbol('proto.GradingCriterion.Grade', null, global);
@@ -46,24 +70,45 @@ goog.exportSymbol('proto.Organizations', null, global);
 goog.exportSymbol('proto.Provider', null, global);
 goog.exportSymbol('proto.Providers', null, global);
 goog.exportSymbol('proto.RebuildRequest', null, global);
+goog.exportSymbol('proto.RejectEnrollmentsRequest', null, global);
 goog.exportSymbol('proto.RemoteIdentity', null, global);
+goog.exportSymbol('proto.ReplayRequest', null, global);
 goog.exportSymbol('proto.Repositories', null, global);
 goog.exportSymbol('proto.Repository', null, global);
 goog.exportSymbol('proto.Repository.Type', null, global);
+goog.exportSymbol('proto.RepositoryAccessToken', null, global);
 goog.exportSymbol('proto.RepositoryRequest', null, global);
 goog.exportSymbol('proto.Review', null, global);
 goog.exportSymbol('proto.ReviewRequest', null, global);
 goog.exportSymbol('proto.Reviewers', null, global);
+goog.exportSymbol('proto.RubricScoreRequest', null, global);
+goog.exportSymbol('proto.SCMAuditEntry', null, global);
+goog.exportSymbol('proto.SCMAuditLog', null, global);
 goog.exportSymbol('proto.Status', null, global);
 goog.exportSymbol('proto.Submission', null, global);
 goog.exportSymbol('proto.Submission.Status', null, global);
+goog.exportSymbol('proto.SubmissionApproval', null, global);
+goog.exportSymbol('proto.SubmissionApprovals', null, global);
+goog.exportSymbol('proto.SubmissionBuild', null, global);
+goog.exportSymbol('proto.SubmissionBuilds', null, global);
+goog.exportSymbol('proto.SubmissionComment', null, global);
+goog.exportSymbol('proto.SubmissionComments', null, global);
+goog.exportSymbol('proto.SubmissionCount', null, global);
+goog.exportSymbol('proto.SubmissionHistoryRequest', null, global);
+goog.exportSymbol('proto.SubmissionIDRequest', null, global);
 goog.exportSymbol('proto.SubmissionLink', null, global);
 goog.exportSymbol('proto.SubmissionRequest', null, global);
+goog.exportSymbol('proto.SubmissionRequest.Filter', null, global);
+goog.exportSymbol('proto.SubmissionRequest.Order', null, global);
 goog.exportSymbol('proto.SubmissionReviewersRequest', null, global);
+goog.exportSymbol('proto.SubmissionSimilarities', null, global);
+goog.exportSymbol('proto.SubmissionSimilarity', null, global);
 goog.exportSymbol('proto.Submissions', null, global);
 goog.exportSymbol('proto.SubmissionsForCourseRequest', null, global);
 goog.exportSymbol('proto.SubmissionsForCourseRequest.Type', null, global);
 goog.exportSymbol('proto.URLRequest', null, global);
+goog.exportSymbol('proto.UpdateCourseRequest', null, global);
+goog.exportSymbol('proto.UpdateCourseWarnings', null, global);
 goog.exportSymbol('proto.UpdateSubmissionRequest', null, global);
 goog.exportSymbol('proto.UpdateSubmissionsRequest', null, global);
 goog.exportSymbol('proto.UsedSlipDays', null, global);
@@ -228,16 +273,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.Repository = function(opt_data) {
+proto.ActivityEvent = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.Repository, jspb.Message);
+goog.inherits(proto.ActivityEvent, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.Repository.displayName = 'proto.Repository';
+  proto.ActivityEvent.displayName = 'proto.ActivityEvent';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -249,16 +294,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.Enrollment = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.Enrollment.repeatedFields_, null);
+proto.CourseActivity = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.CourseActivity.repeatedFields_, null);
 };
-goog.inherits(proto.Enrollment, jspb.Message);
+goog.inherits(proto.CourseActivity, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.Enrollment.displayName = 'proto.Enrollment';
+  proto.CourseActivity.displayName = 'proto.CourseActivity';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -270,16 +315,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.UsedSlipDays = function(opt_data) {
+proto.CourseEnrollment = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.UsedSlipDays, jspb.Message);
+goog.inherits(proto.CourseEnrollment, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.UsedSlipDays.displayName = 'proto.UsedSlipDays';
+  proto.CourseEnrollment.displayName = 'proto.CourseEnrollment';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -291,16 +336,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.Enrollments = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.Enrollments.repeatedFields_, null);
+proto.CourseEnrollments = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.CourseEnrollments.repeatedFields_, null);
 };
-goog.inherits(proto.Enrollments, jspb.Message);
+goog.inherits(proto.CourseEnrollments, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.Enrollments.displayName = 'proto.Enrollments';
+  proto.CourseEnrollments.displayName = 'proto.CourseEnrollments';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -312,16 +357,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.SubmissionLink = function(opt_data) {
+proto.Repository = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.SubmissionLink, jspb.Message);
+goog.inherits(proto.Repository, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.SubmissionLink.displayName = 'proto.SubmissionLink';
+  proto.Repository.displayName = 'proto.Repository';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -333,16 +378,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.EnrollmentLink = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.EnrollmentLink.repeatedFields_, null);
+proto.Enrollment = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.Enrollment.repeatedFields_, null);
 };
-goog.inherits(proto.EnrollmentLink, jspb.Message);
+goog.inherits(proto.Enrollment, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.EnrollmentLink.displayName = 'proto.EnrollmentLink';
+  proto.Enrollment.displayName = 'proto.Enrollment';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -354,16 +399,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.CourseSubmissions = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.CourseSubmissions.repeatedFields_, null);
+proto.UsedSlipDays = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.CourseSubmissions, jspb.Message);
+goog.inherits(proto.UsedSlipDays, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.CourseSubmissions.displayName = 'proto.CourseSubmissions';
+  proto.UsedSlipDays.displayName = 'proto.UsedSlipDays';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -375,16 +420,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.Assignment = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.Assignment.repeatedFields_, null);
+proto.Enrollments = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.Enrollments.repeatedFields_, null);
 };
-goog.inherits(proto.Assignment, jspb.Message);
+goog.inherits(proto.Enrollments, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.Assignment.displayName = 'proto.Assignment';
+  proto.Enrollments.displayName = 'proto.Enrollments';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -396,16 +441,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.Assignments = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.Assignments.repeatedFields_, null);
+proto.EnrollmentImport = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.EnrollmentImport.repeatedFields_, null);
 };
-goog.inherits(proto.Assignments, jspb.Message);
+goog.inherits(proto.EnrollmentImport, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.Assignments.displayName = 'proto.Assignments';
+  proto.EnrollmentImport.displayName = 'proto.EnrollmentImport';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -417,16 +462,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.Submission = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.Submission.repeatedFields_, null);
+proto.EnrollmentCount = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.Submission, jspb.Message);
+goog.inherits(proto.EnrollmentCount, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.Submission.displayName = 'proto.Submission';
+  proto.EnrollmentCount.displayName = 'proto.EnrollmentCount';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -438,16 +483,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.Submissions = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.Submissions.repeatedFields_, null);
+proto.SubmissionLink = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.Submissions, jspb.Message);
+goog.inherits(proto.SubmissionLink, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.Submissions.displayName = 'proto.Submissions';
+  proto.SubmissionLink.displayName = 'proto.SubmissionLink';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -459,16 +504,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.GradingBenchmark = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.GradingBenchmark.repeatedFields_, null);
+proto.EnrollmentLink = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.EnrollmentLink.repeatedFields_, null);
 };
-goog.inherits(proto.GradingBenchmark, jspb.Message);
+goog.inherits(proto.EnrollmentLink, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.GradingBenchmark.displayName = 'proto.GradingBenchmark';
+  proto.EnrollmentLink.displayName = 'proto.EnrollmentLink';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -480,16 +525,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.Benchmarks = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.Benchmarks.repeatedFields_, null);
+proto.CourseSubmissions = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.CourseSubmissions.repeatedFields_, null);
 };
-goog.inherits(proto.Benchmarks, jspb.Message);
+goog.inherits(proto.CourseSubmissions, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.Benchmarks.displayName = 'proto.Benchmarks';
+  proto.CourseSubmissions.displayName = 'proto.CourseSubmissions';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -501,16 +546,58 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.GradingCriterion = function(opt_data) {
+proto.Assignment = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.Assignment.repeatedFields_, null);
+};
+goog.inherits(proto.Assignment, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.Assignment.displayName = 'proto.Assignment';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.Assignments = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.Assignments.repeatedFields_, null);
+};
+goog.inherits(proto.Assignments, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.Assignments.displayName = 'proto.Assignments';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.CourseCalendar = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.GradingCriterion, jspb.Message);
+goog.inherits(proto.CourseCalendar, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.GradingCriterion.displayName = 'proto.GradingCriterion';
+  proto.CourseCalendar.displayName = 'proto.CourseCalendar';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -522,16 +609,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.Review = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.Review.repeatedFields_, null);
+proto.Submission = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.Submission.repeatedFields_, null);
 };
-goog.inherits(proto.Review, jspb.Message);
+goog.inherits(proto.Submission, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.Review.displayName = 'proto.Review';
+  proto.Submission.displayName = 'proto.Submission';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -543,16 +630,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.Reviewers = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.Reviewers.repeatedFields_, null);
+proto.Submissions = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.Submissions.repeatedFields_, null);
 };
-goog.inherits(proto.Reviewers, jspb.Message);
+goog.inherits(proto.Submissions, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.Reviewers.displayName = 'proto.Reviewers';
+  proto.Submissions.displayName = 'proto.Submissions';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -564,16 +651,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ReviewRequest = function(opt_data) {
+proto.SubmissionBuild = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ReviewRequest, jspb.Message);
+goog.inherits(proto.SubmissionBuild, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ReviewRequest.displayName = 'proto.ReviewRequest';
+  proto.SubmissionBuild.displayName = 'proto.SubmissionBuild';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -585,16 +672,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.CourseRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.SubmissionBuilds = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.SubmissionBuilds.repeatedFields_, null);
 };
-goog.inherits(proto.CourseRequest, jspb.Message);
+goog.inherits(proto.SubmissionBuilds, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.CourseRequest.displayName = 'proto.CourseRequest';
+  proto.SubmissionBuilds.displayName = 'proto.SubmissionBuilds';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -606,16 +693,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.UserRequest = function(opt_data) {
+proto.Grade = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.UserRequest, jspb.Message);
+goog.inherits(proto.Grade, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.UserRequest.displayName = 'proto.UserRequest';
+  proto.Grade.displayName = 'proto.Grade';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -627,16 +714,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.GetGroupRequest = function(opt_data) {
+proto.CourseGrades = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.GetGroupRequest, jspb.Message);
+goog.inherits(proto.CourseGrades, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.GetGroupRequest.displayName = 'proto.GetGroupRequest';
+  proto.CourseGrades.displayName = 'proto.CourseGrades';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -648,16 +735,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.GroupRequest = function(opt_data) {
+proto.CourseRoster = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.GroupRequest, jspb.Message);
+goog.inherits(proto.CourseRoster, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.GroupRequest.displayName = 'proto.GroupRequest';
+  proto.CourseRoster.displayName = 'proto.CourseRoster';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -669,16 +756,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.Provider = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.GradingBenchmark = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.GradingBenchmark.repeatedFields_, null);
 };
-goog.inherits(proto.Provider, jspb.Message);
+goog.inherits(proto.GradingBenchmark, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.Provider.displayName = 'proto.Provider';
+  proto.GradingBenchmark.displayName = 'proto.GradingBenchmark';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -690,16 +777,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.OrgRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.Benchmarks = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.Benchmarks.repeatedFields_, null);
 };
-goog.inherits(proto.OrgRequest, jspb.Message);
+goog.inherits(proto.Benchmarks, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.OrgRequest.displayName = 'proto.OrgRequest';
+  proto.Benchmarks.displayName = 'proto.Benchmarks';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -711,16 +798,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.Organization = function(opt_data) {
+proto.GradingCriterion = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.Organization, jspb.Message);
+goog.inherits(proto.GradingCriterion, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.Organization.displayName = 'proto.Organization';
+  proto.GradingCriterion.displayName = 'proto.GradingCriterion';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -732,16 +819,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.Organizations = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.Organizations.repeatedFields_, null);
+proto.Review = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.Review.repeatedFields_, null);
 };
-goog.inherits(proto.Organizations, jspb.Message);
+goog.inherits(proto.Review, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.Organizations.displayName = 'proto.Organizations';
+  proto.Review.displayName = 'proto.Review';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -753,16 +840,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.EnrollmentRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.EnrollmentRequest.repeatedFields_, null);
+proto.SubmissionComment = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.EnrollmentRequest, jspb.Message);
+goog.inherits(proto.SubmissionComment, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.EnrollmentRequest.displayName = 'proto.EnrollmentRequest';
+  proto.SubmissionComment.displayName = 'proto.SubmissionComment';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -774,16 +861,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.EnrollmentStatusRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.EnrollmentStatusRequest.repeatedFields_, null);
+proto.SubmissionComments = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.SubmissionComments.repeatedFields_, null);
 };
-goog.inherits(proto.EnrollmentStatusRequest, jspb.Message);
+goog.inherits(proto.SubmissionComments, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.EnrollmentStatusRequest.displayName = 'proto.EnrollmentStatusRequest';
+  proto.SubmissionComments.displayName = 'proto.SubmissionComments';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -795,16 +882,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.SubmissionRequest = function(opt_data) {
+proto.SubmissionSimilarity = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.SubmissionRequest, jspb.Message);
+goog.inherits(proto.SubmissionSimilarity, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.SubmissionRequest.displayName = 'proto.SubmissionRequest';
+  proto.SubmissionSimilarity.displayName = 'proto.SubmissionSimilarity';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -816,16 +903,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.UpdateSubmissionRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.SubmissionSimilarities = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.SubmissionSimilarities.repeatedFields_, null);
 };
-goog.inherits(proto.UpdateSubmissionRequest, jspb.Message);
+goog.inherits(proto.SubmissionSimilarities, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.UpdateSubmissionRequest.displayName = 'proto.UpdateSubmissionRequest';
+  proto.SubmissionSimilarities.displayName = 'proto.SubmissionSimilarities';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -837,16 +924,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.UpdateSubmissionsRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.Reviewers = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.Reviewers.repeatedFields_, null);
 };
-goog.inherits(proto.UpdateSubmissionsRequest, jspb.Message);
+goog.inherits(proto.Reviewers, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.UpdateSubmissionsRequest.displayName = 'proto.UpdateSubmissionsRequest';
+  proto.Reviewers.displayName = 'proto.Reviewers';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -858,16 +945,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.SubmissionReviewersRequest = function(opt_data) {
+proto.GraderAssignment = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.SubmissionReviewersRequest, jspb.Message);
+goog.inherits(proto.GraderAssignment, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.SubmissionReviewersRequest.displayName = 'proto.SubmissionReviewersRequest';
+  proto.GraderAssignment.displayName = 'proto.GraderAssignment';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -879,16 +966,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.Providers = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.Providers.repeatedFields_, null);
+proto.SCMAuditEntry = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.Providers, jspb.Message);
+goog.inherits(proto.SCMAuditEntry, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.Providers.displayName = 'proto.Providers';
+  proto.SCMAuditEntry.displayName = 'proto.SCMAuditEntry';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -900,16 +987,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.URLRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.URLRequest.repeatedFields_, null);
+proto.SCMAuditLog = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.SCMAuditLog.repeatedFields_, null);
 };
-goog.inherits(proto.URLRequest, jspb.Message);
+goog.inherits(proto.SCMAuditLog, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.URLRequest.displayName = 'proto.URLRequest';
+  proto.SCMAuditLog.displayName = 'proto.SCMAuditLog';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -921,16 +1008,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.RepositoryRequest = function(opt_data) {
+proto.ReviewRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.RepositoryRequest, jspb.Message);
+goog.inherits(proto.ReviewRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.RepositoryRequest.displayName = 'proto.RepositoryRequest';
+  proto.ReviewRequest.displayName = 'proto.ReviewRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -942,16 +1029,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.Repositories = function(opt_data) {
+proto.RubricScoreRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.Repositories, jspb.Message);
+goog.inherits(proto.RubricScoreRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.Repositories.displayName = 'proto.Repositories';
+  proto.RubricScoreRequest.displayName = 'proto.RubricScoreRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -963,16 +1050,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.AuthorizationResponse = function(opt_data) {
+proto.CourseRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.AuthorizationResponse, jspb.Message);
+goog.inherits(proto.CourseRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.AuthorizationResponse.displayName = 'proto.AuthorizationResponse';
+  proto.CourseRequest.displayName = 'proto.CourseRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -984,16 +1071,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.Status = function(opt_data) {
+proto.CourseActivityRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.Status, jspb.Message);
+goog.inherits(proto.CourseActivityRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.Status.displayName = 'proto.Status';
+  proto.CourseActivityRequest.displayName = 'proto.CourseActivityRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1005,16 +1092,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.SubmissionsForCourseRequest = function(opt_data) {
+proto.ReplayRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.SubmissionsForCourseRequest, jspb.Message);
+goog.inherits(proto.ReplayRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.SubmissionsForCourseRequest.displayName = 'proto.SubmissionsForCourseRequest';
+  proto.ReplayRequest.displayName = 'proto.ReplayRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1026,16 +1113,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.RebuildRequest = function(opt_data) {
+proto.SubmissionCount = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.RebuildRequest, jspb.Message);
+goog.inherits(proto.SubmissionCount, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.RebuildRequest.displayName = 'proto.RebuildRequest';
+  proto.SubmissionCount.displayName = 'proto.SubmissionCount';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1047,16 +1134,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.CourseUserRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.CoursesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.CoursesRequest.repeatedFields_, null);
 };
-goog.inherits(proto.CourseUserRequest, jspb.Message);
+goog.inherits(proto.CoursesRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.CourseUserRequest.displayName = 'proto.CourseUserRequest';
+  proto.CoursesRequest.displayName = 'proto.CoursesRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1068,16 +1155,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.LoadCriteriaRequest = function(opt_data) {
+proto.UpdateCourseRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.LoadCriteriaRequest, jspb.Message);
+goog.inherits(proto.UpdateCourseRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.LoadCriteriaRequest.displayName = 'proto.LoadCriteriaRequest';
+  proto.UpdateCourseRequest.displayName = 'proto.UpdateCourseRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1089,24 +1176,9865 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.Void = function(opt_data) {
+proto.CourseFeatureRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.Void, jspb.Message);
+goog.inherits(proto.CourseFeatureRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.Void.displayName = 'proto.Void';
+  proto.CourseFeatureRequest.displayName = 'proto.CourseFeatureRequest';
 }
-
 /**
- * List of repeated fields within this message type.
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.UpdateCourseWarnings = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.UpdateCourseWarnings.repeatedFields_, null);
+};
+goog.inherits(proto.UpdateCourseWarnings, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.UpdateCourseWarnings.displayName = 'proto.UpdateCourseWarnings';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.UserRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.UserRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.UserRequest.displayName = 'proto.UserRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.GetGroupRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.GetGroupRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.GetGroupRequest.displayName = 'proto.GetGroupRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.GroupRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.GroupRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.GroupRequest.displayName = 'proto.GroupRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.Provider = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.Provider, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.Provider.displayName = 'proto.Provider';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.OrgRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.OrgRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.OrgRequest.displayName = 'proto.OrgRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.Organization = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.Organization, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.Organization.displayName = 'proto.Organization';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.Organizations = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.Organizations.repeatedFields_, null);
+};
+goog.inherits(proto.Organizations, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.Organizations.displayName = 'proto.Organizations';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.EnrollmentRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.EnrollmentRequest.repeatedFields_, null);
+};
+goog.inherits(proto.EnrollmentRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.EnrollmentRequest.displayName = 'proto.EnrollmentRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.EnrollmentStatusRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.EnrollmentStatusRequest.repeatedFields_, null);
+};
+goog.inherits(proto.EnrollmentStatusRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.EnrollmentStatusRequest.displayName = 'proto.EnrollmentStatusRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.RejectEnrollmentsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.RejectEnrollmentsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.RejectEnrollmentsRequest.displayName = 'proto.RejectEnrollmentsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.EnrollmentDetailsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.EnrollmentDetailsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.EnrollmentDetailsRequest.displayName = 'proto.EnrollmentDetailsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.AssignmentSubmissionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.AssignmentSubmissionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.AssignmentSubmissionRequest.displayName = 'proto.AssignmentSubmissionRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.AutoApproveRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.AutoApproveRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.AutoApproveRequest.displayName = 'proto.AutoApproveRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.AssignmentRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.AssignmentRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.AssignmentRequest.displayName = 'proto.AssignmentRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.CommitSubmissionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.CommitSubmissionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.CommitSubmissionRequest.displayName = 'proto.CommitSubmissionRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.SubmissionHistoryRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.SubmissionHistoryRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.SubmissionHistoryRequest.displayName = 'proto.SubmissionHistoryRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.SubmissionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.SubmissionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.SubmissionRequest.displayName = 'proto.SubmissionRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.UpdateSubmissionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.UpdateSubmissionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.UpdateSubmissionRequest.displayName = 'proto.UpdateSubmissionRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.UpdateSubmissionsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.UpdateSubmissionsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.UpdateSubmissionsRequest.displayName = 'proto.UpdateSubmissionsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ApproveSubmissionsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ApproveSubmissionsRequest.repeatedFields_, null);
+};
+goog.inherits(proto.ApproveSubmissionsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ApproveSubmissionsRequest.displayName = 'proto.ApproveSubmissionsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.SubmissionApproval = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.SubmissionApproval, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.SubmissionApproval.displayName = 'proto.SubmissionApproval';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.SubmissionApprovals = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.SubmissionApprovals.repeatedFields_, null);
+};
+goog.inherits(proto.SubmissionApprovals, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.SubmissionApprovals.displayName = 'proto.SubmissionApprovals';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.SubmissionReviewersRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.SubmissionReviewersRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.SubmissionReviewersRequest.displayName = 'proto.SubmissionReviewersRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.SubmissionIDRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.SubmissionIDRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.SubmissionIDRequest.displayName = 'proto.SubmissionIDRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.BuildLog = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.BuildLog, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.BuildLog.displayName = 'proto.BuildLog';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.Providers = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.Providers.repeatedFields_, null);
+};
+goog.inherits(proto.Providers, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.Providers.displayName = 'proto.Providers';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.URLRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.URLRequest.repeatedFields_, null);
+};
+goog.inherits(proto.URLRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.URLRequest.displayName = 'proto.URLRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.RepositoryRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.RepositoryRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.RepositoryRequest.displayName = 'proto.RepositoryRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.Repositories = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.Repositories, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.Repositories.displayName = 'proto.Repositories';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.RepositoryAccessToken = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.RepositoryAccessToken, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.RepositoryAccessToken.displayName = 'proto.RepositoryAccessToken';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.AuthorizationResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.AuthorizationResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.AuthorizationResponse.displayName = 'proto.AuthorizationResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.Status = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.Status, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.Status.displayName = 'proto.Status';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.SubmissionsForCourseRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.SubmissionsForCourseRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.SubmissionsForCourseRequest.displayName = 'proto.SubmissionsForCourseRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.AssignGraderRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.AssignGraderRequest.repeatedFields_, null);
+};
+goog.inherits(proto.AssignGraderRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.AssignGraderRequest.displayName = 'proto.AssignGraderRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.RebuildRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.RebuildRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.RebuildRequest.displayName = 'proto.RebuildRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.CourseUserRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.CourseUserRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.CourseUserRequest.displayName = 'proto.CourseUserRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.LoadCriteriaRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.LoadCriteriaRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.LoadCriteriaRequest.displayName = 'proto.LoadCriteriaRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.Void = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.Void, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.Void.displayName = 'proto.Void';
+}
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.User.repeatedFields_ = [8,9];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.User.prototype.toObject = function(opt_includeInstance) {
+  return proto.User.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.User} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.User.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    isadmin: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    studentid: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    email: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    avatarurl: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    login: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    remoteidentitiesList: jspb.Message.toObjectList(msg.getRemoteidentitiesList(),
+    proto.RemoteIdentity.toObject, includeInstance),
+    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
+    proto.Enrollment.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.User}
+ */
+proto.User.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.User;
+  return proto.User.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.User} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.User}
+ */
+proto.User.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsadmin(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStudentid(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEmail(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAvatarurl(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLogin(value);
+      break;
+    case 8:
+      var value = new proto.RemoteIdentity;
+      reader.readMessage(value,proto.RemoteIdentity.deserializeBinaryFromReader);
+      msg.addRemoteidentities(value);
+      break;
+    case 9:
+      var value = new proto.Enrollment;
+      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
+      msg.addEnrollments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.User.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.User.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.User} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.User.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getIsadmin();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getStudentid();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getEmail();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getAvatarurl();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getLogin();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getRemoteidentitiesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      8,
+      f,
+      proto.RemoteIdentity.serializeBinaryToWriter
+    );
+  }
+  f = message.getEnrollmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      9,
+      f,
+      proto.Enrollment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.User.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.User} returns this
+ */
+proto.User.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional bool isAdmin = 2;
+ * @return {boolean}
+ */
+proto.User.prototype.getIsadmin = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.User} returns this
+ */
+proto.User.prototype.setIsadmin = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.User.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.User} returns this
+ */
+proto.User.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string studentID = 4;
+ * @return {string}
+ */
+proto.User.prototype.getStudentid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.User} returns this
+ */
+proto.User.prototype.setStudentid = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string email = 5;
+ * @return {string}
+ */
+proto.User.prototype.getEmail = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.User} returns this
+ */
+proto.User.prototype.setEmail = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string avatarURL = 6;
+ * @return {string}
+ */
+proto.User.prototype.getAvatarurl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.User} returns this
+ */
+proto.User.prototype.setAvatarurl = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string login = 7;
+ * @return {string}
+ */
+proto.User.prototype.getLogin = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.User} returns this
+ */
+proto.User.prototype.setLogin = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * repeated RemoteIdentity remoteIdentities = 8;
+ * @return {!Array<!proto.RemoteIdentity>}
+ */
+proto.User.prototype.getRemoteidentitiesList = function() {
+  return /** @type{!Array<!proto.RemoteIdentity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.RemoteIdentity, 8));
+};
+
+
+/**
+ * @param {!Array<!proto.RemoteIdentity>} value
+ * @return {!proto.User} returns this
+*/
+proto.User.prototype.setRemoteidentitiesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+};
+
+
+/**
+ * @param {!proto.RemoteIdentity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.RemoteIdentity}
+ */
+proto.User.prototype.addRemoteidentities = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.RemoteIdentity, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.User} returns this
+ */
+proto.User.prototype.clearRemoteidentitiesList = function() {
+  return this.setRemoteidentitiesList([]);
+};
+
+
+/**
+ * repeated Enrollment enrollments = 9;
+ * @return {!Array<!proto.Enrollment>}
+ */
+proto.User.prototype.getEnrollmentsList = function() {
+  return /** @type{!Array<!proto.Enrollment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Enrollment, 9));
+};
+
+
+/**
+ * @param {!Array<!proto.Enrollment>} value
+ * @return {!proto.User} returns this
+*/
+proto.User.prototype.setEnrollmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 9, value);
+};
+
+
+/**
+ * @param {!proto.Enrollment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Enrollment}
+ */
+proto.User.prototype.addEnrollments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.Enrollment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.User} returns this
+ */
+proto.User.prototype.clearEnrollmentsList = function() {
+  return this.setEnrollmentsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Users.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Users.prototype.toObject = function(opt_includeInstance) {
+  return proto.Users.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Users} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Users.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    usersList: jspb.Message.toObjectList(msg.getUsersList(),
+    proto.User.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Users}
+ */
+proto.Users.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Users;
+  return proto.Users.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Users} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Users}
+ */
+proto.Users.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.User;
+      reader.readMessage(value,proto.User.deserializeBinaryFromReader);
+      msg.addUsers(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Users.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Users.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Users} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Users.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.User.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated User users = 1;
+ * @return {!Array<!proto.User>}
+ */
+proto.Users.prototype.getUsersList = function() {
+  return /** @type{!Array<!proto.User>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.User, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.User>} value
+ * @return {!proto.Users} returns this
+*/
+proto.Users.prototype.setUsersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.User=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.User}
+ */
+proto.Users.prototype.addUsers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.User, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Users} returns this
+ */
+proto.Users.prototype.clearUsersList = function() {
+  return this.setUsersList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.RemoteIdentity.prototype.toObject = function(opt_includeInstance) {
+  return proto.RemoteIdentity.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.RemoteIdentity} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.RemoteIdentity.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    provider: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    remoteid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    accesstoken: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    userid: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.RemoteIdentity}
+ */
+proto.RemoteIdentity.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.RemoteIdentity;
+  return proto.RemoteIdentity.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.RemoteIdentity} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.RemoteIdentity}
+ */
+proto.RemoteIdentity.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProvider(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setRemoteid(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAccesstoken(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.RemoteIdentity.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.RemoteIdentity.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.RemoteIdentity} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.RemoteIdentity.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRemoteid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getAccesstoken();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.RemoteIdentity.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.RemoteIdentity} returns this
+ */
+proto.RemoteIdentity.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string provider = 2;
+ * @return {string}
+ */
+proto.RemoteIdentity.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.RemoteIdentity} returns this
+ */
+proto.RemoteIdentity.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 remoteID = 3;
+ * @return {number}
+ */
+proto.RemoteIdentity.prototype.getRemoteid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.RemoteIdentity} returns this
+ */
+proto.RemoteIdentity.prototype.setRemoteid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string accessToken = 4;
+ * @return {string}
+ */
+proto.RemoteIdentity.prototype.getAccesstoken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.RemoteIdentity} returns this
+ */
+proto.RemoteIdentity.prototype.setAccesstoken = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional uint64 userID = 5;
+ * @return {number}
+ */
+proto.RemoteIdentity.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.RemoteIdentity} returns this
+ */
+proto.RemoteIdentity.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Group.repeatedFields_ = [6,7];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Group.prototype.toObject = function(opt_includeInstance) {
+  return proto.Group.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Group} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Group.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    teamid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    status: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    usersList: jspb.Message.toObjectList(msg.getUsersList(),
+    proto.User.toObject, includeInstance),
+    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
+    proto.Enrollment.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Group}
+ */
+proto.Group.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Group;
+  return proto.Group.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Group} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Group}
+ */
+proto.Group.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setTeamid(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.Group.GroupStatus} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 6:
+      var value = new proto.User;
+      reader.readMessage(value,proto.User.deserializeBinaryFromReader);
+      msg.addUsers(value);
+      break;
+    case 7:
+      var value = new proto.Enrollment;
+      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
+      msg.addEnrollments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Group.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Group.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Group} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Group.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getTeamid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      6,
+      f,
+      proto.User.serializeBinaryToWriter
+    );
+  }
+  f = message.getEnrollmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      proto.Enrollment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.Group.GroupStatus = {
+  PENDING: 0,
+  APPROVED: 1
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.Group.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Group} returns this
+ */
+proto.Group.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.Group.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Group} returns this
+ */
+proto.Group.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 courseID = 3;
+ * @return {number}
+ */
+proto.Group.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Group} returns this
+ */
+proto.Group.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 teamID = 4;
+ * @return {number}
+ */
+proto.Group.prototype.getTeamid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Group} returns this
+ */
+proto.Group.prototype.setTeamid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional GroupStatus status = 5;
+ * @return {!proto.Group.GroupStatus}
+ */
+proto.Group.prototype.getStatus = function() {
+  return /** @type {!proto.Group.GroupStatus} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.Group.GroupStatus} value
+ * @return {!proto.Group} returns this
+ */
+proto.Group.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * repeated User users = 6;
+ * @return {!Array<!proto.User>}
+ */
+proto.Group.prototype.getUsersList = function() {
+  return /** @type{!Array<!proto.User>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.User, 6));
+};
+
+
+/**
+ * @param {!Array<!proto.User>} value
+ * @return {!proto.Group} returns this
+*/
+proto.Group.prototype.setUsersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+};
+
+
+/**
+ * @param {!proto.User=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.User}
+ */
+proto.Group.prototype.addUsers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.User, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Group} returns this
+ */
+proto.Group.prototype.clearUsersList = function() {
+  return this.setUsersList([]);
+};
+
+
+/**
+ * repeated Enrollment enrollments = 7;
+ * @return {!Array<!proto.Enrollment>}
+ */
+proto.Group.prototype.getEnrollmentsList = function() {
+  return /** @type{!Array<!proto.Enrollment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Enrollment, 7));
+};
+
+
+/**
+ * @param {!Array<!proto.Enrollment>} value
+ * @return {!proto.Group} returns this
+*/
+proto.Group.prototype.setEnrollmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+};
+
+
+/**
+ * @param {!proto.Enrollment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Enrollment}
+ */
+proto.Group.prototype.addEnrollments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.Enrollment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Group} returns this
+ */
+proto.Group.prototype.clearEnrollmentsList = function() {
+  return this.setEnrollmentsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Groups.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Groups.prototype.toObject = function(opt_includeInstance) {
+  return proto.Groups.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Groups} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Groups.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    groupsList: jspb.Message.toObjectList(msg.getGroupsList(),
+    proto.Group.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Groups}
+ */
+proto.Groups.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Groups;
+  return proto.Groups.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Groups} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Groups}
+ */
+proto.Groups.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.Group;
+      reader.readMessage(value,proto.Group.deserializeBinaryFromReader);
+      msg.addGroups(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Groups.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Groups.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Groups} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Groups.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getGroupsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.Group.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Group groups = 1;
+ * @return {!Array<!proto.Group>}
+ */
+proto.Groups.prototype.getGroupsList = function() {
+  return /** @type{!Array<!proto.Group>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Group, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.Group>} value
+ * @return {!proto.Groups} returns this
+*/
+proto.Groups.prototype.setGroupsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.Group=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Group}
+ */
+proto.Groups.prototype.addGroups = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Group, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Groups} returns this
+ */
+proto.Groups.prototype.clearGroupsList = function() {
+  return this.setGroupsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Course.repeatedFields_ = [12,13,14,32];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Course.prototype.toObject = function(opt_includeInstance) {
+  return proto.Course.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Course} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Course.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    coursecreatorid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    code: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    year: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    tag: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    provider: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    organizationid: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    organizationpath: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    slipdays: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    enrolled: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
+    proto.Enrollment.toObject, includeInstance),
+    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
+    proto.Assignment.toObject, includeInstance),
+    groupsList: jspb.Message.toObjectList(msg.getGroupsList(),
+    proto.Group.toObject, includeInstance),
+    numstudents: jspb.Message.getFieldWithDefault(msg, 15, 0),
+    numteachers: jspb.Message.getFieldWithDefault(msg, 16, 0),
+    numpending: jspb.Message.getFieldWithDefault(msg, 17, 0),
+    features: jspb.Message.getFieldWithDefault(msg, 18, 0),
+    emaildomainallowlist: jspb.Message.getFieldWithDefault(msg, 19, ""),
+    enrollmentcode: jspb.Message.getFieldWithDefault(msg, 20, ""),
+    startdate: jspb.Message.getFieldWithDefault(msg, 21, ""),
+    enddate: jspb.Message.getFieldWithDefault(msg, 22, ""),
+    slug: jspb.Message.getFieldWithDefault(msg, 23, ""),
+    maxstudents: jspb.Message.getFieldWithDefault(msg, 24, 0),
+    pb_private: jspb.Message.getBooleanFieldWithDefault(msg, 25, false),
+    gradedbranches: jspb.Message.getFieldWithDefault(msg, 26, ""),
+    gradingconfigversion: jspb.Message.getFieldWithDefault(msg, 27, 0),
+    templaterepo: jspb.Message.getFieldWithDefault(msg, 28, ""),
+    archived: jspb.Message.getBooleanFieldWithDefault(msg, 29, false),
+    maxgroupsize: jspb.Message.getFieldWithDefault(msg, 30, 0),
+    hookid: jspb.Message.getFieldWithDefault(msg, 31, 0),
+    updatemaskList: (f = jspb.Message.getRepeatedField(msg, 32)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Course}
+ */
+proto.Course.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Course;
+  return proto.Course.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Course} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Course}
+ */
+proto.Course.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCoursecreatorid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCode(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setYear(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTag(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProvider(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setOrganizationid(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOrganizationpath(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setSlipdays(value);
+      break;
+    case 11:
+      var value = /** @type {!proto.Enrollment.UserStatus} */ (reader.readEnum());
+      msg.setEnrolled(value);
+      break;
+    case 12:
+      var value = new proto.Enrollment;
+      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
+      msg.addEnrollments(value);
+      break;
+    case 13:
+      var value = new proto.Assignment;
+      reader.readMessage(value,proto.Assignment.deserializeBinaryFromReader);
+      msg.addAssignments(value);
+      break;
+    case 14:
+      var value = new proto.Group;
+      reader.readMessage(value,proto.Group.deserializeBinaryFromReader);
+      msg.addGroups(value);
+      break;
+    case 15:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumstudents(value);
+      break;
+    case 16:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumteachers(value);
+      break;
+    case 17:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumpending(value);
+      break;
+    case 18:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setFeatures(value);
+      break;
+    case 19:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEmaildomainallowlist(value);
+      break;
+    case 20:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnrollmentcode(value);
+      break;
+    case 21:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStartdate(value);
+      break;
+    case 22:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnddate(value);
+      break;
+    case 23:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSlug(value);
+      break;
+    case 24:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMaxstudents(value);
+      break;
+    case 25:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setPrivate(value);
+      break;
+    case 26:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setGradedbranches(value);
+      break;
+    case 27:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setGradingconfigversion(value);
+      break;
+    case 28:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTemplaterepo(value);
+      break;
+    case 29:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setArchived(value);
+      break;
+    case 30:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMaxgroupsize(value);
+      break;
+    case 31:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setHookid(value);
+      break;
+    case 32:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addUpdatemask(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Course.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Course.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Course} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Course.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getCoursecreatorid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getCode();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getYear();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getTag();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getOrganizationid();
+  if (f !== 0) {
+    writer.writeUint64(
+      8,
+      f
+    );
+  }
+  f = message.getOrganizationpath();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getSlipdays();
+  if (f !== 0) {
+    writer.writeUint32(
+      10,
+      f
+    );
+  }
+  f = message.getEnrolled();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      11,
+      f
+    );
+  }
+  f = message.getEnrollmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      12,
+      f,
+      proto.Enrollment.serializeBinaryToWriter
+    );
+  }
+  f = message.getAssignmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      13,
+      f,
+      proto.Assignment.serializeBinaryToWriter
+    );
+  }
+  f = message.getGroupsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      14,
+      f,
+      proto.Group.serializeBinaryToWriter
+    );
+  }
+  f = message.getNumstudents();
+  if (f !== 0) {
+    writer.writeUint32(
+      15,
+      f
+    );
+  }
+  f = message.getNumteachers();
+  if (f !== 0) {
+    writer.writeUint32(
+      16,
+      f
+    );
+  }
+  f = message.getNumpending();
+  if (f !== 0) {
+    writer.writeUint32(
+      17,
+      f
+    );
+  }
+  f = message.getFeatures();
+  if (f !== 0) {
+    writer.writeUint32(
+      18,
+      f
+    );
+  }
+  f = message.getEmaildomainallowlist();
+  if (f.length > 0) {
+    writer.writeString(
+      19,
+      f
+    );
+  }
+  f = message.getEnrollmentcode();
+  if (f.length > 0) {
+    writer.writeString(
+      20,
+      f
+    );
+  }
+  f = message.getStartdate();
+  if (f.length > 0) {
+    writer.writeString(
+      21,
+      f
+    );
+  }
+  f = message.getEnddate();
+  if (f.length > 0) {
+    writer.writeString(
+      22,
+      f
+    );
+  }
+  f = message.getSlug();
+  if (f.length > 0) {
+    writer.writeString(
+      23,
+      f
+    );
+  }
+  f = message.getMaxstudents();
+  if (f !== 0) {
+    writer.writeUint32(
+      24,
+      f
+    );
+  }
+  f = message.getPrivate();
+  if (f) {
+    writer.writeBool(
+      25,
+      f
+    );
+  }
+  f = message.getGradedbranches();
+  if (f.length > 0) {
+    writer.writeString(
+      26,
+      f
+    );
+  }
+  f = message.getGradingconfigversion();
+  if (f !== 0) {
+    writer.writeUint32(
+      27,
+      f
+    );
+  }
+  f = message.getTemplaterepo();
+  if (f.length > 0) {
+    writer.writeString(
+      28,
+      f
+    );
+  }
+  f = message.getArchived();
+  if (f) {
+    writer.writeBool(
+      29,
+      f
+    );
+  }
+  f = message.getMaxgroupsize();
+  if (f !== 0) {
+    writer.writeUint32(
+      30,
+      f
+    );
+  }
+  f = message.getHookid();
+  if (f !== 0) {
+    writer.writeUint64(
+      31,
+      f
+    );
+  }
+  f = message.getUpdatemaskList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      32,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.Course.Feature = {
+  NONE: 0,
+  AUTO_ENROLL: 1,
+  GROUPS_DISABLED: 2,
+  MANUAL_GRADING: 4,
+  PULL_REQUEST_SUBMISSIONS: 8,
+  ALLOW_FORCE_PUSH: 16,
+  GRADE_ON_ENROLL: 32
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.Course.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 courseCreatorID = 2;
+ * @return {number}
+ */
+proto.Course.prototype.getCoursecreatorid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setCoursecreatorid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.Course.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string code = 4;
+ * @return {string}
+ */
+proto.Course.prototype.getCode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setCode = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 year = 5;
+ * @return {number}
+ */
+proto.Course.prototype.getYear = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setYear = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string tag = 6;
+ * @return {string}
+ */
+proto.Course.prototype.getTag = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setTag = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string provider = 7;
+ * @return {string}
+ */
+proto.Course.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional uint64 organizationID = 8;
+ * @return {number}
+ */
+proto.Course.prototype.getOrganizationid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setOrganizationid = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional string organizationPath = 9;
+ * @return {string}
+ */
+proto.Course.prototype.getOrganizationpath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setOrganizationpath = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * optional uint32 slipDays = 10;
+ * @return {number}
+ */
+proto.Course.prototype.getSlipdays = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setSlipdays = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional Enrollment.UserStatus enrolled = 11;
+ * @return {!proto.Enrollment.UserStatus}
+ */
+proto.Course.prototype.getEnrolled = function() {
+  return /** @type {!proto.Enrollment.UserStatus} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {!proto.Enrollment.UserStatus} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setEnrolled = function(value) {
+  return jspb.Message.setProto3EnumField(this, 11, value);
+};
+
+
+/**
+ * repeated Enrollment enrollments = 12;
+ * @return {!Array<!proto.Enrollment>}
+ */
+proto.Course.prototype.getEnrollmentsList = function() {
+  return /** @type{!Array<!proto.Enrollment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Enrollment, 12));
+};
+
+
+/**
+ * @param {!Array<!proto.Enrollment>} value
+ * @return {!proto.Course} returns this
+*/
+proto.Course.prototype.setEnrollmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+};
+
+
+/**
+ * @param {!proto.Enrollment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Enrollment}
+ */
+proto.Course.prototype.addEnrollments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.Enrollment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.clearEnrollmentsList = function() {
+  return this.setEnrollmentsList([]);
+};
+
+
+/**
+ * repeated Assignment assignments = 13;
+ * @return {!Array<!proto.Assignment>}
+ */
+proto.Course.prototype.getAssignmentsList = function() {
+  return /** @type{!Array<!proto.Assignment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Assignment, 13));
+};
+
+
+/**
+ * @param {!Array<!proto.Assignment>} value
+ * @return {!proto.Course} returns this
+*/
+proto.Course.prototype.setAssignmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 13, value);
+};
+
+
+/**
+ * @param {!proto.Assignment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Assignment}
+ */
+proto.Course.prototype.addAssignments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 13, opt_value, proto.Assignment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.clearAssignmentsList = function() {
+  return this.setAssignmentsList([]);
+};
+
+
+/**
+ * repeated Group groups = 14;
+ * @return {!Array<!proto.Group>}
+ */
+proto.Course.prototype.getGroupsList = function() {
+  return /** @type{!Array<!proto.Group>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Group, 14));
+};
+
+
+/**
+ * @param {!Array<!proto.Group>} value
+ * @return {!proto.Course} returns this
+*/
+proto.Course.prototype.setGroupsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+};
+
+
+/**
+ * @param {!proto.Group=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Group}
+ */
+proto.Course.prototype.addGroups = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.Group, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.clearGroupsList = function() {
+  return this.setGroupsList([]);
+};
+
+
+/**
+ * optional uint32 numStudents = 15;
+ * @return {number}
+ */
+proto.Course.prototype.getNumstudents = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setNumstudents = function(value) {
+  return jspb.Message.setProto3IntField(this, 15, value);
+};
+
+
+/**
+ * optional uint32 numTeachers = 16;
+ * @return {number}
+ */
+proto.Course.prototype.getNumteachers = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 16, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setNumteachers = function(value) {
+  return jspb.Message.setProto3IntField(this, 16, value);
+};
+
+
+/**
+ * optional uint32 numPending = 17;
+ * @return {number}
+ */
+proto.Course.prototype.getNumpending = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 17, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setNumpending = function(value) {
+  return jspb.Message.setProto3IntField(this, 17, value);
+};
+
+
+/**
+ * optional uint32 features = 18;
+ * @return {number}
+ */
+proto.Course.prototype.getFeatures = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 18, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setFeatures = function(value) {
+  return jspb.Message.setProto3IntField(this, 18, value);
+};
+
+
+/**
+ * optional string emailDomainAllowlist = 19;
+ * @return {string}
+ */
+proto.Course.prototype.getEmaildomainallowlist = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 19, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setEmaildomainallowlist = function(value) {
+  return jspb.Message.setProto3StringField(this, 19, value);
+};
+
+
+/**
+ * optional string enrollmentCode = 20;
+ * @return {string}
+ */
+proto.Course.prototype.getEnrollmentcode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 20, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setEnrollmentcode = function(value) {
+  return jspb.Message.setProto3StringField(this, 20, value);
+};
+
+
+/**
+ * optional string startDate = 21;
+ * @return {string}
+ */
+proto.Course.prototype.getStartdate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 21, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setStartdate = function(value) {
+  return jspb.Message.setProto3StringField(this, 21, value);
+};
+
+
+/**
+ * optional string endDate = 22;
+ * @return {string}
+ */
+proto.Course.prototype.getEnddate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 22, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setEnddate = function(value) {
+  return jspb.Message.setProto3StringField(this, 22, value);
+};
+
+
+/**
+ * optional string slug = 23;
+ * @return {string}
+ */
+proto.Course.prototype.getSlug = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 23, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setSlug = function(value) {
+  return jspb.Message.setProto3StringField(this, 23, value);
+};
+
+
+/**
+ * optional uint32 maxStudents = 24;
+ * @return {number}
+ */
+proto.Course.prototype.getMaxstudents = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 24, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setMaxstudents = function(value) {
+  return jspb.Message.setProto3IntField(this, 24, value);
+};
+
+
+/**
+ * optional bool private = 25;
+ * @return {boolean}
+ */
+proto.Course.prototype.getPrivate = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 25, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setPrivate = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 25, value);
+};
+
+
+/**
+ * optional string gradedBranches = 26;
+ * @return {string}
+ */
+proto.Course.prototype.getGradedbranches = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 26, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setGradedbranches = function(value) {
+  return jspb.Message.setProto3StringField(this, 26, value);
+};
+
+
+/**
+ * optional uint32 gradingConfigVersion = 27;
+ * @return {number}
+ */
+proto.Course.prototype.getGradingconfigversion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 27, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setGradingconfigversion = function(value) {
+  return jspb.Message.setProto3IntField(this, 27, value);
+};
+
+
+/**
+ * optional string templateRepo = 28;
+ * @return {string}
+ */
+proto.Course.prototype.getTemplaterepo = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 28, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setTemplaterepo = function(value) {
+  return jspb.Message.setProto3StringField(this, 28, value);
+};
+
+
+/**
+ * optional bool archived = 29;
+ * @return {boolean}
+ */
+proto.Course.prototype.getArchived = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 29, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setArchived = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 29, value);
+};
+
+
+/**
+ * optional uint32 maxGroupSize = 30;
+ * @return {number}
+ */
+proto.Course.prototype.getMaxgroupsize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 30, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setMaxgroupsize = function(value) {
+  return jspb.Message.setProto3IntField(this, 30, value);
+};
+
+
+/**
+ * optional uint64 hookID = 31;
+ * @return {number}
+ */
+proto.Course.prototype.getHookid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 31, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setHookid = function(value) {
+  return jspb.Message.setProto3IntField(this, 31, value);
+};
+
+
+/**
+ * repeated string updateMask = 32;
+ * @return {!Array<string>}
+ */
+proto.Course.prototype.getUpdatemaskList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 32));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setUpdatemaskList = function(value) {
+  return jspb.Message.setField(this, 32, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.addUpdatemask = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 32, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.clearUpdatemaskList = function() {
+  return this.setUpdatemaskList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Courses.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Courses.prototype.toObject = function(opt_includeInstance) {
+  return proto.Courses.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Courses} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Courses.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    coursesList: jspb.Message.toObjectList(msg.getCoursesList(),
+    proto.Course.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Courses}
+ */
+proto.Courses.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Courses;
+  return proto.Courses.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Courses} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Courses}
+ */
+proto.Courses.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.Course;
+      reader.readMessage(value,proto.Course.deserializeBinaryFromReader);
+      msg.addCourses(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Courses.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Courses.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Courses} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Courses.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCoursesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.Course.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Course courses = 1;
+ * @return {!Array<!proto.Course>}
+ */
+proto.Courses.prototype.getCoursesList = function() {
+  return /** @type{!Array<!proto.Course>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Course, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.Course>} value
+ * @return {!proto.Courses} returns this
+*/
+proto.Courses.prototype.setCoursesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.Course=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Course}
+ */
+proto.Courses.prototype.addCourses = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Course, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Courses} returns this
+ */
+proto.Courses.prototype.clearCoursesList = function() {
+  return this.setCoursesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ActivityEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.ActivityEvent.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ActivityEvent} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ActivityEvent.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    type: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    date: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    submissionid: jspb.Message.getFieldWithDefault(msg, 7, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ActivityEvent}
+ */
+proto.ActivityEvent.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ActivityEvent;
+  return proto.ActivityEvent.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ActivityEvent} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ActivityEvent}
+ */
+proto.ActivityEvent.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.ActivityEvent.Type} */ (reader.readEnum());
+      msg.setType(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDate(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSubmissionid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ActivityEvent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ActivityEvent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ActivityEvent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ActivityEvent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getDate();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      6,
+      f
+    );
+  }
+  f = message.getSubmissionid();
+  if (f !== 0) {
+    writer.writeUint64(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.ActivityEvent.Type = {
+  ENROLLMENT: 0,
+  SUBMISSION: 1,
+  APPROVAL: 2
+};
+
+/**
+ * optional Type type = 1;
+ * @return {!proto.ActivityEvent.Type}
+ */
+proto.ActivityEvent.prototype.getType = function() {
+  return /** @type {!proto.ActivityEvent.Type} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.ActivityEvent.Type} value
+ * @return {!proto.ActivityEvent} returns this
+ */
+proto.ActivityEvent.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional string date = 2;
+ * @return {string}
+ */
+proto.ActivityEvent.prototype.getDate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ActivityEvent} returns this
+ */
+proto.ActivityEvent.prototype.setDate = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 courseID = 3;
+ * @return {number}
+ */
+proto.ActivityEvent.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ActivityEvent} returns this
+ */
+proto.ActivityEvent.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 userID = 4;
+ * @return {number}
+ */
+proto.ActivityEvent.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ActivityEvent} returns this
+ */
+proto.ActivityEvent.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint64 groupID = 5;
+ * @return {number}
+ */
+proto.ActivityEvent.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ActivityEvent} returns this
+ */
+proto.ActivityEvent.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional uint64 assignmentID = 6;
+ * @return {number}
+ */
+proto.ActivityEvent.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ActivityEvent} returns this
+ */
+proto.ActivityEvent.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional uint64 submissionID = 7;
+ * @return {number}
+ */
+proto.ActivityEvent.prototype.getSubmissionid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ActivityEvent} returns this
+ */
+proto.ActivityEvent.prototype.setSubmissionid = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.CourseActivity.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.CourseActivity.prototype.toObject = function(opt_includeInstance) {
+  return proto.CourseActivity.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.CourseActivity} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CourseActivity.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    eventsList: jspb.Message.toObjectList(msg.getEventsList(),
+    proto.ActivityEvent.toObject, includeInstance),
+    nextcursor: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.CourseActivity}
+ */
+proto.CourseActivity.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.CourseActivity;
+  return proto.CourseActivity.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.CourseActivity} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.CourseActivity}
+ */
+proto.CourseActivity.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ActivityEvent;
+      reader.readMessage(value,proto.ActivityEvent.deserializeBinaryFromReader);
+      msg.addEvents(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNextcursor(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.CourseActivity.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.CourseActivity.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.CourseActivity} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CourseActivity.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEventsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ActivityEvent.serializeBinaryToWriter
+    );
+  }
+  f = message.getNextcursor();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated ActivityEvent events = 1;
+ * @return {!Array<!proto.ActivityEvent>}
+ */
+proto.CourseActivity.prototype.getEventsList = function() {
+  return /** @type{!Array<!proto.ActivityEvent>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ActivityEvent, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ActivityEvent>} value
+ * @return {!proto.CourseActivity} returns this
+*/
+proto.CourseActivity.prototype.setEventsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ActivityEvent=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ActivityEvent}
+ */
+proto.CourseActivity.prototype.addEvents = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ActivityEvent, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.CourseActivity} returns this
+ */
+proto.CourseActivity.prototype.clearEventsList = function() {
+  return this.setEventsList([]);
+};
+
+
+/**
+ * optional string nextCursor = 2;
+ * @return {string}
+ */
+proto.CourseActivity.prototype.getNextcursor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.CourseActivity} returns this
+ */
+proto.CourseActivity.prototype.setNextcursor = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.CourseEnrollment.prototype.toObject = function(opt_includeInstance) {
+  return proto.CourseEnrollment.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.CourseEnrollment} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CourseEnrollment.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    course: (f = msg.getCourse()) && proto.Course.toObject(includeInstance, f),
+    enrollment: (f = msg.getEnrollment()) && proto.Enrollment.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.CourseEnrollment}
+ */
+proto.CourseEnrollment.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.CourseEnrollment;
+  return proto.CourseEnrollment.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.CourseEnrollment} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.CourseEnrollment}
+ */
+proto.CourseEnrollment.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.Course;
+      reader.readMessage(value,proto.Course.deserializeBinaryFromReader);
+      msg.setCourse(value);
+      break;
+    case 2:
+      var value = new proto.Enrollment;
+      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
+      msg.setEnrollment(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.CourseEnrollment.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.CourseEnrollment.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.CourseEnrollment} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CourseEnrollment.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourse();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.Course.serializeBinaryToWriter
+    );
+  }
+  f = message.getEnrollment();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.Enrollment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Course course = 1;
+ * @return {?proto.Course}
+ */
+proto.CourseEnrollment.prototype.getCourse = function() {
+  return /** @type{?proto.Course} */ (
+    jspb.Message.getWrapperField(this, proto.Course, 1));
+};
+
+
+/**
+ * @param {?proto.Course|undefined} value
+ * @return {!proto.CourseEnrollment} returns this
+*/
+proto.CourseEnrollment.prototype.setCourse = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.CourseEnrollment} returns this
+ */
+proto.CourseEnrollment.prototype.clearCourse = function() {
+  return this.setCourse(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.CourseEnrollment.prototype.hasCourse = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional Enrollment enrollment = 2;
+ * @return {?proto.Enrollment}
+ */
+proto.CourseEnrollment.prototype.getEnrollment = function() {
+  return /** @type{?proto.Enrollment} */ (
+    jspb.Message.getWrapperField(this, proto.Enrollment, 2));
+};
+
+
+/**
+ * @param {?proto.Enrollment|undefined} value
+ * @return {!proto.CourseEnrollment} returns this
+*/
+proto.CourseEnrollment.prototype.setEnrollment = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.CourseEnrollment} returns this
+ */
+proto.CourseEnrollment.prototype.clearEnrollment = function() {
+  return this.setEnrollment(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.CourseEnrollment.prototype.hasEnrollment = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.CourseEnrollments.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.CourseEnrollments.prototype.toObject = function(opt_includeInstance) {
+  return proto.CourseEnrollments.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.CourseEnrollments} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CourseEnrollments.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    courseenrollmentsList: jspb.Message.toObjectList(msg.getCourseenrollmentsList(),
+    proto.CourseEnrollment.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.CourseEnrollments}
+ */
+proto.CourseEnrollments.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.CourseEnrollments;
+  return proto.CourseEnrollments.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.CourseEnrollments} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.CourseEnrollments}
+ */
+proto.CourseEnrollments.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.CourseEnrollment;
+      reader.readMessage(value,proto.CourseEnrollment.deserializeBinaryFromReader);
+      msg.addCourseenrollments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.CourseEnrollments.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.CourseEnrollments.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.CourseEnrollments} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CourseEnrollments.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourseenrollmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.CourseEnrollment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated CourseEnrollment courseEnrollments = 1;
+ * @return {!Array<!proto.CourseEnrollment>}
+ */
+proto.CourseEnrollments.prototype.getCourseenrollmentsList = function() {
+  return /** @type{!Array<!proto.CourseEnrollment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.CourseEnrollment, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.CourseEnrollment>} value
+ * @return {!proto.CourseEnrollments} returns this
+*/
+proto.CourseEnrollments.prototype.setCourseenrollmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.CourseEnrollment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.CourseEnrollment}
+ */
+proto.CourseEnrollments.prototype.addCourseenrollments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.CourseEnrollment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.CourseEnrollments} returns this
+ */
+proto.CourseEnrollments.prototype.clearCourseenrollmentsList = function() {
+  return this.setCourseenrollmentsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Repository.prototype.toObject = function(opt_includeInstance) {
+  return proto.Repository.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Repository} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Repository.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    organizationid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    repositoryid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    htmlurl: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    repotype: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    hookid: jspb.Message.getFieldWithDefault(msg, 8, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Repository}
+ */
+proto.Repository.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Repository;
+  return proto.Repository.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Repository} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Repository}
+ */
+proto.Repository.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setOrganizationid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setRepositoryid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHtmlurl(value);
+      break;
+    case 7:
+      var value = /** @type {!proto.Repository.Type} */ (reader.readEnum());
+      msg.setRepotype(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setHookid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Repository.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Repository.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Repository} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Repository.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getOrganizationid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getRepositoryid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
+  f = message.getHtmlurl();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getRepotype();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      7,
+      f
+    );
+  }
+  f = message.getHookid();
+  if (f !== 0) {
+    writer.writeUint64(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.Repository.Type = {
+  NONE: 0,
+  COURSEINFO: 1,
+  ASSIGNMENTS: 2,
+  TESTS: 3,
+  USER: 4,
+  GROUP: 5
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.Repository.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Repository} returns this
+ */
+proto.Repository.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 organizationID = 2;
+ * @return {number}
+ */
+proto.Repository.prototype.getOrganizationid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Repository} returns this
+ */
+proto.Repository.prototype.setOrganizationid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 repositoryID = 3;
+ * @return {number}
+ */
+proto.Repository.prototype.getRepositoryid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Repository} returns this
+ */
+proto.Repository.prototype.setRepositoryid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 userID = 4;
+ * @return {number}
+ */
+proto.Repository.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Repository} returns this
+ */
+proto.Repository.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint64 groupID = 5;
+ * @return {number}
+ */
+proto.Repository.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Repository} returns this
+ */
+proto.Repository.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string HTMLURL = 6;
+ * @return {string}
+ */
+proto.Repository.prototype.getHtmlurl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Repository} returns this
+ */
+proto.Repository.prototype.setHtmlurl = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional Type repoType = 7;
+ * @return {!proto.Repository.Type}
+ */
+proto.Repository.prototype.getRepotype = function() {
+  return /** @type {!proto.Repository.Type} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {!proto.Repository.Type} value
+ * @return {!proto.Repository} returns this
+ */
+proto.Repository.prototype.setRepotype = function(value) {
+  return jspb.Message.setProto3EnumField(this, 7, value);
+};
+
+
+/**
+ * optional uint64 hookID = 8;
+ * @return {number}
+ */
+proto.Repository.prototype.getHookid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Repository} returns this
+ */
+proto.Repository.prototype.setHookid = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Enrollment.repeatedFields_ = [14];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Enrollment.prototype.toObject = function(opt_includeInstance) {
+  return proto.Enrollment.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Enrollment} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Enrollment.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    hasteacherscopes: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    user: (f = msg.getUser()) && proto.User.toObject(includeInstance, f),
+    course: (f = msg.getCourse()) && proto.Course.toObject(includeInstance, f),
+    group: (f = msg.getGroup()) && proto.Group.toObject(includeInstance, f),
+    status: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    state: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    slipdaysremaining: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    lastactivitydate: jspb.Message.getFieldWithDefault(msg, 12, ""),
+    totalapproved: jspb.Message.getFieldWithDefault(msg, 13, 0),
+    usedslipdaysList: jspb.Message.toObjectList(msg.getUsedslipdaysList(),
+    proto.UsedSlipDays.toObject, includeInstance),
+    rejectreason: jspb.Message.getFieldWithDefault(msg, 15, ""),
+    enrollmentcode: jspb.Message.getFieldWithDefault(msg, 16, ""),
+    enrolleddate: jspb.Message.getFieldWithDefault(msg, 17, ""),
+    repositoryurl: jspb.Message.getFieldWithDefault(msg, 18, ""),
+    lastactivityat: jspb.Message.getFieldWithDefault(msg, 19, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Enrollment}
+ */
+proto.Enrollment.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Enrollment;
+  return proto.Enrollment.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Enrollment} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Enrollment}
+ */
+proto.Enrollment.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHasteacherscopes(value);
+      break;
+    case 6:
+      var value = new proto.User;
+      reader.readMessage(value,proto.User.deserializeBinaryFromReader);
+      msg.setUser(value);
+      break;
+    case 7:
+      var value = new proto.Course;
+      reader.readMessage(value,proto.Course.deserializeBinaryFromReader);
+      msg.setCourse(value);
+      break;
+    case 8:
+      var value = new proto.Group;
+      reader.readMessage(value,proto.Group.deserializeBinaryFromReader);
+      msg.setGroup(value);
+      break;
+    case 9:
+      var value = /** @type {!proto.Enrollment.UserStatus} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 10:
+      var value = /** @type {!proto.Enrollment.DisplayState} */ (reader.readEnum());
+      msg.setState(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setSlipdaysremaining(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLastactivitydate(value);
+      break;
+    case 13:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setTotalapproved(value);
+      break;
+    case 14:
+      var value = new proto.UsedSlipDays;
+      reader.readMessage(value,proto.UsedSlipDays.deserializeBinaryFromReader);
+      msg.addUsedslipdays(value);
+      break;
+    case 15:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRejectreason(value);
+      break;
+    case 16:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnrollmentcode(value);
+      break;
+    case 17:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnrolleddate(value);
+      break;
+    case 18:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRepositoryurl(value);
+      break;
+    case 19:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLastactivityat(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Enrollment.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Enrollment.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Enrollment} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Enrollment.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getHasteacherscopes();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getUser();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.User.serializeBinaryToWriter
+    );
+  }
+  f = message.getCourse();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.Course.serializeBinaryToWriter
+    );
+  }
+  f = message.getGroup();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.Group.serializeBinaryToWriter
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      9,
+      f
+    );
+  }
+  f = message.getState();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      10,
+      f
+    );
+  }
+  f = message.getSlipdaysremaining();
+  if (f !== 0) {
+    writer.writeUint32(
+      11,
+      f
+    );
+  }
+  f = message.getLastactivitydate();
+  if (f.length > 0) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+  f = message.getTotalapproved();
+  if (f !== 0) {
+    writer.writeUint64(
+      13,
+      f
+    );
+  }
+  f = message.getUsedslipdaysList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      14,
+      f,
+      proto.UsedSlipDays.serializeBinaryToWriter
+    );
+  }
+  f = message.getRejectreason();
+  if (f.length > 0) {
+    writer.writeString(
+      15,
+      f
+    );
+  }
+  f = message.getEnrollmentcode();
+  if (f.length > 0) {
+    writer.writeString(
+      16,
+      f
+    );
+  }
+  f = message.getEnrolleddate();
+  if (f.length > 0) {
+    writer.writeString(
+      17,
+      f
+    );
+  }
+  f = message.getRepositoryurl();
+  if (f.length > 0) {
+    writer.writeString(
+      18,
+      f
+    );
+  }
+  f = message.getLastactivityat();
+  if (f.length > 0) {
+    writer.writeString(
+      19,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.Enrollment.UserStatus = {
+  NONE: 0,
+  PENDING: 1,
+  STUDENT: 2,
+  TEACHER: 3,
+  LEFT: 4
+};
+
+/**
+ * @enum {number}
+ */
+proto.Enrollment.DisplayState = {
+  UNSET: 0,
+  HIDDEN: 1,
+  VISIBLE: 2,
+  FAVORITE: 3
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.Enrollment.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 courseID = 2;
+ * @return {number}
+ */
+proto.Enrollment.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 userID = 3;
+ * @return {number}
+ */
+proto.Enrollment.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 groupID = 4;
+ * @return {number}
+ */
+proto.Enrollment.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional bool hasTeacherScopes = 5;
+ * @return {boolean}
+ */
+proto.Enrollment.prototype.getHasteacherscopes = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setHasteacherscopes = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional User user = 6;
+ * @return {?proto.User}
+ */
+proto.Enrollment.prototype.getUser = function() {
+  return /** @type{?proto.User} */ (
+    jspb.Message.getWrapperField(this, proto.User, 6));
+};
+
+
+/**
+ * @param {?proto.User|undefined} value
+ * @return {!proto.Enrollment} returns this
+*/
+proto.Enrollment.prototype.setUser = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.clearUser = function() {
+  return this.setUser(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.Enrollment.prototype.hasUser = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional Course course = 7;
+ * @return {?proto.Course}
+ */
+proto.Enrollment.prototype.getCourse = function() {
+  return /** @type{?proto.Course} */ (
+    jspb.Message.getWrapperField(this, proto.Course, 7));
+};
+
+
+/**
+ * @param {?proto.Course|undefined} value
+ * @return {!proto.Enrollment} returns this
+*/
+proto.Enrollment.prototype.setCourse = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.clearCourse = function() {
+  return this.setCourse(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.Enrollment.prototype.hasCourse = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional Group group = 8;
+ * @return {?proto.Group}
+ */
+proto.Enrollment.prototype.getGroup = function() {
+  return /** @type{?proto.Group} */ (
+    jspb.Message.getWrapperField(this, proto.Group, 8));
+};
+
+
+/**
+ * @param {?proto.Group|undefined} value
+ * @return {!proto.Enrollment} returns this
+*/
+proto.Enrollment.prototype.setGroup = function(value) {
+  return jspb.Message.setWrapperField(this, 8, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.clearGroup = function() {
+  return this.setGroup(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.Enrollment.prototype.hasGroup = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional UserStatus status = 9;
+ * @return {!proto.Enrollment.UserStatus}
+ */
+proto.Enrollment.prototype.getStatus = function() {
+  return /** @type {!proto.Enrollment.UserStatus} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {!proto.Enrollment.UserStatus} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 9, value);
+};
+
+
+/**
+ * optional DisplayState state = 10;
+ * @return {!proto.Enrollment.DisplayState}
+ */
+proto.Enrollment.prototype.getState = function() {
+  return /** @type {!proto.Enrollment.DisplayState} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {!proto.Enrollment.DisplayState} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setState = function(value) {
+  return jspb.Message.setProto3EnumField(this, 10, value);
+};
+
+
+/**
+ * optional uint32 slipDaysRemaining = 11;
+ * @return {number}
+ */
+proto.Enrollment.prototype.getSlipdaysremaining = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setSlipdaysremaining = function(value) {
+  return jspb.Message.setProto3IntField(this, 11, value);
+};
+
+
+/**
+ * optional string lastActivityDate = 12;
+ * @return {string}
+ */
+proto.Enrollment.prototype.getLastactivitydate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setLastactivitydate = function(value) {
+  return jspb.Message.setProto3StringField(this, 12, value);
+};
+
+
+/**
+ * optional uint64 totalApproved = 13;
+ * @return {number}
+ */
+proto.Enrollment.prototype.getTotalapproved = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setTotalapproved = function(value) {
+  return jspb.Message.setProto3IntField(this, 13, value);
+};
+
+
+/**
+ * repeated UsedSlipDays usedSlipDays = 14;
+ * @return {!Array<!proto.UsedSlipDays>}
+ */
+proto.Enrollment.prototype.getUsedslipdaysList = function() {
+  return /** @type{!Array<!proto.UsedSlipDays>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.UsedSlipDays, 14));
+};
+
+
+/**
+ * @param {!Array<!proto.UsedSlipDays>} value
+ * @return {!proto.Enrollment} returns this
+*/
+proto.Enrollment.prototype.setUsedslipdaysList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+};
+
+
+/**
+ * @param {!proto.UsedSlipDays=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.UsedSlipDays}
+ */
+proto.Enrollment.prototype.addUsedslipdays = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.UsedSlipDays, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.clearUsedslipdaysList = function() {
+  return this.setUsedslipdaysList([]);
+};
+
+
+/**
+ * optional string rejectReason = 15;
+ * @return {string}
+ */
+proto.Enrollment.prototype.getRejectreason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 15, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setRejectreason = function(value) {
+  return jspb.Message.setProto3StringField(this, 15, value);
+};
+
+
+/**
+ * optional string enrollmentCode = 16;
+ * @return {string}
+ */
+proto.Enrollment.prototype.getEnrollmentcode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 16, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setEnrollmentcode = function(value) {
+  return jspb.Message.setProto3StringField(this, 16, value);
+};
+
+
+/**
+ * optional string enrolledDate = 17;
+ * @return {string}
+ */
+proto.Enrollment.prototype.getEnrolleddate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 17, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setEnrolleddate = function(value) {
+  return jspb.Message.setProto3StringField(this, 17, value);
+};
+
+
+/**
+ * optional string repositoryURL = 18;
+ * @return {string}
+ */
+proto.Enrollment.prototype.getRepositoryurl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 18, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setRepositoryurl = function(value) {
+  return jspb.Message.setProto3StringField(this, 18, value);
+};
+
+
+/**
+ * optional string lastActivityAt = 19;
+ * @return {string}
+ */
+proto.Enrollment.prototype.getLastactivityat = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 19, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setLastactivityat = function(value) {
+  return jspb.Message.setProto3StringField(this, 19, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.UsedSlipDays.prototype.toObject = function(opt_includeInstance) {
+  return proto.UsedSlipDays.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.UsedSlipDays} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.UsedSlipDays.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    enrollmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    usedslipdays: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.UsedSlipDays}
+ */
+proto.UsedSlipDays.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.UsedSlipDays;
+  return proto.UsedSlipDays.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.UsedSlipDays} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.UsedSlipDays}
+ */
+proto.UsedSlipDays.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setEnrollmentid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setUsedslipdays(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.UsedSlipDays.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.UsedSlipDays.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.UsedSlipDays} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.UsedSlipDays.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getEnrollmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getUsedslipdays();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.UsedSlipDays.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.UsedSlipDays} returns this
+ */
+proto.UsedSlipDays.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 enrollmentID = 2;
+ * @return {number}
+ */
+proto.UsedSlipDays.prototype.getEnrollmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.UsedSlipDays} returns this
+ */
+proto.UsedSlipDays.prototype.setEnrollmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 assignmentID = 3;
+ * @return {number}
+ */
+proto.UsedSlipDays.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.UsedSlipDays} returns this
+ */
+proto.UsedSlipDays.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 usedSlipDays = 4;
+ * @return {number}
+ */
+proto.UsedSlipDays.prototype.getUsedslipdays = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.UsedSlipDays} returns this
+ */
+proto.UsedSlipDays.prototype.setUsedslipdays = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Enrollments.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Enrollments.prototype.toObject = function(opt_includeInstance) {
+  return proto.Enrollments.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Enrollments} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Enrollments.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
+    proto.Enrollment.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Enrollments}
+ */
+proto.Enrollments.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Enrollments;
+  return proto.Enrollments.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Enrollments} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Enrollments}
+ */
+proto.Enrollments.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.Enrollment;
+      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
+      msg.addEnrollments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Enrollments.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Enrollments.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Enrollments} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Enrollments.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnrollmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.Enrollment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Enrollment enrollments = 1;
+ * @return {!Array<!proto.Enrollment>}
+ */
+proto.Enrollments.prototype.getEnrollmentsList = function() {
+  return /** @type{!Array<!proto.Enrollment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Enrollment, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.Enrollment>} value
+ * @return {!proto.Enrollments} returns this
+*/
+proto.Enrollments.prototype.setEnrollmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.Enrollment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Enrollment}
+ */
+proto.Enrollments.prototype.addEnrollments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Enrollment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Enrollments} returns this
+ */
+proto.Enrollments.prototype.clearEnrollmentsList = function() {
+  return this.setEnrollmentsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.EnrollmentImport.repeatedFields_ = [1,2,3,4,5];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.EnrollmentImport.prototype.toObject = function(opt_includeInstance) {
+  return proto.EnrollmentImport.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.EnrollmentImport} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.EnrollmentImport.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    importedList: jspb.Message.toObjectList(msg.getImportedList(),
+    proto.Enrollment.toObject, includeInstance),
+    unmatchedList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    norepositoryList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    rejectedList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    coursefullList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.EnrollmentImport}
+ */
+proto.EnrollmentImport.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.EnrollmentImport;
+  return proto.EnrollmentImport.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.EnrollmentImport} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.EnrollmentImport}
+ */
+proto.EnrollmentImport.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.Enrollment;
+      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
+      msg.addImported(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addUnmatched(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addNorepository(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addRejected(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCoursefull(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.EnrollmentImport.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.EnrollmentImport.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.EnrollmentImport} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.EnrollmentImport.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getImportedList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.Enrollment.serializeBinaryToWriter
+    );
+  }
+  f = message.getUnmatchedList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getNorepositoryList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+  f = message.getRejectedList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+  f = message.getCoursefullList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated Enrollment imported = 1;
+ * @return {!Array<!proto.Enrollment>}
+ */
+proto.EnrollmentImport.prototype.getImportedList = function() {
+  return /** @type{!Array<!proto.Enrollment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Enrollment, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.Enrollment>} value
+ * @return {!proto.EnrollmentImport} returns this
+*/
+proto.EnrollmentImport.prototype.setImportedList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.Enrollment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Enrollment}
+ */
+proto.EnrollmentImport.prototype.addImported = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Enrollment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.EnrollmentImport} returns this
+ */
+proto.EnrollmentImport.prototype.clearImportedList = function() {
+  return this.setImportedList([]);
+};
+
+
+/**
+ * repeated string unmatched = 2;
+ * @return {!Array<string>}
+ */
+proto.EnrollmentImport.prototype.getUnmatchedList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.EnrollmentImport} returns this
+ */
+proto.EnrollmentImport.prototype.setUnmatchedList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.EnrollmentImport} returns this
+ */
+proto.EnrollmentImport.prototype.addUnmatched = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.EnrollmentImport} returns this
+ */
+proto.EnrollmentImport.prototype.clearUnmatchedList = function() {
+  return this.setUnmatchedList([]);
+};
+
+
+/**
+ * repeated string noRepository = 3;
+ * @return {!Array<string>}
+ */
+proto.EnrollmentImport.prototype.getNorepositoryList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.EnrollmentImport} returns this
+ */
+proto.EnrollmentImport.prototype.setNorepositoryList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.EnrollmentImport} returns this
+ */
+proto.EnrollmentImport.prototype.addNorepository = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.EnrollmentImport} returns this
+ */
+proto.EnrollmentImport.prototype.clearNorepositoryList = function() {
+  return this.setNorepositoryList([]);
+};
+
+
+/**
+ * repeated string rejected = 4;
+ * @return {!Array<string>}
+ */
+proto.EnrollmentImport.prototype.getRejectedList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.EnrollmentImport} returns this
+ */
+proto.EnrollmentImport.prototype.setRejectedList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.EnrollmentImport} returns this
+ */
+proto.EnrollmentImport.prototype.addRejected = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.EnrollmentImport} returns this
+ */
+proto.EnrollmentImport.prototype.clearRejectedList = function() {
+  return this.setRejectedList([]);
+};
+
+
+/**
+ * repeated string courseFull = 5;
+ * @return {!Array<string>}
+ */
+proto.EnrollmentImport.prototype.getCoursefullList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.EnrollmentImport} returns this
+ */
+proto.EnrollmentImport.prototype.setCoursefullList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.EnrollmentImport} returns this
+ */
+proto.EnrollmentImport.prototype.addCoursefull = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.EnrollmentImport} returns this
+ */
+proto.EnrollmentImport.prototype.clearCoursefullList = function() {
+  return this.setCoursefullList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.EnrollmentCount.prototype.toObject = function(opt_includeInstance) {
+  return proto.EnrollmentCount.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.EnrollmentCount} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.EnrollmentCount.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    count: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.EnrollmentCount}
+ */
+proto.EnrollmentCount.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.EnrollmentCount;
+  return proto.EnrollmentCount.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.EnrollmentCount} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.EnrollmentCount}
+ */
+proto.EnrollmentCount.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCount(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.EnrollmentCount.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.EnrollmentCount.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.EnrollmentCount} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.EnrollmentCount.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCount();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 count = 1;
+ * @return {number}
+ */
+proto.EnrollmentCount.prototype.getCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.EnrollmentCount} returns this
+ */
+proto.EnrollmentCount.prototype.setCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.SubmissionLink.prototype.toObject = function(opt_includeInstance) {
+  return proto.SubmissionLink.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.SubmissionLink} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.SubmissionLink.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    assignment: (f = msg.getAssignment()) && proto.Assignment.toObject(includeInstance, f),
+    submission: (f = msg.getSubmission()) && proto.Submission.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.SubmissionLink}
+ */
+proto.SubmissionLink.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.SubmissionLink;
+  return proto.SubmissionLink.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.SubmissionLink} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.SubmissionLink}
+ */
+proto.SubmissionLink.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.Assignment;
+      reader.readMessage(value,proto.Assignment.deserializeBinaryFromReader);
+      msg.setAssignment(value);
+      break;
+    case 2:
+      var value = new proto.Submission;
+      reader.readMessage(value,proto.Submission.deserializeBinaryFromReader);
+      msg.setSubmission(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.SubmissionLink.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.SubmissionLink.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.SubmissionLink} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.SubmissionLink.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAssignment();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.Assignment.serializeBinaryToWriter
+    );
+  }
+  f = message.getSubmission();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.Submission.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Assignment assignment = 1;
+ * @return {?proto.Assignment}
+ */
+proto.SubmissionLink.prototype.getAssignment = function() {
+  return /** @type{?proto.Assignment} */ (
+    jspb.Message.getWrapperField(this, proto.Assignment, 1));
+};
+
+
+/**
+ * @param {?proto.Assignment|undefined} value
+ * @return {!proto.SubmissionLink} returns this
+*/
+proto.SubmissionLink.prototype.setAssignment = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.SubmissionLink} returns this
+ */
+proto.SubmissionLink.prototype.clearAssignment = function() {
+  return this.setAssignment(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.SubmissionLink.prototype.hasAssignment = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional Submission submission = 2;
+ * @return {?proto.Submission}
+ */
+proto.SubmissionLink.prototype.getSubmission = function() {
+  return /** @type{?proto.Submission} */ (
+    jspb.Message.getWrapperField(this, proto.Submission, 2));
+};
+
+
+/**
+ * @param {?proto.Submission|undefined} value
+ * @return {!proto.SubmissionLink} returns this
+*/
+proto.SubmissionLink.prototype.setSubmission = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.SubmissionLink} returns this
+ */
+proto.SubmissionLink.prototype.clearSubmission = function() {
+  return this.setSubmission(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.SubmissionLink.prototype.hasSubmission = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.EnrollmentLink.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.EnrollmentLink.prototype.toObject = function(opt_includeInstance) {
+  return proto.EnrollmentLink.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.EnrollmentLink} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.EnrollmentLink.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enrollment: (f = msg.getEnrollment()) && proto.Enrollment.toObject(includeInstance, f),
+    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
+    proto.SubmissionLink.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.EnrollmentLink}
+ */
+proto.EnrollmentLink.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.EnrollmentLink;
+  return proto.EnrollmentLink.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.EnrollmentLink} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.EnrollmentLink}
+ */
+proto.EnrollmentLink.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 2:
+      var value = new proto.Enrollment;
+      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
+      msg.setEnrollment(value);
+      break;
+    case 3:
+      var value = new proto.SubmissionLink;
+      reader.readMessage(value,proto.SubmissionLink.deserializeBinaryFromReader);
+      msg.addSubmissions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.EnrollmentLink.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.EnrollmentLink.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.EnrollmentLink} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.EnrollmentLink.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnrollment();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.Enrollment.serializeBinaryToWriter
+    );
+  }
+  f = message.getSubmissionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.SubmissionLink.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Enrollment enrollment = 2;
+ * @return {?proto.Enrollment}
+ */
+proto.EnrollmentLink.prototype.getEnrollment = function() {
+  return /** @type{?proto.Enrollment} */ (
+    jspb.Message.getWrapperField(this, proto.Enrollment, 2));
+};
+
+
+/**
+ * @param {?proto.Enrollment|undefined} value
+ * @return {!proto.EnrollmentLink} returns this
+*/
+proto.EnrollmentLink.prototype.setEnrollment = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.EnrollmentLink} returns this
+ */
+proto.EnrollmentLink.prototype.clearEnrollment = function() {
+  return this.setEnrollment(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.EnrollmentLink.prototype.hasEnrollment = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * repeated SubmissionLink submissions = 3;
+ * @return {!Array<!proto.SubmissionLink>}
+ */
+proto.EnrollmentLink.prototype.getSubmissionsList = function() {
+  return /** @type{!Array<!proto.SubmissionLink>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.SubmissionLink, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.SubmissionLink>} value
+ * @return {!proto.EnrollmentLink} returns this
+*/
+proto.EnrollmentLink.prototype.setSubmissionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.SubmissionLink=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.SubmissionLink}
+ */
+proto.EnrollmentLink.prototype.addSubmissions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.SubmissionLink, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.EnrollmentLink} returns this
+ */
+proto.EnrollmentLink.prototype.clearSubmissionsList = function() {
+  return this.setSubmissionsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.CourseSubmissions.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.CourseSubmissions.prototype.toObject = function(opt_includeInstance) {
+  return proto.CourseSubmissions.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.CourseSubmissions} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CourseSubmissions.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    course: (f = msg.getCourse()) && proto.Course.toObject(includeInstance, f),
+    linksList: jspb.Message.toObjectList(msg.getLinksList(),
+    proto.EnrollmentLink.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.CourseSubmissions}
+ */
+proto.CourseSubmissions.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.CourseSubmissions;
+  return proto.CourseSubmissions.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.CourseSubmissions} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.CourseSubmissions}
+ */
+proto.CourseSubmissions.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.Course;
+      reader.readMessage(value,proto.Course.deserializeBinaryFromReader);
+      msg.setCourse(value);
+      break;
+    case 2:
+      var value = new proto.EnrollmentLink;
+      reader.readMessage(value,proto.EnrollmentLink.deserializeBinaryFromReader);
+      msg.addLinks(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.CourseSubmissions.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.CourseSubmissions.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.CourseSubmissions} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CourseSubmissions.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourse();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.Course.serializeBinaryToWriter
+    );
+  }
+  f = message.getLinksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.EnrollmentLink.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Course course = 1;
+ * @return {?proto.Course}
+ */
+proto.CourseSubmissions.prototype.getCourse = function() {
+  return /** @type{?proto.Course} */ (
+    jspb.Message.getWrapperField(this, proto.Course, 1));
+};
+
+
+/**
+ * @param {?proto.Course|undefined} value
+ * @return {!proto.CourseSubmissions} returns this
+*/
+proto.CourseSubmissions.prototype.setCourse = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.CourseSubmissions} returns this
+ */
+proto.CourseSubmissions.prototype.clearCourse = function() {
+  return this.setCourse(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.CourseSubmissions.prototype.hasCourse = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated EnrollmentLink links = 2;
+ * @return {!Array<!proto.EnrollmentLink>}
+ */
+proto.CourseSubmissions.prototype.getLinksList = function() {
+  return /** @type{!Array<!proto.EnrollmentLink>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.EnrollmentLink, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.EnrollmentLink>} value
+ * @return {!proto.CourseSubmissions} returns this
+*/
+proto.CourseSubmissions.prototype.setLinksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.EnrollmentLink=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.EnrollmentLink}
+ */
+proto.CourseSubmissions.prototype.addLinks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.EnrollmentLink, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.CourseSubmissions} returns this
+ */
+proto.CourseSubmissions.prototype.clearLinksList = function() {
+  return this.setLinksList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Assignment.repeatedFields_ = [12,13];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Assignment.prototype.toObject = function(opt_includeInstance) {
+  return proto.Assignment.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Assignment} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Assignment.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    scriptfile: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    deadline: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    autoapprove: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+    order: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    isgrouplab: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+    scorelimit: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    reviewers: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    skiptests: jspb.Message.getBooleanFieldWithDefault(msg, 11, false),
+    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
+    proto.Submission.toObject, includeInstance),
+    gradingbenchmarksList: jspb.Message.toObjectList(msg.getGradingbenchmarksList(),
+    proto.GradingBenchmark.toObject, includeInstance),
+    containertimeout: jspb.Message.getFieldWithDefault(msg, 14, 0),
+    latepenalty: jspb.Message.getFieldWithDefault(msg, 15, 0),
+    maxlatepenalty: jspb.Message.getFieldWithDefault(msg, 16, 0),
+    prerequisite: jspb.Message.getFieldWithDefault(msg, 17, 0),
+    maxattempts: jspb.Message.getFieldWithDefault(msg, 18, 0),
+    category: jspb.Message.getFieldWithDefault(msg, 19, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Assignment}
+ */
+proto.Assignment.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Assignment;
+  return proto.Assignment.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Assignment} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Assignment}
+ */
+proto.Assignment.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setScriptfile(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeadline(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAutoapprove(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setOrder(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsgrouplab(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScorelimit(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setReviewers(value);
+      break;
+    case 11:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSkiptests(value);
+      break;
+    case 12:
+      var value = new proto.Submission;
+      reader.readMessage(value,proto.Submission.deserializeBinaryFromReader);
+      msg.addSubmissions(value);
+      break;
+    case 13:
+      var value = new proto.GradingBenchmark;
+      reader.readMessage(value,proto.GradingBenchmark.deserializeBinaryFromReader);
+      msg.addGradingbenchmarks(value);
+      break;
+    case 14:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setContainertimeout(value);
+      break;
+    case 15:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setLatepenalty(value);
+      break;
+    case 16:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMaxlatepenalty(value);
+      break;
+    case 17:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPrerequisite(value);
+      break;
+    case 18:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMaxattempts(value);
+      break;
+    case 19:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCategory(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Assignment.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Assignment.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Assignment} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Assignment.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getScriptfile();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getDeadline();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getAutoapprove();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = message.getOrder();
+  if (f !== 0) {
+    writer.writeUint32(
+      7,
+      f
+    );
+  }
+  f = message.getIsgrouplab();
+  if (f) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = message.getScorelimit();
+  if (f !== 0) {
+    writer.writeUint32(
+      9,
+      f
+    );
+  }
+  f = message.getReviewers();
+  if (f !== 0) {
+    writer.writeUint32(
+      10,
+      f
+    );
+  }
+  f = message.getSkiptests();
+  if (f) {
+    writer.writeBool(
+      11,
+      f
+    );
+  }
+  f = message.getSubmissionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      12,
+      f,
+      proto.Submission.serializeBinaryToWriter
+    );
+  }
+  f = message.getGradingbenchmarksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      13,
+      f,
+      proto.GradingBenchmark.serializeBinaryToWriter
+    );
+  }
+  f = message.getContainertimeout();
+  if (f !== 0) {
+    writer.writeUint32(
+      14,
+      f
+    );
+  }
+  f = message.getLatepenalty();
+  if (f !== 0) {
+    writer.writeUint32(
+      15,
+      f
+    );
+  }
+  f = message.getMaxlatepenalty();
+  if (f !== 0) {
+    writer.writeUint32(
+      16,
+      f
+    );
+  }
+  f = message.getPrerequisite();
+  if (f !== 0) {
+    writer.writeUint32(
+      17,
+      f
+    );
+  }
+  f = message.getMaxattempts();
+  if (f !== 0) {
+    writer.writeUint32(
+      18,
+      f
+    );
+  }
+  f = message.getCategory();
+  if (f.length > 0) {
+    writer.writeString(
+      19,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.Assignment.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 courseID = 2;
+ * @return {number}
+ */
+proto.Assignment.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.Assignment.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string scriptFile = 4;
+ * @return {string}
+ */
+proto.Assignment.prototype.getScriptfile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setScriptfile = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string deadline = 5;
+ * @return {string}
+ */
+proto.Assignment.prototype.getDeadline = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setDeadline = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional bool autoApprove = 6;
+ * @return {boolean}
+ */
+proto.Assignment.prototype.getAutoapprove = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setAutoapprove = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
+};
+
+
+/**
+ * optional uint32 order = 7;
+ * @return {number}
+ */
+proto.Assignment.prototype.getOrder = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setOrder = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional bool isGroupLab = 8;
+ * @return {boolean}
+ */
+proto.Assignment.prototype.getIsgrouplab = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setIsgrouplab = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
+};
+
+
+/**
+ * optional uint32 scoreLimit = 9;
+ * @return {number}
+ */
+proto.Assignment.prototype.getScorelimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setScorelimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+/**
+ * optional uint32 reviewers = 10;
+ * @return {number}
+ */
+proto.Assignment.prototype.getReviewers = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setReviewers = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional bool skipTests = 11;
+ * @return {boolean}
+ */
+proto.Assignment.prototype.getSkiptests = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 11, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setSkiptests = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 11, value);
+};
+
+
+/**
+ * repeated Submission submissions = 12;
+ * @return {!Array<!proto.Submission>}
+ */
+proto.Assignment.prototype.getSubmissionsList = function() {
+  return /** @type{!Array<!proto.Submission>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Submission, 12));
+};
+
+
+/**
+ * @param {!Array<!proto.Submission>} value
+ * @return {!proto.Assignment} returns this
+*/
+proto.Assignment.prototype.setSubmissionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+};
+
+
+/**
+ * @param {!proto.Submission=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Submission}
+ */
+proto.Assignment.prototype.addSubmissions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.Submission, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.clearSubmissionsList = function() {
+  return this.setSubmissionsList([]);
+};
+
+
+/**
+ * repeated GradingBenchmark gradingBenchmarks = 13;
+ * @return {!Array<!proto.GradingBenchmark>}
+ */
+proto.Assignment.prototype.getGradingbenchmarksList = function() {
+  return /** @type{!Array<!proto.GradingBenchmark>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.GradingBenchmark, 13));
+};
+
+
+/**
+ * @param {!Array<!proto.GradingBenchmark>} value
+ * @return {!proto.Assignment} returns this
+*/
+proto.Assignment.prototype.setGradingbenchmarksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 13, value);
+};
+
+
+/**
+ * @param {!proto.GradingBenchmark=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.GradingBenchmark}
+ */
+proto.Assignment.prototype.addGradingbenchmarks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 13, opt_value, proto.GradingBenchmark, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.clearGradingbenchmarksList = function() {
+  return this.setGradingbenchmarksList([]);
+};
+
+
+/**
+ * optional uint32 containerTimeout = 14;
+ * @return {number}
+ */
+proto.Assignment.prototype.getContainertimeout = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setContainertimeout = function(value) {
+  return jspb.Message.setProto3IntField(this, 14, value);
+};
+
+
+/**
+ * optional uint32 latePenalty = 15;
+ * @return {number}
+ */
+proto.Assignment.prototype.getLatepenalty = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setLatepenalty = function(value) {
+  return jspb.Message.setProto3IntField(this, 15, value);
+};
+
+
+/**
+ * optional uint32 maxLatePenalty = 16;
+ * @return {number}
+ */
+proto.Assignment.prototype.getMaxlatepenalty = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 16, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setMaxlatepenalty = function(value) {
+  return jspb.Message.setProto3IntField(this, 16, value);
+};
+
+
+/**
+ * optional uint32 prerequisite = 17;
+ * @return {number}
+ */
+proto.Assignment.prototype.getPrerequisite = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 17, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setPrerequisite = function(value) {
+  return jspb.Message.setProto3IntField(this, 17, value);
+};
+
+
+/**
+ * optional uint32 maxAttempts = 18;
+ * @return {number}
+ */
+proto.Assignment.prototype.getMaxattempts = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 18, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setMaxattempts = function(value) {
+  return jspb.Message.setProto3IntField(this, 18, value);
+};
+
+
+/**
+ * optional string category = 19;
+ * @return {string}
+ */
+proto.Assignment.prototype.getCategory = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 19, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setCategory = function(value) {
+  return jspb.Message.setProto3StringField(this, 19, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Assignments.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Assignments.prototype.toObject = function(opt_includeInstance) {
+  return proto.Assignments.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Assignments} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Assignments.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
+    proto.Assignment.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Assignments}
+ */
+proto.Assignments.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Assignments;
+  return proto.Assignments.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Assignments} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Assignments}
+ */
+proto.Assignments.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.Assignment;
+      reader.readMessage(value,proto.Assignment.deserializeBinaryFromReader);
+      msg.addAssignments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Assignments.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Assignments.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Assignments} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Assignments.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAssignmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.Assignment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Assignment assignments = 1;
+ * @return {!Array<!proto.Assignment>}
+ */
+proto.Assignments.prototype.getAssignmentsList = function() {
+  return /** @type{!Array<!proto.Assignment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Assignment, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.Assignment>} value
+ * @return {!proto.Assignments} returns this
+*/
+proto.Assignments.prototype.setAssignmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.Assignment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Assignment}
+ */
+proto.Assignments.prototype.addAssignments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Assignment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Assignments} returns this
+ */
+proto.Assignments.prototype.clearAssignmentsList = function() {
+  return this.setAssignmentsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.CourseCalendar.prototype.toObject = function(opt_includeInstance) {
+  return proto.CourseCalendar.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.CourseCalendar} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CourseCalendar.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    ics: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.CourseCalendar}
+ */
+proto.CourseCalendar.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.CourseCalendar;
+  return proto.CourseCalendar.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.CourseCalendar} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.CourseCalendar}
+ */
+proto.CourseCalendar.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIcs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.CourseCalendar.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.CourseCalendar.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.CourseCalendar} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CourseCalendar.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIcs();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string ics = 1;
+ * @return {string}
+ */
+proto.CourseCalendar.prototype.getIcs = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.CourseCalendar} returns this
+ */
+proto.CourseCalendar.prototype.setIcs = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Submission.repeatedFields_ = [12];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Submission.prototype.toObject = function(opt_includeInstance) {
+  return proto.Submission.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Submission} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Submission.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    score: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    scoreobjects: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    buildinfo: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    commithash: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    released: jspb.Message.getBooleanFieldWithDefault(msg, 9, false),
+    status: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    approveddate: jspb.Message.getFieldWithDefault(msg, 11, ""),
+    reviewsList: jspb.Message.toObjectList(msg.getReviewsList(),
+    proto.Review.toObject, includeInstance),
+    rawscore: jspb.Message.getFieldWithDefault(msg, 15, 0),
+    attempts: jspb.Message.getFieldWithDefault(msg, 16, 0),
+    extraattempts: jspb.Message.getFieldWithDefault(msg, 17, 0),
+    needsreview: jspb.Message.getBooleanFieldWithDefault(msg, 18, false),
+    gradingconfigversion: jspb.Message.getFieldWithDefault(msg, 19, 0),
+    builddate: jspb.Message.getFieldWithDefault(msg, 21, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Submission}
+ */
+proto.Submission.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Submission;
+  return proto.Submission.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Submission} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Submission}
+ */
+proto.Submission.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScore(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setScoreobjects(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBuildinfo(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommithash(value);
+      break;
+    case 9:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReleased(value);
+      break;
+    case 10:
+      var value = /** @type {!proto.Submission.Status} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApproveddate(value);
+      break;
+    case 12:
+      var value = new proto.Review;
+      reader.readMessage(value,proto.Review.deserializeBinaryFromReader);
+      msg.addReviews(value);
+      break;
+    case 15:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRawscore(value);
+      break;
+    case 16:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setAttempts(value);
+      break;
+    case 17:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setExtraattempts(value);
+      break;
+    case 18:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setNeedsreview(value);
+      break;
+    case 19:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setGradingconfigversion(value);
+      break;
+    case 21:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBuilddate(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Submission.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Submission.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Submission} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Submission.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getScoreobjects();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getBuildinfo();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getCommithash();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getReleased();
+  if (f) {
+    writer.writeBool(
+      9,
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      10,
+      f
+    );
+  }
+  f = message.getApproveddate();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+  f = message.getReviewsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      12,
+      f,
+      proto.Review.serializeBinaryToWriter
+    );
+  }
+  f = message.getRawscore();
+  if (f !== 0) {
+    writer.writeUint32(
+      15,
+      f
+    );
+  }
+  f = message.getAttempts();
+  if (f !== 0) {
+    writer.writeUint32(
+      16,
+      f
+    );
+  }
+  f = message.getExtraattempts();
+  if (f !== 0) {
+    writer.writeUint32(
+      17,
+      f
+    );
+  }
+  f = message.getNeedsreview();
+  if (f) {
+    writer.writeBool(
+      18,
+      f
+    );
+  }
+  f = message.getGradingconfigversion();
+  if (f !== 0) {
+    writer.writeUint32(
+      19,
+      f
+    );
+  }
+  f = message.getBuilddate();
+  if (f.length > 0) {
+    writer.writeString(
+      21,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.Submission.Status = {
+  NONE: 0,
+  APPROVED: 1,
+  REJECTED: 2,
+  REVISION: 3
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.Submission.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 assignmentID = 2;
+ * @return {number}
+ */
+proto.Submission.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 userID = 3;
+ * @return {number}
+ */
+proto.Submission.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 groupID = 4;
+ * @return {number}
+ */
+proto.Submission.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 score = 5;
+ * @return {number}
+ */
+proto.Submission.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string scoreObjects = 6;
+ * @return {string}
+ */
+proto.Submission.prototype.getScoreobjects = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setScoreobjects = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string buildInfo = 7;
+ * @return {string}
+ */
+proto.Submission.prototype.getBuildinfo = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setBuildinfo = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional string commitHash = 8;
+ * @return {string}
+ */
+proto.Submission.prototype.getCommithash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setCommithash = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+/**
+ * optional bool released = 9;
+ * @return {boolean}
+ */
+proto.Submission.prototype.getReleased = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 9, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setReleased = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 9, value);
+};
+
+
+/**
+ * optional Status status = 10;
+ * @return {!proto.Submission.Status}
+ */
+proto.Submission.prototype.getStatus = function() {
+  return /** @type {!proto.Submission.Status} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {!proto.Submission.Status} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 10, value);
+};
+
+
+/**
+ * optional string approvedDate = 11;
+ * @return {string}
+ */
+proto.Submission.prototype.getApproveddate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setApproveddate = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
+};
+
+
+/**
+ * repeated Review reviews = 12;
+ * @return {!Array<!proto.Review>}
+ */
+proto.Submission.prototype.getReviewsList = function() {
+  return /** @type{!Array<!proto.Review>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Review, 12));
+};
+
+
+/**
+ * @param {!Array<!proto.Review>} value
+ * @return {!proto.Submission} returns this
+*/
+proto.Submission.prototype.setReviewsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+};
+
+
+/**
+ * @param {!proto.Review=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Review}
+ */
+proto.Submission.prototype.addReviews = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.Review, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.clearReviewsList = function() {
+  return this.setReviewsList([]);
+};
+
+
+/**
+ * optional uint32 rawScore = 15;
+ * @return {number}
+ */
+proto.Submission.prototype.getRawscore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setRawscore = function(value) {
+  return jspb.Message.setProto3IntField(this, 15, value);
+};
+
+
+/**
+ * optional uint32 attempts = 16;
+ * @return {number}
+ */
+proto.Submission.prototype.getAttempts = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 16, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setAttempts = function(value) {
+  return jspb.Message.setProto3IntField(this, 16, value);
+};
+
+
+/**
+ * optional uint32 extraAttempts = 17;
+ * @return {number}
+ */
+proto.Submission.prototype.getExtraattempts = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 17, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setExtraattempts = function(value) {
+  return jspb.Message.setProto3IntField(this, 17, value);
+};
+
+
+/**
+ * optional bool needsReview = 18;
+ * @return {boolean}
+ */
+proto.Submission.prototype.getNeedsreview = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 18, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setNeedsreview = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 18, value);
+};
+
+
+/**
+ * optional uint32 gradingConfigVersion = 19;
+ * @return {number}
+ */
+proto.Submission.prototype.getGradingconfigversion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 19, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setGradingconfigversion = function(value) {
+  return jspb.Message.setProto3IntField(this, 19, value);
+};
+
+
+/**
+ * optional string buildDate = 21;
+ * @return {string}
+ */
+proto.Submission.prototype.getBuilddate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 21, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setBuilddate = function(value) {
+  return jspb.Message.setProto3StringField(this, 21, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Submissions.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Submissions.prototype.toObject = function(opt_includeInstance) {
+  return proto.Submissions.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Submissions} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Submissions.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
+    proto.Submission.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Submissions}
+ */
+proto.Submissions.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Submissions;
+  return proto.Submissions.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Submissions} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Submissions}
+ */
+proto.Submissions.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.Submission;
+      reader.readMessage(value,proto.Submission.deserializeBinaryFromReader);
+      msg.addSubmissions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Submissions.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Submissions.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Submissions} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Submissions.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSubmissionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.Submission.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Submission submissions = 1;
+ * @return {!Array<!proto.Submission>}
+ */
+proto.Submissions.prototype.getSubmissionsList = function() {
+  return /** @type{!Array<!proto.Submission>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Submission, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.Submission>} value
+ * @return {!proto.Submissions} returns this
+*/
+proto.Submissions.prototype.setSubmissionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.Submission=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Submission}
+ */
+proto.Submissions.prototype.addSubmissions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Submission, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Submissions} returns this
+ */
+proto.Submissions.prototype.clearSubmissionsList = function() {
+  return this.setSubmissionsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.SubmissionBuild.prototype.toObject = function(opt_includeInstance) {
+  return proto.SubmissionBuild.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.SubmissionBuild} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.SubmissionBuild.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    score: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    commithash: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    builddate: jspb.Message.getFieldWithDefault(msg, 8, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.SubmissionBuild}
+ */
+proto.SubmissionBuild.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.SubmissionBuild;
+  return proto.SubmissionBuild.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.SubmissionBuild} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.SubmissionBuild}
+ */
+proto.SubmissionBuild.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSubmissionid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScore(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommithash(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBuilddate(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.SubmissionBuild.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.SubmissionBuild.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.SubmissionBuild} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.SubmissionBuild.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getSubmissionid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = message.getCommithash();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getBuilddate();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.SubmissionBuild.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.SubmissionBuild} returns this
+ */
+proto.SubmissionBuild.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 submissionID = 2;
+ * @return {number}
+ */
+proto.SubmissionBuild.prototype.getSubmissionid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.SubmissionBuild} returns this
+ */
+proto.SubmissionBuild.prototype.setSubmissionid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 assignmentID = 3;
+ * @return {number}
+ */
+proto.SubmissionBuild.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.SubmissionBuild} returns this
+ */
+proto.SubmissionBuild.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 userID = 4;
+ * @return {number}
+ */
+proto.SubmissionBuild.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.SubmissionBuild} returns this
+ */
+proto.SubmissionBuild.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint64 groupID = 5;
+ * @return {number}
+ */
+proto.SubmissionBuild.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.SubmissionBuild} returns this
+ */
+proto.SubmissionBuild.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional uint32 score = 6;
+ * @return {number}
+ */
+proto.SubmissionBuild.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.SubmissionBuild} returns this
+ */
+proto.SubmissionBuild.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional string commitHash = 7;
+ * @return {string}
+ */
+proto.SubmissionBuild.prototype.getCommithash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.SubmissionBuild} returns this
+ */
+proto.SubmissionBuild.prototype.setCommithash = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional string buildDate = 8;
+ * @return {string}
+ */
+proto.SubmissionBuild.prototype.getBuilddate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.SubmissionBuild} returns this
+ */
+proto.SubmissionBuild.prototype.setBuilddate = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.SubmissionBuilds.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.SubmissionBuilds.prototype.toObject = function(opt_includeInstance) {
+  return proto.SubmissionBuilds.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.SubmissionBuilds} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.SubmissionBuilds.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    buildsList: jspb.Message.toObjectList(msg.getBuildsList(),
+    proto.SubmissionBuild.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.SubmissionBuilds}
+ */
+proto.SubmissionBuilds.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.SubmissionBuilds;
+  return proto.SubmissionBuilds.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.SubmissionBuilds} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.SubmissionBuilds}
+ */
+proto.SubmissionBuilds.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.SubmissionBuild;
+      reader.readMessage(value,proto.SubmissionBuild.deserializeBinaryFromReader);
+      msg.addBuilds(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.SubmissionBuilds.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.SubmissionBuilds.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.SubmissionBuilds} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.SubmissionBuilds.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getBuildsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.SubmissionBuild.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated SubmissionBuild builds = 1;
+ * @return {!Array<!proto.SubmissionBuild>}
+ */
+proto.SubmissionBuilds.prototype.getBuildsList = function() {
+  return /** @type{!Array<!proto.SubmissionBuild>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.SubmissionBuild, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.SubmissionBuild>} value
+ * @return {!proto.SubmissionBuilds} returns this
+*/
+proto.SubmissionBuilds.prototype.setBuildsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.SubmissionBuild=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.SubmissionBuild}
+ */
+proto.SubmissionBuilds.prototype.addBuilds = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.SubmissionBuild, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.SubmissionBuilds} returns this
+ */
+proto.SubmissionBuilds.prototype.clearBuildsList = function() {
+  return this.setBuildsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Grade.prototype.toObject = function(opt_includeInstance) {
+  return proto.Grade.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Grade} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Grade.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    score: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    status: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Grade}
+ */
+proto.Grade.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Grade;
+  return proto.Grade.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Grade} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Grade}
+ */
+proto.Grade.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScore(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.Submission.Status} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Grade.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Grade.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Grade} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Grade.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 userID = 1;
+ * @return {number}
+ */
+proto.Grade.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Grade} returns this
+ */
+proto.Grade.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 assignmentID = 2;
+ * @return {number}
+ */
+proto.Grade.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Grade} returns this
+ */
+proto.Grade.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 score = 3;
+ * @return {number}
+ */
+proto.Grade.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Grade} returns this
+ */
+proto.Grade.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional Submission.Status status = 4;
+ * @return {!proto.Submission.Status}
+ */
+proto.Grade.prototype.getStatus = function() {
+  return /** @type {!proto.Submission.Status} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.Submission.Status} value
+ * @return {!proto.Grade} returns this
+ */
+proto.Grade.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.CourseGrades.prototype.toObject = function(opt_includeInstance) {
+  return proto.CourseGrades.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.CourseGrades} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CourseGrades.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    csv: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.CourseGrades}
+ */
+proto.CourseGrades.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.CourseGrades;
+  return proto.CourseGrades.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.CourseGrades} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.CourseGrades}
+ */
+proto.CourseGrades.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCsv(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.CourseGrades.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.CourseGrades.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.CourseGrades} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CourseGrades.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCsv();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string csv = 1;
+ * @return {string}
+ */
+proto.CourseGrades.prototype.getCsv = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.CourseGrades} returns this
+ */
+proto.CourseGrades.prototype.setCsv = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.CourseRoster.prototype.toObject = function(opt_includeInstance) {
+  return proto.CourseRoster.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.CourseRoster} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CourseRoster.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    csv: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.CourseRoster}
+ */
+proto.CourseRoster.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.CourseRoster;
+  return proto.CourseRoster.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.CourseRoster} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.CourseRoster}
+ */
+proto.CourseRoster.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCsv(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.CourseRoster.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.CourseRoster.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.CourseRoster} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CourseRoster.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCsv();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string csv = 1;
+ * @return {string}
+ */
+proto.CourseRoster.prototype.getCsv = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.CourseRoster} returns this
+ */
+proto.CourseRoster.prototype.setCsv = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.User.repeatedFields_ = [8,9];
+proto.GradingBenchmark.repeatedFields_ = [5];
 
 
 
@@ -1123,8 +11051,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.User.prototype.toObject = function(opt_includeInstance) {
-  return proto.User.toObject(opt_includeInstance, this);
+proto.GradingBenchmark.prototype.toObject = function(opt_includeInstance) {
+  return proto.GradingBenchmark.toObject(opt_includeInstance, this);
 };
 
 
@@ -1133,23 +11061,18 @@ proto.User.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.User} msg The msg instance to transform.
+ * @param {!proto.GradingBenchmark} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.User.toObject = function(includeInstance, msg) {
+proto.GradingBenchmark.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    isadmin: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    studentid: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    email: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    avatarurl: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    login: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    remoteidentitiesList: jspb.Message.toObjectList(msg.getRemoteidentitiesList(),
-    proto.RemoteIdentity.toObject, includeInstance),
-    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
-    proto.Enrollment.toObject, includeInstance)
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    heading: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    comment: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    criteriaList: jspb.Message.toObjectList(msg.getCriteriaList(),
+    proto.GradingCriterion.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -1163,23 +11086,23 @@ proto.User.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.User}
+ * @return {!proto.GradingBenchmark}
  */
-proto.User.deserializeBinary = function(bytes) {
+proto.GradingBenchmark.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.User;
-  return proto.User.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.GradingBenchmark;
+  return proto.GradingBenchmark.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.User} msg The message object to deserialize into.
+ * @param {!proto.GradingBenchmark} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.User}
+ * @return {!proto.GradingBenchmark}
  */
-proto.User.deserializeBinaryFromReader = function(msg, reader) {
+proto.GradingBenchmark.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1191,38 +11114,21 @@ proto.User.deserializeBinaryFromReader = function(msg, reader) {
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsadmin(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setHeading(value);
       break;
     case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setStudentid(value);
+      msg.setComment(value);
       break;
     case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEmail(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setAvatarurl(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setLogin(value);
-      break;
-    case 8:
-      var value = new proto.RemoteIdentity;
-      reader.readMessage(value,proto.RemoteIdentity.deserializeBinaryFromReader);
-      msg.addRemoteidentities(value);
-      break;
-    case 9:
-      var value = new proto.Enrollment;
-      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
-      msg.addEnrollments(value);
+      var value = new proto.GradingCriterion;
+      reader.readMessage(value,proto.GradingCriterion.deserializeBinaryFromReader);
+      msg.addCriteria(value);
       break;
     default:
       reader.skipField();
@@ -1237,9 +11143,9 @@ proto.User.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.User.prototype.serializeBinary = function() {
+proto.GradingBenchmark.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.User.serializeBinaryToWriter(this, writer);
+  proto.GradingBenchmark.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1247,11 +11153,11 @@ proto.User.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.User} message
+ * @param {!proto.GradingBenchmark} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.User.serializeBinaryToWriter = function(message, writer) {
+proto.GradingBenchmark.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -1260,266 +11166,145 @@ proto.User.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getIsadmin();
-  if (f) {
-    writer.writeBool(
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getName();
+  f = message.getHeading();
   if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = message.getStudentid();
+  f = message.getComment();
   if (f.length > 0) {
     writer.writeString(
       4,
       f
     );
   }
-  f = message.getEmail();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getAvatarurl();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getLogin();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
-  f = message.getRemoteidentitiesList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      8,
-      f,
-      proto.RemoteIdentity.serializeBinaryToWriter
-    );
-  }
-  f = message.getEnrollmentsList();
+  f = message.getCriteriaList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
-      9,
+      5,
       f,
-      proto.Enrollment.serializeBinaryToWriter
-    );
-  }
-};
-
-
-/**
- * optional uint64 ID = 1;
- * @return {number}
- */
-proto.User.prototype.getId = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.User} returns this
- */
-proto.User.prototype.setId = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
-
-
-/**
- * optional bool isAdmin = 2;
- * @return {boolean}
- */
-proto.User.prototype.getIsadmin = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.User} returns this
- */
-proto.User.prototype.setIsadmin = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
-};
-
-
-/**
- * optional string name = 3;
- * @return {string}
- */
-proto.User.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.User} returns this
- */
-proto.User.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
-/**
- * optional string studentID = 4;
- * @return {string}
- */
-proto.User.prototype.getStudentid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.User} returns this
- */
-proto.User.prototype.setStudentid = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
-
-/**
- * optional string email = 5;
- * @return {string}
- */
-proto.User.prototype.getEmail = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+      proto.GradingCriterion.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.User} returns this
+ * optional uint64 ID = 1;
+ * @return {number}
  */
-proto.User.prototype.setEmail = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.GradingBenchmark.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * optional string avatarURL = 6;
- * @return {string}
+ * @param {number} value
+ * @return {!proto.GradingBenchmark} returns this
  */
-proto.User.prototype.getAvatarurl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.GradingBenchmark.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.User} returns this
+ * optional uint64 assignmentID = 2;
+ * @return {number}
  */
-proto.User.prototype.setAvatarurl = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.GradingBenchmark.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * optional string login = 7;
- * @return {string}
+ * @param {number} value
+ * @return {!proto.GradingBenchmark} returns this
  */
-proto.User.prototype.getLogin = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.GradingBenchmark.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.User} returns this
+ * optional string heading = 3;
+ * @return {string}
  */
-proto.User.prototype.setLogin = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.GradingBenchmark.prototype.getHeading = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * repeated RemoteIdentity remoteIdentities = 8;
- * @return {!Array<!proto.RemoteIdentity>}
+ * @param {string} value
+ * @return {!proto.GradingBenchmark} returns this
  */
-proto.User.prototype.getRemoteidentitiesList = function() {
-  return /** @type{!Array<!proto.RemoteIdentity>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.RemoteIdentity, 8));
-};
-
-
-/**
- * @param {!Array<!proto.RemoteIdentity>} value
- * @return {!proto.User} returns this
-*/
-proto.User.prototype.setRemoteidentitiesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+proto.GradingBenchmark.prototype.setHeading = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * @param {!proto.RemoteIdentity=} opt_value
- * @param {number=} opt_index
- * @return {!proto.RemoteIdentity}
+ * optional string comment = 4;
+ * @return {string}
  */
-proto.User.prototype.addRemoteidentities = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.RemoteIdentity, opt_index);
+proto.GradingBenchmark.prototype.getComment = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.User} returns this
+ * @param {string} value
+ * @return {!proto.GradingBenchmark} returns this
  */
-proto.User.prototype.clearRemoteidentitiesList = function() {
-  return this.setRemoteidentitiesList([]);
+proto.GradingBenchmark.prototype.setComment = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * repeated Enrollment enrollments = 9;
- * @return {!Array<!proto.Enrollment>}
+ * repeated GradingCriterion criteria = 5;
+ * @return {!Array<!proto.GradingCriterion>}
  */
-proto.User.prototype.getEnrollmentsList = function() {
-  return /** @type{!Array<!proto.Enrollment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Enrollment, 9));
+proto.GradingBenchmark.prototype.getCriteriaList = function() {
+  return /** @type{!Array<!proto.GradingCriterion>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.GradingCriterion, 5));
 };
 
 
 /**
- * @param {!Array<!proto.Enrollment>} value
- * @return {!proto.User} returns this
+ * @param {!Array<!proto.GradingCriterion>} value
+ * @return {!proto.GradingBenchmark} returns this
 */
-proto.User.prototype.setEnrollmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 9, value);
+proto.GradingBenchmark.prototype.setCriteriaList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
 };
 
 
 /**
- * @param {!proto.Enrollment=} opt_value
+ * @param {!proto.GradingCriterion=} opt_value
  * @param {number=} opt_index
- * @return {!proto.Enrollment}
+ * @return {!proto.GradingCriterion}
  */
-proto.User.prototype.addEnrollments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.Enrollment, opt_index);
+proto.GradingBenchmark.prototype.addCriteria = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.GradingCriterion, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.User} returns this
+ * @return {!proto.GradingBenchmark} returns this
  */
-proto.User.prototype.clearEnrollmentsList = function() {
-  return this.setEnrollmentsList([]);
+proto.GradingBenchmark.prototype.clearCriteriaList = function() {
+  return this.setCriteriaList([]);
 };
 
 
@@ -1529,7 +11314,7 @@ proto.User.prototype.clearEnrollmentsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.Users.repeatedFields_ = [1];
+proto.Benchmarks.repeatedFields_ = [1];
 
 
 
@@ -1546,8 +11331,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Users.prototype.toObject = function(opt_includeInstance) {
-  return proto.Users.toObject(opt_includeInstance, this);
+proto.Benchmarks.prototype.toObject = function(opt_includeInstance) {
+  return proto.Benchmarks.toObject(opt_includeInstance, this);
 };
 
 
@@ -1556,14 +11341,14 @@ proto.Users.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Users} msg The msg instance to transform.
+ * @param {!proto.Benchmarks} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Users.toObject = function(includeInstance, msg) {
+proto.Benchmarks.toObject = function(includeInstance, msg) {
   var f, obj = {
-    usersList: jspb.Message.toObjectList(msg.getUsersList(),
-    proto.User.toObject, includeInstance)
+    benchmarksList: jspb.Message.toObjectList(msg.getBenchmarksList(),
+    proto.GradingBenchmark.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -1577,23 +11362,23 @@ proto.Users.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Users}
+ * @return {!proto.Benchmarks}
  */
-proto.Users.deserializeBinary = function(bytes) {
+proto.Benchmarks.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Users;
-  return proto.Users.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.Benchmarks;
+  return proto.Benchmarks.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Users} msg The message object to deserialize into.
+ * @param {!proto.Benchmarks} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Users}
+ * @return {!proto.Benchmarks}
  */
-proto.Users.deserializeBinaryFromReader = function(msg, reader) {
+proto.Benchmarks.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1601,9 +11386,9 @@ proto.Users.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.User;
-      reader.readMessage(value,proto.User.deserializeBinaryFromReader);
-      msg.addUsers(value);
+      var value = new proto.GradingBenchmark;
+      reader.readMessage(value,proto.GradingBenchmark.deserializeBinaryFromReader);
+      msg.addBenchmarks(value);
       break;
     default:
       reader.skipField();
@@ -1618,9 +11403,9 @@ proto.Users.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Users.prototype.serializeBinary = function() {
+proto.Benchmarks.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Users.serializeBinaryToWriter(this, writer);
+  proto.Benchmarks.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1628,58 +11413,58 @@ proto.Users.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Users} message
+ * @param {!proto.Benchmarks} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Users.serializeBinaryToWriter = function(message, writer) {
+proto.Benchmarks.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUsersList();
+  f = message.getBenchmarksList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.User.serializeBinaryToWriter
+      proto.GradingBenchmark.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated User users = 1;
- * @return {!Array<!proto.User>}
+ * repeated GradingBenchmark benchmarks = 1;
+ * @return {!Array<!proto.GradingBenchmark>}
  */
-proto.Users.prototype.getUsersList = function() {
-  return /** @type{!Array<!proto.User>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.User, 1));
+proto.Benchmarks.prototype.getBenchmarksList = function() {
+  return /** @type{!Array<!proto.GradingBenchmark>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.GradingBenchmark, 1));
 };
 
 
 /**
- * @param {!Array<!proto.User>} value
- * @return {!proto.Users} returns this
+ * @param {!Array<!proto.GradingBenchmark>} value
+ * @return {!proto.Benchmarks} returns this
 */
-proto.Users.prototype.setUsersList = function(value) {
+proto.Benchmarks.prototype.setBenchmarksList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.User=} opt_value
+ * @param {!proto.GradingBenchmark=} opt_value
  * @param {number=} opt_index
- * @return {!proto.User}
+ * @return {!proto.GradingBenchmark}
  */
-proto.Users.prototype.addUsers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.User, opt_index);
+proto.Benchmarks.prototype.addBenchmarks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.GradingBenchmark, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.Users} returns this
+ * @return {!proto.Benchmarks} returns this
  */
-proto.Users.prototype.clearUsersList = function() {
-  return this.setUsersList([]);
+proto.Benchmarks.prototype.clearBenchmarksList = function() {
+  return this.setBenchmarksList([]);
 };
 
 
@@ -1699,8 +11484,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.RemoteIdentity.prototype.toObject = function(opt_includeInstance) {
-  return proto.RemoteIdentity.toObject(opt_includeInstance, this);
+proto.GradingCriterion.prototype.toObject = function(opt_includeInstance) {
+  return proto.GradingCriterion.toObject(opt_includeInstance, this);
 };
 
 
@@ -1709,17 +11494,18 @@ proto.RemoteIdentity.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.RemoteIdentity} msg The msg instance to transform.
+ * @param {!proto.GradingCriterion} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.RemoteIdentity.toObject = function(includeInstance, msg) {
+proto.GradingCriterion.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    provider: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    remoteid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    accesstoken: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    userid: jspb.Message.getFieldWithDefault(msg, 5, 0)
+    points: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    benchmarkid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    description: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    grade: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    comment: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -1733,23 +11519,23 @@ proto.RemoteIdentity.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.RemoteIdentity}
+ * @return {!proto.GradingCriterion}
  */
-proto.RemoteIdentity.deserializeBinary = function(bytes) {
+proto.GradingCriterion.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.RemoteIdentity;
-  return proto.RemoteIdentity.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.GradingCriterion;
+  return proto.GradingCriterion.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.RemoteIdentity} msg The message object to deserialize into.
+ * @param {!proto.GradingCriterion} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.RemoteIdentity}
+ * @return {!proto.GradingCriterion}
  */
-proto.RemoteIdentity.deserializeBinaryFromReader = function(msg, reader) {
+proto.GradingCriterion.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1761,20 +11547,24 @@ proto.RemoteIdentity.deserializeBinaryFromReader = function(msg, reader) {
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setProvider(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setPoints(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setRemoteid(value);
+      msg.setBenchmarkid(value);
       break;
     case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setAccesstoken(value);
+      msg.setDescription(value);
       break;
     case 5:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      var value = /** @type {!proto.GradingCriterion.Grade} */ (reader.readEnum());
+      msg.setGrade(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setComment(value);
       break;
     default:
       reader.skipField();
@@ -1789,9 +11579,9 @@ proto.RemoteIdentity.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.RemoteIdentity.prototype.serializeBinary = function() {
+proto.GradingCriterion.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.RemoteIdentity.serializeBinaryToWriter(this, writer);
+  proto.GradingCriterion.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1799,11 +11589,11 @@ proto.RemoteIdentity.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.RemoteIdentity} message
+ * @param {!proto.GradingCriterion} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.RemoteIdentity.serializeBinaryToWriter = function(message, writer) {
+proto.GradingCriterion.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -1812,124 +11602,158 @@ proto.RemoteIdentity.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getProvider();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getPoints();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getRemoteid();
+  f = message.getBenchmarkid();
   if (f !== 0) {
     writer.writeUint64(
       3,
       f
     );
   }
-  f = message.getAccesstoken();
+  f = message.getDescription();
   if (f.length > 0) {
     writer.writeString(
       4,
       f
     );
   }
-  f = message.getUserid();
-  if (f !== 0) {
-    writer.writeUint64(
-      5,
+  f = message.getGrade();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getComment();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
       f
     );
   }
 };
 
 
+/**
+ * @enum {number}
+ */
+proto.GradingCriterion.Grade = {
+  NONE: 0,
+  FAILED: 1,
+  PASSED: 2
+};
+
 /**
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.RemoteIdentity.prototype.getId = function() {
+proto.GradingCriterion.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.RemoteIdentity} returns this
+ * @return {!proto.GradingCriterion} returns this
  */
-proto.RemoteIdentity.prototype.setId = function(value) {
+proto.GradingCriterion.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string provider = 2;
- * @return {string}
+ * optional uint64 points = 2;
+ * @return {number}
  */
-proto.RemoteIdentity.prototype.getProvider = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.GradingCriterion.prototype.getPoints = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.RemoteIdentity} returns this
+ * @param {number} value
+ * @return {!proto.GradingCriterion} returns this
  */
-proto.RemoteIdentity.prototype.setProvider = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.GradingCriterion.prototype.setPoints = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 remoteID = 3;
+ * optional uint64 benchmarkID = 3;
  * @return {number}
  */
-proto.RemoteIdentity.prototype.getRemoteid = function() {
+proto.GradingCriterion.prototype.getBenchmarkid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.RemoteIdentity} returns this
+ * @return {!proto.GradingCriterion} returns this
  */
-proto.RemoteIdentity.prototype.setRemoteid = function(value) {
+proto.GradingCriterion.prototype.setBenchmarkid = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional string accessToken = 4;
+ * optional string description = 4;
  * @return {string}
  */
-proto.RemoteIdentity.prototype.getAccesstoken = function() {
+proto.GradingCriterion.prototype.getDescription = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.RemoteIdentity} returns this
+ * @return {!proto.GradingCriterion} returns this
  */
-proto.RemoteIdentity.prototype.setAccesstoken = function(value) {
+proto.GradingCriterion.prototype.setDescription = function(value) {
   return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional uint64 userID = 5;
- * @return {number}
+ * optional Grade grade = 5;
+ * @return {!proto.GradingCriterion.Grade}
  */
-proto.RemoteIdentity.prototype.getUserid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.GradingCriterion.prototype.getGrade = function() {
+  return /** @type {!proto.GradingCriterion.Grade} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.RemoteIdentity} returns this
+ * @param {!proto.GradingCriterion.Grade} value
+ * @return {!proto.GradingCriterion} returns this
  */
-proto.RemoteIdentity.prototype.setUserid = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.GradingCriterion.prototype.setGrade = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * optional string comment = 6;
+ * @return {string}
+ */
+proto.GradingCriterion.prototype.getComment = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.GradingCriterion} returns this
+ */
+proto.GradingCriterion.prototype.setComment = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
@@ -1939,7 +11763,7 @@ proto.RemoteIdentity.prototype.setUserid = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.Group.repeatedFields_ = [6,7];
+proto.Review.repeatedFields_ = [8];
 
 
 
@@ -1956,8 +11780,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Group.prototype.toObject = function(opt_includeInstance) {
-  return proto.Group.toObject(opt_includeInstance, this);
+proto.Review.prototype.toObject = function(opt_includeInstance) {
+  return proto.Review.toObject(opt_includeInstance, this);
 };
 
 
@@ -1966,21 +11790,22 @@ proto.Group.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Group} msg The msg instance to transform.
+ * @param {!proto.Review} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Group.toObject = function(includeInstance, msg) {
+proto.Review.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    teamid: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    status: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    usersList: jspb.Message.toObjectList(msg.getUsersList(),
-    proto.User.toObject, includeInstance),
-    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
-    proto.Enrollment.toObject, includeInstance)
+    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    reviewerid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    review: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    feedback: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    ready: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+    score: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    benchmarksList: jspb.Message.toObjectList(msg.getBenchmarksList(),
+    proto.GradingBenchmark.toObject, includeInstance),
+    edited: jspb.Message.getFieldWithDefault(msg, 9, "")
   };
 
   if (includeInstance) {
@@ -1994,23 +11819,23 @@ proto.Group.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Group}
+ * @return {!proto.Review}
  */
-proto.Group.deserializeBinary = function(bytes) {
+proto.Review.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Group;
-  return proto.Group.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.Review;
+  return proto.Review.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Group} msg The message object to deserialize into.
+ * @param {!proto.Review} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Group}
+ * @return {!proto.Review}
  */
-proto.Group.deserializeBinaryFromReader = function(msg, reader) {
+proto.Review.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2022,30 +11847,37 @@ proto.Group.deserializeBinaryFromReader = function(msg, reader) {
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSubmissionid(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      msg.setReviewerid(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setTeamid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReview(value);
       break;
     case 5:
-      var value = /** @type {!proto.Group.GroupStatus} */ (reader.readEnum());
-      msg.setStatus(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFeedback(value);
       break;
     case 6:
-      var value = new proto.User;
-      reader.readMessage(value,proto.User.deserializeBinaryFromReader);
-      msg.addUsers(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReady(value);
       break;
     case 7:
-      var value = new proto.Enrollment;
-      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
-      msg.addEnrollments(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setScore(value);
+      break;
+    case 8:
+      var value = new proto.GradingBenchmark;
+      reader.readMessage(value,proto.GradingBenchmark.deserializeBinaryFromReader);
+      msg.addBenchmarks(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEdited(value);
       break;
     default:
       reader.skipField();
@@ -2060,9 +11892,9 @@ proto.Group.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Group.prototype.serializeBinary = function() {
+proto.Review.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Group.serializeBinaryToWriter(this, writer);
+  proto.Review.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2070,11 +11902,11 @@ proto.Group.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Group} message
+ * @param {!proto.Review} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Group.serializeBinaryToWriter = function(message, writer) {
+proto.Review.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -2083,234 +11915,248 @@ proto.Group.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getSubmissionid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getCourseid();
+  f = message.getReviewerid();
   if (f !== 0) {
     writer.writeUint64(
       3,
       f
     );
   }
-  f = message.getTeamid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getReview();
+  if (f.length > 0) {
+    writer.writeString(
       4,
       f
     );
   }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getFeedback();
+  if (f.length > 0) {
+    writer.writeString(
       5,
       f
     );
   }
-  f = message.getUsersList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getReady();
+  if (f) {
+    writer.writeBool(
       6,
-      f,
-      proto.User.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getEnrollmentsList();
+  f = message.getScore();
+  if (f !== 0) {
+    writer.writeUint64(
+      7,
+      f
+    );
+  }
+  f = message.getBenchmarksList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
-      7,
+      8,
       f,
-      proto.Enrollment.serializeBinaryToWriter
+      proto.GradingBenchmark.serializeBinaryToWriter
+    );
+  }
+  f = message.getEdited();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
     );
   }
 };
 
 
-/**
- * @enum {number}
- */
-proto.Group.GroupStatus = {
-  PENDING: 0,
-  APPROVED: 1
-};
-
 /**
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.Group.prototype.getId = function() {
+proto.Review.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Group} returns this
+ * @return {!proto.Review} returns this
  */
-proto.Group.prototype.setId = function(value) {
+proto.Review.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
- * @return {string}
+ * optional uint64 submissionID = 2;
+ * @return {number}
  */
-proto.Group.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.Review.prototype.getSubmissionid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Group} returns this
+ * @param {number} value
+ * @return {!proto.Review} returns this
  */
-proto.Group.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.Review.prototype.setSubmissionid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 courseID = 3;
+ * optional uint64 reviewerID = 3;
  * @return {number}
  */
-proto.Group.prototype.getCourseid = function() {
+proto.Review.prototype.getReviewerid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Group} returns this
+ * @return {!proto.Review} returns this
  */
-proto.Group.prototype.setCourseid = function(value) {
+proto.Review.prototype.setReviewerid = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional uint64 teamID = 4;
- * @return {number}
+ * optional string review = 4;
+ * @return {string}
  */
-proto.Group.prototype.getTeamid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.Review.prototype.getReview = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Group} returns this
+ * @param {string} value
+ * @return {!proto.Review} returns this
  */
-proto.Group.prototype.setTeamid = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.Review.prototype.setReview = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional GroupStatus status = 5;
- * @return {!proto.Group.GroupStatus}
+ * optional string feedback = 5;
+ * @return {string}
  */
-proto.Group.prototype.getStatus = function() {
-  return /** @type {!proto.Group.GroupStatus} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.Review.prototype.getFeedback = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * @param {!proto.Group.GroupStatus} value
- * @return {!proto.Group} returns this
+ * @param {string} value
+ * @return {!proto.Review} returns this
  */
-proto.Group.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.Review.prototype.setFeedback = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * repeated User users = 6;
- * @return {!Array<!proto.User>}
+ * optional bool ready = 6;
+ * @return {boolean}
  */
-proto.Group.prototype.getUsersList = function() {
-  return /** @type{!Array<!proto.User>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.User, 6));
+proto.Review.prototype.getReady = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
 };
 
 
 /**
- * @param {!Array<!proto.User>} value
- * @return {!proto.Group} returns this
-*/
-proto.Group.prototype.setUsersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+ * @param {boolean} value
+ * @return {!proto.Review} returns this
+ */
+proto.Review.prototype.setReady = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
 };
 
 
 /**
- * @param {!proto.User=} opt_value
- * @param {number=} opt_index
- * @return {!proto.User}
+ * optional uint64 score = 7;
+ * @return {number}
  */
-proto.Group.prototype.addUsers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.User, opt_index);
+proto.Review.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Group} returns this
+ * @param {number} value
+ * @return {!proto.Review} returns this
  */
-proto.Group.prototype.clearUsersList = function() {
-  return this.setUsersList([]);
+proto.Review.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
 /**
- * repeated Enrollment enrollments = 7;
- * @return {!Array<!proto.Enrollment>}
+ * repeated GradingBenchmark benchmarks = 8;
+ * @return {!Array<!proto.GradingBenchmark>}
  */
-proto.Group.prototype.getEnrollmentsList = function() {
-  return /** @type{!Array<!proto.Enrollment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Enrollment, 7));
+proto.Review.prototype.getBenchmarksList = function() {
+  return /** @type{!Array<!proto.GradingBenchmark>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.GradingBenchmark, 8));
 };
 
 
 /**
- * @param {!Array<!proto.Enrollment>} value
- * @return {!proto.Group} returns this
+ * @param {!Array<!proto.GradingBenchmark>} value
+ * @return {!proto.Review} returns this
 */
-proto.Group.prototype.setEnrollmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+proto.Review.prototype.setBenchmarksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
 };
 
 
 /**
- * @param {!proto.Enrollment=} opt_value
+ * @param {!proto.GradingBenchmark=} opt_value
  * @param {number=} opt_index
- * @return {!proto.Enrollment}
+ * @return {!proto.GradingBenchmark}
  */
-proto.Group.prototype.addEnrollments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.Enrollment, opt_index);
+proto.Review.prototype.addBenchmarks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.GradingBenchmark, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.Group} returns this
+ * @return {!proto.Review} returns this
  */
-proto.Group.prototype.clearEnrollmentsList = function() {
-  return this.setEnrollmentsList([]);
+proto.Review.prototype.clearBenchmarksList = function() {
+  return this.setBenchmarksList([]);
 };
 
 
+/**
+ * optional string edited = 9;
+ * @return {string}
+ */
+proto.Review.prototype.getEdited = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {string} value
+ * @return {!proto.Review} returns this
  */
-proto.Groups.repeatedFields_ = [1];
+proto.Review.prototype.setEdited = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
 
 
 
@@ -2327,8 +12173,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Groups.prototype.toObject = function(opt_includeInstance) {
-  return proto.Groups.toObject(opt_includeInstance, this);
+proto.SubmissionComment.prototype.toObject = function(opt_includeInstance) {
+  return proto.SubmissionComment.toObject(opt_includeInstance, this);
 };
 
 
@@ -2337,14 +12183,17 @@ proto.Groups.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Groups} msg The msg instance to transform.
+ * @param {!proto.SubmissionComment} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Groups.toObject = function(includeInstance, msg) {
+proto.SubmissionComment.toObject = function(includeInstance, msg) {
   var f, obj = {
-    groupsList: jspb.Message.toObjectList(msg.getGroupsList(),
-    proto.Group.toObject, includeInstance)
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    text: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    date: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -2358,23 +12207,23 @@ proto.Groups.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Groups}
+ * @return {!proto.SubmissionComment}
  */
-proto.Groups.deserializeBinary = function(bytes) {
+proto.SubmissionComment.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Groups;
-  return proto.Groups.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.SubmissionComment;
+  return proto.SubmissionComment.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Groups} msg The message object to deserialize into.
+ * @param {!proto.SubmissionComment} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Groups}
+ * @return {!proto.SubmissionComment}
  */
-proto.Groups.deserializeBinaryFromReader = function(msg, reader) {
+proto.SubmissionComment.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2382,9 +12231,24 @@ proto.Groups.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.Group;
-      reader.readMessage(value,proto.Group.deserializeBinaryFromReader);
-      msg.addGroups(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSubmissionid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setText(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDate(value);
       break;
     default:
       reader.skipField();
@@ -2399,9 +12263,9 @@ proto.Groups.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Groups.prototype.serializeBinary = function() {
+proto.SubmissionComment.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Groups.serializeBinaryToWriter(this, writer);
+  proto.SubmissionComment.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2409,58 +12273,137 @@ proto.Groups.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Groups} message
+ * @param {!proto.SubmissionComment} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Groups.serializeBinaryToWriter = function(message, writer) {
+proto.SubmissionComment.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getGroupsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.Group.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getSubmissionid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getText();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getDate();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
     );
   }
 };
 
 
 /**
- * repeated Group groups = 1;
- * @return {!Array<!proto.Group>}
+ * optional uint64 ID = 1;
+ * @return {number}
  */
-proto.Groups.prototype.getGroupsList = function() {
-  return /** @type{!Array<!proto.Group>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Group, 1));
+proto.SubmissionComment.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!Array<!proto.Group>} value
- * @return {!proto.Groups} returns this
-*/
-proto.Groups.prototype.setGroupsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.SubmissionComment} returns this
+ */
+proto.SubmissionComment.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 submissionID = 2;
+ * @return {number}
+ */
+proto.SubmissionComment.prototype.getSubmissionid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.SubmissionComment} returns this
+ */
+proto.SubmissionComment.prototype.setSubmissionid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 userID = 3;
+ * @return {number}
+ */
+proto.SubmissionComment.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.SubmissionComment} returns this
+ */
+proto.SubmissionComment.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string text = 4;
+ * @return {string}
+ */
+proto.SubmissionComment.prototype.getText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.SubmissionComment} returns this
+ */
+proto.SubmissionComment.prototype.setText = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * @param {!proto.Group=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Group}
+ * optional string date = 5;
+ * @return {string}
  */
-proto.Groups.prototype.addGroups = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Group, opt_index);
+proto.SubmissionComment.prototype.getDate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Groups} returns this
+ * @param {string} value
+ * @return {!proto.SubmissionComment} returns this
  */
-proto.Groups.prototype.clearGroupsList = function() {
-  return this.setGroupsList([]);
+proto.SubmissionComment.prototype.setDate = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
@@ -2470,7 +12413,7 @@ proto.Groups.prototype.clearGroupsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.Course.repeatedFields_ = [12,13,14];
+proto.SubmissionComments.repeatedFields_ = [1];
 
 
 
@@ -2487,8 +12430,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Course.prototype.toObject = function(opt_includeInstance) {
-  return proto.Course.toObject(opt_includeInstance, this);
+proto.SubmissionComments.prototype.toObject = function(opt_includeInstance) {
+  return proto.SubmissionComments.toObject(opt_includeInstance, this);
 };
 
 
@@ -2497,29 +12440,14 @@ proto.Course.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Course} msg The msg instance to transform.
+ * @param {!proto.SubmissionComments} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Course.toObject = function(includeInstance, msg) {
+proto.SubmissionComments.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    coursecreatorid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    code: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    year: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    tag: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    provider: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    organizationid: jspb.Message.getFieldWithDefault(msg, 8, 0),
-    organizationpath: jspb.Message.getFieldWithDefault(msg, 9, ""),
-    slipdays: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    enrolled: jspb.Message.getFieldWithDefault(msg, 11, 0),
-    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
-    proto.Enrollment.toObject, includeInstance),
-    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
-    proto.Assignment.toObject, includeInstance),
-    groupsList: jspb.Message.toObjectList(msg.getGroupsList(),
-    proto.Group.toObject, includeInstance)
+    commentsList: jspb.Message.toObjectList(msg.getCommentsList(),
+    proto.SubmissionComment.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -2533,23 +12461,23 @@ proto.Course.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Course}
+ * @return {!proto.SubmissionComments}
  */
-proto.Course.deserializeBinary = function(bytes) {
+proto.SubmissionComments.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Course;
-  return proto.Course.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.SubmissionComments;
+  return proto.SubmissionComments.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Course} msg The message object to deserialize into.
+ * @param {!proto.SubmissionComments} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Course}
+ * @return {!proto.SubmissionComments}
  */
-proto.Course.deserializeBinaryFromReader = function(msg, reader) {
+proto.SubmissionComments.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2557,63 +12485,9 @@ proto.Course.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCoursecreatorid(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCode(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setYear(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTag(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setProvider(value);
-      break;
-    case 8:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setOrganizationid(value);
-      break;
-    case 9:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setOrganizationpath(value);
-      break;
-    case 10:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setSlipdays(value);
-      break;
-    case 11:
-      var value = /** @type {!proto.Enrollment.UserStatus} */ (reader.readEnum());
-      msg.setEnrolled(value);
-      break;
-    case 12:
-      var value = new proto.Enrollment;
-      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
-      msg.addEnrollments(value);
-      break;
-    case 13:
-      var value = new proto.Assignment;
-      reader.readMessage(value,proto.Assignment.deserializeBinaryFromReader);
-      msg.addAssignments(value);
-      break;
-    case 14:
-      var value = new proto.Group;
-      reader.readMessage(value,proto.Group.deserializeBinaryFromReader);
-      msg.addGroups(value);
+      var value = new proto.SubmissionComment;
+      reader.readMessage(value,proto.SubmissionComment.deserializeBinaryFromReader);
+      msg.addComments(value);
       break;
     default:
       reader.skipField();
@@ -2628,9 +12502,9 @@ proto.Course.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Course.prototype.serializeBinary = function() {
+proto.SubmissionComments.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Course.serializeBinaryToWriter(this, writer);
+  proto.SubmissionComments.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2638,425 +12512,408 @@ proto.Course.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Course} message
+ * @param {!proto.SubmissionComments} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Course.serializeBinaryToWriter = function(message, writer) {
+proto.SubmissionComments.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f !== 0) {
-    writer.writeUint64(
-      1,
-      f
-    );
-  }
-  f = message.getCoursecreatorid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getCode();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getYear();
-  if (f !== 0) {
-    writer.writeUint32(
-      5,
-      f
-    );
-  }
-  f = message.getTag();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getProvider();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
-  f = message.getOrganizationid();
-  if (f !== 0) {
-    writer.writeUint64(
-      8,
-      f
-    );
-  }
-  f = message.getOrganizationpath();
-  if (f.length > 0) {
-    writer.writeString(
-      9,
-      f
-    );
-  }
-  f = message.getSlipdays();
-  if (f !== 0) {
-    writer.writeUint32(
-      10,
-      f
-    );
-  }
-  f = message.getEnrolled();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      11,
-      f
-    );
-  }
-  f = message.getEnrollmentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      12,
-      f,
-      proto.Enrollment.serializeBinaryToWriter
-    );
-  }
-  f = message.getAssignmentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      13,
-      f,
-      proto.Assignment.serializeBinaryToWriter
-    );
-  }
-  f = message.getGroupsList();
+  f = message.getCommentsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
-      14,
-      f,
-      proto.Group.serializeBinaryToWriter
-    );
-  }
-};
-
-
-/**
- * optional uint64 ID = 1;
- * @return {number}
- */
-proto.Course.prototype.getId = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.Course} returns this
- */
-proto.Course.prototype.setId = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
-
-
-/**
- * optional uint64 courseCreatorID = 2;
- * @return {number}
- */
-proto.Course.prototype.getCoursecreatorid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.Course} returns this
- */
-proto.Course.prototype.setCoursecreatorid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional string name = 3;
- * @return {string}
- */
-proto.Course.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.Course} returns this
- */
-proto.Course.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+      1,
+      f,
+      proto.SubmissionComment.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string code = 4;
- * @return {string}
+ * repeated SubmissionComment comments = 1;
+ * @return {!Array<!proto.SubmissionComment>}
  */
-proto.Course.prototype.getCode = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.SubmissionComments.prototype.getCommentsList = function() {
+  return /** @type{!Array<!proto.SubmissionComment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.SubmissionComment, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Course} returns this
- */
-proto.Course.prototype.setCode = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+ * @param {!Array<!proto.SubmissionComment>} value
+ * @return {!proto.SubmissionComments} returns this
+*/
+proto.SubmissionComments.prototype.setCommentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional uint32 year = 5;
- * @return {number}
+ * @param {!proto.SubmissionComment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.SubmissionComment}
  */
-proto.Course.prototype.getYear = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.SubmissionComments.prototype.addComments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.SubmissionComment, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Course} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.SubmissionComments} returns this
  */
-proto.Course.prototype.setYear = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.SubmissionComments.prototype.clearCommentsList = function() {
+  return this.setCommentsList([]);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string tag = 6;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.Course.prototype.getTag = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.SubmissionSimilarity.prototype.toObject = function(opt_includeInstance) {
+  return proto.SubmissionSimilarity.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Course} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.SubmissionSimilarity} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Course.prototype.setTag = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.SubmissionSimilarity.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    submissionid1: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    submissionid2: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    similarity: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string provider = 7;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.SubmissionSimilarity}
  */
-proto.Course.prototype.getProvider = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.SubmissionSimilarity.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.SubmissionSimilarity;
+  return proto.SubmissionSimilarity.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Course} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.SubmissionSimilarity} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.SubmissionSimilarity}
  */
-proto.Course.prototype.setProvider = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.SubmissionSimilarity.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSubmissionid1(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSubmissionid2(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setSimilarity(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional uint64 organizationID = 8;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.Course.prototype.getOrganizationid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.SubmissionSimilarity.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.SubmissionSimilarity.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Course} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.SubmissionSimilarity} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Course.prototype.setOrganizationid = function(value) {
-  return jspb.Message.setProto3IntField(this, 8, value);
+proto.SubmissionSimilarity.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSubmissionid1();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getSubmissionid2();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getSimilarity();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string organizationPath = 9;
- * @return {string}
+ * optional uint64 submissionID1 = 1;
+ * @return {number}
  */
-proto.Course.prototype.getOrganizationpath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.SubmissionSimilarity.prototype.getSubmissionid1 = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Course} returns this
+ * @param {number} value
+ * @return {!proto.SubmissionSimilarity} returns this
  */
-proto.Course.prototype.setOrganizationpath = function(value) {
-  return jspb.Message.setProto3StringField(this, 9, value);
+proto.SubmissionSimilarity.prototype.setSubmissionid1 = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint32 slipDays = 10;
+ * optional uint64 submissionID2 = 2;
  * @return {number}
  */
-proto.Course.prototype.getSlipdays = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.SubmissionSimilarity.prototype.getSubmissionid2 = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Course} returns this
+ * @return {!proto.SubmissionSimilarity} returns this
  */
-proto.Course.prototype.setSlipdays = function(value) {
-  return jspb.Message.setProto3IntField(this, 10, value);
+proto.SubmissionSimilarity.prototype.setSubmissionid2 = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional Enrollment.UserStatus enrolled = 11;
- * @return {!proto.Enrollment.UserStatus}
+ * optional double similarity = 3;
+ * @return {number}
  */
-proto.Course.prototype.getEnrolled = function() {
-  return /** @type {!proto.Enrollment.UserStatus} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+proto.SubmissionSimilarity.prototype.getSimilarity = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
 };
 
 
 /**
- * @param {!proto.Enrollment.UserStatus} value
- * @return {!proto.Course} returns this
+ * @param {number} value
+ * @return {!proto.SubmissionSimilarity} returns this
  */
-proto.Course.prototype.setEnrolled = function(value) {
-  return jspb.Message.setProto3EnumField(this, 11, value);
+proto.SubmissionSimilarity.prototype.setSimilarity = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
 };
 
 
+
 /**
- * repeated Enrollment enrollments = 12;
- * @return {!Array<!proto.Enrollment>}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.Course.prototype.getEnrollmentsList = function() {
-  return /** @type{!Array<!proto.Enrollment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Enrollment, 12));
-};
-
+proto.SubmissionSimilarities.repeatedFields_ = [1];
 
-/**
- * @param {!Array<!proto.Enrollment>} value
- * @return {!proto.Course} returns this
-*/
-proto.Course.prototype.setEnrollmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 12, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {!proto.Enrollment=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Enrollment}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.Course.prototype.addEnrollments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.Enrollment, opt_index);
+proto.SubmissionSimilarities.prototype.toObject = function(opt_includeInstance) {
+  return proto.SubmissionSimilarities.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Course} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.SubmissionSimilarities} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Course.prototype.clearEnrollmentsList = function() {
-  return this.setEnrollmentsList([]);
+proto.SubmissionSimilarities.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    similaritiesList: jspb.Message.toObjectList(msg.getSimilaritiesList(),
+    proto.SubmissionSimilarity.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * repeated Assignment assignments = 13;
- * @return {!Array<!proto.Assignment>}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.SubmissionSimilarities}
  */
-proto.Course.prototype.getAssignmentsList = function() {
-  return /** @type{!Array<!proto.Assignment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Assignment, 13));
+proto.SubmissionSimilarities.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.SubmissionSimilarities;
+  return proto.SubmissionSimilarities.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!Array<!proto.Assignment>} value
- * @return {!proto.Course} returns this
-*/
-proto.Course.prototype.setAssignmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 13, value);
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.SubmissionSimilarities} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.SubmissionSimilarities}
+ */
+proto.SubmissionSimilarities.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.SubmissionSimilarity;
+      reader.readMessage(value,proto.SubmissionSimilarity.deserializeBinaryFromReader);
+      msg.addSimilarities(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {!proto.Assignment=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Assignment}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.Course.prototype.addAssignments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 13, opt_value, proto.Assignment, opt_index);
+proto.SubmissionSimilarities.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.SubmissionSimilarities.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Course} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.SubmissionSimilarities} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Course.prototype.clearAssignmentsList = function() {
-  return this.setAssignmentsList([]);
+proto.SubmissionSimilarities.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSimilaritiesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.SubmissionSimilarity.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * repeated Group groups = 14;
- * @return {!Array<!proto.Group>}
+ * repeated SubmissionSimilarity similarities = 1;
+ * @return {!Array<!proto.SubmissionSimilarity>}
  */
-proto.Course.prototype.getGroupsList = function() {
-  return /** @type{!Array<!proto.Group>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Group, 14));
+proto.SubmissionSimilarities.prototype.getSimilaritiesList = function() {
+  return /** @type{!Array<!proto.SubmissionSimilarity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.SubmissionSimilarity, 1));
 };
 
 
 /**
- * @param {!Array<!proto.Group>} value
- * @return {!proto.Course} returns this
+ * @param {!Array<!proto.SubmissionSimilarity>} value
+ * @return {!proto.SubmissionSimilarities} returns this
 */
-proto.Course.prototype.setGroupsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+proto.SubmissionSimilarities.prototype.setSimilaritiesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.Group=} opt_value
+ * @param {!proto.SubmissionSimilarity=} opt_value
  * @param {number=} opt_index
- * @return {!proto.Group}
+ * @return {!proto.SubmissionSimilarity}
  */
-proto.Course.prototype.addGroups = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.Group, opt_index);
+proto.SubmissionSimilarities.prototype.addSimilarities = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.SubmissionSimilarity, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.Course} returns this
+ * @return {!proto.SubmissionSimilarities} returns this
  */
-proto.Course.prototype.clearGroupsList = function() {
-  return this.setGroupsList([]);
+proto.SubmissionSimilarities.prototype.clearSimilaritiesList = function() {
+  return this.setSimilaritiesList([]);
 };
 
 
@@ -3066,7 +12923,7 @@ proto.Course.prototype.clearGroupsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.Courses.repeatedFields_ = [1];
+proto.Reviewers.repeatedFields_ = [1];
 
 
 
@@ -3083,8 +12940,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Courses.prototype.toObject = function(opt_includeInstance) {
-  return proto.Courses.toObject(opt_includeInstance, this);
+proto.Reviewers.prototype.toObject = function(opt_includeInstance) {
+  return proto.Reviewers.toObject(opt_includeInstance, this);
 };
 
 
@@ -3093,14 +12950,14 @@ proto.Courses.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Courses} msg The msg instance to transform.
+ * @param {!proto.Reviewers} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Courses.toObject = function(includeInstance, msg) {
+proto.Reviewers.toObject = function(includeInstance, msg) {
   var f, obj = {
-    coursesList: jspb.Message.toObjectList(msg.getCoursesList(),
-    proto.Course.toObject, includeInstance)
+    reviewersList: jspb.Message.toObjectList(msg.getReviewersList(),
+    proto.User.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3114,23 +12971,23 @@ proto.Courses.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Courses}
+ * @return {!proto.Reviewers}
  */
-proto.Courses.deserializeBinary = function(bytes) {
+proto.Reviewers.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Courses;
-  return proto.Courses.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.Reviewers;
+  return proto.Reviewers.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Courses} msg The message object to deserialize into.
+ * @param {!proto.Reviewers} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Courses}
+ * @return {!proto.Reviewers}
  */
-proto.Courses.deserializeBinaryFromReader = function(msg, reader) {
+proto.Reviewers.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3138,9 +12995,9 @@ proto.Courses.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.Course;
-      reader.readMessage(value,proto.Course.deserializeBinaryFromReader);
-      msg.addCourses(value);
+      var value = new proto.User;
+      reader.readMessage(value,proto.User.deserializeBinaryFromReader);
+      msg.addReviewers(value);
       break;
     default:
       reader.skipField();
@@ -3155,9 +13012,9 @@ proto.Courses.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Courses.prototype.serializeBinary = function() {
+proto.Reviewers.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Courses.serializeBinaryToWriter(this, writer);
+  proto.Reviewers.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3165,58 +13022,58 @@ proto.Courses.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Courses} message
+ * @param {!proto.Reviewers} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Courses.serializeBinaryToWriter = function(message, writer) {
+proto.Reviewers.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCoursesList();
+  f = message.getReviewersList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.Course.serializeBinaryToWriter
+      proto.User.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated Course courses = 1;
- * @return {!Array<!proto.Course>}
+ * repeated User reviewers = 1;
+ * @return {!Array<!proto.User>}
  */
-proto.Courses.prototype.getCoursesList = function() {
-  return /** @type{!Array<!proto.Course>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Course, 1));
+proto.Reviewers.prototype.getReviewersList = function() {
+  return /** @type{!Array<!proto.User>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.User, 1));
 };
 
 
 /**
- * @param {!Array<!proto.Course>} value
- * @return {!proto.Courses} returns this
+ * @param {!Array<!proto.User>} value
+ * @return {!proto.Reviewers} returns this
 */
-proto.Courses.prototype.setCoursesList = function(value) {
+proto.Reviewers.prototype.setReviewersList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.Course=} opt_value
+ * @param {!proto.User=} opt_value
  * @param {number=} opt_index
- * @return {!proto.Course}
+ * @return {!proto.User}
  */
-proto.Courses.prototype.addCourses = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Course, opt_index);
+proto.Reviewers.prototype.addReviewers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.User, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.Courses} returns this
+ * @return {!proto.Reviewers} returns this
  */
-proto.Courses.prototype.clearCoursesList = function() {
-  return this.setCoursesList([]);
+proto.Reviewers.prototype.clearReviewersList = function() {
+  return this.setReviewersList([]);
 };
 
 
@@ -3236,8 +13093,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Repository.prototype.toObject = function(opt_includeInstance) {
-  return proto.Repository.toObject(opt_includeInstance, this);
+proto.GraderAssignment.prototype.toObject = function(opt_includeInstance) {
+  return proto.GraderAssignment.toObject(opt_includeInstance, this);
 };
 
 
@@ -3246,19 +13103,16 @@ proto.Repository.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Repository} msg The msg instance to transform.
+ * @param {!proto.GraderAssignment} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Repository.toObject = function(includeInstance, msg) {
+proto.GraderAssignment.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    organizationid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    repositoryid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    userid: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    htmlurl: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    repotype: jspb.Message.getFieldWithDefault(msg, 7, 0)
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    graderid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    studentid: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -3272,23 +13126,23 @@ proto.Repository.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Repository}
+ * @return {!proto.GraderAssignment}
  */
-proto.Repository.deserializeBinary = function(bytes) {
+proto.GraderAssignment.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Repository;
-  return proto.Repository.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.GraderAssignment;
+  return proto.GraderAssignment.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Repository} msg The message object to deserialize into.
+ * @param {!proto.GraderAssignment} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Repository}
+ * @return {!proto.GraderAssignment}
  */
-proto.Repository.deserializeBinaryFromReader = function(msg, reader) {
+proto.GraderAssignment.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3301,27 +13155,15 @@ proto.Repository.deserializeBinaryFromReader = function(msg, reader) {
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setOrganizationid(value);
+      msg.setCourseid(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setRepositoryid(value);
+      msg.setGraderid(value);
       break;
     case 4:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setHtmlurl(value);
-      break;
-    case 7:
-      var value = /** @type {!proto.Repository.Type} */ (reader.readEnum());
-      msg.setRepotype(value);
+      msg.setStudentid(value);
       break;
     default:
       reader.skipField();
@@ -3336,9 +13178,9 @@ proto.Repository.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Repository.prototype.serializeBinary = function() {
+proto.GraderAssignment.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Repository.serializeBinaryToWriter(this, writer);
+  proto.GraderAssignment.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3346,11 +13188,11 @@ proto.Repository.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Repository} message
+ * @param {!proto.GraderAssignment} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Repository.serializeBinaryToWriter = function(message, writer) {
+proto.GraderAssignment.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -3359,196 +13201,102 @@ proto.Repository.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getOrganizationid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getRepositoryid();
+  f = message.getGraderid();
   if (f !== 0) {
     writer.writeUint64(
       3,
       f
     );
   }
-  f = message.getUserid();
+  f = message.getStudentid();
   if (f !== 0) {
     writer.writeUint64(
       4,
       f
     );
   }
-  f = message.getGroupid();
-  if (f !== 0) {
-    writer.writeUint64(
-      5,
-      f
-    );
-  }
-  f = message.getHtmlurl();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getRepotype();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      7,
-      f
-    );
-  }
 };
 
 
-/**
- * @enum {number}
- */
-proto.Repository.Type = {
-  NONE: 0,
-  COURSEINFO: 1,
-  ASSIGNMENTS: 2,
-  TESTS: 3,
-  USER: 4,
-  GROUP: 5
-};
-
 /**
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.Repository.prototype.getId = function() {
+proto.GraderAssignment.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Repository} returns this
+ * @return {!proto.GraderAssignment} returns this
  */
-proto.Repository.prototype.setId = function(value) {
+proto.GraderAssignment.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 organizationID = 2;
+ * optional uint64 courseID = 2;
  * @return {number}
  */
-proto.Repository.prototype.getOrganizationid = function() {
+proto.GraderAssignment.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Repository} returns this
+ * @return {!proto.GraderAssignment} returns this
  */
-proto.Repository.prototype.setOrganizationid = function(value) {
+proto.GraderAssignment.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 repositoryID = 3;
+ * optional uint64 graderID = 3;
  * @return {number}
  */
-proto.Repository.prototype.getRepositoryid = function() {
+proto.GraderAssignment.prototype.getGraderid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Repository} returns this
+ * @return {!proto.GraderAssignment} returns this
  */
-proto.Repository.prototype.setRepositoryid = function(value) {
+proto.GraderAssignment.prototype.setGraderid = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional uint64 userID = 4;
+ * optional uint64 studentID = 4;
  * @return {number}
  */
-proto.Repository.prototype.getUserid = function() {
+proto.GraderAssignment.prototype.getStudentid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Repository} returns this
+ * @return {!proto.GraderAssignment} returns this
  */
-proto.Repository.prototype.setUserid = function(value) {
+proto.GraderAssignment.prototype.setStudentid = function(value) {
   return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
-/**
- * optional uint64 groupID = 5;
- * @return {number}
- */
-proto.Repository.prototype.getGroupid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.Repository} returns this
- */
-proto.Repository.prototype.setGroupid = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
-};
-
-
-/**
- * optional string HTMLURL = 6;
- * @return {string}
- */
-proto.Repository.prototype.getHtmlurl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.Repository} returns this
- */
-proto.Repository.prototype.setHtmlurl = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
-};
-
-
-/**
- * optional Type repoType = 7;
- * @return {!proto.Repository.Type}
- */
-proto.Repository.prototype.getRepotype = function() {
-  return /** @type {!proto.Repository.Type} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
-};
-
-
-/**
- * @param {!proto.Repository.Type} value
- * @return {!proto.Repository} returns this
- */
-proto.Repository.prototype.setRepotype = function(value) {
-  return jspb.Message.setProto3EnumField(this, 7, value);
-};
-
-
-
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.Enrollment.repeatedFields_ = [14];
 
 
 
@@ -3565,8 +13313,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Enrollment.prototype.toObject = function(opt_includeInstance) {
-  return proto.Enrollment.toObject(opt_includeInstance, this);
+proto.SCMAuditEntry.prototype.toObject = function(opt_includeInstance) {
+  return proto.SCMAuditEntry.toObject(opt_includeInstance, this);
 };
 
 
@@ -3575,27 +13323,20 @@ proto.Enrollment.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Enrollment} msg The msg instance to transform.
+ * @param {!proto.SCMAuditEntry} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Enrollment.toObject = function(includeInstance, msg) {
+proto.SCMAuditEntry.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
     courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    hasteacherscopes: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
-    user: (f = msg.getUser()) && proto.User.toObject(includeInstance, f),
-    course: (f = msg.getCourse()) && proto.Course.toObject(includeInstance, f),
-    group: (f = msg.getGroup()) && proto.Group.toObject(includeInstance, f),
-    status: jspb.Message.getFieldWithDefault(msg, 9, 0),
-    state: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    slipdaysremaining: jspb.Message.getFieldWithDefault(msg, 11, 0),
-    lastactivitydate: jspb.Message.getFieldWithDefault(msg, 12, ""),
-    totalapproved: jspb.Message.getFieldWithDefault(msg, 13, 0),
-    usedslipdaysList: jspb.Message.toObjectList(msg.getUsedslipdaysList(),
-    proto.UsedSlipDays.toObject, includeInstance)
+    method: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    organization: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    user: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    resource: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    error: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    date: jspb.Message.getFieldWithDefault(msg, 8, "")
   };
 
   if (includeInstance) {
@@ -3609,23 +13350,23 @@ proto.Enrollment.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Enrollment}
+ * @return {!proto.SCMAuditEntry}
  */
-proto.Enrollment.deserializeBinary = function(bytes) {
+proto.SCMAuditEntry.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Enrollment;
-  return proto.Enrollment.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.SCMAuditEntry;
+  return proto.SCMAuditEntry.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Enrollment} msg The message object to deserialize into.
+ * @param {!proto.SCMAuditEntry} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Enrollment}
+ * @return {!proto.SCMAuditEntry}
  */
-proto.Enrollment.deserializeBinaryFromReader = function(msg, reader) {
+proto.SCMAuditEntry.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3641,56 +13382,28 @@ proto.Enrollment.deserializeBinaryFromReader = function(msg, reader) {
       msg.setCourseid(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMethod(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOrganization(value);
       break;
     case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setHasteacherscopes(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUser(value);
       break;
     case 6:
-      var value = new proto.User;
-      reader.readMessage(value,proto.User.deserializeBinaryFromReader);
-      msg.setUser(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setResource(value);
       break;
     case 7:
-      var value = new proto.Course;
-      reader.readMessage(value,proto.Course.deserializeBinaryFromReader);
-      msg.setCourse(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setError(value);
       break;
     case 8:
-      var value = new proto.Group;
-      reader.readMessage(value,proto.Group.deserializeBinaryFromReader);
-      msg.setGroup(value);
-      break;
-    case 9:
-      var value = /** @type {!proto.Enrollment.UserStatus} */ (reader.readEnum());
-      msg.setStatus(value);
-      break;
-    case 10:
-      var value = /** @type {!proto.Enrollment.DisplayState} */ (reader.readEnum());
-      msg.setState(value);
-      break;
-    case 11:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setSlipdaysremaining(value);
-      break;
-    case 12:
       var value = /** @type {string} */ (reader.readString());
-      msg.setLastactivitydate(value);
-      break;
-    case 13:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setTotalapproved(value);
-      break;
-    case 14:
-      var value = new proto.UsedSlipDays;
-      reader.readMessage(value,proto.UsedSlipDays.deserializeBinaryFromReader);
-      msg.addUsedslipdays(value);
+      msg.setDate(value);
       break;
     default:
       reader.skipField();
@@ -3705,9 +13418,9 @@ proto.Enrollment.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Enrollment.prototype.serializeBinary = function() {
+proto.SCMAuditEntry.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Enrollment.serializeBinaryToWriter(this, writer);
+  proto.SCMAuditEntry.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3715,11 +13428,11 @@ proto.Enrollment.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Enrollment} message
+ * @param {!proto.SCMAuditEntry} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Enrollment.serializeBinaryToWriter = function(message, writer) {
+proto.SCMAuditEntry.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -3735,306 +13448,524 @@ proto.Enrollment.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getUserid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getMethod();
+  if (f.length > 0) {
+    writer.writeString(
       3,
       f
     );
   }
-  f = message.getGroupid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getOrganization();
+  if (f.length > 0) {
+    writer.writeString(
       4,
       f
     );
   }
-  f = message.getHasteacherscopes();
-  if (f) {
-    writer.writeBool(
+  f = message.getUser();
+  if (f.length > 0) {
+    writer.writeString(
       5,
       f
     );
   }
-  f = message.getUser();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getResource();
+  if (f.length > 0) {
+    writer.writeString(
       6,
-      f,
-      proto.User.serializeBinaryToWriter
-    );
-  }
-  f = message.getCourse();
-  if (f != null) {
-    writer.writeMessage(
-      7,
-      f,
-      proto.Course.serializeBinaryToWriter
-    );
-  }
-  f = message.getGroup();
-  if (f != null) {
-    writer.writeMessage(
-      8,
-      f,
-      proto.Group.serializeBinaryToWriter
-    );
-  }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      9,
-      f
-    );
-  }
-  f = message.getState();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      10,
-      f
-    );
-  }
-  f = message.getSlipdaysremaining();
-  if (f !== 0) {
-    writer.writeUint32(
-      11,
       f
     );
   }
-  f = message.getLastactivitydate();
+  f = message.getError();
   if (f.length > 0) {
     writer.writeString(
-      12,
-      f
-    );
-  }
-  f = message.getTotalapproved();
-  if (f !== 0) {
-    writer.writeUint64(
-      13,
+      7,
       f
     );
   }
-  f = message.getUsedslipdaysList();
+  f = message.getDate();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      14,
-      f,
-      proto.UsedSlipDays.serializeBinaryToWriter
+    writer.writeString(
+      8,
+      f
     );
   }
 };
 
 
 /**
- * @enum {number}
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.SCMAuditEntry.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.SCMAuditEntry} returns this
+ */
+proto.SCMAuditEntry.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 courseID = 2;
+ * @return {number}
+ */
+proto.SCMAuditEntry.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.SCMAuditEntry} returns this
+ */
+proto.SCMAuditEntry.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string method = 3;
+ * @return {string}
+ */
+proto.SCMAuditEntry.prototype.getMethod = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.SCMAuditEntry} returns this
+ */
+proto.SCMAuditEntry.prototype.setMethod = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string organization = 4;
+ * @return {string}
+ */
+proto.SCMAuditEntry.prototype.getOrganization = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.SCMAuditEntry} returns this
+ */
+proto.SCMAuditEntry.prototype.setOrganization = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string user = 5;
+ * @return {string}
+ */
+proto.SCMAuditEntry.prototype.getUser = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.SCMAuditEntry} returns this
+ */
+proto.SCMAuditEntry.prototype.setUser = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string resource = 6;
+ * @return {string}
+ */
+proto.SCMAuditEntry.prototype.getResource = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.SCMAuditEntry} returns this
+ */
+proto.SCMAuditEntry.prototype.setResource = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string error = 7;
+ * @return {string}
+ */
+proto.SCMAuditEntry.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.SCMAuditEntry} returns this
+ */
+proto.SCMAuditEntry.prototype.setError = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional string date = 8;
+ * @return {string}
+ */
+proto.SCMAuditEntry.prototype.getDate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.SCMAuditEntry} returns this
  */
-proto.Enrollment.UserStatus = {
-  NONE: 0,
-  PENDING: 1,
-  STUDENT: 2,
-  TEACHER: 3
+proto.SCMAuditEntry.prototype.setDate = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
 };
 
+
+
 /**
- * @enum {number}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.Enrollment.DisplayState = {
-  UNSET: 0,
-  HIDDEN: 1,
-  VISIBLE: 2,
-  FAVORITE: 3
-};
+proto.SCMAuditLog.repeatedFields_ = [1];
+
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional uint64 ID = 1;
- * @return {number}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.Enrollment.prototype.getId = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.SCMAuditLog.prototype.toObject = function(opt_includeInstance) {
+  return proto.SCMAuditLog.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Enrollment} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.SCMAuditLog} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Enrollment.prototype.setId = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.SCMAuditLog.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    entriesList: jspb.Message.toObjectList(msg.getEntriesList(),
+    proto.SCMAuditEntry.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional uint64 courseID = 2;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.SCMAuditLog}
  */
-proto.Enrollment.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.SCMAuditLog.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.SCMAuditLog;
+  return proto.SCMAuditLog.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Enrollment} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.SCMAuditLog} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.SCMAuditLog}
  */
-proto.Enrollment.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.SCMAuditLog.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.SCMAuditEntry;
+      reader.readMessage(value,proto.SCMAuditEntry.deserializeBinaryFromReader);
+      msg.addEntries(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional uint64 userID = 3;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.Enrollment.prototype.getUserid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.SCMAuditLog.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.SCMAuditLog.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Enrollment} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.SCMAuditLog} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Enrollment.prototype.setUserid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.SCMAuditLog.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEntriesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.SCMAuditEntry.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional uint64 groupID = 4;
- * @return {number}
+ * repeated SCMAuditEntry entries = 1;
+ * @return {!Array<!proto.SCMAuditEntry>}
  */
-proto.Enrollment.prototype.getGroupid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.SCMAuditLog.prototype.getEntriesList = function() {
+  return /** @type{!Array<!proto.SCMAuditEntry>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.SCMAuditEntry, 1));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Enrollment} returns this
- */
-proto.Enrollment.prototype.setGroupid = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+ * @param {!Array<!proto.SCMAuditEntry>} value
+ * @return {!proto.SCMAuditLog} returns this
+*/
+proto.SCMAuditLog.prototype.setEntriesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional bool hasTeacherScopes = 5;
- * @return {boolean}
+ * @param {!proto.SCMAuditEntry=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.SCMAuditEntry}
  */
-proto.Enrollment.prototype.getHasteacherscopes = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.SCMAuditLog.prototype.addEntries = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.SCMAuditEntry, opt_index);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.Enrollment} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.SCMAuditLog} returns this
  */
-proto.Enrollment.prototype.setHasteacherscopes = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 5, value);
+proto.SCMAuditLog.prototype.clearEntriesList = function() {
+  return this.setEntriesList([]);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional User user = 6;
- * @return {?proto.User}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.Enrollment.prototype.getUser = function() {
-  return /** @type{?proto.User} */ (
-    jspb.Message.getWrapperField(this, proto.User, 6));
+proto.ReviewRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ReviewRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {?proto.User|undefined} value
- * @return {!proto.Enrollment} returns this
-*/
-proto.Enrollment.prototype.setUser = function(value) {
-  return jspb.Message.setWrapperField(this, 6, value);
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ReviewRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ReviewRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    review: (f = msg.getReview()) && proto.Review.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.Enrollment} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ReviewRequest}
  */
-proto.Enrollment.prototype.clearUser = function() {
-  return this.setUser(undefined);
+proto.ReviewRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ReviewRequest;
+  return proto.ReviewRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ReviewRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ReviewRequest}
  */
-proto.Enrollment.prototype.hasUser = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.ReviewRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = new proto.Review;
+      reader.readMessage(value,proto.Review.deserializeBinaryFromReader);
+      msg.setReview(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional Course course = 7;
- * @return {?proto.Course}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.Enrollment.prototype.getCourse = function() {
-  return /** @type{?proto.Course} */ (
-    jspb.Message.getWrapperField(this, proto.Course, 7));
+proto.ReviewRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ReviewRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {?proto.Course|undefined} value
- * @return {!proto.Enrollment} returns this
-*/
-proto.Enrollment.prototype.setCourse = function(value) {
-  return jspb.Message.setWrapperField(this, 7, value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ReviewRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ReviewRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getReview();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.Review.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.Enrollment} returns this
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.Enrollment.prototype.clearCourse = function() {
-  return this.setCourse(undefined);
+proto.ReviewRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.ReviewRequest} returns this
  */
-proto.Enrollment.prototype.hasCourse = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.ReviewRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional Group group = 8;
- * @return {?proto.Group}
+ * optional Review review = 2;
+ * @return {?proto.Review}
  */
-proto.Enrollment.prototype.getGroup = function() {
-  return /** @type{?proto.Group} */ (
-    jspb.Message.getWrapperField(this, proto.Group, 8));
+proto.ReviewRequest.prototype.getReview = function() {
+  return /** @type{?proto.Review} */ (
+    jspb.Message.getWrapperField(this, proto.Review, 2));
 };
 
 
 /**
- * @param {?proto.Group|undefined} value
- * @return {!proto.Enrollment} returns this
+ * @param {?proto.Review|undefined} value
+ * @return {!proto.ReviewRequest} returns this
 */
-proto.Enrollment.prototype.setGroup = function(value) {
-  return jspb.Message.setWrapperField(this, 8, value);
+proto.ReviewRequest.prototype.setReview = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.Enrollment} returns this
+ * @return {!proto.ReviewRequest} returns this
  */
-proto.Enrollment.prototype.clearGroup = function() {
-  return this.setGroup(undefined);
+proto.ReviewRequest.prototype.clearReview = function() {
+  return this.setReview(undefined);
 };
 
 
@@ -4042,137 +13973,172 @@ proto.Enrollment.prototype.clearGroup = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.Enrollment.prototype.hasGroup = function() {
-  return jspb.Message.getField(this, 8) != null;
+proto.ReviewRequest.prototype.hasReview = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
-/**
- * optional UserStatus status = 9;
- * @return {!proto.Enrollment.UserStatus}
- */
-proto.Enrollment.prototype.getStatus = function() {
-  return /** @type {!proto.Enrollment.UserStatus} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
-};
-
 
-/**
- * @param {!proto.Enrollment.UserStatus} value
- * @return {!proto.Enrollment} returns this
- */
-proto.Enrollment.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 9, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional DisplayState state = 10;
- * @return {!proto.Enrollment.DisplayState}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.Enrollment.prototype.getState = function() {
-  return /** @type {!proto.Enrollment.DisplayState} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.RubricScoreRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.RubricScoreRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!proto.Enrollment.DisplayState} value
- * @return {!proto.Enrollment} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.RubricScoreRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Enrollment.prototype.setState = function(value) {
-  return jspb.Message.setProto3EnumField(this, 10, value);
+proto.RubricScoreRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    gradesMap: (f = msg.getGradesMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional uint32 slipDaysRemaining = 11;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.RubricScoreRequest}
  */
-proto.Enrollment.prototype.getSlipdaysremaining = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+proto.RubricScoreRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.RubricScoreRequest;
+  return proto.RubricScoreRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Enrollment} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.RubricScoreRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.RubricScoreRequest}
  */
-proto.Enrollment.prototype.setSlipdaysremaining = function(value) {
-  return jspb.Message.setProto3IntField(this, 11, value);
+proto.RubricScoreRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSubmissionid(value);
+      break;
+    case 2:
+      var value = msg.getGradesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readUint64, jspb.BinaryReader.prototype.readEnum, null, 0, 0);
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string lastActivityDate = 12;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.Enrollment.prototype.getLastactivitydate = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+proto.RubricScoreRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.RubricScoreRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Enrollment} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.RubricScoreRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Enrollment.prototype.setLastactivitydate = function(value) {
-  return jspb.Message.setProto3StringField(this, 12, value);
+proto.RubricScoreRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSubmissionid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getGradesMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeUint64, jspb.BinaryWriter.prototype.writeEnum);
+  }
 };
 
 
 /**
- * optional uint64 totalApproved = 13;
+ * optional uint64 submissionID = 1;
  * @return {number}
  */
-proto.Enrollment.prototype.getTotalapproved = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+proto.RubricScoreRequest.prototype.getSubmissionid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Enrollment} returns this
- */
-proto.Enrollment.prototype.setTotalapproved = function(value) {
-  return jspb.Message.setProto3IntField(this, 13, value);
-};
-
-
-/**
- * repeated UsedSlipDays usedSlipDays = 14;
- * @return {!Array<!proto.UsedSlipDays>}
+ * @return {!proto.RubricScoreRequest} returns this
  */
-proto.Enrollment.prototype.getUsedslipdaysList = function() {
-  return /** @type{!Array<!proto.UsedSlipDays>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.UsedSlipDays, 14));
-};
-
-
-/**
- * @param {!Array<!proto.UsedSlipDays>} value
- * @return {!proto.Enrollment} returns this
-*/
-proto.Enrollment.prototype.setUsedslipdaysList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+proto.RubricScoreRequest.prototype.setSubmissionid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.UsedSlipDays=} opt_value
- * @param {number=} opt_index
- * @return {!proto.UsedSlipDays}
+ * map<uint64, GradingCriterion.Grade> grades = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<number,!proto.GradingCriterion.Grade>}
  */
-proto.Enrollment.prototype.addUsedslipdays = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.UsedSlipDays, opt_index);
+proto.RubricScoreRequest.prototype.getGradesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<number,!proto.GradingCriterion.Grade>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Enrollment} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.RubricScoreRequest} returns this
  */
-proto.Enrollment.prototype.clearUsedslipdaysList = function() {
-  return this.setUsedslipdaysList([]);
-};
+proto.RubricScoreRequest.prototype.clearGradesMap = function() {
+  this.getGradesMap().clear();
+  return this;};
 
 
 
@@ -4191,8 +14157,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.UsedSlipDays.prototype.toObject = function(opt_includeInstance) {
-  return proto.UsedSlipDays.toObject(opt_includeInstance, this);
+proto.CourseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.CourseRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4201,16 +14167,15 @@ proto.UsedSlipDays.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.UsedSlipDays} msg The msg instance to transform.
+ * @param {!proto.CourseRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.UsedSlipDays.toObject = function(includeInstance, msg) {
+proto.CourseRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    enrollmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    usedslipdays: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    withstats: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    slug: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -4224,23 +14189,23 @@ proto.UsedSlipDays.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.UsedSlipDays}
+ * @return {!proto.CourseRequest}
  */
-proto.UsedSlipDays.deserializeBinary = function(bytes) {
+proto.CourseRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.UsedSlipDays;
-  return proto.UsedSlipDays.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.CourseRequest;
+  return proto.CourseRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.UsedSlipDays} msg The message object to deserialize into.
+ * @param {!proto.CourseRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.UsedSlipDays}
+ * @return {!proto.CourseRequest}
  */
-proto.UsedSlipDays.deserializeBinaryFromReader = function(msg, reader) {
+proto.CourseRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4249,19 +14214,15 @@ proto.UsedSlipDays.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      msg.setCourseid(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setEnrollmentid(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setWithstats(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setUsedslipdays(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSlug(value);
       break;
     default:
       reader.skipField();
@@ -4276,9 +14237,9 @@ proto.UsedSlipDays.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.UsedSlipDays.prototype.serializeBinary = function() {
+proto.CourseRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.UsedSlipDays.serializeBinaryToWriter(this, writer);
+  proto.CourseRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4286,37 +14247,30 @@ proto.UsedSlipDays.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.UsedSlipDays} message
+ * @param {!proto.CourseRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.UsedSlipDays.serializeBinaryToWriter = function(message, writer) {
+proto.CourseRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getEnrollmentid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = message.getAssignmentid();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
+  f = message.getWithstats();
+  if (f) {
+    writer.writeBool(
+      2,
       f
     );
   }
-  f = message.getUsedslipdays();
-  if (f !== 0) {
-    writer.writeUint32(
-      4,
+  f = message.getSlug();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
       f
     );
   }
@@ -4324,85 +14278,60 @@ proto.UsedSlipDays.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional uint64 ID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.UsedSlipDays.prototype.getId = function() {
+proto.CourseRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.UsedSlipDays} returns this
+ * @return {!proto.CourseRequest} returns this
  */
-proto.UsedSlipDays.prototype.setId = function(value) {
+proto.CourseRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 enrollmentID = 2;
- * @return {number}
- */
-proto.UsedSlipDays.prototype.getEnrollmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.UsedSlipDays} returns this
- */
-proto.UsedSlipDays.prototype.setEnrollmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional uint64 assignmentID = 3;
- * @return {number}
+ * optional bool withStats = 2;
+ * @return {boolean}
  */
-proto.UsedSlipDays.prototype.getAssignmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.CourseRequest.prototype.getWithstats = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.UsedSlipDays} returns this
+ * @param {boolean} value
+ * @return {!proto.CourseRequest} returns this
  */
-proto.UsedSlipDays.prototype.setAssignmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.CourseRequest.prototype.setWithstats = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
 
 
 /**
- * optional uint32 usedSlipDays = 4;
- * @return {number}
+ * optional string slug = 3;
+ * @return {string}
  */
-proto.UsedSlipDays.prototype.getUsedslipdays = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.CourseRequest.prototype.getSlug = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.UsedSlipDays} returns this
+ * @param {string} value
+ * @return {!proto.CourseRequest} returns this
  */
-proto.UsedSlipDays.prototype.setUsedslipdays = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.CourseRequest.prototype.setSlug = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.Enrollments.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4418,8 +14347,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Enrollments.prototype.toObject = function(opt_includeInstance) {
-  return proto.Enrollments.toObject(opt_includeInstance, this);
+proto.CourseActivityRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.CourseActivityRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4428,14 +14357,16 @@ proto.Enrollments.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Enrollments} msg The msg instance to transform.
+ * @param {!proto.CourseActivityRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Enrollments.toObject = function(includeInstance, msg) {
+proto.CourseActivityRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
-    proto.Enrollment.toObject, includeInstance)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    since: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    limit: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    cursor: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -4449,23 +14380,23 @@ proto.Enrollments.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Enrollments}
+ * @return {!proto.CourseActivityRequest}
  */
-proto.Enrollments.deserializeBinary = function(bytes) {
+proto.CourseActivityRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Enrollments;
-  return proto.Enrollments.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.CourseActivityRequest;
+  return proto.CourseActivityRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Enrollments} msg The message object to deserialize into.
+ * @param {!proto.CourseActivityRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Enrollments}
+ * @return {!proto.CourseActivityRequest}
  */
-proto.Enrollments.deserializeBinaryFromReader = function(msg, reader) {
+proto.CourseActivityRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4473,9 +14404,20 @@ proto.Enrollments.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.Enrollment;
-      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
-      msg.addEnrollments(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSince(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setLimit(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCursor(value);
       break;
     default:
       reader.skipField();
@@ -4490,9 +14432,9 @@ proto.Enrollments.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Enrollments.prototype.serializeBinary = function() {
+proto.CourseActivityRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Enrollments.serializeBinaryToWriter(this, writer);
+  proto.CourseActivityRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4500,58 +14442,112 @@ proto.Enrollments.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Enrollments} message
+ * @param {!proto.CourseActivityRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Enrollments.serializeBinaryToWriter = function(message, writer) {
+proto.CourseActivityRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnrollmentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.Enrollment.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getSince();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getCursor();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
     );
   }
 };
 
 
 /**
- * repeated Enrollment enrollments = 1;
- * @return {!Array<!proto.Enrollment>}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.Enrollments.prototype.getEnrollmentsList = function() {
-  return /** @type{!Array<!proto.Enrollment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Enrollment, 1));
+proto.CourseActivityRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!Array<!proto.Enrollment>} value
- * @return {!proto.Enrollments} returns this
-*/
-proto.Enrollments.prototype.setEnrollmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.CourseActivityRequest} returns this
+ */
+proto.CourseActivityRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.Enrollment=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Enrollment}
+ * optional string since = 2;
+ * @return {string}
  */
-proto.Enrollments.prototype.addEnrollments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Enrollment, opt_index);
+proto.CourseActivityRequest.prototype.getSince = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Enrollments} returns this
+ * @param {string} value
+ * @return {!proto.CourseActivityRequest} returns this
  */
-proto.Enrollments.prototype.clearEnrollmentsList = function() {
-  return this.setEnrollmentsList([]);
+proto.CourseActivityRequest.prototype.setSince = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 limit = 3;
+ * @return {number}
+ */
+proto.CourseActivityRequest.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.CourseActivityRequest} returns this
+ */
+proto.CourseActivityRequest.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string cursor = 4;
+ * @return {string}
+ */
+proto.CourseActivityRequest.prototype.getCursor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.CourseActivityRequest} returns this
+ */
+proto.CourseActivityRequest.prototype.setCursor = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
@@ -4571,8 +14567,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.SubmissionLink.prototype.toObject = function(opt_includeInstance) {
-  return proto.SubmissionLink.toObject(opt_includeInstance, this);
+proto.ReplayRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ReplayRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4581,14 +14577,14 @@ proto.SubmissionLink.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.SubmissionLink} msg The msg instance to transform.
+ * @param {!proto.ReplayRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.SubmissionLink.toObject = function(includeInstance, msg) {
+proto.ReplayRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    assignment: (f = msg.getAssignment()) && proto.Assignment.toObject(includeInstance, f),
-    submission: (f = msg.getSubmission()) && proto.Submission.toObject(includeInstance, f)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    since: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -4602,23 +14598,23 @@ proto.SubmissionLink.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.SubmissionLink}
+ * @return {!proto.ReplayRequest}
  */
-proto.SubmissionLink.deserializeBinary = function(bytes) {
+proto.ReplayRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.SubmissionLink;
-  return proto.SubmissionLink.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ReplayRequest;
+  return proto.ReplayRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.SubmissionLink} msg The message object to deserialize into.
+ * @param {!proto.ReplayRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.SubmissionLink}
+ * @return {!proto.ReplayRequest}
  */
-proto.SubmissionLink.deserializeBinaryFromReader = function(msg, reader) {
+proto.ReplayRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4626,14 +14622,12 @@ proto.SubmissionLink.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.Assignment;
-      reader.readMessage(value,proto.Assignment.deserializeBinaryFromReader);
-      msg.setAssignment(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
       break;
     case 2:
-      var value = new proto.Submission;
-      reader.readMessage(value,proto.Submission.deserializeBinaryFromReader);
-      msg.setSubmission(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSince(value);
       break;
     default:
       reader.skipField();
@@ -4648,9 +14642,9 @@ proto.SubmissionLink.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.SubmissionLink.prototype.serializeBinary = function() {
+proto.ReplayRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.SubmissionLink.serializeBinaryToWriter(this, writer);
+  proto.ReplayRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4658,113 +14652,66 @@ proto.SubmissionLink.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.SubmissionLink} message
+ * @param {!proto.ReplayRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.SubmissionLink.serializeBinaryToWriter = function(message, writer) {
+proto.ReplayRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAssignment();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.Assignment.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getSubmission();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getSince();
+  if (f.length > 0) {
+    writer.writeString(
       2,
-      f,
-      proto.Submission.serializeBinaryToWriter
+      f
     );
-  }
-};
-
-
-/**
- * optional Assignment assignment = 1;
- * @return {?proto.Assignment}
- */
-proto.SubmissionLink.prototype.getAssignment = function() {
-  return /** @type{?proto.Assignment} */ (
-    jspb.Message.getWrapperField(this, proto.Assignment, 1));
-};
-
-
-/**
- * @param {?proto.Assignment|undefined} value
- * @return {!proto.SubmissionLink} returns this
-*/
-proto.SubmissionLink.prototype.setAssignment = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.SubmissionLink} returns this
- */
-proto.SubmissionLink.prototype.clearAssignment = function() {
-  return this.setAssignment(undefined);
+  }
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.SubmissionLink.prototype.hasAssignment = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.ReplayRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * optional Submission submission = 2;
- * @return {?proto.Submission}
+ * @param {number} value
+ * @return {!proto.ReplayRequest} returns this
  */
-proto.SubmissionLink.prototype.getSubmission = function() {
-  return /** @type{?proto.Submission} */ (
-    jspb.Message.getWrapperField(this, proto.Submission, 2));
-};
-
-
-/**
- * @param {?proto.Submission|undefined} value
- * @return {!proto.SubmissionLink} returns this
-*/
-proto.SubmissionLink.prototype.setSubmission = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.ReplayRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.SubmissionLink} returns this
+ * optional string since = 2;
+ * @return {string}
  */
-proto.SubmissionLink.prototype.clearSubmission = function() {
-  return this.setSubmission(undefined);
+proto.ReplayRequest.prototype.getSince = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.ReplayRequest} returns this
  */
-proto.SubmissionLink.prototype.hasSubmission = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.ReplayRequest.prototype.setSince = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.EnrollmentLink.repeatedFields_ = [3];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4780,8 +14727,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.EnrollmentLink.prototype.toObject = function(opt_includeInstance) {
-  return proto.EnrollmentLink.toObject(opt_includeInstance, this);
+proto.SubmissionCount.prototype.toObject = function(opt_includeInstance) {
+  return proto.SubmissionCount.toObject(opt_includeInstance, this);
 };
 
 
@@ -4790,15 +14737,13 @@ proto.EnrollmentLink.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.EnrollmentLink} msg The msg instance to transform.
+ * @param {!proto.SubmissionCount} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.EnrollmentLink.toObject = function(includeInstance, msg) {
+proto.SubmissionCount.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enrollment: (f = msg.getEnrollment()) && proto.Enrollment.toObject(includeInstance, f),
-    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
-    proto.SubmissionLink.toObject, includeInstance)
+    count: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -4812,38 +14757,32 @@ proto.EnrollmentLink.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.EnrollmentLink}
+ * @return {!proto.SubmissionCount}
  */
-proto.EnrollmentLink.deserializeBinary = function(bytes) {
+proto.SubmissionCount.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.EnrollmentLink;
-  return proto.EnrollmentLink.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.SubmissionCount;
+  return proto.SubmissionCount.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.EnrollmentLink} msg The message object to deserialize into.
+ * @param {!proto.SubmissionCount} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.EnrollmentLink}
+ * @return {!proto.SubmissionCount}
  */
-proto.EnrollmentLink.deserializeBinaryFromReader = function(msg, reader) {
+proto.SubmissionCount.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 2:
-      var value = new proto.Enrollment;
-      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
-      msg.setEnrollment(value);
-      break;
-    case 3:
-      var value = new proto.SubmissionLink;
-      reader.readMessage(value,proto.SubmissionLink.deserializeBinaryFromReader);
-      msg.addSubmissions(value);
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCount(value);
       break;
     default:
       reader.skipField();
@@ -4858,9 +14797,9 @@ proto.EnrollmentLink.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.EnrollmentLink.prototype.serializeBinary = function() {
+proto.SubmissionCount.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.EnrollmentLink.serializeBinaryToWriter(this, writer);
+  proto.SubmissionCount.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4868,103 +14807,37 @@ proto.EnrollmentLink.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.EnrollmentLink} message
+ * @param {!proto.SubmissionCount} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.EnrollmentLink.serializeBinaryToWriter = function(message, writer) {
+proto.SubmissionCount.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnrollment();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.Enrollment.serializeBinaryToWriter
-    );
-  }
-  f = message.getSubmissionsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      3,
-      f,
-      proto.SubmissionLink.serializeBinaryToWriter
+  f = message.getCount();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
     );
   }
 };
 
 
 /**
- * optional Enrollment enrollment = 2;
- * @return {?proto.Enrollment}
- */
-proto.EnrollmentLink.prototype.getEnrollment = function() {
-  return /** @type{?proto.Enrollment} */ (
-    jspb.Message.getWrapperField(this, proto.Enrollment, 2));
-};
-
-
-/**
- * @param {?proto.Enrollment|undefined} value
- * @return {!proto.EnrollmentLink} returns this
-*/
-proto.EnrollmentLink.prototype.setEnrollment = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.EnrollmentLink} returns this
- */
-proto.EnrollmentLink.prototype.clearEnrollment = function() {
-  return this.setEnrollment(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.EnrollmentLink.prototype.hasEnrollment = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-/**
- * repeated SubmissionLink submissions = 3;
- * @return {!Array<!proto.SubmissionLink>}
- */
-proto.EnrollmentLink.prototype.getSubmissionsList = function() {
-  return /** @type{!Array<!proto.SubmissionLink>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.SubmissionLink, 3));
-};
-
-
-/**
- * @param {!Array<!proto.SubmissionLink>} value
- * @return {!proto.EnrollmentLink} returns this
-*/
-proto.EnrollmentLink.prototype.setSubmissionsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
-};
-
-
-/**
- * @param {!proto.SubmissionLink=} opt_value
- * @param {number=} opt_index
- * @return {!proto.SubmissionLink}
+ * optional uint32 count = 1;
+ * @return {number}
  */
-proto.EnrollmentLink.prototype.addSubmissions = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.SubmissionLink, opt_index);
+proto.SubmissionCount.prototype.getCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.EnrollmentLink} returns this
+ * @param {number} value
+ * @return {!proto.SubmissionCount} returns this
  */
-proto.EnrollmentLink.prototype.clearSubmissionsList = function() {
-  return this.setSubmissionsList([]);
+proto.SubmissionCount.prototype.setCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
@@ -4974,7 +14847,7 @@ proto.EnrollmentLink.prototype.clearSubmissionsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.CourseSubmissions.repeatedFields_ = [2];
+proto.CoursesRequest.repeatedFields_ = [1];
 
 
 
@@ -4991,8 +14864,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.CourseSubmissions.prototype.toObject = function(opt_includeInstance) {
-  return proto.CourseSubmissions.toObject(opt_includeInstance, this);
+proto.CoursesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.CoursesRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5001,15 +14874,13 @@ proto.CourseSubmissions.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.CourseSubmissions} msg The msg instance to transform.
+ * @param {!proto.CoursesRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.CourseSubmissions.toObject = function(includeInstance, msg) {
+proto.CoursesRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    course: (f = msg.getCourse()) && proto.Course.toObject(includeInstance, f),
-    linksList: jspb.Message.toObjectList(msg.getLinksList(),
-    proto.EnrollmentLink.toObject, includeInstance)
+    courseidsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -5023,23 +14894,23 @@ proto.CourseSubmissions.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.CourseSubmissions}
+ * @return {!proto.CoursesRequest}
  */
-proto.CourseSubmissions.deserializeBinary = function(bytes) {
+proto.CoursesRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.CourseSubmissions;
-  return proto.CourseSubmissions.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.CoursesRequest;
+  return proto.CoursesRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.CourseSubmissions} msg The message object to deserialize into.
+ * @param {!proto.CoursesRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.CourseSubmissions}
+ * @return {!proto.CoursesRequest}
  */
-proto.CourseSubmissions.deserializeBinaryFromReader = function(msg, reader) {
+proto.CoursesRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5047,14 +14918,10 @@ proto.CourseSubmissions.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.Course;
-      reader.readMessage(value,proto.Course.deserializeBinaryFromReader);
-      msg.setCourse(value);
-      break;
-    case 2:
-      var value = new proto.EnrollmentLink;
-      reader.readMessage(value,proto.EnrollmentLink.deserializeBinaryFromReader);
-      msg.addLinks(value);
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedUint64() : [reader.readUint64()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addCourseids(values[i]);
+      }
       break;
     default:
       reader.skipField();
@@ -5069,9 +14936,9 @@ proto.CourseSubmissions.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.CourseSubmissions.prototype.serializeBinary = function() {
+proto.CoursesRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.CourseSubmissions.serializeBinaryToWriter(this, writer);
+  proto.CoursesRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5079,114 +14946,60 @@ proto.CourseSubmissions.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.CourseSubmissions} message
+ * @param {!proto.CoursesRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.CourseSubmissions.serializeBinaryToWriter = function(message, writer) {
+proto.CoursesRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCourse();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      proto.Course.serializeBinaryToWriter
-    );
-  }
-  f = message.getLinksList();
+  f = message.getCourseidsList();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      2,
-      f,
-      proto.EnrollmentLink.serializeBinaryToWriter
+    writer.writePackedUint64(
+      1,
+      f
     );
   }
 };
 
 
 /**
- * optional Course course = 1;
- * @return {?proto.Course}
- */
-proto.CourseSubmissions.prototype.getCourse = function() {
-  return /** @type{?proto.Course} */ (
-    jspb.Message.getWrapperField(this, proto.Course, 1));
-};
-
-
-/**
- * @param {?proto.Course|undefined} value
- * @return {!proto.CourseSubmissions} returns this
-*/
-proto.CourseSubmissions.prototype.setCourse = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.CourseSubmissions} returns this
- */
-proto.CourseSubmissions.prototype.clearCourse = function() {
-  return this.setCourse(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * repeated uint64 courseIDs = 1;
+ * @return {!Array<number>}
  */
-proto.CourseSubmissions.prototype.hasCourse = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.CoursesRequest.prototype.getCourseidsList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * repeated EnrollmentLink links = 2;
- * @return {!Array<!proto.EnrollmentLink>}
+ * @param {!Array<number>} value
+ * @return {!proto.CoursesRequest} returns this
  */
-proto.CourseSubmissions.prototype.getLinksList = function() {
-  return /** @type{!Array<!proto.EnrollmentLink>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.EnrollmentLink, 2));
-};
-
-
-/**
- * @param {!Array<!proto.EnrollmentLink>} value
- * @return {!proto.CourseSubmissions} returns this
-*/
-proto.CourseSubmissions.prototype.setLinksList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+proto.CoursesRequest.prototype.setCourseidsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * @param {!proto.EnrollmentLink=} opt_value
+ * @param {number} value
  * @param {number=} opt_index
- * @return {!proto.EnrollmentLink}
+ * @return {!proto.CoursesRequest} returns this
  */
-proto.CourseSubmissions.prototype.addLinks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.EnrollmentLink, opt_index);
+proto.CoursesRequest.prototype.addCourseids = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.CourseSubmissions} returns this
+ * @return {!proto.CoursesRequest} returns this
  */
-proto.CourseSubmissions.prototype.clearLinksList = function() {
-  return this.setLinksList([]);
+proto.CoursesRequest.prototype.clearCourseidsList = function() {
+  return this.setCourseidsList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.Assignment.repeatedFields_ = [12,13];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5202,8 +15015,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Assignment.prototype.toObject = function(opt_includeInstance) {
-  return proto.Assignment.toObject(opt_includeInstance, this);
+proto.UpdateCourseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.UpdateCourseRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5212,28 +15025,14 @@ proto.Assignment.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Assignment} msg The msg instance to transform.
+ * @param {!proto.UpdateCourseRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Assignment.toObject = function(includeInstance, msg) {
+proto.UpdateCourseRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    scriptfile: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    deadline: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    autoapprove: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
-    order: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    isgrouplab: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
-    scorelimit: jspb.Message.getFieldWithDefault(msg, 9, 0),
-    reviewers: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    skiptests: jspb.Message.getBooleanFieldWithDefault(msg, 11, false),
-    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
-    proto.Submission.toObject, includeInstance),
-    gradingbenchmarksList: jspb.Message.toObjectList(msg.getGradingbenchmarksList(),
-    proto.GradingBenchmark.toObject, includeInstance),
-    containertimeout: jspb.Message.getFieldWithDefault(msg, 14, 0)
+    course: (f = msg.getCourse()) && proto.Course.toObject(includeInstance, f),
+    skiporganizationcheck: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
   };
 
   if (includeInstance) {
@@ -5247,23 +15046,23 @@ proto.Assignment.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Assignment}
+ * @return {!proto.UpdateCourseRequest}
  */
-proto.Assignment.deserializeBinary = function(bytes) {
+proto.UpdateCourseRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Assignment;
-  return proto.Assignment.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.UpdateCourseRequest;
+  return proto.UpdateCourseRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Assignment} msg The message object to deserialize into.
+ * @param {!proto.UpdateCourseRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Assignment}
+ * @return {!proto.UpdateCourseRequest}
  */
-proto.Assignment.deserializeBinaryFromReader = function(msg, reader) {
+proto.UpdateCourseRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5271,62 +15070,13 @@ proto.Assignment.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      var value = new proto.Course;
+      reader.readMessage(value,proto.Course.deserializeBinaryFromReader);
+      msg.setCourse(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setScriptfile(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDeadline(value);
-      break;
-    case 6:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setAutoapprove(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setOrder(value);
-      break;
-    case 8:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsgrouplab(value);
-      break;
-    case 9:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setScorelimit(value);
-      break;
-    case 10:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setReviewers(value);
-      break;
-    case 11:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSkiptests(value);
-      break;
-    case 12:
-      var value = new proto.Submission;
-      reader.readMessage(value,proto.Submission.deserializeBinaryFromReader);
-      msg.addSubmissions(value);
-      break;
-    case 13:
-      var value = new proto.GradingBenchmark;
-      reader.readMessage(value,proto.GradingBenchmark.deserializeBinaryFromReader);
-      msg.addGradingbenchmarks(value);
-      break;
-    case 14:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setContainertimeout(value);
+      msg.setSkiporganizationcheck(value);
       break;
     default:
       reader.skipField();
@@ -5341,9 +15091,9 @@ proto.Assignment.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Assignment.prototype.serializeBinary = function() {
+proto.UpdateCourseRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Assignment.serializeBinaryToWriter(this, writer);
+  proto.UpdateCourseRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5351,109 +15101,24 @@ proto.Assignment.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Assignment} message
+ * @param {!proto.UpdateCourseRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Assignment.serializeBinaryToWriter = function(message, writer) {
+proto.UpdateCourseRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getCourse();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
-    );
-  }
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getScriptfile();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getDeadline();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getAutoapprove();
-  if (f) {
-    writer.writeBool(
-      6,
-      f
-    );
-  }
-  f = message.getOrder();
-  if (f !== 0) {
-    writer.writeUint32(
-      7,
-      f
-    );
-  }
-  f = message.getIsgrouplab();
-  if (f) {
-    writer.writeBool(
-      8,
-      f
-    );
-  }
-  f = message.getScorelimit();
-  if (f !== 0) {
-    writer.writeUint32(
-      9,
-      f
-    );
-  }
-  f = message.getReviewers();
-  if (f !== 0) {
-    writer.writeUint32(
-      10,
-      f
+      f,
+      proto.Course.serializeBinaryToWriter
     );
   }
-  f = message.getSkiptests();
+  f = message.getSkiporganizationcheck();
   if (f) {
     writer.writeBool(
-      11,
-      f
-    );
-  }
-  f = message.getSubmissionsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      12,
-      f,
-      proto.Submission.serializeBinaryToWriter
-    );
-  }
-  f = message.getGradingbenchmarksList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      13,
-      f,
-      proto.GradingBenchmark.serializeBinaryToWriter
-    );
-  }
-  f = message.getContainertimeout();
-  if (f !== 0) {
-    writer.writeUint32(
-      14,
+      2,
       f
     );
   }
@@ -5461,305 +15126,537 @@ proto.Assignment.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional uint64 ID = 1;
- * @return {number}
+ * optional Course course = 1;
+ * @return {?proto.Course}
+ */
+proto.UpdateCourseRequest.prototype.getCourse = function() {
+  return /** @type{?proto.Course} */ (
+    jspb.Message.getWrapperField(this, proto.Course, 1));
+};
+
+
+/**
+ * @param {?proto.Course|undefined} value
+ * @return {!proto.UpdateCourseRequest} returns this
+*/
+proto.UpdateCourseRequest.prototype.setCourse = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.UpdateCourseRequest} returns this
+ */
+proto.UpdateCourseRequest.prototype.clearCourse = function() {
+  return this.setCourse(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.UpdateCourseRequest.prototype.hasCourse = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional bool skipOrganizationCheck = 2;
+ * @return {boolean}
+ */
+proto.UpdateCourseRequest.prototype.getSkiporganizationcheck = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.UpdateCourseRequest} returns this
+ */
+proto.UpdateCourseRequest.prototype.setSkiporganizationcheck = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.Assignment.prototype.getId = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.CourseFeatureRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.CourseFeatureRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Assignment} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.CourseFeatureRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Assignment.prototype.setId = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.CourseFeatureRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    feature: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    enabled: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional uint64 courseID = 2;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.CourseFeatureRequest}
  */
-proto.Assignment.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.CourseFeatureRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.CourseFeatureRequest;
+  return proto.CourseFeatureRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Assignment} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.CourseFeatureRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.CourseFeatureRequest}
  */
-proto.Assignment.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.CourseFeatureRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.Course.Feature} */ (reader.readEnum());
+      msg.setFeature(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setEnabled(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string name = 3;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.Assignment.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.CourseFeatureRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.CourseFeatureRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Assignment} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.CourseFeatureRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Assignment.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.CourseFeatureRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getFeature();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getEnabled();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string scriptFile = 4;
- * @return {string}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.Assignment.prototype.getScriptfile = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.CourseFeatureRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Assignment} returns this
+ * @param {number} value
+ * @return {!proto.CourseFeatureRequest} returns this
  */
-proto.Assignment.prototype.setScriptfile = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.CourseFeatureRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string deadline = 5;
- * @return {string}
+ * optional Course.Feature feature = 2;
+ * @return {!proto.Course.Feature}
  */
-proto.Assignment.prototype.getDeadline = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.CourseFeatureRequest.prototype.getFeature = function() {
+  return /** @type {!proto.Course.Feature} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Assignment} returns this
+ * @param {!proto.Course.Feature} value
+ * @return {!proto.CourseFeatureRequest} returns this
  */
-proto.Assignment.prototype.setDeadline = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.CourseFeatureRequest.prototype.setFeature = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
 /**
- * optional bool autoApprove = 6;
+ * optional bool enabled = 3;
  * @return {boolean}
  */
-proto.Assignment.prototype.getAutoapprove = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+proto.CourseFeatureRequest.prototype.getEnabled = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
  * @param {boolean} value
- * @return {!proto.Assignment} returns this
+ * @return {!proto.CourseFeatureRequest} returns this
  */
-proto.Assignment.prototype.setAutoapprove = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 6, value);
+proto.CourseFeatureRequest.prototype.setEnabled = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
+
 /**
- * optional uint32 order = 7;
- * @return {number}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.Assignment.prototype.getOrder = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
-};
+proto.UpdateCourseWarnings.repeatedFields_ = [1];
+
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {number} value
- * @return {!proto.Assignment} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.Assignment.prototype.setOrder = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
+proto.UpdateCourseWarnings.prototype.toObject = function(opt_includeInstance) {
+  return proto.UpdateCourseWarnings.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional bool isGroupLab = 8;
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.UpdateCourseWarnings} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Assignment.prototype.getIsgrouplab = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+proto.UpdateCourseWarnings.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    warningsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {boolean} value
- * @return {!proto.Assignment} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.UpdateCourseWarnings}
  */
-proto.Assignment.prototype.setIsgrouplab = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 8, value);
+proto.UpdateCourseWarnings.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.UpdateCourseWarnings;
+  return proto.UpdateCourseWarnings.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional uint32 scoreLimit = 9;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.UpdateCourseWarnings} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.UpdateCourseWarnings}
  */
-proto.Assignment.prototype.getScorelimit = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+proto.UpdateCourseWarnings.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addWarnings(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Assignment} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.Assignment.prototype.setScorelimit = function(value) {
-  return jspb.Message.setProto3IntField(this, 9, value);
+proto.UpdateCourseWarnings.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.UpdateCourseWarnings.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional uint32 reviewers = 10;
- * @return {number}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.UpdateCourseWarnings} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Assignment.prototype.getReviewers = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.UpdateCourseWarnings.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getWarningsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Assignment} returns this
+ * repeated string warnings = 1;
+ * @return {!Array<string>}
  */
-proto.Assignment.prototype.setReviewers = function(value) {
-  return jspb.Message.setProto3IntField(this, 10, value);
+proto.UpdateCourseWarnings.prototype.getWarningsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * optional bool skipTests = 11;
- * @return {boolean}
+ * @param {!Array<string>} value
+ * @return {!proto.UpdateCourseWarnings} returns this
  */
-proto.Assignment.prototype.getSkiptests = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 11, false));
+proto.UpdateCourseWarnings.prototype.setWarningsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.Assignment} returns this
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.UpdateCourseWarnings} returns this
  */
-proto.Assignment.prototype.setSkiptests = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 11, value);
+proto.UpdateCourseWarnings.prototype.addWarnings = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * repeated Submission submissions = 12;
- * @return {!Array<!proto.Submission>}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.UpdateCourseWarnings} returns this
  */
-proto.Assignment.prototype.getSubmissionsList = function() {
-  return /** @type{!Array<!proto.Submission>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Submission, 12));
+proto.UpdateCourseWarnings.prototype.clearWarningsList = function() {
+  return this.setWarningsList([]);
 };
 
 
-/**
- * @param {!Array<!proto.Submission>} value
- * @return {!proto.Assignment} returns this
-*/
-proto.Assignment.prototype.setSubmissionsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 12, value);
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {!proto.Submission=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Submission}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.Assignment.prototype.addSubmissions = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.Submission, opt_index);
+proto.UserRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.UserRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Assignment} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.UserRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Assignment.prototype.clearSubmissionsList = function() {
-  return this.setSubmissionsList([]);
+proto.UserRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    userid: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * repeated GradingBenchmark gradingBenchmarks = 13;
- * @return {!Array<!proto.GradingBenchmark>}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.UserRequest}
  */
-proto.Assignment.prototype.getGradingbenchmarksList = function() {
-  return /** @type{!Array<!proto.GradingBenchmark>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.GradingBenchmark, 13));
+proto.UserRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.UserRequest;
+  return proto.UserRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!Array<!proto.GradingBenchmark>} value
- * @return {!proto.Assignment} returns this
-*/
-proto.Assignment.prototype.setGradingbenchmarksList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 13, value);
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.UserRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.UserRequest}
+ */
+proto.UserRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {!proto.GradingBenchmark=} opt_value
- * @param {number=} opt_index
- * @return {!proto.GradingBenchmark}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.Assignment.prototype.addGradingbenchmarks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 13, opt_value, proto.GradingBenchmark, opt_index);
+proto.UserRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.UserRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Assignment} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.UserRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Assignment.prototype.clearGradingbenchmarksList = function() {
-  return this.setGradingbenchmarksList([]);
+proto.UserRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint32 containerTimeout = 14;
+ * optional uint64 userID = 1;
  * @return {number}
  */
-proto.Assignment.prototype.getContainertimeout = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
+proto.UserRequest.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Assignment} returns this
+ * @return {!proto.UserRequest} returns this
  */
-proto.Assignment.prototype.setContainertimeout = function(value) {
-  return jspb.Message.setProto3IntField(this, 14, value);
+proto.UserRequest.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.Assignments.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5775,8 +15672,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Assignments.prototype.toObject = function(opt_includeInstance) {
-  return proto.Assignments.toObject(opt_includeInstance, this);
+proto.GetGroupRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.GetGroupRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5785,14 +15682,13 @@ proto.Assignments.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Assignments} msg The msg instance to transform.
+ * @param {!proto.GetGroupRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Assignments.toObject = function(includeInstance, msg) {
+proto.GetGroupRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
-    proto.Assignment.toObject, includeInstance)
+    groupid: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -5806,23 +15702,23 @@ proto.Assignments.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Assignments}
+ * @return {!proto.GetGroupRequest}
  */
-proto.Assignments.deserializeBinary = function(bytes) {
+proto.GetGroupRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Assignments;
-  return proto.Assignments.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.GetGroupRequest;
+  return proto.GetGroupRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Assignments} msg The message object to deserialize into.
+ * @param {!proto.GetGroupRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Assignments}
+ * @return {!proto.GetGroupRequest}
  */
-proto.Assignments.deserializeBinaryFromReader = function(msg, reader) {
+proto.GetGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5830,9 +15726,8 @@ proto.Assignments.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.Assignment;
-      reader.readMessage(value,proto.Assignment.deserializeBinaryFromReader);
-      msg.addAssignments(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
       break;
     default:
       reader.skipField();
@@ -5847,9 +15742,9 @@ proto.Assignments.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Assignments.prototype.serializeBinary = function() {
+proto.GetGroupRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Assignments.serializeBinaryToWriter(this, writer);
+  proto.GetGroupRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5857,69 +15752,41 @@ proto.Assignments.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Assignments} message
+ * @param {!proto.GetGroupRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Assignments.serializeBinaryToWriter = function(message, writer) {
+proto.GetGroupRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAssignmentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.Assignment.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated Assignment assignments = 1;
- * @return {!Array<!proto.Assignment>}
- */
-proto.Assignments.prototype.getAssignmentsList = function() {
-  return /** @type{!Array<!proto.Assignment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Assignment, 1));
-};
-
-
-/**
- * @param {!Array<!proto.Assignment>} value
- * @return {!proto.Assignments} returns this
-*/
-proto.Assignments.prototype.setAssignmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.Assignment=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Assignment}
+ * optional uint64 groupID = 1;
+ * @return {number}
  */
-proto.Assignments.prototype.addAssignments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Assignment, opt_index);
+proto.GetGroupRequest.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Assignments} returns this
+ * @param {number} value
+ * @return {!proto.GetGroupRequest} returns this
  */
-proto.Assignments.prototype.clearAssignmentsList = function() {
-  return this.setAssignmentsList([]);
+proto.GetGroupRequest.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.Submission.repeatedFields_ = [12];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5935,8 +15802,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Submission.prototype.toObject = function(opt_includeInstance) {
-  return proto.Submission.toObject(opt_includeInstance, this);
+proto.GroupRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.GroupRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5945,25 +15812,15 @@ proto.Submission.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Submission} msg The msg instance to transform.
+ * @param {!proto.GroupRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Submission.toObject = function(includeInstance, msg) {
+proto.GroupRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    score: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    scoreobjects: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    buildinfo: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    commithash: jspb.Message.getFieldWithDefault(msg, 8, ""),
-    released: jspb.Message.getBooleanFieldWithDefault(msg, 9, false),
-    status: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    approveddate: jspb.Message.getFieldWithDefault(msg, 11, ""),
-    reviewsList: jspb.Message.toObjectList(msg.getReviewsList(),
-    proto.Review.toObject, includeInstance)
+    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -5977,23 +15834,23 @@ proto.Submission.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Submission}
+ * @return {!proto.GroupRequest}
  */
-proto.Submission.deserializeBinary = function(bytes) {
+proto.GroupRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Submission;
-  return proto.Submission.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.GroupRequest;
+  return proto.GroupRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Submission} msg The message object to deserialize into.
+ * @param {!proto.GroupRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Submission}
+ * @return {!proto.GroupRequest}
  */
-proto.Submission.deserializeBinaryFromReader = function(msg, reader) {
+proto.GroupRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6001,53 +15858,16 @@ proto.Submission.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
-      break;
-    case 3:
       var value = /** @type {number} */ (reader.readUint64());
       msg.setUserid(value);
       break;
-    case 4:
+    case 2:
       var value = /** @type {number} */ (reader.readUint64());
       msg.setGroupid(value);
       break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setScore(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setScoreobjects(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBuildinfo(value);
-      break;
-    case 8:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCommithash(value);
-      break;
-    case 9:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReleased(value);
-      break;
-    case 10:
-      var value = /** @type {!proto.Submission.Status} */ (reader.readEnum());
-      msg.setStatus(value);
-      break;
-    case 11:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setApproveddate(value);
-      break;
-    case 12:
-      var value = new proto.Review;
-      reader.readMessage(value,proto.Review.deserializeBinaryFromReader);
-      msg.addReviews(value);
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
       break;
     default:
       reader.skipField();
@@ -6062,9 +15882,9 @@ proto.Submission.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Submission.prototype.serializeBinary = function() {
+proto.GroupRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Submission.serializeBinaryToWriter(this, writer);
+  proto.GroupRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6072,354 +15892,221 @@ proto.Submission.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Submission} message
+ * @param {!proto.GroupRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Submission.serializeBinaryToWriter = function(message, writer) {
+proto.GroupRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getUserid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getAssignmentid();
+  f = message.getGroupid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getUserid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       3,
       f
     );
   }
-  f = message.getGroupid();
-  if (f !== 0) {
-    writer.writeUint64(
-      4,
-      f
-    );
-  }
-  f = message.getScore();
-  if (f !== 0) {
-    writer.writeUint32(
-      5,
-      f
-    );
-  }
-  f = message.getScoreobjects();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getBuildinfo();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
-  f = message.getCommithash();
-  if (f.length > 0) {
-    writer.writeString(
-      8,
-      f
-    );
-  }
-  f = message.getReleased();
-  if (f) {
-    writer.writeBool(
-      9,
-      f
-    );
-  }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      10,
-      f
-    );
-  }
-  f = message.getApproveddate();
-  if (f.length > 0) {
-    writer.writeString(
-      11,
-      f
-    );
-  }
-  f = message.getReviewsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      12,
-      f,
-      proto.Review.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * @enum {number}
- */
-proto.Submission.Status = {
-  NONE: 0,
-  APPROVED: 1,
-  REJECTED: 2,
-  REVISION: 3
-};
-
-/**
- * optional uint64 ID = 1;
+ * optional uint64 userID = 1;
  * @return {number}
  */
-proto.Submission.prototype.getId = function() {
+proto.GroupRequest.prototype.getUserid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Submission} returns this
+ * @return {!proto.GroupRequest} returns this
  */
-proto.Submission.prototype.setId = function(value) {
+proto.GroupRequest.prototype.setUserid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 assignmentID = 2;
+ * optional uint64 groupID = 2;
  * @return {number}
  */
-proto.Submission.prototype.getAssignmentid = function() {
+proto.GroupRequest.prototype.getGroupid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Submission} returns this
+ * @return {!proto.GroupRequest} returns this
  */
-proto.Submission.prototype.setAssignmentid = function(value) {
+proto.GroupRequest.prototype.setGroupid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 userID = 3;
+ * optional uint64 courseID = 3;
  * @return {number}
  */
-proto.Submission.prototype.getUserid = function() {
+proto.GroupRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Submission} returns this
+ * @return {!proto.GroupRequest} returns this
  */
-proto.Submission.prototype.setUserid = function(value) {
+proto.GroupRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
-/**
- * optional uint64 groupID = 4;
- * @return {number}
- */
-proto.Submission.prototype.getGroupid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.Submission} returns this
- */
-proto.Submission.prototype.setGroupid = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
-};
-
-
-/**
- * optional uint32 score = 5;
- * @return {number}
- */
-proto.Submission.prototype.getScore = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.Submission} returns this
- */
-proto.Submission.prototype.setScore = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
-};
-
-
-/**
- * optional string scoreObjects = 6;
- * @return {string}
- */
-proto.Submission.prototype.getScoreobjects = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.Submission} returns this
- */
-proto.Submission.prototype.setScoreobjects = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
-};
-
-
-/**
- * optional string buildInfo = 7;
- * @return {string}
- */
-proto.Submission.prototype.getBuildinfo = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.Submission} returns this
- */
-proto.Submission.prototype.setBuildinfo = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
-};
-
-
-/**
- * optional string commitHash = 8;
- * @return {string}
- */
-proto.Submission.prototype.getCommithash = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.Submission} returns this
- */
-proto.Submission.prototype.setCommithash = function(value) {
-  return jspb.Message.setProto3StringField(this, 8, value);
-};
-
-
-/**
- * optional bool released = 9;
- * @return {boolean}
- */
-proto.Submission.prototype.getReleased = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 9, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.Submission} returns this
- */
-proto.Submission.prototype.setReleased = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 9, value);
-};
-
 
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional Status status = 10;
- * @return {!proto.Submission.Status}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.Submission.prototype.getStatus = function() {
-  return /** @type {!proto.Submission.Status} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.Provider.prototype.toObject = function(opt_includeInstance) {
+  return proto.Provider.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!proto.Submission.Status} value
- * @return {!proto.Submission} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Provider} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Submission.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 10, value);
+proto.Provider.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    provider: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string approvedDate = 11;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Provider}
  */
-proto.Submission.prototype.getApproveddate = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+proto.Provider.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Provider;
+  return proto.Provider.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Submission} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Provider} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Provider}
  */
-proto.Submission.prototype.setApproveddate = function(value) {
-  return jspb.Message.setProto3StringField(this, 11, value);
+proto.Provider.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProvider(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * repeated Review reviews = 12;
- * @return {!Array<!proto.Review>}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.Submission.prototype.getReviewsList = function() {
-  return /** @type{!Array<!proto.Review>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Review, 12));
+proto.Provider.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Provider.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {!Array<!proto.Review>} value
- * @return {!proto.Submission} returns this
-*/
-proto.Submission.prototype.setReviewsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Provider} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Provider.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {!proto.Review=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Review}
+ * optional string provider = 1;
+ * @return {string}
  */
-proto.Submission.prototype.addReviews = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.Review, opt_index);
+proto.Provider.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Submission} returns this
+ * @param {string} value
+ * @return {!proto.Provider} returns this
  */
-proto.Submission.prototype.clearReviewsList = function() {
-  return this.setReviewsList([]);
+proto.Provider.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.Submissions.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6435,8 +16122,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Submissions.prototype.toObject = function(opt_includeInstance) {
-  return proto.Submissions.toObject(opt_includeInstance, this);
+proto.OrgRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.OrgRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -6445,14 +16132,13 @@ proto.Submissions.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Submissions} msg The msg instance to transform.
+ * @param {!proto.OrgRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Submissions.toObject = function(includeInstance, msg) {
+proto.OrgRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
-    proto.Submission.toObject, includeInstance)
+    orgname: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -6466,23 +16152,23 @@ proto.Submissions.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Submissions}
+ * @return {!proto.OrgRequest}
  */
-proto.Submissions.deserializeBinary = function(bytes) {
+proto.OrgRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Submissions;
-  return proto.Submissions.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.OrgRequest;
+  return proto.OrgRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Submissions} msg The message object to deserialize into.
+ * @param {!proto.OrgRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Submissions}
+ * @return {!proto.OrgRequest}
  */
-proto.Submissions.deserializeBinaryFromReader = function(msg, reader) {
+proto.OrgRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6490,9 +16176,8 @@ proto.Submissions.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.Submission;
-      reader.readMessage(value,proto.Submission.deserializeBinaryFromReader);
-      msg.addSubmissions(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOrgname(value);
       break;
     default:
       reader.skipField();
@@ -6507,9 +16192,9 @@ proto.Submissions.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Submissions.prototype.serializeBinary = function() {
+proto.OrgRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Submissions.serializeBinaryToWriter(this, writer);
+  proto.OrgRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6517,69 +16202,41 @@ proto.Submissions.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Submissions} message
+ * @param {!proto.OrgRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Submissions.serializeBinaryToWriter = function(message, writer) {
+proto.OrgRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSubmissionsList();
+  f = message.getOrgname();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.Submission.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated Submission submissions = 1;
- * @return {!Array<!proto.Submission>}
- */
-proto.Submissions.prototype.getSubmissionsList = function() {
-  return /** @type{!Array<!proto.Submission>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Submission, 1));
-};
-
-
-/**
- * @param {!Array<!proto.Submission>} value
- * @return {!proto.Submissions} returns this
-*/
-proto.Submissions.prototype.setSubmissionsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.Submission=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Submission}
+ * optional string orgName = 1;
+ * @return {string}
  */
-proto.Submissions.prototype.addSubmissions = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Submission, opt_index);
+proto.OrgRequest.prototype.getOrgname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Submissions} returns this
+ * @param {string} value
+ * @return {!proto.OrgRequest} returns this
  */
-proto.Submissions.prototype.clearSubmissionsList = function() {
-  return this.setSubmissionsList([]);
+proto.OrgRequest.prototype.setOrgname = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.GradingBenchmark.repeatedFields_ = [5];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6595,8 +16252,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.GradingBenchmark.prototype.toObject = function(opt_includeInstance) {
-  return proto.GradingBenchmark.toObject(opt_includeInstance, this);
+proto.Organization.prototype.toObject = function(opt_includeInstance) {
+  return proto.Organization.toObject(opt_includeInstance, this);
 };
 
 
@@ -6605,18 +16262,16 @@ proto.GradingBenchmark.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.GradingBenchmark} msg The msg instance to transform.
+ * @param {!proto.Organization} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.GradingBenchmark.toObject = function(includeInstance, msg) {
+proto.Organization.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    heading: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    comment: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    criteriaList: jspb.Message.toObjectList(msg.getCriteriaList(),
-    proto.GradingCriterion.toObject, includeInstance)
+    path: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    avatar: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    paymentplan: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -6630,23 +16285,23 @@ proto.GradingBenchmark.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.GradingBenchmark}
+ * @return {!proto.Organization}
  */
-proto.GradingBenchmark.deserializeBinary = function(bytes) {
+proto.Organization.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.GradingBenchmark;
-  return proto.GradingBenchmark.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.Organization;
+  return proto.Organization.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.GradingBenchmark} msg The message object to deserialize into.
+ * @param {!proto.Organization} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.GradingBenchmark}
+ * @return {!proto.Organization}
  */
-proto.GradingBenchmark.deserializeBinaryFromReader = function(msg, reader) {
+proto.Organization.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6658,21 +16313,16 @@ proto.GradingBenchmark.deserializeBinaryFromReader = function(msg, reader) {
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setHeading(value);
+      msg.setAvatar(value);
       break;
     case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setComment(value);
-      break;
-    case 5:
-      var value = new proto.GradingCriterion;
-      reader.readMessage(value,proto.GradingCriterion.deserializeBinaryFromReader);
-      msg.addCriteria(value);
+      msg.setPaymentplan(value);
       break;
     default:
       reader.skipField();
@@ -6687,9 +16337,9 @@ proto.GradingBenchmark.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.GradingBenchmark.prototype.serializeBinary = function() {
+proto.Organization.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.GradingBenchmark.serializeBinaryToWriter(this, writer);
+  proto.Organization.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6697,11 +16347,11 @@ proto.GradingBenchmark.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.GradingBenchmark} message
+ * @param {!proto.Organization} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.GradingBenchmark.serializeBinaryToWriter = function(message, writer) {
+proto.Organization.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -6710,35 +16360,27 @@ proto.GradingBenchmark.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getAssignmentid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getPath();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getHeading();
+  f = message.getAvatar();
   if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = message.getComment();
+  f = message.getPaymentplan();
   if (f.length > 0) {
     writer.writeString(
       4,
       f
     );
   }
-  f = message.getCriteriaList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      5,
-      f,
-      proto.GradingCriterion.serializeBinaryToWriter
-    );
-  }
 };
 
 
@@ -6746,119 +16388,81 @@ proto.GradingBenchmark.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.GradingBenchmark.prototype.getId = function() {
+proto.Organization.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.GradingBenchmark} returns this
+ * @return {!proto.Organization} returns this
  */
-proto.GradingBenchmark.prototype.setId = function(value) {
+proto.Organization.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 assignmentID = 2;
- * @return {number}
+ * optional string path = 2;
+ * @return {string}
  */
-proto.GradingBenchmark.prototype.getAssignmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.Organization.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.GradingBenchmark} returns this
+ * @param {string} value
+ * @return {!proto.Organization} returns this
  */
-proto.GradingBenchmark.prototype.setAssignmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.Organization.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string heading = 3;
+ * optional string avatar = 3;
  * @return {string}
  */
-proto.GradingBenchmark.prototype.getHeading = function() {
+proto.Organization.prototype.getAvatar = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.GradingBenchmark} returns this
+ * @return {!proto.Organization} returns this
  */
-proto.GradingBenchmark.prototype.setHeading = function(value) {
+proto.Organization.prototype.setAvatar = function(value) {
   return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string comment = 4;
+ * optional string paymentPlan = 4;
  * @return {string}
  */
-proto.GradingBenchmark.prototype.getComment = function() {
+proto.Organization.prototype.getPaymentplan = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.GradingBenchmark} returns this
+ * @return {!proto.Organization} returns this
  */
-proto.GradingBenchmark.prototype.setComment = function(value) {
+proto.Organization.prototype.setPaymentplan = function(value) {
   return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
-/**
- * repeated GradingCriterion criteria = 5;
- * @return {!Array<!proto.GradingCriterion>}
- */
-proto.GradingBenchmark.prototype.getCriteriaList = function() {
-  return /** @type{!Array<!proto.GradingCriterion>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.GradingCriterion, 5));
-};
-
-
-/**
- * @param {!Array<!proto.GradingCriterion>} value
- * @return {!proto.GradingBenchmark} returns this
-*/
-proto.GradingBenchmark.prototype.setCriteriaList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 5, value);
-};
-
-
-/**
- * @param {!proto.GradingCriterion=} opt_value
- * @param {number=} opt_index
- * @return {!proto.GradingCriterion}
- */
-proto.GradingBenchmark.prototype.addCriteria = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.GradingCriterion, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.GradingBenchmark} returns this
- */
-proto.GradingBenchmark.prototype.clearCriteriaList = function() {
-  return this.setCriteriaList([]);
-};
-
-
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.Benchmarks.repeatedFields_ = [1];
+proto.Organizations.repeatedFields_ = [1];
 
 
 
@@ -6875,8 +16479,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Benchmarks.prototype.toObject = function(opt_includeInstance) {
-  return proto.Benchmarks.toObject(opt_includeInstance, this);
+proto.Organizations.prototype.toObject = function(opt_includeInstance) {
+  return proto.Organizations.toObject(opt_includeInstance, this);
 };
 
 
@@ -6885,14 +16489,14 @@ proto.Benchmarks.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Benchmarks} msg The msg instance to transform.
+ * @param {!proto.Organizations} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Benchmarks.toObject = function(includeInstance, msg) {
+proto.Organizations.toObject = function(includeInstance, msg) {
   var f, obj = {
-    benchmarksList: jspb.Message.toObjectList(msg.getBenchmarksList(),
-    proto.GradingBenchmark.toObject, includeInstance)
+    organizationsList: jspb.Message.toObjectList(msg.getOrganizationsList(),
+    proto.Organization.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -6906,23 +16510,23 @@ proto.Benchmarks.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Benchmarks}
+ * @return {!proto.Organizations}
  */
-proto.Benchmarks.deserializeBinary = function(bytes) {
+proto.Organizations.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Benchmarks;
-  return proto.Benchmarks.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.Organizations;
+  return proto.Organizations.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Benchmarks} msg The message object to deserialize into.
+ * @param {!proto.Organizations} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Benchmarks}
+ * @return {!proto.Organizations}
  */
-proto.Benchmarks.deserializeBinaryFromReader = function(msg, reader) {
+proto.Organizations.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6930,9 +16534,9 @@ proto.Benchmarks.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.GradingBenchmark;
-      reader.readMessage(value,proto.GradingBenchmark.deserializeBinaryFromReader);
-      msg.addBenchmarks(value);
+      var value = new proto.Organization;
+      reader.readMessage(value,proto.Organization.deserializeBinaryFromReader);
+      msg.addOrganizations(value);
       break;
     default:
       reader.skipField();
@@ -6947,9 +16551,9 @@ proto.Benchmarks.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Benchmarks.prototype.serializeBinary = function() {
+proto.Organizations.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Benchmarks.serializeBinaryToWriter(this, writer);
+  proto.Organizations.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6957,62 +16561,69 @@ proto.Benchmarks.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Benchmarks} message
+ * @param {!proto.Organizations} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Benchmarks.serializeBinaryToWriter = function(message, writer) {
+proto.Organizations.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getBenchmarksList();
+  f = message.getOrganizationsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.GradingBenchmark.serializeBinaryToWriter
+      proto.Organization.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated GradingBenchmark benchmarks = 1;
- * @return {!Array<!proto.GradingBenchmark>}
+ * repeated Organization organizations = 1;
+ * @return {!Array<!proto.Organization>}
  */
-proto.Benchmarks.prototype.getBenchmarksList = function() {
-  return /** @type{!Array<!proto.GradingBenchmark>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.GradingBenchmark, 1));
+proto.Organizations.prototype.getOrganizationsList = function() {
+  return /** @type{!Array<!proto.Organization>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Organization, 1));
 };
 
 
 /**
- * @param {!Array<!proto.GradingBenchmark>} value
- * @return {!proto.Benchmarks} returns this
+ * @param {!Array<!proto.Organization>} value
+ * @return {!proto.Organizations} returns this
 */
-proto.Benchmarks.prototype.setBenchmarksList = function(value) {
+proto.Organizations.prototype.setOrganizationsList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.GradingBenchmark=} opt_value
+ * @param {!proto.Organization=} opt_value
  * @param {number=} opt_index
- * @return {!proto.GradingBenchmark}
+ * @return {!proto.Organization}
  */
-proto.Benchmarks.prototype.addBenchmarks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.GradingBenchmark, opt_index);
+proto.Organizations.prototype.addOrganizations = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Organization, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.Benchmarks} returns this
+ * @return {!proto.Organizations} returns this
  */
-proto.Benchmarks.prototype.clearBenchmarksList = function() {
-  return this.setBenchmarksList([]);
+proto.Organizations.prototype.clearOrganizationsList = function() {
+  return this.setOrganizationsList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.EnrollmentRequest.repeatedFields_ = [4];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -7028,8 +16639,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.GradingCriterion.prototype.toObject = function(opt_includeInstance) {
-  return proto.GradingCriterion.toObject(opt_includeInstance, this);
+proto.EnrollmentRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.EnrollmentRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -7038,18 +16649,17 @@ proto.GradingCriterion.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.GradingCriterion} msg The msg instance to transform.
+ * @param {!proto.EnrollmentRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.GradingCriterion.toObject = function(includeInstance, msg) {
+proto.EnrollmentRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    points: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    benchmarkid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    description: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    grade: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    comment: jspb.Message.getFieldWithDefault(msg, 6, "")
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    ignoregroupmembers: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    withactivity: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    statusesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    onlygroupmembers: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
   };
 
   if (includeInstance) {
@@ -7063,23 +16673,23 @@ proto.GradingCriterion.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.GradingCriterion}
+ * @return {!proto.EnrollmentRequest}
  */
-proto.GradingCriterion.deserializeBinary = function(bytes) {
+proto.EnrollmentRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.GradingCriterion;
-  return proto.GradingCriterion.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.EnrollmentRequest;
+  return proto.EnrollmentRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.GradingCriterion} msg The message object to deserialize into.
+ * @param {!proto.EnrollmentRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.GradingCriterion}
+ * @return {!proto.EnrollmentRequest}
  */
-proto.GradingCriterion.deserializeBinaryFromReader = function(msg, reader) {
+proto.EnrollmentRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7088,27 +16698,25 @@ proto.GradingCriterion.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      msg.setCourseid(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setPoints(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIgnoregroupmembers(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setBenchmarkid(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setWithactivity(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDescription(value);
+      var values = /** @type {!Array<!proto.Enrollment.UserStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addStatuses(values[i]);
+      }
       break;
     case 5:
-      var value = /** @type {!proto.GradingCriterion.Grade} */ (reader.readEnum());
-      msg.setGrade(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setComment(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setOnlygroupmembers(value);
       break;
     default:
       reader.skipField();
@@ -7123,9 +16731,9 @@ proto.GradingCriterion.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.GradingCriterion.prototype.serializeBinary = function() {
+proto.EnrollmentRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.GradingCriterion.serializeBinaryToWriter(this, writer);
+  proto.EnrollmentRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7133,171 +16741,156 @@ proto.GradingCriterion.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.GradingCriterion} message
+ * @param {!proto.EnrollmentRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.GradingCriterion.serializeBinaryToWriter = function(message, writer) {
+proto.EnrollmentRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getPoints();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getIgnoregroupmembers();
+  if (f) {
+    writer.writeBool(
       2,
       f
     );
   }
-  f = message.getBenchmarkid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getWithactivity();
+  if (f) {
+    writer.writeBool(
       3,
       f
     );
   }
-  f = message.getDescription();
+  f = message.getStatusesList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writePackedEnum(
       4,
       f
     );
   }
-  f = message.getGrade();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getOnlygroupmembers();
+  if (f) {
+    writer.writeBool(
       5,
       f
     );
   }
-  f = message.getComment();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
 };
 
 
 /**
- * @enum {number}
- */
-proto.GradingCriterion.Grade = {
-  NONE: 0,
-  FAILED: 1,
-  PASSED: 2
-};
-
-/**
- * optional uint64 ID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.GradingCriterion.prototype.getId = function() {
+proto.EnrollmentRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.GradingCriterion} returns this
+ * @return {!proto.EnrollmentRequest} returns this
  */
-proto.GradingCriterion.prototype.setId = function(value) {
+proto.EnrollmentRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 points = 2;
- * @return {number}
+ * optional bool ignoreGroupMembers = 2;
+ * @return {boolean}
  */
-proto.GradingCriterion.prototype.getPoints = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.EnrollmentRequest.prototype.getIgnoregroupmembers = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.GradingCriterion} returns this
+ * @param {boolean} value
+ * @return {!proto.EnrollmentRequest} returns this
  */
-proto.GradingCriterion.prototype.setPoints = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.EnrollmentRequest.prototype.setIgnoregroupmembers = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
 
 
 /**
- * optional uint64 benchmarkID = 3;
- * @return {number}
+ * optional bool withActivity = 3;
+ * @return {boolean}
  */
-proto.GradingCriterion.prototype.getBenchmarkid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.EnrollmentRequest.prototype.getWithactivity = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.GradingCriterion} returns this
+ * @param {boolean} value
+ * @return {!proto.EnrollmentRequest} returns this
  */
-proto.GradingCriterion.prototype.setBenchmarkid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.EnrollmentRequest.prototype.setWithactivity = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
 /**
- * optional string description = 4;
- * @return {string}
+ * repeated Enrollment.UserStatus statuses = 4;
+ * @return {!Array<!proto.Enrollment.UserStatus>}
  */
-proto.GradingCriterion.prototype.getDescription = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.EnrollmentRequest.prototype.getStatusesList = function() {
+  return /** @type {!Array<!proto.Enrollment.UserStatus>} */ (jspb.Message.getRepeatedField(this, 4));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.GradingCriterion} returns this
+ * @param {!Array<!proto.Enrollment.UserStatus>} value
+ * @return {!proto.EnrollmentRequest} returns this
  */
-proto.GradingCriterion.prototype.setDescription = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.EnrollmentRequest.prototype.setStatusesList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
 };
 
 
 /**
- * optional Grade grade = 5;
- * @return {!proto.GradingCriterion.Grade}
+ * @param {!proto.Enrollment.UserStatus} value
+ * @param {number=} opt_index
+ * @return {!proto.EnrollmentRequest} returns this
  */
-proto.GradingCriterion.prototype.getGrade = function() {
-  return /** @type {!proto.GradingCriterion.Grade} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.EnrollmentRequest.prototype.addStatuses = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
 };
 
 
 /**
- * @param {!proto.GradingCriterion.Grade} value
- * @return {!proto.GradingCriterion} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.EnrollmentRequest} returns this
  */
-proto.GradingCriterion.prototype.setGrade = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.EnrollmentRequest.prototype.clearStatusesList = function() {
+  return this.setStatusesList([]);
 };
 
 
 /**
- * optional string comment = 6;
- * @return {string}
+ * optional bool onlyGroupMembers = 5;
+ * @return {boolean}
  */
-proto.GradingCriterion.prototype.getComment = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.EnrollmentRequest.prototype.getOnlygroupmembers = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.GradingCriterion} returns this
+ * @param {boolean} value
+ * @return {!proto.EnrollmentRequest} returns this
  */
-proto.GradingCriterion.prototype.setComment = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.EnrollmentRequest.prototype.setOnlygroupmembers = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
 };
 
 
@@ -7307,7 +16900,7 @@ proto.GradingCriterion.prototype.setComment = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.Review.repeatedFields_ = [8];
+proto.EnrollmentStatusRequest.repeatedFields_ = [2];
 
 
 
@@ -7324,8 +16917,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Review.prototype.toObject = function(opt_includeInstance) {
-  return proto.Review.toObject(opt_includeInstance, this);
+proto.EnrollmentStatusRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.EnrollmentStatusRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -7334,22 +16927,14 @@ proto.Review.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Review} msg The msg instance to transform.
+ * @param {!proto.EnrollmentStatusRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Review.toObject = function(includeInstance, msg) {
+proto.EnrollmentStatusRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    reviewerid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    review: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    feedback: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    ready: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
-    score: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    benchmarksList: jspb.Message.toObjectList(msg.getBenchmarksList(),
-    proto.GradingBenchmark.toObject, includeInstance),
-    edited: jspb.Message.getFieldWithDefault(msg, 9, "")
+    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    statusesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -7363,23 +16948,23 @@ proto.Review.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Review}
+ * @return {!proto.EnrollmentStatusRequest}
  */
-proto.Review.deserializeBinary = function(bytes) {
+proto.EnrollmentStatusRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Review;
-  return proto.Review.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.EnrollmentStatusRequest;
+  return proto.EnrollmentStatusRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Review} msg The message object to deserialize into.
+ * @param {!proto.EnrollmentStatusRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Review}
+ * @return {!proto.EnrollmentStatusRequest}
  */
-proto.Review.deserializeBinaryFromReader = function(msg, reader) {
+proto.EnrollmentStatusRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7388,40 +16973,13 @@ proto.Review.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      msg.setUserid(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setSubmissionid(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setReviewerid(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setReview(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setFeedback(value);
-      break;
-    case 6:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReady(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setScore(value);
-      break;
-    case 8:
-      var value = new proto.GradingBenchmark;
-      reader.readMessage(value,proto.GradingBenchmark.deserializeBinaryFromReader);
-      msg.addBenchmarks(value);
-      break;
-    case 9:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEdited(value);
+      var values = /** @type {!Array<!proto.Enrollment.UserStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addStatuses(values[i]);
+      }
       break;
     default:
       reader.skipField();
@@ -7436,83 +16994,33 @@ proto.Review.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Review.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.Review.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
-
-
-/**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.Review} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.Review.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getId();
-  if (f !== 0) {
-    writer.writeUint64(
-      1,
-      f
-    );
-  }
-  f = message.getSubmissionid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = message.getReviewerid();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
-      f
-    );
-  }
-  f = message.getReview();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getFeedback();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getReady();
-  if (f) {
-    writer.writeBool(
-      6,
-      f
-    );
-  }
-  f = message.getScore();
+proto.EnrollmentStatusRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.EnrollmentStatusRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.EnrollmentStatusRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.EnrollmentStatusRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUserid();
   if (f !== 0) {
     writer.writeUint64(
-      7,
+      1,
       f
     );
   }
-  f = message.getBenchmarksList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      8,
-      f,
-      proto.GradingBenchmark.serializeBinaryToWriter
-    );
-  }
-  f = message.getEdited();
+  f = message.getStatusesList();
   if (f.length > 0) {
-    writer.writeString(
-      9,
+    writer.writePackedEnum(
+      2,
       f
     );
   }
@@ -7520,195 +17028,221 @@ proto.Review.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional uint64 ID = 1;
+ * optional uint64 userID = 1;
  * @return {number}
  */
-proto.Review.prototype.getId = function() {
+proto.EnrollmentStatusRequest.prototype.getUserid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Review} returns this
+ * @return {!proto.EnrollmentStatusRequest} returns this
  */
-proto.Review.prototype.setId = function(value) {
+proto.EnrollmentStatusRequest.prototype.setUserid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 submissionID = 2;
- * @return {number}
+ * repeated Enrollment.UserStatus statuses = 2;
+ * @return {!Array<!proto.Enrollment.UserStatus>}
  */
-proto.Review.prototype.getSubmissionid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.EnrollmentStatusRequest.prototype.getStatusesList = function() {
+  return /** @type {!Array<!proto.Enrollment.UserStatus>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Review} returns this
+ * @param {!Array<!proto.Enrollment.UserStatus>} value
+ * @return {!proto.EnrollmentStatusRequest} returns this
  */
-proto.Review.prototype.setSubmissionid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.EnrollmentStatusRequest.prototype.setStatusesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * optional uint64 reviewerID = 3;
- * @return {number}
+ * @param {!proto.Enrollment.UserStatus} value
+ * @param {number=} opt_index
+ * @return {!proto.EnrollmentStatusRequest} returns this
  */
-proto.Review.prototype.getReviewerid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.EnrollmentStatusRequest.prototype.addStatuses = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Review} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.EnrollmentStatusRequest} returns this
  */
-proto.Review.prototype.setReviewerid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.EnrollmentStatusRequest.prototype.clearStatusesList = function() {
+  return this.setStatusesList([]);
 };
 
 
-/**
- * optional string review = 4;
- * @return {string}
- */
-proto.Review.prototype.getReview = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
 
-/**
- * @param {string} value
- * @return {!proto.Review} returns this
- */
-proto.Review.prototype.setReview = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string feedback = 5;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.Review.prototype.getFeedback = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.RejectEnrollmentsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.RejectEnrollmentsRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Review} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.RejectEnrollmentsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Review.prototype.setFeedback = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
-};
-
+proto.RejectEnrollmentsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    reason: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
 
-/**
- * optional bool ready = 6;
- * @return {boolean}
- */
-proto.Review.prototype.getReady = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {boolean} value
- * @return {!proto.Review} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.RejectEnrollmentsRequest}
  */
-proto.Review.prototype.setReady = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 6, value);
+proto.RejectEnrollmentsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.RejectEnrollmentsRequest;
+  return proto.RejectEnrollmentsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional uint64 score = 7;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.RejectEnrollmentsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.RejectEnrollmentsRequest}
  */
-proto.Review.prototype.getScore = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.RejectEnrollmentsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReason(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Review} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.Review.prototype.setScore = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
+proto.RejectEnrollmentsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.RejectEnrollmentsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * repeated GradingBenchmark benchmarks = 8;
- * @return {!Array<!proto.GradingBenchmark>}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.RejectEnrollmentsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Review.prototype.getBenchmarksList = function() {
-  return /** @type{!Array<!proto.GradingBenchmark>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.GradingBenchmark, 8));
-};
-
-
-/**
- * @param {!Array<!proto.GradingBenchmark>} value
- * @return {!proto.Review} returns this
-*/
-proto.Review.prototype.setBenchmarksList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+proto.RejectEnrollmentsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getReason();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {!proto.GradingBenchmark=} opt_value
- * @param {number=} opt_index
- * @return {!proto.GradingBenchmark}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.Review.prototype.addBenchmarks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.GradingBenchmark, opt_index);
+proto.RejectEnrollmentsRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Review} returns this
+ * @param {number} value
+ * @return {!proto.RejectEnrollmentsRequest} returns this
  */
-proto.Review.prototype.clearBenchmarksList = function() {
-  return this.setBenchmarksList([]);
+proto.RejectEnrollmentsRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string edited = 9;
+ * optional string reason = 2;
  * @return {string}
  */
-proto.Review.prototype.getEdited = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.RejectEnrollmentsRequest.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.Review} returns this
+ * @return {!proto.RejectEnrollmentsRequest} returns this
  */
-proto.Review.prototype.setEdited = function(value) {
-  return jspb.Message.setProto3StringField(this, 9, value);
+proto.RejectEnrollmentsRequest.prototype.setReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.Reviewers.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -7724,8 +17258,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Reviewers.prototype.toObject = function(opt_includeInstance) {
-  return proto.Reviewers.toObject(opt_includeInstance, this);
+proto.EnrollmentDetailsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.EnrollmentDetailsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -7734,14 +17268,14 @@ proto.Reviewers.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Reviewers} msg The msg instance to transform.
+ * @param {!proto.EnrollmentDetailsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Reviewers.toObject = function(includeInstance, msg) {
+proto.EnrollmentDetailsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    reviewersList: jspb.Message.toObjectList(msg.getReviewersList(),
-    proto.User.toObject, includeInstance)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    withdetails: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
   };
 
   if (includeInstance) {
@@ -7755,23 +17289,23 @@ proto.Reviewers.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Reviewers}
+ * @return {!proto.EnrollmentDetailsRequest}
  */
-proto.Reviewers.deserializeBinary = function(bytes) {
+proto.EnrollmentDetailsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Reviewers;
-  return proto.Reviewers.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.EnrollmentDetailsRequest;
+  return proto.EnrollmentDetailsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Reviewers} msg The message object to deserialize into.
+ * @param {!proto.EnrollmentDetailsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Reviewers}
+ * @return {!proto.EnrollmentDetailsRequest}
  */
-proto.Reviewers.deserializeBinaryFromReader = function(msg, reader) {
+proto.EnrollmentDetailsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7779,9 +17313,12 @@ proto.Reviewers.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.User;
-      reader.readMessage(value,proto.User.deserializeBinaryFromReader);
-      msg.addReviewers(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setWithdetails(value);
       break;
     default:
       reader.skipField();
@@ -7796,9 +17333,9 @@ proto.Reviewers.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Reviewers.prototype.serializeBinary = function() {
+proto.EnrollmentDetailsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Reviewers.serializeBinaryToWriter(this, writer);
+  proto.EnrollmentDetailsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7806,58 +17343,62 @@ proto.Reviewers.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Reviewers} message
+ * @param {!proto.EnrollmentDetailsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Reviewers.serializeBinaryToWriter = function(message, writer) {
+proto.EnrollmentDetailsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getReviewersList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.User.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getWithdetails();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
     );
   }
 };
 
 
 /**
- * repeated User reviewers = 1;
- * @return {!Array<!proto.User>}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.Reviewers.prototype.getReviewersList = function() {
-  return /** @type{!Array<!proto.User>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.User, 1));
+proto.EnrollmentDetailsRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!Array<!proto.User>} value
- * @return {!proto.Reviewers} returns this
-*/
-proto.Reviewers.prototype.setReviewersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.EnrollmentDetailsRequest} returns this
+ */
+proto.EnrollmentDetailsRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.User=} opt_value
- * @param {number=} opt_index
- * @return {!proto.User}
+ * optional bool withDetails = 2;
+ * @return {boolean}
  */
-proto.Reviewers.prototype.addReviewers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.User, opt_index);
+proto.EnrollmentDetailsRequest.prototype.getWithdetails = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Reviewers} returns this
+ * @param {boolean} value
+ * @return {!proto.EnrollmentDetailsRequest} returns this
  */
-proto.Reviewers.prototype.clearReviewersList = function() {
-  return this.setReviewersList([]);
+proto.EnrollmentDetailsRequest.prototype.setWithdetails = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
 
 
@@ -7877,8 +17418,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ReviewRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ReviewRequest.toObject(opt_includeInstance, this);
+proto.AssignmentSubmissionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.AssignmentSubmissionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -7887,14 +17428,14 @@ proto.ReviewRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ReviewRequest} msg The msg instance to transform.
+ * @param {!proto.AssignmentSubmissionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ReviewRequest.toObject = function(includeInstance, msg) {
+proto.AssignmentSubmissionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    review: (f = msg.getReview()) && proto.Review.toObject(includeInstance, f)
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -7908,23 +17449,23 @@ proto.ReviewRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ReviewRequest}
+ * @return {!proto.AssignmentSubmissionRequest}
  */
-proto.ReviewRequest.deserializeBinary = function(bytes) {
+proto.AssignmentSubmissionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ReviewRequest;
-  return proto.ReviewRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.AssignmentSubmissionRequest;
+  return proto.AssignmentSubmissionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ReviewRequest} msg The message object to deserialize into.
+ * @param {!proto.AssignmentSubmissionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ReviewRequest}
+ * @return {!proto.AssignmentSubmissionRequest}
  */
-proto.ReviewRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.AssignmentSubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7936,9 +17477,8 @@ proto.ReviewRequest.deserializeBinaryFromReader = function(msg, reader) {
       msg.setCourseid(value);
       break;
     case 2:
-      var value = new proto.Review;
-      reader.readMessage(value,proto.Review.deserializeBinaryFromReader);
-      msg.setReview(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
       break;
     default:
       reader.skipField();
@@ -7953,9 +17493,9 @@ proto.ReviewRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ReviewRequest.prototype.serializeBinary = function() {
+proto.AssignmentSubmissionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ReviewRequest.serializeBinaryToWriter(this, writer);
+  proto.AssignmentSubmissionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7963,11 +17503,11 @@ proto.ReviewRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ReviewRequest} message
+ * @param {!proto.AssignmentSubmissionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ReviewRequest.serializeBinaryToWriter = function(message, writer) {
+proto.AssignmentSubmissionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getCourseid();
   if (f !== 0) {
@@ -7976,12 +17516,11 @@ proto.ReviewRequest.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getReview();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
-      f,
-      proto.Review.serializeBinaryToWriter
+      f
     );
   }
 };
@@ -7991,54 +17530,35 @@ proto.ReviewRequest.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.ReviewRequest.prototype.getCourseid = function() {
+proto.AssignmentSubmissionRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ReviewRequest} returns this
+ * @return {!proto.AssignmentSubmissionRequest} returns this
  */
-proto.ReviewRequest.prototype.setCourseid = function(value) {
+proto.AssignmentSubmissionRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional Review review = 2;
- * @return {?proto.Review}
- */
-proto.ReviewRequest.prototype.getReview = function() {
-  return /** @type{?proto.Review} */ (
-    jspb.Message.getWrapperField(this, proto.Review, 2));
-};
-
-
-/**
- * @param {?proto.Review|undefined} value
- * @return {!proto.ReviewRequest} returns this
-*/
-proto.ReviewRequest.prototype.setReview = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.ReviewRequest} returns this
+ * optional uint64 assignmentID = 2;
+ * @return {number}
  */
-proto.ReviewRequest.prototype.clearReview = function() {
-  return this.setReview(undefined);
+proto.AssignmentSubmissionRequest.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.AssignmentSubmissionRequest} returns this
  */
-proto.ReviewRequest.prototype.hasReview = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.AssignmentSubmissionRequest.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
@@ -8058,8 +17578,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.CourseRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.CourseRequest.toObject(opt_includeInstance, this);
+proto.AutoApproveRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.AutoApproveRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8068,13 +17588,16 @@ proto.CourseRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.CourseRequest} msg The msg instance to transform.
+ * @param {!proto.AutoApproveRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.CourseRequest.toObject = function(includeInstance, msg) {
+proto.AutoApproveRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    enabled: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    scorelimit: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -8088,23 +17611,23 @@ proto.CourseRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.CourseRequest}
+ * @return {!proto.AutoApproveRequest}
  */
-proto.CourseRequest.deserializeBinary = function(bytes) {
+proto.AutoApproveRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.CourseRequest;
-  return proto.CourseRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.AutoApproveRequest;
+  return proto.AutoApproveRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.CourseRequest} msg The message object to deserialize into.
+ * @param {!proto.AutoApproveRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.CourseRequest}
+ * @return {!proto.AutoApproveRequest}
  */
-proto.CourseRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.AutoApproveRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8115,6 +17638,18 @@ proto.CourseRequest.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {number} */ (reader.readUint64());
       msg.setCourseid(value);
       break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setEnabled(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScorelimit(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -8128,9 +17663,9 @@ proto.CourseRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.CourseRequest.prototype.serializeBinary = function() {
+proto.AutoApproveRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.CourseRequest.serializeBinaryToWriter(this, writer);
+  proto.AutoApproveRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8138,11 +17673,11 @@ proto.CourseRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.CourseRequest} message
+ * @param {!proto.AutoApproveRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.CourseRequest.serializeBinaryToWriter = function(message, writer) {
+proto.AutoApproveRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getCourseid();
   if (f !== 0) {
@@ -8151,6 +17686,27 @@ proto.CourseRequest.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getEnabled();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getScorelimit();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
 };
 
 
@@ -8158,20 +17714,74 @@ proto.CourseRequest.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.CourseRequest.prototype.getCourseid = function() {
+proto.AutoApproveRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.CourseRequest} returns this
+ * @return {!proto.AutoApproveRequest} returns this
  */
-proto.CourseRequest.prototype.setCourseid = function(value) {
+proto.AutoApproveRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
+/**
+ * optional uint64 assignmentID = 2;
+ * @return {number}
+ */
+proto.AutoApproveRequest.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.AutoApproveRequest} returns this
+ */
+proto.AutoApproveRequest.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional bool enabled = 3;
+ * @return {boolean}
+ */
+proto.AutoApproveRequest.prototype.getEnabled = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.AutoApproveRequest} returns this
+ */
+proto.AutoApproveRequest.prototype.setEnabled = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 scoreLimit = 4;
+ * @return {number}
+ */
+proto.AutoApproveRequest.prototype.getScorelimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.AutoApproveRequest} returns this
+ */
+proto.AutoApproveRequest.prototype.setScorelimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
 
 
 
@@ -8188,8 +17798,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.UserRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.UserRequest.toObject(opt_includeInstance, this);
+proto.AssignmentRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.AssignmentRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8198,13 +17808,14 @@ proto.UserRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.UserRequest} msg The msg instance to transform.
+ * @param {!proto.AssignmentRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.UserRequest.toObject = function(includeInstance, msg) {
+proto.AssignmentRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    userid: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -8218,23 +17829,23 @@ proto.UserRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.UserRequest}
+ * @return {!proto.AssignmentRequest}
  */
-proto.UserRequest.deserializeBinary = function(bytes) {
+proto.AssignmentRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.UserRequest;
-  return proto.UserRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.AssignmentRequest;
+  return proto.AssignmentRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.UserRequest} msg The message object to deserialize into.
+ * @param {!proto.AssignmentRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.UserRequest}
+ * @return {!proto.AssignmentRequest}
  */
-proto.UserRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.AssignmentRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8243,7 +17854,11 @@ proto.UserRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
       break;
     default:
       reader.skipField();
@@ -8258,9 +17873,9 @@ proto.UserRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.UserRequest.prototype.serializeBinary = function() {
+proto.AssignmentRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.UserRequest.serializeBinaryToWriter(this, writer);
+  proto.AssignmentRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8268,40 +17883,65 @@ proto.UserRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.UserRequest} message
+ * @param {!proto.AssignmentRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.UserRequest.serializeBinaryToWriter = function(message, writer) {
+proto.AssignmentRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUserid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint64 userID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.UserRequest.prototype.getUserid = function() {
+proto.AssignmentRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.UserRequest} returns this
+ * @return {!proto.AssignmentRequest} returns this
  */
-proto.UserRequest.prototype.setUserid = function(value) {
+proto.AssignmentRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
+/**
+ * optional uint64 assignmentID = 2;
+ * @return {number}
+ */
+proto.AssignmentRequest.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.AssignmentRequest} returns this
+ */
+proto.AssignmentRequest.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
 
 
 
@@ -8318,8 +17958,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.GetGroupRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.GetGroupRequest.toObject(opt_includeInstance, this);
+proto.CommitSubmissionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.CommitSubmissionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8328,13 +17968,15 @@ proto.GetGroupRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.GetGroupRequest} msg The msg instance to transform.
+ * @param {!proto.CommitSubmissionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.GetGroupRequest.toObject = function(includeInstance, msg) {
+proto.CommitSubmissionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    groupid: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    repositoryid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    commithash: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -8348,23 +17990,23 @@ proto.GetGroupRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.GetGroupRequest}
+ * @return {!proto.CommitSubmissionRequest}
  */
-proto.GetGroupRequest.deserializeBinary = function(bytes) {
+proto.CommitSubmissionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.GetGroupRequest;
-  return proto.GetGroupRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.CommitSubmissionRequest;
+  return proto.CommitSubmissionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.GetGroupRequest} msg The message object to deserialize into.
+ * @param {!proto.CommitSubmissionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.GetGroupRequest}
+ * @return {!proto.CommitSubmissionRequest}
  */
-proto.GetGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.CommitSubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8373,7 +18015,15 @@ proto.GetGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setRepositoryid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommithash(value);
       break;
     default:
       reader.skipField();
@@ -8388,9 +18038,9 @@ proto.GetGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.GetGroupRequest.prototype.serializeBinary = function() {
+proto.CommitSubmissionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.GetGroupRequest.serializeBinaryToWriter(this, writer);
+  proto.CommitSubmissionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8398,40 +18048,90 @@ proto.GetGroupRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.GetGroupRequest} message
+ * @param {!proto.CommitSubmissionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.GetGroupRequest.serializeBinaryToWriter = function(message, writer) {
+proto.CommitSubmissionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getGroupid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
+  f = message.getRepositoryid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getCommithash();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint64 groupID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.GetGroupRequest.prototype.getGroupid = function() {
+proto.CommitSubmissionRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.GetGroupRequest} returns this
+ * @return {!proto.CommitSubmissionRequest} returns this
  */
-proto.GetGroupRequest.prototype.setGroupid = function(value) {
+proto.CommitSubmissionRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
+/**
+ * optional uint64 repositoryID = 2;
+ * @return {number}
+ */
+proto.CommitSubmissionRequest.prototype.getRepositoryid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.CommitSubmissionRequest} returns this
+ */
+proto.CommitSubmissionRequest.prototype.setRepositoryid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string commitHash = 3;
+ * @return {string}
+ */
+proto.CommitSubmissionRequest.prototype.getCommithash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.CommitSubmissionRequest} returns this
+ */
+proto.CommitSubmissionRequest.prototype.setCommithash = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
 
 
 
@@ -8448,8 +18148,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.GroupRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.GroupRequest.toObject(opt_includeInstance, this);
+proto.SubmissionHistoryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.SubmissionHistoryRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8458,15 +18158,15 @@ proto.GroupRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.GroupRequest} msg The msg instance to transform.
+ * @param {!proto.SubmissionHistoryRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.GroupRequest.toObject = function(includeInstance, msg) {
+proto.SubmissionHistoryRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -8480,23 +18180,23 @@ proto.GroupRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.GroupRequest}
+ * @return {!proto.SubmissionHistoryRequest}
  */
-proto.GroupRequest.deserializeBinary = function(bytes) {
+proto.SubmissionHistoryRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.GroupRequest;
-  return proto.GroupRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.SubmissionHistoryRequest;
+  return proto.SubmissionHistoryRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.GroupRequest} msg The message object to deserialize into.
+ * @param {!proto.SubmissionHistoryRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.GroupRequest}
+ * @return {!proto.SubmissionHistoryRequest}
  */
-proto.GroupRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.SubmissionHistoryRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8505,15 +18205,15 @@ proto.GroupRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      msg.setCourseid(value);
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
+      msg.setUserid(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      msg.setAssignmentid(value);
       break;
     default:
       reader.skipField();
@@ -8528,9 +18228,9 @@ proto.GroupRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.GroupRequest.prototype.serializeBinary = function() {
+proto.SubmissionHistoryRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.GroupRequest.serializeBinaryToWriter(this, writer);
+  proto.SubmissionHistoryRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8538,27 +18238,27 @@ proto.GroupRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.GroupRequest} message
+ * @param {!proto.SubmissionHistoryRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.GroupRequest.serializeBinaryToWriter = function(message, writer) {
+proto.SubmissionHistoryRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUserid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getGroupid();
+  f = message.getUserid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getCourseid();
+  f = message.getAssignmentid();
   if (f !== 0) {
     writer.writeUint64(
       3,
@@ -8569,55 +18269,55 @@ proto.GroupRequest.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional uint64 userID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.GroupRequest.prototype.getUserid = function() {
+proto.SubmissionHistoryRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.GroupRequest} returns this
+ * @return {!proto.SubmissionHistoryRequest} returns this
  */
-proto.GroupRequest.prototype.setUserid = function(value) {
+proto.SubmissionHistoryRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 groupID = 2;
+ * optional uint64 userID = 2;
  * @return {number}
  */
-proto.GroupRequest.prototype.getGroupid = function() {
+proto.SubmissionHistoryRequest.prototype.getUserid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.GroupRequest} returns this
+ * @return {!proto.SubmissionHistoryRequest} returns this
  */
-proto.GroupRequest.prototype.setGroupid = function(value) {
+proto.SubmissionHistoryRequest.prototype.setUserid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 courseID = 3;
+ * optional uint64 assignmentID = 3;
  * @return {number}
  */
-proto.GroupRequest.prototype.getCourseid = function() {
+proto.SubmissionHistoryRequest.prototype.getAssignmentid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.GroupRequest} returns this
+ * @return {!proto.SubmissionHistoryRequest} returns this
  */
-proto.GroupRequest.prototype.setCourseid = function(value) {
+proto.SubmissionHistoryRequest.prototype.setAssignmentid = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
@@ -8638,8 +18338,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Provider.prototype.toObject = function(opt_includeInstance) {
-  return proto.Provider.toObject(opt_includeInstance, this);
+proto.SubmissionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.SubmissionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8648,13 +18348,18 @@ proto.Provider.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Provider} msg The msg instance to transform.
+ * @param {!proto.SubmissionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Provider.toObject = function(includeInstance, msg) {
+proto.SubmissionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    provider: jspb.Message.getFieldWithDefault(msg, 1, "")
+    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    filter: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    order: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    category: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -8668,23 +18373,23 @@ proto.Provider.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Provider}
+ * @return {!proto.SubmissionRequest}
  */
-proto.Provider.deserializeBinary = function(bytes) {
+proto.SubmissionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Provider;
-  return proto.Provider.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.SubmissionRequest;
+  return proto.SubmissionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Provider} msg The message object to deserialize into.
+ * @param {!proto.SubmissionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Provider}
+ * @return {!proto.SubmissionRequest}
  */
-proto.Provider.deserializeBinaryFromReader = function(msg, reader) {
+proto.SubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8692,8 +18397,28 @@ proto.Provider.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.SubmissionRequest.Filter} */ (reader.readEnum());
+      msg.setFilter(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.SubmissionRequest.Order} */ (reader.readEnum());
+      msg.setOrder(value);
+      break;
+    case 6:
       var value = /** @type {string} */ (reader.readString());
-      msg.setProvider(value);
+      msg.setCategory(value);
       break;
     default:
       reader.skipField();
@@ -8708,9 +18433,9 @@ proto.Provider.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Provider.prototype.serializeBinary = function() {
+proto.SubmissionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Provider.serializeBinaryToWriter(this, writer);
+  proto.SubmissionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8718,16 +18443,51 @@ proto.Provider.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Provider} message
+ * @param {!proto.SubmissionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Provider.serializeBinaryToWriter = function(message, writer) {
+proto.SubmissionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getProvider();
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getFilter();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+  f = message.getOrder();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getCategory();
   if (f.length > 0) {
     writer.writeString(
-      1,
+      6,
       f
     );
   }
@@ -8735,150 +18495,127 @@ proto.Provider.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional string provider = 1;
- * @return {string}
+ * @enum {number}
  */
-proto.Provider.prototype.getProvider = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.SubmissionRequest.Filter = {
+  ALL: 0,
+  APPROVED: 1,
+  UNAPPROVED: 2
 };
 
-
 /**
- * @param {string} value
- * @return {!proto.Provider} returns this
+ * @enum {number}
  */
-proto.Provider.prototype.setProvider = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.SubmissionRequest.Order = {
+  NEWEST: 0,
+  OLDEST: 1
 };
 
+/**
+ * optional uint64 userID = 1;
+ * @return {number}
+ */
+proto.SubmissionRequest.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
 
 
+/**
+ * @param {number} value
+ * @return {!proto.SubmissionRequest} returns this
+ */
+proto.SubmissionRequest.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional uint64 groupID = 2;
+ * @return {number}
+ */
+proto.SubmissionRequest.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.SubmissionRequest} returns this
  */
-proto.OrgRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.OrgRequest.toObject(opt_includeInstance, this);
+proto.SubmissionRequest.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.OrgRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional uint64 courseID = 3;
+ * @return {number}
  */
-proto.OrgRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    orgname: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
+proto.SubmissionRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * @param {number} value
+ * @return {!proto.SubmissionRequest} returns this
+ */
+proto.SubmissionRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.OrgRequest}
+ * optional Filter filter = 4;
+ * @return {!proto.SubmissionRequest.Filter}
  */
-proto.OrgRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.OrgRequest;
-  return proto.OrgRequest.deserializeBinaryFromReader(msg, reader);
+proto.SubmissionRequest.prototype.getFilter = function() {
+  return /** @type {!proto.SubmissionRequest.Filter} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.OrgRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.OrgRequest}
+ * @param {!proto.SubmissionRequest.Filter} value
+ * @return {!proto.SubmissionRequest} returns this
  */
-proto.OrgRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setOrgname(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.SubmissionRequest.prototype.setFilter = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional Order order = 5;
+ * @return {!proto.SubmissionRequest.Order}
  */
-proto.OrgRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.OrgRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.SubmissionRequest.prototype.getOrder = function() {
+  return /** @type {!proto.SubmissionRequest.Order} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.OrgRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {!proto.SubmissionRequest.Order} value
+ * @return {!proto.SubmissionRequest} returns this
  */
-proto.OrgRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getOrgname();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.SubmissionRequest.prototype.setOrder = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
 };
 
 
 /**
- * optional string orgName = 1;
+ * optional string category = 6;
  * @return {string}
  */
-proto.OrgRequest.prototype.getOrgname = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.SubmissionRequest.prototype.getCategory = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.OrgRequest} returns this
+ * @return {!proto.SubmissionRequest} returns this
  */
-proto.OrgRequest.prototype.setOrgname = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.SubmissionRequest.prototype.setCategory = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
@@ -8898,8 +18635,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Organization.prototype.toObject = function(opt_includeInstance) {
-  return proto.Organization.toObject(opt_includeInstance, this);
+proto.UpdateSubmissionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.UpdateSubmissionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8908,16 +18645,18 @@ proto.Organization.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Organization} msg The msg instance to transform.
+ * @param {!proto.UpdateSubmissionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Organization.toObject = function(includeInstance, msg) {
+proto.UpdateSubmissionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    path: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    avatar: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    paymentplan: jspb.Message.getFieldWithDefault(msg, 4, "")
+    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    score: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    released: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    status: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    extraattempts: jspb.Message.getFieldWithDefault(msg, 6, 0)
   };
 
   if (includeInstance) {
@@ -8931,23 +18670,23 @@ proto.Organization.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Organization}
+ * @return {!proto.UpdateSubmissionRequest}
  */
-proto.Organization.deserializeBinary = function(bytes) {
+proto.UpdateSubmissionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Organization;
-  return proto.Organization.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.UpdateSubmissionRequest;
+  return proto.UpdateSubmissionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Organization} msg The message object to deserialize into.
+ * @param {!proto.UpdateSubmissionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Organization}
+ * @return {!proto.UpdateSubmissionRequest}
  */
-proto.Organization.deserializeBinaryFromReader = function(msg, reader) {
+proto.UpdateSubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8956,19 +18695,27 @@ proto.Organization.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      msg.setSubmissionid(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setAvatar(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScore(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPaymentplan(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReleased(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.Submission.Status} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setExtraattempts(value);
       break;
     default:
       reader.skipField();
@@ -8983,9 +18730,9 @@ proto.Organization.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Organization.prototype.serializeBinary = function() {
+proto.UpdateSubmissionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Organization.serializeBinaryToWriter(this, writer);
+  proto.UpdateSubmissionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8993,123 +18740,166 @@ proto.Organization.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Organization} message
+ * @param {!proto.UpdateSubmissionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Organization.serializeBinaryToWriter = function(message, writer) {
+proto.UpdateSubmissionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getSubmissionid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getPath();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getAvatar();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getScore();
+  if (f !== 0) {
+    writer.writeUint32(
       3,
       f
     );
   }
-  f = message.getPaymentplan();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getReleased();
+  if (f) {
+    writer.writeBool(
       4,
       f
     );
   }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getExtraattempts();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint64 ID = 1;
+ * optional uint64 submissionID = 1;
  * @return {number}
  */
-proto.Organization.prototype.getId = function() {
+proto.UpdateSubmissionRequest.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Organization} returns this
+ * @return {!proto.UpdateSubmissionRequest} returns this
  */
-proto.Organization.prototype.setId = function(value) {
+proto.UpdateSubmissionRequest.prototype.setSubmissionid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string path = 2;
- * @return {string}
+ * optional uint64 courseID = 2;
+ * @return {number}
  */
-proto.Organization.prototype.getPath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.UpdateSubmissionRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Organization} returns this
+ * @param {number} value
+ * @return {!proto.UpdateSubmissionRequest} returns this
  */
-proto.Organization.prototype.setPath = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.UpdateSubmissionRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional string avatar = 3;
- * @return {string}
+ * optional uint32 score = 3;
+ * @return {number}
  */
-proto.Organization.prototype.getAvatar = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.UpdateSubmissionRequest.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.UpdateSubmissionRequest} returns this
+ */
+proto.UpdateSubmissionRequest.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional bool released = 4;
+ * @return {boolean}
+ */
+proto.UpdateSubmissionRequest.prototype.getReleased = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.UpdateSubmissionRequest} returns this
+ */
+proto.UpdateSubmissionRequest.prototype.setReleased = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * optional Submission.Status status = 5;
+ * @return {!proto.Submission.Status}
+ */
+proto.UpdateSubmissionRequest.prototype.getStatus = function() {
+  return /** @type {!proto.Submission.Status} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Organization} returns this
+ * @param {!proto.Submission.Status} value
+ * @return {!proto.UpdateSubmissionRequest} returns this
  */
-proto.Organization.prototype.setAvatar = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.UpdateSubmissionRequest.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
 };
 
 
 /**
- * optional string paymentPlan = 4;
- * @return {string}
+ * optional uint32 extraAttempts = 6;
+ * @return {number}
  */
-proto.Organization.prototype.getPaymentplan = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.UpdateSubmissionRequest.prototype.getExtraattempts = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Organization} returns this
+ * @param {number} value
+ * @return {!proto.UpdateSubmissionRequest} returns this
  */
-proto.Organization.prototype.setPaymentplan = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.UpdateSubmissionRequest.prototype.setExtraattempts = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.Organizations.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -9125,8 +18915,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Organizations.prototype.toObject = function(opt_includeInstance) {
-  return proto.Organizations.toObject(opt_includeInstance, this);
+proto.UpdateSubmissionsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.UpdateSubmissionsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -9135,14 +18925,17 @@ proto.Organizations.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Organizations} msg The msg instance to transform.
+ * @param {!proto.UpdateSubmissionsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Organizations.toObject = function(includeInstance, msg) {
+proto.UpdateSubmissionsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    organizationsList: jspb.Message.toObjectList(msg.getOrganizationsList(),
-    proto.Organization.toObject, includeInstance)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    scorelimit: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    release: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    approve: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
   };
 
   if (includeInstance) {
@@ -9156,23 +18949,23 @@ proto.Organizations.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Organizations}
+ * @return {!proto.UpdateSubmissionsRequest}
  */
-proto.Organizations.deserializeBinary = function(bytes) {
+proto.UpdateSubmissionsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Organizations;
-  return proto.Organizations.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.UpdateSubmissionsRequest;
+  return proto.UpdateSubmissionsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Organizations} msg The message object to deserialize into.
+ * @param {!proto.UpdateSubmissionsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Organizations}
+ * @return {!proto.UpdateSubmissionsRequest}
  */
-proto.Organizations.deserializeBinaryFromReader = function(msg, reader) {
+proto.UpdateSubmissionsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9180,9 +18973,24 @@ proto.Organizations.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.Organization;
-      reader.readMessage(value,proto.Organization.deserializeBinaryFromReader);
-      msg.addOrganizations(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScorelimit(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRelease(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setApprove(value);
       break;
     default:
       reader.skipField();
@@ -9197,9 +19005,9 @@ proto.Organizations.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Organizations.prototype.serializeBinary = function() {
+proto.UpdateSubmissionsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Organizations.serializeBinaryToWriter(this, writer);
+  proto.UpdateSubmissionsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9207,58 +19015,137 @@ proto.Organizations.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Organizations} message
+ * @param {!proto.UpdateSubmissionsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Organizations.serializeBinaryToWriter = function(message, writer) {
+proto.UpdateSubmissionsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getOrganizationsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.Organization.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getScorelimit();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getRelease();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getApprove();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
     );
   }
 };
 
 
 /**
- * repeated Organization organizations = 1;
- * @return {!Array<!proto.Organization>}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.Organizations.prototype.getOrganizationsList = function() {
-  return /** @type{!Array<!proto.Organization>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Organization, 1));
+proto.UpdateSubmissionsRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!Array<!proto.Organization>} value
- * @return {!proto.Organizations} returns this
-*/
-proto.Organizations.prototype.setOrganizationsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.UpdateSubmissionsRequest} returns this
+ */
+proto.UpdateSubmissionsRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.Organization=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Organization}
+ * optional uint64 assignmentID = 2;
+ * @return {number}
  */
-proto.Organizations.prototype.addOrganizations = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Organization, opt_index);
+proto.UpdateSubmissionsRequest.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Organizations} returns this
+ * @param {number} value
+ * @return {!proto.UpdateSubmissionsRequest} returns this
  */
-proto.Organizations.prototype.clearOrganizationsList = function() {
-  return this.setOrganizationsList([]);
+proto.UpdateSubmissionsRequest.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 scoreLimit = 3;
+ * @return {number}
+ */
+proto.UpdateSubmissionsRequest.prototype.getScorelimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.UpdateSubmissionsRequest} returns this
+ */
+proto.UpdateSubmissionsRequest.prototype.setScorelimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional bool release = 4;
+ * @return {boolean}
+ */
+proto.UpdateSubmissionsRequest.prototype.getRelease = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.UpdateSubmissionsRequest} returns this
+ */
+proto.UpdateSubmissionsRequest.prototype.setRelease = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * optional bool approve = 5;
+ * @return {boolean}
+ */
+proto.UpdateSubmissionsRequest.prototype.getApprove = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.UpdateSubmissionsRequest} returns this
+ */
+proto.UpdateSubmissionsRequest.prototype.setApprove = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
 };
 
 
@@ -9268,7 +19155,7 @@ proto.Organizations.prototype.clearOrganizationsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.EnrollmentRequest.repeatedFields_ = [4];
+proto.ApproveSubmissionsRequest.repeatedFields_ = [3];
 
 
 
@@ -9285,8 +19172,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.EnrollmentRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.EnrollmentRequest.toObject(opt_includeInstance, this);
+proto.ApproveSubmissionsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ApproveSubmissionsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -9295,16 +19182,15 @@ proto.EnrollmentRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.EnrollmentRequest} msg The msg instance to transform.
+ * @param {!proto.ApproveSubmissionsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.EnrollmentRequest.toObject = function(includeInstance, msg) {
+proto.ApproveSubmissionsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    ignoregroupmembers: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    withactivity: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    statusesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    submissionidsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -9318,23 +19204,23 @@ proto.EnrollmentRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.EnrollmentRequest}
+ * @return {!proto.ApproveSubmissionsRequest}
  */
-proto.EnrollmentRequest.deserializeBinary = function(bytes) {
+proto.ApproveSubmissionsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.EnrollmentRequest;
-  return proto.EnrollmentRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ApproveSubmissionsRequest;
+  return proto.ApproveSubmissionsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.EnrollmentRequest} msg The message object to deserialize into.
+ * @param {!proto.ApproveSubmissionsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.EnrollmentRequest}
+ * @return {!proto.ApproveSubmissionsRequest}
  */
-proto.EnrollmentRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ApproveSubmissionsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9346,17 +19232,13 @@ proto.EnrollmentRequest.deserializeBinaryFromReader = function(msg, reader) {
       msg.setCourseid(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIgnoregroupmembers(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setWithactivity(value);
-      break;
-    case 4:
-      var values = /** @type {!Array<!proto.Enrollment.UserStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedUint64() : [reader.readUint64()]);
       for (var i = 0; i < values.length; i++) {
-        msg.addStatuses(values[i]);
+        msg.addSubmissionids(values[i]);
       }
       break;
     default:
@@ -9372,9 +19254,9 @@ proto.EnrollmentRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.EnrollmentRequest.prototype.serializeBinary = function() {
+proto.ApproveSubmissionsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.EnrollmentRequest.serializeBinaryToWriter(this, writer);
+  proto.ApproveSubmissionsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9382,11 +19264,11 @@ proto.EnrollmentRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.EnrollmentRequest} message
+ * @param {!proto.ApproveSubmissionsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.EnrollmentRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ApproveSubmissionsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getCourseid();
   if (f !== 0) {
@@ -9395,24 +19277,17 @@ proto.EnrollmentRequest.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getIgnoregroupmembers();
-  if (f) {
-    writer.writeBool(
-      2,
-      f
-    );
-  }
-  f = message.getWithactivity();
-  if (f) {
-    writer.writeBool(
-      3,
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
       f
     );
   }
-  f = message.getStatusesList();
+  f = message.getSubmissionidsList();
   if (f.length > 0) {
-    writer.writePackedEnum(
-      4,
+    writer.writePackedUint64(
+      3,
       f
     );
   }
@@ -9423,101 +19298,76 @@ proto.EnrollmentRequest.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.EnrollmentRequest.prototype.getCourseid = function() {
+proto.ApproveSubmissionsRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.EnrollmentRequest} returns this
+ * @return {!proto.ApproveSubmissionsRequest} returns this
  */
-proto.EnrollmentRequest.prototype.setCourseid = function(value) {
+proto.ApproveSubmissionsRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional bool ignoreGroupMembers = 2;
- * @return {boolean}
- */
-proto.EnrollmentRequest.prototype.getIgnoregroupmembers = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.EnrollmentRequest} returns this
- */
-proto.EnrollmentRequest.prototype.setIgnoregroupmembers = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
-};
-
-
-/**
- * optional bool withActivity = 3;
- * @return {boolean}
+ * optional uint64 assignmentID = 2;
+ * @return {number}
  */
-proto.EnrollmentRequest.prototype.getWithactivity = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.ApproveSubmissionsRequest.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.EnrollmentRequest} returns this
+ * @param {number} value
+ * @return {!proto.ApproveSubmissionsRequest} returns this
  */
-proto.EnrollmentRequest.prototype.setWithactivity = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.ApproveSubmissionsRequest.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * repeated Enrollment.UserStatus statuses = 4;
- * @return {!Array<!proto.Enrollment.UserStatus>}
+ * repeated uint64 submissionIDs = 3;
+ * @return {!Array<number>}
  */
-proto.EnrollmentRequest.prototype.getStatusesList = function() {
-  return /** @type {!Array<!proto.Enrollment.UserStatus>} */ (jspb.Message.getRepeatedField(this, 4));
+proto.ApproveSubmissionsRequest.prototype.getSubmissionidsList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
- * @param {!Array<!proto.Enrollment.UserStatus>} value
- * @return {!proto.EnrollmentRequest} returns this
+ * @param {!Array<number>} value
+ * @return {!proto.ApproveSubmissionsRequest} returns this
  */
-proto.EnrollmentRequest.prototype.setStatusesList = function(value) {
-  return jspb.Message.setField(this, 4, value || []);
+proto.ApproveSubmissionsRequest.prototype.setSubmissionidsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
- * @param {!proto.Enrollment.UserStatus} value
+ * @param {number} value
  * @param {number=} opt_index
- * @return {!proto.EnrollmentRequest} returns this
+ * @return {!proto.ApproveSubmissionsRequest} returns this
  */
-proto.EnrollmentRequest.prototype.addStatuses = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+proto.ApproveSubmissionsRequest.prototype.addSubmissionids = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.EnrollmentRequest} returns this
+ * @return {!proto.ApproveSubmissionsRequest} returns this
  */
-proto.EnrollmentRequest.prototype.clearStatusesList = function() {
-  return this.setStatusesList([]);
+proto.ApproveSubmissionsRequest.prototype.clearSubmissionidsList = function() {
+  return this.setSubmissionidsList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.EnrollmentStatusRequest.repeatedFields_ = [2];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -9533,8 +19383,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.EnrollmentStatusRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.EnrollmentStatusRequest.toObject(opt_includeInstance, this);
+proto.SubmissionApproval.prototype.toObject = function(opt_includeInstance) {
+  return proto.SubmissionApproval.toObject(opt_includeInstance, this);
 };
 
 
@@ -9543,14 +19393,15 @@ proto.EnrollmentStatusRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.EnrollmentStatusRequest} msg The msg instance to transform.
+ * @param {!proto.SubmissionApproval} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.EnrollmentStatusRequest.toObject = function(includeInstance, msg) {
+proto.SubmissionApproval.toObject = function(includeInstance, msg) {
   var f, obj = {
-    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    statusesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    approved: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    error: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -9564,23 +19415,23 @@ proto.EnrollmentStatusRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.EnrollmentStatusRequest}
+ * @return {!proto.SubmissionApproval}
  */
-proto.EnrollmentStatusRequest.deserializeBinary = function(bytes) {
+proto.SubmissionApproval.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.EnrollmentStatusRequest;
-  return proto.EnrollmentStatusRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.SubmissionApproval;
+  return proto.SubmissionApproval.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.EnrollmentStatusRequest} msg The message object to deserialize into.
+ * @param {!proto.SubmissionApproval} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.EnrollmentStatusRequest}
+ * @return {!proto.SubmissionApproval}
  */
-proto.EnrollmentStatusRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.SubmissionApproval.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9589,13 +19440,15 @@ proto.EnrollmentStatusRequest.deserializeBinaryFromReader = function(msg, reader
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      msg.setSubmissionid(value);
       break;
     case 2:
-      var values = /** @type {!Array<!proto.Enrollment.UserStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addStatuses(values[i]);
-      }
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setApproved(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setError(value);
       break;
     default:
       reader.skipField();
@@ -9610,9 +19463,9 @@ proto.EnrollmentStatusRequest.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.EnrollmentStatusRequest.prototype.serializeBinary = function() {
+proto.SubmissionApproval.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.EnrollmentStatusRequest.serializeBinaryToWriter(this, writer);
+  proto.SubmissionApproval.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9620,85 +19473,98 @@ proto.EnrollmentStatusRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.EnrollmentStatusRequest} message
+ * @param {!proto.SubmissionApproval} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.EnrollmentStatusRequest.serializeBinaryToWriter = function(message, writer) {
+proto.SubmissionApproval.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUserid();
+  f = message.getSubmissionid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getStatusesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
+  f = message.getApproved();
+  if (f) {
+    writer.writeBool(
       2,
       f
     );
   }
+  f = message.getError();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint64 userID = 1;
+ * optional uint64 submissionID = 1;
  * @return {number}
  */
-proto.EnrollmentStatusRequest.prototype.getUserid = function() {
+proto.SubmissionApproval.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.EnrollmentStatusRequest} returns this
+ * @return {!proto.SubmissionApproval} returns this
  */
-proto.EnrollmentStatusRequest.prototype.setUserid = function(value) {
+proto.SubmissionApproval.prototype.setSubmissionid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * repeated Enrollment.UserStatus statuses = 2;
- * @return {!Array<!proto.Enrollment.UserStatus>}
+ * optional bool approved = 2;
+ * @return {boolean}
  */
-proto.EnrollmentStatusRequest.prototype.getStatusesList = function() {
-  return /** @type {!Array<!proto.Enrollment.UserStatus>} */ (jspb.Message.getRepeatedField(this, 2));
+proto.SubmissionApproval.prototype.getApproved = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
 
 /**
- * @param {!Array<!proto.Enrollment.UserStatus>} value
- * @return {!proto.EnrollmentStatusRequest} returns this
+ * @param {boolean} value
+ * @return {!proto.SubmissionApproval} returns this
  */
-proto.EnrollmentStatusRequest.prototype.setStatusesList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
+proto.SubmissionApproval.prototype.setApproved = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.Enrollment.UserStatus} value
- * @param {number=} opt_index
- * @return {!proto.EnrollmentStatusRequest} returns this
+ * optional string error = 3;
+ * @return {string}
  */
-proto.EnrollmentStatusRequest.prototype.addStatuses = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.SubmissionApproval.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.EnrollmentStatusRequest} returns this
+ * @param {string} value
+ * @return {!proto.SubmissionApproval} returns this
  */
-proto.EnrollmentStatusRequest.prototype.clearStatusesList = function() {
-  return this.setStatusesList([]);
+proto.SubmissionApproval.prototype.setError = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.SubmissionApprovals.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -9714,8 +19580,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.SubmissionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.SubmissionRequest.toObject(opt_includeInstance, this);
+proto.SubmissionApprovals.prototype.toObject = function(opt_includeInstance) {
+  return proto.SubmissionApprovals.toObject(opt_includeInstance, this);
 };
 
 
@@ -9724,15 +19590,14 @@ proto.SubmissionRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.SubmissionRequest} msg The msg instance to transform.
+ * @param {!proto.SubmissionApprovals} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.SubmissionRequest.toObject = function(includeInstance, msg) {
+proto.SubmissionApprovals.toObject = function(includeInstance, msg) {
   var f, obj = {
-    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    approvalsList: jspb.Message.toObjectList(msg.getApprovalsList(),
+    proto.SubmissionApproval.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -9746,23 +19611,23 @@ proto.SubmissionRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.SubmissionRequest}
+ * @return {!proto.SubmissionApprovals}
  */
-proto.SubmissionRequest.deserializeBinary = function(bytes) {
+proto.SubmissionApprovals.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.SubmissionRequest;
-  return proto.SubmissionRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.SubmissionApprovals;
+  return proto.SubmissionApprovals.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.SubmissionRequest} msg The message object to deserialize into.
+ * @param {!proto.SubmissionApprovals} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.SubmissionRequest}
+ * @return {!proto.SubmissionApprovals}
  */
-proto.SubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.SubmissionApprovals.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9770,16 +19635,9 @@ proto.SubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      var value = new proto.SubmissionApproval;
+      reader.readMessage(value,proto.SubmissionApproval.deserializeBinaryFromReader);
+      msg.addApprovals(value);
       break;
     default:
       reader.skipField();
@@ -9794,9 +19652,9 @@ proto.SubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.SubmissionRequest.prototype.serializeBinary = function() {
+proto.SubmissionApprovals.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.SubmissionRequest.serializeBinaryToWriter(this, writer);
+  proto.SubmissionApprovals.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9804,87 +19662,58 @@ proto.SubmissionRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.SubmissionRequest} message
+ * @param {!proto.SubmissionApprovals} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.SubmissionRequest.serializeBinaryToWriter = function(message, writer) {
+proto.SubmissionApprovals.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUserid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getApprovalsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       1,
-      f
-    );
-  }
-  f = message.getGroupid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
-      f
+      f,
+      proto.SubmissionApproval.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional uint64 userID = 1;
- * @return {number}
- */
-proto.SubmissionRequest.prototype.getUserid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.SubmissionRequest} returns this
- */
-proto.SubmissionRequest.prototype.setUserid = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
-
-
-/**
- * optional uint64 groupID = 2;
- * @return {number}
+ * repeated SubmissionApproval approvals = 1;
+ * @return {!Array<!proto.SubmissionApproval>}
  */
-proto.SubmissionRequest.prototype.getGroupid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.SubmissionApprovals.prototype.getApprovalsList = function() {
+  return /** @type{!Array<!proto.SubmissionApproval>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.SubmissionApproval, 1));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.SubmissionRequest} returns this
- */
-proto.SubmissionRequest.prototype.setGroupid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+ * @param {!Array<!proto.SubmissionApproval>} value
+ * @return {!proto.SubmissionApprovals} returns this
+*/
+proto.SubmissionApprovals.prototype.setApprovalsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional uint64 courseID = 3;
- * @return {number}
+ * @param {!proto.SubmissionApproval=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.SubmissionApproval}
  */
-proto.SubmissionRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.SubmissionApprovals.prototype.addApprovals = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.SubmissionApproval, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.SubmissionRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.SubmissionApprovals} returns this
  */
-proto.SubmissionRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.SubmissionApprovals.prototype.clearApprovalsList = function() {
+  return this.setApprovalsList([]);
 };
 
 
@@ -9904,8 +19733,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.UpdateSubmissionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.UpdateSubmissionRequest.toObject(opt_includeInstance, this);
+proto.SubmissionReviewersRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.SubmissionReviewersRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -9914,17 +19743,14 @@ proto.UpdateSubmissionRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.UpdateSubmissionRequest} msg The msg instance to transform.
+ * @param {!proto.SubmissionReviewersRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.UpdateSubmissionRequest.toObject = function(includeInstance, msg) {
+proto.SubmissionReviewersRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    score: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    released: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
-    status: jspb.Message.getFieldWithDefault(msg, 5, 0)
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -9938,23 +19764,23 @@ proto.UpdateSubmissionRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.UpdateSubmissionRequest}
+ * @return {!proto.SubmissionReviewersRequest}
  */
-proto.UpdateSubmissionRequest.deserializeBinary = function(bytes) {
+proto.SubmissionReviewersRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.UpdateSubmissionRequest;
-  return proto.UpdateSubmissionRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.SubmissionReviewersRequest;
+  return proto.SubmissionReviewersRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.UpdateSubmissionRequest} msg The message object to deserialize into.
+ * @param {!proto.SubmissionReviewersRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.UpdateSubmissionRequest}
+ * @return {!proto.SubmissionReviewersRequest}
  */
-proto.UpdateSubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.SubmissionReviewersRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9969,18 +19795,6 @@ proto.UpdateSubmissionRequest.deserializeBinaryFromReader = function(msg, reader
       var value = /** @type {number} */ (reader.readUint64());
       msg.setCourseid(value);
       break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setScore(value);
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReleased(value);
-      break;
-    case 5:
-      var value = /** @type {!proto.Submission.Status} */ (reader.readEnum());
-      msg.setStatus(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -9994,9 +19808,9 @@ proto.UpdateSubmissionRequest.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.UpdateSubmissionRequest.prototype.serializeBinary = function() {
+proto.SubmissionReviewersRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.UpdateSubmissionRequest.serializeBinaryToWriter(this, writer);
+  proto.SubmissionReviewersRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10004,11 +19818,11 @@ proto.UpdateSubmissionRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.UpdateSubmissionRequest} message
+ * @param {!proto.SubmissionReviewersRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.UpdateSubmissionRequest.serializeBinaryToWriter = function(message, writer) {
+proto.SubmissionReviewersRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getSubmissionid();
   if (f !== 0) {
@@ -10024,27 +19838,6 @@ proto.UpdateSubmissionRequest.serializeBinaryToWriter = function(message, writer
       f
     );
   }
-  f = message.getScore();
-  if (f !== 0) {
-    writer.writeUint32(
-      3,
-      f
-    );
-  }
-  f = message.getReleased();
-  if (f) {
-    writer.writeBool(
-      4,
-      f
-    );
-  }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      5,
-      f
-    );
-  }
 };
 
 
@@ -10052,16 +19845,16 @@ proto.UpdateSubmissionRequest.serializeBinaryToWriter = function(message, writer
  * optional uint64 submissionID = 1;
  * @return {number}
  */
-proto.UpdateSubmissionRequest.prototype.getSubmissionid = function() {
+proto.SubmissionReviewersRequest.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.UpdateSubmissionRequest} returns this
+ * @return {!proto.SubmissionReviewersRequest} returns this
  */
-proto.UpdateSubmissionRequest.prototype.setSubmissionid = function(value) {
+proto.SubmissionReviewersRequest.prototype.setSubmissionid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
@@ -10070,74 +19863,20 @@ proto.UpdateSubmissionRequest.prototype.setSubmissionid = function(value) {
  * optional uint64 courseID = 2;
  * @return {number}
  */
-proto.UpdateSubmissionRequest.prototype.getCourseid = function() {
+proto.SubmissionReviewersRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.UpdateSubmissionRequest} returns this
+ * @return {!proto.SubmissionReviewersRequest} returns this
  */
-proto.UpdateSubmissionRequest.prototype.setCourseid = function(value) {
+proto.SubmissionReviewersRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
-/**
- * optional uint32 score = 3;
- * @return {number}
- */
-proto.UpdateSubmissionRequest.prototype.getScore = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.UpdateSubmissionRequest} returns this
- */
-proto.UpdateSubmissionRequest.prototype.setScore = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-/**
- * optional bool released = 4;
- * @return {boolean}
- */
-proto.UpdateSubmissionRequest.prototype.getReleased = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.UpdateSubmissionRequest} returns this
- */
-proto.UpdateSubmissionRequest.prototype.setReleased = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 4, value);
-};
-
-
-/**
- * optional Submission.Status status = 5;
- * @return {!proto.Submission.Status}
- */
-proto.UpdateSubmissionRequest.prototype.getStatus = function() {
-  return /** @type {!proto.Submission.Status} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
-};
-
-
-/**
- * @param {!proto.Submission.Status} value
- * @return {!proto.UpdateSubmissionRequest} returns this
- */
-proto.UpdateSubmissionRequest.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
-};
-
-
 
 
 
@@ -10154,8 +19893,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.UpdateSubmissionsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.UpdateSubmissionsRequest.toObject(opt_includeInstance, this);
+proto.SubmissionIDRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.SubmissionIDRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -10164,17 +19903,13 @@ proto.UpdateSubmissionsRequest.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.UpdateSubmissionsRequest} msg The msg instance to transform.
+ * @param {!proto.SubmissionIDRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.UpdateSubmissionsRequest.toObject = function(includeInstance, msg) {
+proto.SubmissionIDRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    scorelimit: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    release: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
-    approve: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
+    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -10188,23 +19923,23 @@ proto.UpdateSubmissionsRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.UpdateSubmissionsRequest}
+ * @return {!proto.SubmissionIDRequest}
  */
-proto.UpdateSubmissionsRequest.deserializeBinary = function(bytes) {
+proto.SubmissionIDRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.UpdateSubmissionsRequest;
-  return proto.UpdateSubmissionsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.SubmissionIDRequest;
+  return proto.SubmissionIDRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.UpdateSubmissionsRequest} msg The message object to deserialize into.
+ * @param {!proto.SubmissionIDRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.UpdateSubmissionsRequest}
+ * @return {!proto.SubmissionIDRequest}
  */
-proto.UpdateSubmissionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.SubmissionIDRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10213,23 +19948,7 @@ proto.UpdateSubmissionsRequest.deserializeBinaryFromReader = function(msg, reade
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setScorelimit(value);
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setRelease(value);
-      break;
-    case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setApprove(value);
+      msg.setSubmissionid(value);
       break;
     default:
       reader.skipField();
@@ -10244,9 +19963,9 @@ proto.UpdateSubmissionsRequest.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.UpdateSubmissionsRequest.prototype.serializeBinary = function() {
+proto.SubmissionIDRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.UpdateSubmissionsRequest.serializeBinaryToWriter(this, writer);
+  proto.SubmissionIDRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10254,140 +19973,40 @@ proto.UpdateSubmissionsRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.UpdateSubmissionsRequest} message
+ * @param {!proto.SubmissionIDRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.UpdateSubmissionsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.SubmissionIDRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCourseid();
+  f = message.getSubmissionid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getAssignmentid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = message.getScorelimit();
-  if (f !== 0) {
-    writer.writeUint32(
-      3,
-      f
-    );
-  }
-  f = message.getRelease();
-  if (f) {
-    writer.writeBool(
-      4,
-      f
-    );
-  }
-  f = message.getApprove();
-  if (f) {
-    writer.writeBool(
-      5,
-      f
-    );
-  }
 };
 
 
 /**
- * optional uint64 courseID = 1;
+ * optional uint64 submissionID = 1;
  * @return {number}
  */
-proto.UpdateSubmissionsRequest.prototype.getCourseid = function() {
+proto.SubmissionIDRequest.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.UpdateSubmissionsRequest} returns this
+ * @return {!proto.SubmissionIDRequest} returns this
  */
-proto.UpdateSubmissionsRequest.prototype.setCourseid = function(value) {
+proto.SubmissionIDRequest.prototype.setSubmissionid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
-/**
- * optional uint64 assignmentID = 2;
- * @return {number}
- */
-proto.UpdateSubmissionsRequest.prototype.getAssignmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.UpdateSubmissionsRequest} returns this
- */
-proto.UpdateSubmissionsRequest.prototype.setAssignmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional uint32 scoreLimit = 3;
- * @return {number}
- */
-proto.UpdateSubmissionsRequest.prototype.getScorelimit = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.UpdateSubmissionsRequest} returns this
- */
-proto.UpdateSubmissionsRequest.prototype.setScorelimit = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-/**
- * optional bool release = 4;
- * @return {boolean}
- */
-proto.UpdateSubmissionsRequest.prototype.getRelease = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.UpdateSubmissionsRequest} returns this
- */
-proto.UpdateSubmissionsRequest.prototype.setRelease = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 4, value);
-};
-
-
-/**
- * optional bool approve = 5;
- * @return {boolean}
- */
-proto.UpdateSubmissionsRequest.prototype.getApprove = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.UpdateSubmissionsRequest} returns this
- */
-proto.UpdateSubmissionsRequest.prototype.setApprove = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 5, value);
-};
-
-
 
 
 
@@ -10404,8 +20023,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.SubmissionReviewersRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.SubmissionReviewersRequest.toObject(opt_includeInstance, this);
+proto.BuildLog.prototype.toObject = function(opt_includeInstance) {
+  return proto.BuildLog.toObject(opt_includeInstance, this);
 };
 
 
@@ -10414,14 +20033,13 @@ proto.SubmissionReviewersRequest.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.SubmissionReviewersRequest} msg The msg instance to transform.
+ * @param {!proto.BuildLog} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.SubmissionReviewersRequest.toObject = function(includeInstance, msg) {
+proto.BuildLog.toObject = function(includeInstance, msg) {
   var f, obj = {
-    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    log: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -10435,23 +20053,23 @@ proto.SubmissionReviewersRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.SubmissionReviewersRequest}
+ * @return {!proto.BuildLog}
  */
-proto.SubmissionReviewersRequest.deserializeBinary = function(bytes) {
+proto.BuildLog.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.SubmissionReviewersRequest;
-  return proto.SubmissionReviewersRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.BuildLog;
+  return proto.BuildLog.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.SubmissionReviewersRequest} msg The message object to deserialize into.
+ * @param {!proto.BuildLog} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.SubmissionReviewersRequest}
+ * @return {!proto.BuildLog}
  */
-proto.SubmissionReviewersRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.BuildLog.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10459,12 +20077,8 @@ proto.SubmissionReviewersRequest.deserializeBinaryFromReader = function(msg, rea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setSubmissionid(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLog(value);
       break;
     default:
       reader.skipField();
@@ -10479,9 +20093,9 @@ proto.SubmissionReviewersRequest.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.SubmissionReviewersRequest.prototype.serializeBinary = function() {
+proto.BuildLog.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.SubmissionReviewersRequest.serializeBinaryToWriter(this, writer);
+  proto.BuildLog.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10489,62 +20103,37 @@ proto.SubmissionReviewersRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.SubmissionReviewersRequest} message
+ * @param {!proto.BuildLog} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.SubmissionReviewersRequest.serializeBinaryToWriter = function(message, writer) {
+proto.BuildLog.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSubmissionid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getLog();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
 };
 
 
 /**
- * optional uint64 submissionID = 1;
- * @return {number}
- */
-proto.SubmissionReviewersRequest.prototype.getSubmissionid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.SubmissionReviewersRequest} returns this
- */
-proto.SubmissionReviewersRequest.prototype.setSubmissionid = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
-
-
-/**
- * optional uint64 courseID = 2;
- * @return {number}
+ * optional string log = 1;
+ * @return {string}
  */
-proto.SubmissionReviewersRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.BuildLog.prototype.getLog = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.SubmissionReviewersRequest} returns this
+ * @param {string} value
+ * @return {!proto.BuildLog} returns this
  */
-proto.SubmissionReviewersRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.BuildLog.prototype.setLog = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -10744,7 +20333,8 @@ proto.URLRequest.prototype.toObject = function(opt_includeInstance) {
 proto.URLRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    repotypesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+    repotypesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    userid: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -10791,6 +20381,10 @@ proto.URLRequest.deserializeBinaryFromReader = function(msg, reader) {
         msg.addRepotypes(values[i]);
       }
       break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -10834,6 +20428,13 @@ proto.URLRequest.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -10892,6 +20493,24 @@ proto.URLRequest.prototype.clearRepotypesList = function() {
 };
 
 
+/**
+ * optional uint64 userID = 3;
+ * @return {number}
+ */
+proto.URLRequest.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.URLRequest} returns this
+ */
+proto.URLRequest.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
 
 
 
@@ -11178,41 +20797,231 @@ proto.Repositories.prototype.serializeBinary = function() {
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.Repositories} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Repositories} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Repositories.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrlsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * map<string, string> URLs = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.Repositories.prototype.getUrlsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.Repositories} returns this
+ */
+proto.Repositories.prototype.clearUrlsMap = function() {
+  this.getUrlsMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.RepositoryAccessToken.prototype.toObject = function(opt_includeInstance) {
+  return proto.RepositoryAccessToken.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.RepositoryAccessToken} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.RepositoryAccessToken.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    token: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    expiresat: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    repositoryurl: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.RepositoryAccessToken}
+ */
+proto.RepositoryAccessToken.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.RepositoryAccessToken;
+  return proto.RepositoryAccessToken.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.RepositoryAccessToken} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.RepositoryAccessToken}
+ */
+proto.RepositoryAccessToken.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setToken(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExpiresat(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRepositoryurl(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.RepositoryAccessToken.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.RepositoryAccessToken.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.RepositoryAccessToken} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.RepositoryAccessToken.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getToken();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getExpiresat();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRepositoryurl();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string token = 1;
+ * @return {string}
+ */
+proto.RepositoryAccessToken.prototype.getToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.RepositoryAccessToken} returns this
+ */
+proto.RepositoryAccessToken.prototype.setToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string expiresAt = 2;
+ * @return {string}
+ */
+proto.RepositoryAccessToken.prototype.getExpiresat = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.RepositoryAccessToken} returns this
  */
-proto.Repositories.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getUrlsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
+proto.RepositoryAccessToken.prototype.setExpiresat = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * map<string, string> URLs = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * optional string repositoryURL = 3;
+ * @return {string}
  */
-proto.Repositories.prototype.getUrlsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      null));
+proto.RepositoryAccessToken.prototype.getRepositoryurl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.Repositories} returns this
+ * @param {string} value
+ * @return {!proto.RepositoryAccessToken} returns this
  */
-proto.Repositories.prototype.clearUrlsMap = function() {
-  this.getUrlsMap().clear();
-  return this;};
+proto.RepositoryAccessToken.prototype.setRepositoryurl = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
 
 
 
@@ -11539,7 +21348,9 @@ proto.SubmissionsForCourseRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
     type: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    skipbuildinfo: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+    skipbuildinfo: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    graderid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    category: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -11588,6 +21399,14 @@ proto.SubmissionsForCourseRequest.deserializeBinaryFromReader = function(msg, re
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setSkipbuildinfo(value);
       break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGraderid(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCategory(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -11638,6 +21457,20 @@ proto.SubmissionsForCourseRequest.serializeBinaryToWriter = function(message, wr
       f
     );
   }
+  f = message.getGraderid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getCategory();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -11704,6 +21537,260 @@ proto.SubmissionsForCourseRequest.prototype.setSkipbuildinfo = function(value) {
 };
 
 
+/**
+ * optional uint64 graderID = 4;
+ * @return {number}
+ */
+proto.SubmissionsForCourseRequest.prototype.getGraderid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.SubmissionsForCourseRequest} returns this
+ */
+proto.SubmissionsForCourseRequest.prototype.setGraderid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional string category = 5;
+ * @return {string}
+ */
+proto.SubmissionsForCourseRequest.prototype.getCategory = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.SubmissionsForCourseRequest} returns this
+ */
+proto.SubmissionsForCourseRequest.prototype.setCategory = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.AssignGraderRequest.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.AssignGraderRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.AssignGraderRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.AssignGraderRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.AssignGraderRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    graderid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    studentidsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.AssignGraderRequest}
+ */
+proto.AssignGraderRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.AssignGraderRequest;
+  return proto.AssignGraderRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.AssignGraderRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.AssignGraderRequest}
+ */
+proto.AssignGraderRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGraderid(value);
+      break;
+    case 3:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedUint64() : [reader.readUint64()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addStudentids(values[i]);
+      }
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.AssignGraderRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.AssignGraderRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.AssignGraderRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.AssignGraderRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getGraderid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getStudentidsList();
+  if (f.length > 0) {
+    writer.writePackedUint64(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 courseID = 1;
+ * @return {number}
+ */
+proto.AssignGraderRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.AssignGraderRequest} returns this
+ */
+proto.AssignGraderRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 graderID = 2;
+ * @return {number}
+ */
+proto.AssignGraderRequest.prototype.getGraderid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.AssignGraderRequest} returns this
+ */
+proto.AssignGraderRequest.prototype.setGraderid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * repeated uint64 studentIDs = 3;
+ * @return {!Array<number>}
+ */
+proto.AssignGraderRequest.prototype.getStudentidsList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.AssignGraderRequest} returns this
+ */
+proto.AssignGraderRequest.prototype.setStudentidsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.AssignGraderRequest} returns this
+ */
+proto.AssignGraderRequest.prototype.addStudentids = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.AssignGraderRequest} returns this
+ */
+proto.AssignGraderRequest.prototype.clearStudentidsList = function() {
+  return this.setStudentidsList([]);
+};
+
+
 
 
 
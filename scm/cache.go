@@ -0,0 +1,129 @@
+package scm
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+const (
+	// orgCacheSize is the maximum number of organizations kept by an SCM client.
+	orgCacheSize = 100
+	// orgCacheTTL is how long a cached organization is used before it is fetched again.
+	orgCacheTTL = 30 * time.Second
+)
+
+// orgCache is a least recently used cache of organizations keyed by ID,
+// whose entries expire after a fixed time to live. It is safe for concurrent use.
+type orgCache struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	now      func() time.Time
+	entries  map[uint64]*list.Element
+	lru      *list.List // most recently used entry first
+}
+
+type orgCacheEntry struct {
+	org     pb.Organization
+	expires time.Time
+}
+
+func newOrgCache(capacity int, ttl time.Duration) *orgCache {
+	return &orgCache{
+		capacity: capacity,
+		ttl:      ttl,
+		now:      time.Now,
+		entries:  make(map[uint64]*list.Element),
+		lru:      list.New(),
+	}
+}
+
+// get returns a copy of the cached organization with the given ID,
+// if it has not expired.
+func (c *orgCache) get(id uint64) (*pb.Organization, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[id]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*orgCacheEntry)
+	if !c.now().Before(entry.expires) {
+		c.lru.Remove(elem)
+		delete(c.entries, id)
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	org := entry.org
+	return &org, true
+}
+
+// add caches a copy of the given organization, evicting
+// the least recently used organization if the cache is full.
+func (c *orgCache) add(org *pb.Organization) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry := &orgCacheEntry{org: *org, expires: c.now().Add(c.ttl)}
+	if elem, ok := c.entries[org.GetID()]; ok {
+		elem.Value = entry
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[org.GetID()] = c.lru.PushFront(entry)
+	if c.lru.Len() > c.capacity {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*orgCacheEntry).org.GetID())
+	}
+}
+
+// remove invalidates the cached organization with the given ID, if any.
+func (c *orgCache) remove(id uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[id]; ok {
+		c.lru.Remove(elem)
+		delete(c.entries, id)
+	}
+}
+
+// teamCache maps the paths of teams, i.e., "organization/team-slug", to their IDs.
+// Team IDs never change, so entries do not expire, but are removed when the team
+// is deleted. It is safe for concurrent use.
+type teamCache struct {
+	mu  sync.Mutex
+	ids map[string]int
+}
+
+func newTeamCache() *teamCache {
+	return &teamCache{ids: make(map[string]int)}
+}
+
+// get returns the ID of the team with the given path, if cached.
+func (c *teamCache) get(path string) (int, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id, ok := c.ids[path]
+	return id, ok
+}
+
+// add caches the ID of the team with the given path.
+func (c *teamCache) add(path string, id int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ids[path] = id
+}
+
+// remove invalidates the cached team with the given ID, if any.
+func (c *teamCache) remove(id int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for path, teamID := range c.ids {
+		if teamID == id {
+			delete(c.ids, path)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package scm
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestOrgCache(t *testing.T) {
+	now := time.Date(2021, time.January, 10, 12, 0, 0, 0, time.UTC)
+	cache := newOrgCache(2, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	cache.add(&pb.Organization{ID: 1, Path: "org1"})
+	cache.add(&pb.Organization{ID: 2, Path: "org2"})
+	org, ok := cache.get(1)
+	if !ok || org.GetPath() != "org1" {
+		t.Fatalf("get(1) = %v, %t, want org1", org, ok)
+	}
+	// callers may modify the returned organization without affecting the cache
+	org.Path = "modified"
+	if org, _ := cache.get(1); org.GetPath() != "org1" {
+		t.Errorf("get(1) = %v, want unmodified org1", org)
+	}
+
+	// org2 is the least recently used organization
+	cache.add(&pb.Organization{ID: 3, Path: "org3"})
+	if _, ok := cache.get(2); ok {
+		t.Error("expected org2 to be evicted")
+	}
+	for _, id := range []uint64{1, 3} {
+		if _, ok := cache.get(id); !ok {
+			t.Errorf("expected org%d to be cached", id)
+		}
+	}
+
+	cache.remove(3)
+	if _, ok := cache.get(3); ok {
+		t.Error("expected org3 to be invalidated")
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := cache.get(1); ok {
+		t.Error("expected org1 to expire")
+	}
+}
+
+func TestTeamCache(t *testing.T) {
+	cache := newTeamCache()
+	cache.add("org/students", 10)
+	cache.add("org/teachers", 11)
+	if id, ok := cache.get("org/students"); !ok || id != 10 {
+		t.Fatalf("get(org/students) = %d, %t, want 10", id, ok)
+	}
+	if _, ok := cache.get("other/students"); ok {
+		t.Error("expected other/students not to be cached")
+	}
+
+	cache.remove(10)
+	if _, ok := cache.get("org/students"); ok {
+		t.Error("expected org/students to be invalidated")
+	}
+	if id, ok := cache.get("org/teachers"); !ok || id != 11 {
+		t.Errorf("get(org/teachers) = %d, %t, want 11", id, ok)
+	}
+}
@@ -3,7 +3,11 @@ package scm
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 )
@@ -14,15 +18,39 @@ type FakeSCM struct {
 	Organizations map[uint64]*pb.Organization
 	Hooks         map[uint64]int
 	Teams         map[uint64]*Team
+	// TeamMembers maps team IDs to the logins of the team's members and their roles.
+	// Membership changes to teams not found in Teams are ignored.
+	TeamMembers map[uint64]map[string]string
+	// OrganizationHooks maps organization paths to their number of webhooks.
+	OrganizationHooks map[string]int
+	// ProtectedBranches maps repository IDs to their protected branches,
+	// and whether force pushes are allowed to each branch.
+	ProtectedBranches map[uint64]map[string]bool
+	// PrivateOrganizations maps organization IDs to whether they are private.
+	PrivateOrganizations map[uint64]bool
+	// Commits maps repository IDs to their commits, keyed by SHA.
+	Commits map[uint64]map[string]*Commit
+	// Files maps "owner/repository" to the content of the repository's files, keyed by path.
+	// The ref of file requests is ignored.
+	Files map[string]map[string]string
+	// AccessTokens maps repository IDs to the access tokens created for them.
+	AccessTokens map[uint64][]*AccessToken
 }
 
 // NewFakeSCMClient returns a new Fake client implementing the SCM interface.
 func NewFakeSCMClient() *FakeSCM {
 	return &FakeSCM{
-		Repositories:  make(map[uint64]*Repository),
-		Organizations: make(map[uint64]*pb.Organization),
-		Hooks:         make(map[uint64]int),
-		Teams:         make(map[uint64]*Team),
+		Repositories:         make(map[uint64]*Repository),
+		Organizations:        make(map[uint64]*pb.Organization),
+		Hooks:                make(map[uint64]int),
+		Teams:                make(map[uint64]*Team),
+		TeamMembers:          make(map[uint64]map[string]string),
+		OrganizationHooks:    make(map[string]int),
+		ProtectedBranches:    make(map[uint64]map[string]bool),
+		PrivateOrganizations: make(map[uint64]bool),
+		Commits:              make(map[uint64]map[string]*Commit),
+		Files:                make(map[string]map[string]string),
+		AccessTokens:         make(map[uint64][]*AccessToken),
 	}
 }
 
@@ -38,12 +66,31 @@ func (s *FakeSCM) CreateOrganization(ctx context.Context, opt *OrganizationOptio
 	return org, nil
 }
 
+// EnsureOrganization implements the SCM interface.
+func (s *FakeSCM) EnsureOrganization(ctx context.Context, opt *OrganizationOptions) (*pb.Organization, error) {
+	for _, org := range s.Organizations {
+		if org.Path == opt.Path {
+			return org, nil
+		}
+	}
+	return s.CreateOrganization(ctx, opt)
+}
+
 // UpdateOrganization implements the SCM interface.
 func (s *FakeSCM) UpdateOrganization(ctx context.Context, opt *OrganizationOptions) error {
 	// TODO no implementation provided yet
 	return nil
 }
 
+// UpdateOrganizationVisibility implements the SCM interface.
+func (s *FakeSCM) UpdateOrganizationVisibility(ctx context.Context, orgID uint64, private bool) error {
+	if _, ok := s.Organizations[orgID]; !ok {
+		return fmt.Errorf("organization %d %w", orgID, ErrNotFound)
+	}
+	s.PrivateOrganizations[orgID] = private
+	return nil
+}
+
 // GetOrganization implements the SCM interface.
 func (s *FakeSCM) GetOrganization(ctx context.Context, opt *GetOrgOptions) (*pb.Organization, error) {
 	org, ok := s.Organizations[opt.ID]
@@ -53,6 +100,17 @@ func (s *FakeSCM) GetOrganization(ctx context.Context, opt *GetOrgOptions) (*pb.
 	return org, nil
 }
 
+// ListOrganizations implements the SCM interface.
+func (s *FakeSCM) ListOrganizations(ctx context.Context, opt *ListOrgOptions) ([]*pb.Organization, error) {
+	var orgs []*pb.Organization
+	for _, org := range s.Organizations {
+		if matchesSearch(org.GetPath(), opt.Search) {
+			orgs = append(orgs, org)
+		}
+	}
+	return orgs, nil
+}
+
 // CreateRepository implements the SCM interface.
 func (s *FakeSCM) CreateRepository(ctx context.Context, opt *CreateRepositoryOptions) (*Repository, error) {
 	repo := &Repository{
@@ -67,10 +125,47 @@ func (s *FakeSCM) CreateRepository(ctx context.Context, opt *CreateRepositoryOpt
 	return repo, nil
 }
 
+// CreateRepositoryFromTemplate implements the SCM interface.
+func (s *FakeSCM) CreateRepositoryFromTemplate(ctx context.Context, opt *CreateRepositoryOptions) (*Repository, error) {
+	owner := opt.Organization.Path
+	if _, err := s.GetRepository(ctx, &RepositoryOptions{Owner: owner, Path: opt.Template}); err != nil {
+		return nil, fmt.Errorf("template %w", err)
+	}
+	repo, err := s.CreateRepository(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+	if files, ok := s.Files[owner+"/"+opt.Template]; ok {
+		copied := make(map[string]string, len(files))
+		for path, content := range files {
+			copied[path] = content
+		}
+		s.Files[owner+"/"+opt.Path] = copied
+	}
+	return repo, nil
+}
+
 // GetRepository implements the SCM interface.
-func (s *FakeSCM) GetRepository(cts context.Context, opt *RepositoryOptions) (*Repository, error) {
-	// TODO no implementation provided yet
-	return nil, nil
+func (s *FakeSCM) GetRepository(ctx context.Context, opt *RepositoryOptions) (*Repository, error) {
+	if opt.ID > 0 {
+		if repo, ok := s.Repositories[opt.ID]; ok {
+			return repo, nil
+		}
+		return nil, fmt.Errorf("repository %w", ErrNotFound)
+	}
+	for _, repo := range s.Repositories {
+		org, ok := s.Organizations[repo.OrgID]
+		if repo.Path == opt.Path && ok && org.Path == opt.Owner {
+			return repo, nil
+		}
+	}
+	return nil, fmt.Errorf("repository %w", ErrNotFound)
+}
+
+// RepositoryExists implements the SCM interface.
+func (s *FakeSCM) RepositoryExists(ctx context.Context, repoID uint64) (bool, error) {
+	_, ok := s.Repositories[repoID]
+	return ok, nil
 }
 
 // GetRepositories implements the SCM interface.
@@ -87,7 +182,7 @@ func (s *FakeSCM) GetRepositories(ctx context.Context, org *pb.Organization) ([]
 // DeleteRepository implements the SCM interface.
 func (s *FakeSCM) DeleteRepository(ctx context.Context, opt *RepositoryOptions) error {
 	if _, ok := s.Repositories[opt.ID]; !ok {
-		return errors.New("repository not found")
+		return fmt.Errorf("repository %w", ErrNotFound)
 	}
 	delete(s.Repositories, opt.ID)
 	return nil
@@ -99,6 +194,29 @@ func (s *FakeSCM) UpdateRepoAccess(ctx context.Context, repo *Repository, user,
 	return nil
 }
 
+// RevokeRepoAccess implements the SCM interface.
+func (s *FakeSCM) RevokeRepoAccess(ctx context.Context, repo *Repository, user string) error {
+	// TODO no implementation provided yet
+	return nil
+}
+
+// RenameRepository implements the SCM interface.
+func (s *FakeSCM) RenameRepository(ctx context.Context, repoID uint64, newName string) (*Repository, error) {
+	repo, ok := s.Repositories[repoID]
+	if !ok {
+		return nil, fmt.Errorf("repository %w", ErrNotFound)
+	}
+	orgPath := ""
+	if org, ok := s.Organizations[repo.OrgID]; ok {
+		orgPath = org.Path
+	}
+	repo.Path = newName
+	repo.WebURL = "https://example.com/" + orgPath + "/" + newName
+	repo.SSHURL = "git@example.com:" + orgPath + "/" + newName
+	repo.HTTPURL = "https://example.com/" + orgPath + "/" + newName + ".git"
+	return repo, nil
+}
+
 // RepositoryIsEmpty implements the SCM interface
 func (s *FakeSCM) RepositoryIsEmpty(ctx context.Context, opt *RepositoryOptions) bool {
 	// TODO no implementation provided yet
@@ -111,17 +229,75 @@ func (s *FakeSCM) ListHooks(ctx context.Context, repo *Repository, org string) (
 	return nil, nil
 }
 
+// ListPullRequests implements the SCM interface.
+func (s *FakeSCM) ListPullRequests(ctx context.Context, opt *RepositoryOptions) ([]*PullRequest, error) {
+	// TODO no implementation provided yet
+	return nil, nil
+}
+
 // CreateHook implements the SCM interface.
-func (s *FakeSCM) CreateHook(ctx context.Context, opt *CreateHookOptions) error {
+func (s *FakeSCM) CreateHook(ctx context.Context, opt *CreateHookOptions) (*Hook, error) {
+	hook := &Hook{URL: opt.URL}
 	if opt.Repository != nil {
 		if _, ok := s.Repositories[opt.Repository.ID]; !ok {
-			return errors.New("repository not found")
+			return nil, errors.New("repository not found")
 		}
 		s.Hooks[opt.Repository.ID]++
+		hook.ID = uint64(s.Hooks[opt.Repository.ID])
+	} else if opt.Organization != "" {
+		s.OrganizationHooks[opt.Organization]++
+		hook.ID = uint64(s.OrganizationHooks[opt.Organization])
 	}
+	return hook, nil
+}
+
+// DeleteHook implements the SCM interface.
+func (s *FakeSCM) DeleteHook(ctx context.Context, repoID, hookID uint64) error {
+	if s.Hooks[repoID] < 1 {
+		return fmt.Errorf("hook %d %w", hookID, ErrNotFound)
+	}
+	s.Hooks[repoID]--
 	return nil
 }
 
+// DeleteOrganizationHook implements the SCM interface.
+func (s *FakeSCM) DeleteOrganizationHook(ctx context.Context, org string, hookID uint64) error {
+	if s.OrganizationHooks[org] < 1 {
+		return fmt.Errorf("hook %d %w", hookID, ErrNotFound)
+	}
+	s.OrganizationHooks[org]--
+	return nil
+}
+
+// ProtectBranch implements the SCM interface.
+func (s *FakeSCM) ProtectBranch(ctx context.Context, repoID uint64, branch string, allowForcePush bool) error {
+	if _, ok := s.Repositories[repoID]; !ok {
+		return fmt.Errorf("repository %d %w", repoID, ErrNotFound)
+	}
+	if s.ProtectedBranches[repoID] == nil {
+		s.ProtectedBranches[repoID] = make(map[string]bool)
+	}
+	s.ProtectedBranches[repoID][branch] = allowForcePush
+	return nil
+}
+
+// CreateRepositoryAccessToken implements the SCM interface.
+func (s *FakeSCM) CreateRepositoryAccessToken(ctx context.Context, repoID uint64, scopes []string, expiry time.Time) (*AccessToken, error) {
+	if _, ok := s.Repositories[repoID]; !ok {
+		return nil, fmt.Errorf("repository %d %w", repoID, ErrNotFound)
+	}
+	id := len(s.AccessTokens[repoID]) + 1
+	token := &AccessToken{
+		ID:        uint64(id),
+		Name:      "quickfeed",
+		Token:     fmt.Sprintf("fake-token-%d-%d", repoID, id),
+		Scopes:    scopes,
+		ExpiresAt: expiry,
+	}
+	s.AccessTokens[repoID] = append(s.AccessTokens[repoID], token)
+	return token, nil
+}
+
 // CreateTeam implements the SCM interface.
 func (s *FakeSCM) CreateTeam(ctx context.Context, opt *NewTeamOptions) (*Team, error) {
 	newTeam := &Team{
@@ -136,9 +312,9 @@ func (s *FakeSCM) CreateTeam(ctx context.Context, opt *NewTeamOptions) (*Team, e
 // DeleteTeam implements the SCM interface.
 func (s *FakeSCM) DeleteTeam(ctx context.Context, opt *TeamOptions) error {
 	if _, ok := s.Teams[opt.TeamID]; !ok {
-		return errors.New("repository not found")
+		return fmt.Errorf("team %w", ErrNotFound)
 	}
-	delete(s.Repositories, opt.TeamID)
+	delete(s.Teams, opt.TeamID)
 	return nil
 }
 
@@ -164,19 +340,68 @@ func (s *FakeSCM) GetTeams(ctx context.Context, org *pb.Organization) ([]*Team,
 
 // AddTeamMember implements the scm interface
 func (s *FakeSCM) AddTeamMember(ctx context.Context, opt *TeamMembershipOptions) error {
+	team := s.findTeam(opt)
+	if team == nil {
+		return nil
+	}
+	members, ok := s.TeamMembers[team.ID]
+	if !ok {
+		members = make(map[string]string)
+		s.TeamMembers[team.ID] = members
+	}
+	members[opt.Username] = opt.Role
+	return nil
+}
+
+// AddTeamMembers implements the scm interface
+func (s *FakeSCM) AddTeamMembers(ctx context.Context, opt *TeamMembershipOptions, logins []string) error {
 	// TODO no implementation provided yet
 	return nil
 }
 
 // RemoveTeamMember implements the scm interface
 func (s *FakeSCM) RemoveTeamMember(ctx context.Context, opt *TeamMembershipOptions) error {
+	if team := s.findTeam(opt); team != nil {
+		delete(s.TeamMembers[team.ID], opt.Username)
+	}
+	return nil
+}
+
+// UpdateTeamMembership implements the SCM interface.
+func (s *FakeSCM) UpdateTeamMembership(ctx context.Context, opt *TeamMembershipOptions) error {
 	// TODO no implementation provided yet
 	return nil
 }
 
 // UpdateTeamMembers implements the SCM interface.
 func (s *FakeSCM) UpdateTeamMembers(ctx context.Context, opt *UpdateTeamOptions) error {
-	// TODO no implementation provided yet
+	if _, ok := s.Teams[opt.TeamID]; !ok {
+		return nil
+	}
+	members := make(map[string]string)
+	for _, user := range opt.Users {
+		// keep the role of existing members
+		role, ok := s.TeamMembers[opt.TeamID][user]
+		if !ok {
+			role = TeamMember
+		}
+		members[user] = role
+	}
+	s.TeamMembers[opt.TeamID] = members
+	return nil
+}
+
+// findTeam returns the team with the given ID, or with the given name in the given organization.
+// If no such team exists, nil is returned.
+func (s *FakeSCM) findTeam(opt *TeamMembershipOptions) *Team {
+	if team, ok := s.Teams[opt.TeamID]; ok {
+		return team
+	}
+	for _, team := range s.Teams {
+		if team.Organization == opt.Organization && team.Name == opt.TeamName {
+			return team
+		}
+	}
 	return nil
 }
 
@@ -212,14 +437,67 @@ func (s *FakeSCM) RemoveMember(ctx context.Context, opt *OrgMembershipOptions) e
 	return nil
 }
 
+// ListOrganizationMembers implements the SCM interface
+func (s *FakeSCM) ListOrganizationMembers(ctx context.Context, org *pb.Organization) ([]*OrganizationMember, error) {
+	// TODO no implementation provided yet
+	return nil, nil
+}
+
+// IsOrgMember implements the SCM interface.
+// All users are considered members of the fake organizations.
+func (s *FakeSCM) IsOrgMember(ctx context.Context, org, login string) (bool, error) {
+	return true, nil
+}
+
 // GetUserScopes implements the SCM interface
 func (s *FakeSCM) GetUserScopes(ctx context.Context) *Authorization {
 	// TODO no implementation provided yet
 	return nil
 }
 
+// GetCommit implements the SCM interface.
+func (s *FakeSCM) GetCommit(ctx context.Context, repoID uint64, sha string) (*Commit, error) {
+	commit, ok := s.Commits[repoID][sha]
+	if !ok {
+		return nil, fmt.Errorf("commit %s in repository %d %w", sha, repoID, ErrNotFound)
+	}
+	return commit, nil
+}
+
+// ListCommits implements the SCM interface.
+func (s *FakeSCM) ListCommits(ctx context.Context, repoID uint64, since time.Time) ([]*Commit, error) {
+	var commits []*Commit
+	for _, commit := range s.Commits[repoID] {
+		if commit.Date.After(since) {
+			commits = append(commits, commit)
+		}
+	}
+	sort.Slice(commits, func(i, j int) bool {
+		return commits[i].Date.After(commits[j].Date)
+	})
+	return commits, nil
+}
+
+// VerifyScopes implements the SCM interface.
+// Fake access tokens are granted all scopes.
+func (s *FakeSCM) VerifyScopes(ctx context.Context, required []string) error {
+	return nil
+}
+
 // GetFileContent implements the SCM interface
-func (s *FakeSCM) GetFileContent(context.Context, *FileOptions) (string, error) {
-	// TODO no implementation provided yet
-	return "", nil
+func (s *FakeSCM) GetFileContent(ctx context.Context, opt *FileOptions) (string, error) {
+	return s.Files[opt.Owner+"/"+opt.Repository][opt.Path], nil
+}
+
+// ListFiles implements the SCM interface.
+func (s *FakeSCM) ListFiles(ctx context.Context, opt *FileOptions) ([]string, error) {
+	prefix := strings.TrimSuffix(opt.Path, "/") + "/"
+	var files []string
+	for path := range s.Files[opt.Owner+"/"+opt.Repository] {
+		if strings.HasPrefix(path, prefix) {
+			files = append(files, path)
+		}
+	}
+	sort.Strings(files)
+	return files, nil
 }
@@ -0,0 +1,94 @@
+package scm_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/autograde/quickfeed/scm"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListOrganizationsSearch(t *testing.T) {
+	s := scm.NewFakeSCMClient()
+	ctx := context.Background()
+	for _, path := range []string{"dat320-2021", "DAT520-2021", "ide-2021"} {
+		if _, err := s.CreateOrganization(ctx, &scm.OrganizationOptions{Path: path, Name: path}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		search string
+		want   []string
+	}{
+		{search: "", want: []string{"DAT520-2021", "dat320-2021", "ide-2021"}},
+		{search: "dat", want: []string{"DAT520-2021", "dat320-2021"}},
+		{search: "520", want: []string{"DAT520-2021"}},
+		{search: "2020", want: nil},
+	}
+	for _, test := range tests {
+		orgs, err := s.ListOrganizations(ctx, &scm.ListOrgOptions{Search: test.search})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, org := range orgs {
+			got = append(got, org.GetPath())
+		}
+		sort.Strings(got)
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("ListOrganizations(%q) mismatch (-want +got):\n%s", test.search, diff)
+		}
+	}
+}
+
+func TestFakeTeamMembers(t *testing.T) {
+	s := scm.NewFakeSCMClient()
+	ctx := context.Background()
+	team, err := s.CreateTeam(ctx, &scm.NewTeamOptions{Organization: "dat320", TeamName: scm.StudentsTeam})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// members can be added by team name or team ID
+	if err := s.AddTeamMember(ctx, &scm.TeamMembershipOptions{Organization: "dat320", TeamName: scm.StudentsTeam, Username: "alice", Role: scm.TeamMember}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.AddTeamMember(ctx, &scm.TeamMembershipOptions{TeamID: team.ID, Username: "bob", Role: scm.TeamMaintainer}); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"alice": scm.TeamMember, "bob": scm.TeamMaintainer}
+	if diff := cmp.Diff(want, s.TeamMembers[team.ID]); diff != "" {
+		t.Errorf("AddTeamMember() mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := s.RemoveTeamMember(ctx, &scm.TeamMembershipOptions{TeamID: team.ID, Username: "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UpdateTeamMembers(ctx, &scm.UpdateTeamOptions{TeamID: team.ID, Users: []string{"bob", "carol"}}); err != nil {
+		t.Fatal(err)
+	}
+	want = map[string]string{"bob": scm.TeamMaintainer, "carol": scm.TeamMember}
+	if diff := cmp.Diff(want, s.TeamMembers[team.ID]); diff != "" {
+		t.Errorf("UpdateTeamMembers() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFakeGetCommit(t *testing.T) {
+	s := scm.NewFakeSCMClient()
+	want := &scm.Commit{SHA: "abc123", Author: "Test Student", Committer: "Test Student", Message: "lab1 done"}
+	s.Commits[1] = map[string]*scm.Commit{want.SHA: want}
+
+	ctx := context.Background()
+	got, err := s.GetCommit(ctx, 1, want.SHA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetCommit() mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := s.GetCommit(ctx, 1, "unknown"); !scm.IsNotFound(err) {
+		t.Errorf("GetCommit(unknown) = %v, want not found error", err)
+	}
+}
@@ -3,8 +3,10 @@ package scm
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -40,6 +42,30 @@ func (s *GithubSCM) CreateOrganization(ctx context.Context, opt *OrganizationOpt
 	}
 }
 
+// EnsureOrganization implements the SCM interface.
+// GitHub organizations cannot be created through the API. Hence, the
+// organization with the given path is returned if it exists; otherwise,
+// ErrNotSupported is returned.
+func (s *GithubSCM) EnsureOrganization(ctx context.Context, opt *OrganizationOptions) (*pb.Organization, error) {
+	if opt.Path == "" {
+		return nil, ErrMissingFields{
+			Method:  "EnsureOrganization",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	org, err := s.GetOrganization(ctx, &GetOrgOptions{Name: opt.Path})
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, ErrNotSupported{
+				SCM:    "github",
+				Method: "EnsureOrganization",
+			}
+		}
+		return nil, err
+	}
+	return org, nil
+}
+
 // UpdateOrganization implements the SCM interface.
 func (s *GithubSCM) UpdateOrganization(ctx context.Context, opt *OrganizationOptions) error {
 	if !opt.valid() {
@@ -56,6 +82,15 @@ func (s *GithubSCM) UpdateOrganization(ctx context.Context, opt *OrganizationOpt
 	return err
 }
 
+// UpdateOrganizationVisibility implements the SCM interface.
+// GitHub organizations are always public.
+func (s *GithubSCM) UpdateOrganizationVisibility(ctx context.Context, orgID uint64, private bool) error {
+	return ErrNotSupported{
+		SCM:    "github",
+		Method: "UpdateOrganizationVisibility",
+	}
+}
+
 // GetOrganization implements the SCM interface.
 func (s *GithubSCM) GetOrganization(ctx context.Context, opt *GetOrgOptions) (*pb.Organization, error) {
 	if !opt.valid() {
@@ -106,6 +141,79 @@ func (s *GithubSCM) GetOrganization(ctx context.Context, opt *GetOrgOptions) (*p
 	}, nil
 }
 
+// ListOrganizations implements the SCM interface.
+func (s *GithubSCM) ListOrganizations(ctx context.Context, opt *ListOrgOptions) ([]*pb.Organization, error) {
+	if opt.ParentID > 0 {
+		// github organizations cannot be nested
+		return nil, ErrNotSupported{
+			SCM:    "github",
+			Method: "ListOrganizations",
+		}
+	}
+	listOpts := &github.ListOrgMembershipsOptions{
+		State:       "active",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	listPage := func(ctx context.Context, page int) ([]*github.Membership, *github.Response, error) {
+		pageOpts := *listOpts
+		pageOpts.Page = page
+		memberships, resp, err := s.client.Organizations.ListOrgMemberships(ctx, &pageOpts)
+		if err != nil {
+			return nil, nil, ErrFailedSCM{
+				Method:   "ListOrganizations",
+				Message:  "failed to list organization memberships",
+				GitError: err,
+			}
+		}
+		return memberships, resp, nil
+	}
+	memberships, resp, err := listPage(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+	if resp.LastPage > 1 {
+		pages := make([][]*github.Membership, resp.LastPage-1)
+		err = fetchPages(ctx, 2, resp.LastPage, func(ctx context.Context, page int) error {
+			var err error
+			pages[page-2], _, err = listPage(ctx, page)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, page := range pages {
+			memberships = append(memberships, page...)
+		}
+	} else {
+		// the last page is unknown if the link header omits it
+		for resp.NextPage > 0 {
+			var page []*github.Membership
+			page, resp, err = listPage(ctx, resp.NextPage)
+			if err != nil {
+				return nil, err
+			}
+			memberships = append(memberships, page...)
+		}
+	}
+
+	var orgs []*pb.Organization
+	for _, membership := range memberships {
+		if (opt.Manageable || opt.Owned) && membership.GetRole() != OrgOwner {
+			continue
+		}
+		gitOrg := membership.GetOrganization()
+		if !matchesSearch(gitOrg.GetLogin(), opt.Search) {
+			continue
+		}
+		orgs = append(orgs, &pb.Organization{
+			ID:     uint64(gitOrg.GetID()),
+			Path:   gitOrg.GetLogin(),
+			Avatar: gitOrg.GetAvatarURL(),
+		})
+	}
+	return orgs, nil
+}
+
 // CreateRepository implements the SCM interface.
 func (s *GithubSCM) CreateRepository(ctx context.Context, opt *CreateRepositoryOptions) (*Repository, error) {
 	if !opt.valid() {
@@ -138,6 +246,29 @@ func (s *GithubSCM) CreateRepository(ctx context.Context, opt *CreateRepositoryO
 	return toRepository(repo), nil
 }
 
+// CreateRepositoryFromTemplate implements the SCM interface.
+func (s *GithubSCM) CreateRepositoryFromTemplate(ctx context.Context, opt *CreateRepositoryOptions) (*Repository, error) {
+	if !opt.valid() || opt.Template == "" {
+		return nil, ErrMissingFields{
+			Method:  "CreateRepositoryFromTemplate",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	repo, _, err := s.client.Repositories.CreateFromTemplate(ctx, opt.Organization.Path, opt.Template, &github.TemplateRepoRequest{
+		Name:    &opt.Path,
+		Owner:   &opt.Organization.Path,
+		Private: &opt.Private,
+	})
+	if err != nil {
+		return nil, ErrFailedSCM{
+			Method:   "CreateRepositoryFromTemplate",
+			Message:  fmt.Sprintf("failed to create repository %s from template %s, make sure the template exists and is marked as a template repository", opt.Path, opt.Template),
+			GitError: err,
+		}
+	}
+	return toRepository(repo), nil
+}
+
 // GetRepository implements the SCM interface.
 func (s *GithubSCM) GetRepository(ctx context.Context, opt *RepositoryOptions) (*Repository, error) {
 	if !opt.valid() {
@@ -162,6 +293,26 @@ func (s *GithubSCM) GetRepository(ctx context.Context, opt *RepositoryOptions) (
 	return toRepository(repo), nil
 }
 
+// RepositoryExists implements the SCM interface.
+func (s *GithubSCM) RepositoryExists(ctx context.Context, repoID uint64) (bool, error) {
+	req, err := s.client.NewRequest(http.MethodHead, fmt.Sprintf("repositories/%d", repoID), nil)
+	if err != nil {
+		return false, err
+	}
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		return false, ErrFailedSCM{
+			Method:   "RepositoryExists",
+			Message:  fmt.Sprintf("failed to check if repository %d exists", repoID),
+			GitError: err,
+		}
+	}
+	return true, nil
+}
+
 // GetRepositories implements the SCM interface.
 func (s *GithubSCM) GetRepositories(ctx context.Context, org *pb.Organization) ([]*Repository, error) {
 	if !org.IsValid() {
@@ -251,6 +402,53 @@ func (s *GithubSCM) UpdateRepoAccess(ctx context.Context, repo *Repository, user
 	return nil
 }
 
+// RevokeRepoAccess implements the SCM interface.
+func (s *GithubSCM) RevokeRepoAccess(ctx context.Context, repo *Repository, user string) error {
+	if repo == nil || !repo.valid() {
+		return ErrMissingFields{
+			Method:  "RevokeRepoAccess",
+			Message: fmt.Sprintf("%+v", repo),
+		}
+	}
+	if _, err := s.client.Repositories.RemoveCollaborator(ctx, repo.Owner, repo.Path, user); err != nil {
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "RevokeRepoAccess",
+			Message:  fmt.Sprintf("failed to remove user %s as collaborator for repository %s", user, repo.Path),
+		}
+	}
+	return nil
+}
+
+// RenameRepository implements the SCM interface.
+func (s *GithubSCM) RenameRepository(ctx context.Context, repoID uint64, newName string) (*Repository, error) {
+	if repoID < 1 || newName == "" {
+		return nil, ErrMissingFields{
+			Method:  "RenameRepository",
+			Message: fmt.Sprintf("repository ID: %d, name: %s", repoID, newName),
+		}
+	}
+	repo, _, err := s.client.Repositories.GetByID(ctx, int64(repoID))
+	if err != nil {
+		return nil, ErrFailedSCM{
+			GitError: err,
+			Method:   "RenameRepository",
+			Message:  fmt.Sprintf("failed to fetch repository %d", repoID),
+		}
+	}
+	repo, _, err = s.client.Repositories.Edit(ctx, repo.GetOwner().GetLogin(), repo.GetName(), &github.Repository{
+		Name: &newName,
+	})
+	if err != nil {
+		return nil, ErrFailedSCM{
+			GitError: err,
+			Method:   "RenameRepository",
+			Message:  fmt.Sprintf("failed to rename repository %d to %s", repoID, newName),
+		}
+	}
+	return toRepository(repo), nil
+}
+
 // RepositoryIsEmpty implements the SCM interface
 func (s *GithubSCM) RepositoryIsEmpty(ctx context.Context, opt *RepositoryOptions) bool {
 	repo, err := s.GetRepository(ctx, opt)
@@ -284,11 +482,11 @@ func (s *GithubSCM) ListHooks(ctx context.Context, repo *Repository, org string)
 	case repo != nil && repo.valid():
 		githubHooks, _, err = s.client.Repositories.ListHooks(ctx, repo.Owner, repo.Path, nil)
 		if err != nil {
-			return nil, fmt.Errorf("ListHooks: failed to get hooks for repository %q: %w", repo, err)
+			return nil, fmt.Errorf("ListHooks: failed to get hooks for repository %s/%s: %w", repo.Owner, repo.Path, err)
 		}
 
 	default:
-		return nil, fmt.Errorf("ListHooks: called with missing or incompatible arguments: %q %q", repo, org)
+		return nil, fmt.Errorf("ListHooks: called with missing or incompatible arguments: %+v %q", repo, org)
 	}
 
 	for _, hook := range githubHooks {
@@ -301,16 +499,60 @@ func (s *GithubSCM) ListHooks(ctx context.Context, repo *Repository, org string)
 	return hooks, nil
 }
 
+// ListPullRequests implements the SCM interface.
+func (s *GithubSCM) ListPullRequests(ctx context.Context, opt *RepositoryOptions) ([]*PullRequest, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "ListPullRequests",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	owner, path := opt.Owner, opt.Path
+	if path == "" {
+		repo, err := s.GetRepository(ctx, opt)
+		if err != nil {
+			return nil, err
+		}
+		owner, path = repo.Owner, repo.Path
+	}
+
+	githubPullRequests, _, err := s.client.PullRequests.List(ctx, owner, path, &github.PullRequestListOptions{
+		State: "open",
+	})
+	if err != nil {
+		return nil, ErrFailedSCM{
+			Method:   "ListPullRequests",
+			Message:  fmt.Sprintf("failed to list pull requests for repository %s/%s", owner, path),
+			GitError: err,
+		}
+	}
+
+	var pullRequests []*PullRequest
+	for _, pr := range githubPullRequests {
+		pullRequests = append(pullRequests, &PullRequest{
+			ID:           uint64(pr.GetNumber()),
+			Title:        pr.GetTitle(),
+			Author:       pr.GetUser().GetLogin(),
+			State:        pr.GetState(),
+			WebURL:       pr.GetHTMLURL(),
+			SourceBranch: pr.GetHead().GetRef(),
+			SHA:          pr.GetHead().GetSHA(),
+		})
+	}
+	return pullRequests, nil
+}
+
 // CreateHook implements the SCM interface.
-func (s *GithubSCM) CreateHook(ctx context.Context, opt *CreateHookOptions) error {
+func (s *GithubSCM) CreateHook(ctx context.Context, opt *CreateHookOptions) (*Hook, error) {
 	if !opt.valid() {
-		return ErrMissingFields{
+		return nil, ErrMissingFields{
 			Method:  "CreateHook",
 			Message: fmt.Sprintf("%+v", opt),
 		}
 	}
 
 	hook := &github.Hook{
+		Events: []string{"push", "pull_request"},
 		Config: map[string]interface{}{
 			"url":          opt.URL,
 			"secret":       opt.Secret,
@@ -321,21 +563,94 @@ func (s *GithubSCM) CreateHook(ctx context.Context, opt *CreateHookOptions) erro
 	var err error
 	// prioritize creating an organization hook
 	if opt.Organization != "" {
-		_, _, err = s.client.Organizations.CreateHook(ctx, opt.Organization, hook)
+		hook, _, err = s.client.Organizations.CreateHook(ctx, opt.Organization, hook)
 		if err != nil {
-			return fmt.Errorf("CreateOrgHook: failed to create GitHub hook for org %s: %w", opt.Organization, err)
+			return nil, fmt.Errorf("CreateOrgHook: failed to create GitHub hook for org %s: %w", opt.Organization, err)
 		}
 	} else {
-		_, _, err = s.client.Repositories.CreateHook(ctx, opt.Repository.Owner, opt.Repository.Path, hook)
+		hook, _, err = s.client.Repositories.CreateHook(ctx, opt.Repository.Owner, opt.Repository.Path, hook)
 	}
 	if err != nil {
-		return ErrFailedSCM{
+		return nil, ErrFailedSCM{
 			GitError: err,
 			Method:   "CreateHook",
 			Message:  fmt.Sprintf("failed to create GitHub hook with query: %+v", opt),
 		}
 	}
-	return err
+	return &Hook{
+		ID:     uint64(hook.GetID()),
+		URL:    hook.GetURL(),
+		Events: hook.Events,
+	}, nil
+}
+
+// DeleteHook implements the SCM interface.
+func (s *GithubSCM) DeleteHook(ctx context.Context, repoID, hookID uint64) error {
+	if repoID < 1 || hookID < 1 {
+		return ErrMissingFields{
+			Method:  "DeleteHook",
+			Message: fmt.Sprintf("repository ID %d, hook ID %d", repoID, hookID),
+		}
+	}
+	repo, _, err := s.client.Repositories.GetByID(ctx, int64(repoID))
+	if err != nil {
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "DeleteHook",
+			Message:  fmt.Sprintf("failed to fetch repository %d", repoID),
+		}
+	}
+	resp, err := s.client.Repositories.DeleteHook(ctx, repo.Owner.GetLogin(), repo.GetName(), int64(hookID))
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("hook %d %w", hookID, ErrNotFound)
+		}
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "DeleteHook",
+			Message:  fmt.Sprintf("failed to delete hook %d for repository %s", hookID, repo.GetFullName()),
+		}
+	}
+	return nil
+}
+
+// DeleteOrganizationHook implements the SCM interface.
+func (s *GithubSCM) DeleteOrganizationHook(ctx context.Context, org string, hookID uint64) error {
+	if org == "" || hookID < 1 {
+		return ErrMissingFields{
+			Method:  "DeleteOrganizationHook",
+			Message: fmt.Sprintf("organization %q, hook ID %d", org, hookID),
+		}
+	}
+	resp, err := s.client.Organizations.DeleteHook(ctx, org, int64(hookID))
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("hook %d %w", hookID, ErrNotFound)
+		}
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "DeleteOrganizationHook",
+			Message:  fmt.Sprintf("failed to delete hook %d for organization %s", hookID, org),
+		}
+	}
+	return nil
+}
+
+// CreateRepositoryAccessToken implements the SCM interface.
+// GitHub has no access tokens limited to a single repository that can be created through its API.
+func (s *GithubSCM) CreateRepositoryAccessToken(ctx context.Context, repoID uint64, scopes []string, expiry time.Time) (*AccessToken, error) {
+	return nil, ErrNotSupported{
+		SCM:    "github",
+		Method: "CreateRepositoryAccessToken",
+	}
+}
+
+// ProtectBranch implements the SCM interface.
+func (s *GithubSCM) ProtectBranch(ctx context.Context, repoID uint64, branch string, allowForcePush bool) error {
+	return ErrNotSupported{
+		SCM:    "github",
+		Method: "ProtectBranch",
+	}
 }
 
 // CreateTeam implements the SCM interface.
@@ -488,6 +803,72 @@ func (s *GithubSCM) AddTeamMember(ctx context.Context, opt *TeamMembershipOption
 	return err
 }
 
+// AddTeamMembers implements the scm interface.
+// GitHub cannot add several members to a team in one request; the team's members
+// are listed first, such that only the users missing from the team are added.
+func (s *GithubSCM) AddTeamMembers(ctx context.Context, opt *TeamMembershipOptions, logins []string) error {
+	if !opt.validTeam() {
+		return ErrMissingFields{
+			Method:  "AddTeamMembers",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	if len(logins) == 0 {
+		return nil
+	}
+
+	teamSlug := slug.Make(opt.TeamName)
+	isMember := make(map[string]bool)
+	listOpt := &github.TeamListTeamMembersOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		var (
+			members []*github.User
+			resp    *github.Response
+			err     error
+		)
+		if opt.TeamID < 1 {
+			members, resp, err = s.client.Teams.ListTeamMembersBySlug(ctx, opt.Organization, teamSlug, listOpt)
+		} else {
+			members, resp, err = s.client.Teams.ListTeamMembersByID(ctx, int64(opt.OrganizationID), int64(opt.TeamID), listOpt)
+		}
+		if err != nil {
+			return ErrFailedSCM{
+				GitError: err,
+				Method:   "AddTeamMembers",
+				Message:  fmt.Sprintf("failed to list members of team (ID %d, team name: %s)", opt.TeamID, opt.TeamName),
+			}
+		}
+		for _, member := range members {
+			isMember[strings.ToLower(member.GetLogin())] = true
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		listOpt.Page = resp.NextPage
+	}
+
+	membershipOpt := &github.TeamAddTeamMembershipOptions{Role: opt.Role}
+	for _, login := range logins {
+		if isMember[strings.ToLower(login)] {
+			continue
+		}
+		var err error
+		if opt.TeamID < 1 {
+			_, _, err = s.client.Teams.AddTeamMembershipBySlug(ctx, opt.Organization, teamSlug, login, membershipOpt)
+		} else {
+			_, _, err = s.client.Teams.AddTeamMembershipByID(ctx, int64(opt.OrganizationID), int64(opt.TeamID), login, membershipOpt)
+		}
+		if err != nil {
+			return ErrFailedSCM{
+				GitError: err,
+				Method:   "AddTeamMembers",
+				Message:  fmt.Sprintf("failed to add user (%s) to team (ID %d, team name: %s) with role %s", login, opt.TeamID, opt.TeamName, opt.Role),
+			}
+		}
+	}
+	return nil
+}
+
 // RemoveTeamMember implements the scm interface
 func (s *GithubSCM) RemoveTeamMember(ctx context.Context, opt *TeamMembershipOptions) error {
 	if !opt.valid() {
@@ -514,6 +895,47 @@ func (s *GithubSCM) RemoveTeamMember(ctx context.Context, opt *TeamMembershipOpt
 	return err
 }
 
+// UpdateTeamMembership implements the SCM interface.
+// Returns an error wrapping ErrNotFound if the user is not a member of the team.
+func (s *GithubSCM) UpdateTeamMembership(ctx context.Context, opt *TeamMembershipOptions) error {
+	if !opt.valid() || opt.Role == "" {
+		return ErrMissingFields{
+			Method:  "UpdateTeamMembership",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+
+	var (
+		resp *github.Response
+		err  error
+	)
+	membershipOpt := &github.TeamAddTeamMembershipOptions{Role: opt.Role}
+	if opt.TeamID < 1 {
+		teamSlug := slug.Make(opt.TeamName)
+		if _, resp, err = s.client.Teams.GetTeamMembershipBySlug(ctx, opt.Organization, teamSlug, opt.Username); err == nil {
+			// adding an existing member changes the member's role
+			_, _, err = s.client.Teams.AddTeamMembershipBySlug(ctx, opt.Organization, teamSlug, opt.Username, membershipOpt)
+		}
+	} else {
+		orgID, teamID := int64(opt.OrganizationID), int64(opt.TeamID)
+		if _, resp, err = s.client.Teams.GetTeamMembershipByID(ctx, orgID, teamID, opt.Username); err == nil {
+			_, _, err = s.client.Teams.AddTeamMembershipByID(ctx, orgID, teamID, opt.Username, membershipOpt)
+		}
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("member %s of team (ID %d, team name: %s) %w", opt.Username, opt.TeamID, opt.TeamName, ErrNotFound)
+		}
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "UpdateTeamMembership",
+			Message:  fmt.Sprintf("failed to change role of user (%s) in team (ID %d, team name: %s) to %s", opt.Username, opt.TeamID, opt.TeamName, opt.Role),
+		}
+	}
+	return nil
+}
+
 // UpdateTeamMembers implements the SCM interface
 func (s *GithubSCM) UpdateTeamMembers(ctx context.Context, opt *UpdateTeamOptions) error {
 	if !opt.valid() {
@@ -659,6 +1081,58 @@ func (s *GithubSCM) RemoveMember(ctx context.Context, opt *OrgMembershipOptions)
 	return nil
 }
 
+// ListOrganizationMembers implements the SCM interface
+func (s *GithubSCM) ListOrganizationMembers(ctx context.Context, org *pb.Organization) ([]*OrganizationMember, error) {
+	if org.GetPath() == "" {
+		return nil, ErrMissingFields{
+			Method:  "ListOrganizationMembers",
+			Message: fmt.Sprintf("%+v", org),
+		}
+	}
+
+	var members []*OrganizationMember
+	opt := &github.ListMembersOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		users, resp, err := s.client.Organizations.ListMembers(ctx, org.GetPath(), opt)
+		if err != nil {
+			return nil, ErrFailedSCM{
+				Method:   "ListOrganizationMembers",
+				Message:  fmt.Sprintf("failed to list members of organization %s", org.GetPath()),
+				GitError: err,
+			}
+		}
+		for _, user := range users {
+			members = append(members, &OrganizationMember{
+				ID:    uint64(user.GetID()),
+				Login: user.GetLogin(),
+			})
+		}
+		if resp.NextPage == 0 {
+			return members, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// IsOrgMember implements the SCM interface
+func (s *GithubSCM) IsOrgMember(ctx context.Context, org, login string) (bool, error) {
+	if org == "" || login == "" {
+		return false, ErrMissingFields{
+			Method:  "IsOrgMember",
+			Message: fmt.Sprintf("organization %q, user %q", org, login),
+		}
+	}
+	member, _, err := s.client.Organizations.IsMember(ctx, org, login)
+	if err != nil {
+		return false, ErrFailedSCM{
+			Method:   "IsOrgMember",
+			Message:  fmt.Sprintf("failed to check membership of user %s in organization %s", login, org),
+			GitError: err,
+		}
+	}
+	return member, nil
+}
+
 // GetUserScopes implements the SCM interface
 func (s *GithubSCM) GetUserScopes(ctx context.Context) *Authorization {
 	// Users.Get method will always return nil, response struct and error,
@@ -681,6 +1155,86 @@ func (s *GithubSCM) GetUserScopes(ctx context.Context) *Authorization {
 	return &Authorization{Scopes: gitScopes}
 }
 
+// GetCommit implements the SCM interface
+func (s *GithubSCM) GetCommit(ctx context.Context, repoID uint64, sha string) (*Commit, error) {
+	repo, err := s.GetRepository(ctx, &RepositoryOptions{ID: repoID})
+	if err != nil {
+		return nil, err
+	}
+	commit, _, err := s.client.Repositories.GetCommit(ctx, repo.Owner, repo.Path, sha)
+	if err != nil {
+		return nil, ErrFailedSCM{
+			Method:   "GetCommit",
+			Message:  fmt.Sprintf("failed to get commit %s in repository %s/%s", sha, repo.Owner, repo.Path),
+			GitError: err,
+		}
+	}
+	c := toCommit(commit)
+	for _, file := range commit.Files {
+		c.Files = append(c.Files, file.GetFilename())
+	}
+	return c, nil
+}
+
+// ListCommits implements the SCM interface
+func (s *GithubSCM) ListCommits(ctx context.Context, repoID uint64, since time.Time) ([]*Commit, error) {
+	repo, err := s.GetRepository(ctx, &RepositoryOptions{ID: repoID})
+	if err != nil {
+		return nil, err
+	}
+	var commits []*Commit
+	opt := &github.CommitsListOptions{Since: since, ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		repoCommits, resp, err := s.client.Repositories.ListCommits(ctx, repo.Owner, repo.Path, opt)
+		if err != nil {
+			return nil, ErrFailedSCM{
+				Method:   "ListCommits",
+				Message:  fmt.Sprintf("failed to list commits in repository %s/%s", repo.Owner, repo.Path),
+				GitError: err,
+			}
+		}
+		for _, commit := range repoCommits {
+			commits = append(commits, toCommit(commit))
+		}
+		if resp.NextPage == 0 {
+			return commits, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+func toCommit(commit *github.RepositoryCommit) *Commit {
+	gitCommit := commit.GetCommit()
+	return &Commit{
+		SHA:       commit.GetSHA(),
+		Author:    gitCommit.GetAuthor().GetName(),
+		Committer: gitCommit.GetCommitter().GetName(),
+		Message:   gitCommit.GetMessage(),
+		Date:      gitCommit.GetCommitter().GetDate(),
+	}
+}
+
+// VerifyScopes implements the SCM interface
+func (s *GithubSCM) VerifyScopes(ctx context.Context, required []string) error {
+	_, resp, err := s.client.Users.Get(ctx, "")
+	if resp == nil {
+		return ErrFailedSCM{
+			Method:   "VerifyScopes",
+			Message:  "failed to get the scopes of the access token",
+			GitError: err,
+		}
+	}
+	// header contains a single string with all GitHub scopes for the access token
+	var granted []string
+	if header := resp.Header.Get("X-OAuth-Scopes"); header != "" {
+		granted = strings.Split(header, ",")
+	}
+	if missing := missingScopes(granted, required); len(missing) > 0 {
+		return ErrMissingScopes{SCM: "github", Scopes: missing}
+	}
+	return nil
+}
+
 func toRepository(repo *github.Repository) *Repository {
 	return &Repository{
 		ID:      uint64(repo.GetID()),
@@ -691,6 +1245,9 @@ func toRepository(repo *github.Repository) *Repository {
 		HTTPURL: repo.GetCloneURL(),
 		OrgID:   uint64(repo.Organization.GetID()),
 		Size:    uint64(repo.GetSize()),
+
+		DefaultBranch: repo.GetDefaultBranch(),
+		Archived:      repo.GetArchived(),
 	}
 }
 
@@ -703,7 +1260,7 @@ func (s *GithubSCM) GetFileContent(ctx context.Context, opt *FileOptions) (strin
 		}
 	}
 
-	fileContent, _, _, err := s.client.Repositories.GetContents(ctx, opt.Owner, opt.Repository, opt.Path, nil)
+	fileContent, _, _, err := s.client.Repositories.GetContents(ctx, opt.Owner, opt.Repository, opt.Path, &github.RepositoryContentGetOptions{Ref: opt.Ref})
 	if err != nil || fileContent == nil {
 		return "", ErrFailedSCM{
 			Method:   "GetFileContent",
@@ -728,3 +1285,33 @@ func (s *GithubSCM) GetFileContent(ctx context.Context, opt *FileOptions) (strin
 	}
 	return contentString, nil
 }
+
+// ListFiles implements the SCM interface
+func (s *GithubSCM) ListFiles(ctx context.Context, opt *FileOptions) ([]string, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "ListFiles",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	ref := opt.Ref
+	if ref == "" {
+		ref = "HEAD"
+	}
+	tree, _, err := s.client.Git.GetTree(ctx, opt.Owner, opt.Repository, ref, true)
+	if err != nil {
+		return nil, ErrFailedSCM{
+			Method:   "ListFiles",
+			GitError: fmt.Errorf("failed to get tree %s in repo %s of organization %s: %w", ref, opt.Repository, opt.Owner, err),
+			Message:  fmt.Sprintf("failed to list files at %s", opt.Path),
+		}
+	}
+	prefix := strings.TrimSuffix(opt.Path, "/") + "/"
+	var files []string
+	for _, entry := range tree.Entries {
+		if entry.GetType() == "blob" && strings.HasPrefix(entry.GetPath(), prefix) {
+			files = append(files, entry.GetPath())
+		}
+	}
+	return files, nil
+}
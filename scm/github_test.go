@@ -105,7 +105,7 @@ func TestCreateHook(t *testing.T) {
 		Secret:     secret,
 		Repository: &scm.Repository{Owner: gitHubTestOrg, Path: "tests"},
 	}
-	err = s.CreateHook(ctx, opt)
+	_, err = s.CreateHook(ctx, opt)
 	if err != nil {
 		t.Fatal(err)
 	}
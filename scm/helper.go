@@ -1,6 +1,13 @@
 package scm
 
-import "errors"
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v32/github"
+	gitlab "github.com/xanzy/go-gitlab"
+)
 
 const (
 	// Organization roles //
@@ -51,8 +58,40 @@ var (
 	ErrNotMember = errors.New("user is not a member of the organization")
 	// ErrNotOwner indicates that user has no admin rights in the requested organization.
 	ErrNotOwner = errors.New("user is not an owner of the organization")
+	// ErrNotFound indicates that the requested resource does not exist.
+	ErrNotFound = errors.New("not found")
 )
 
+// IsNotFound returns true if the given error was caused by
+// a request for a resource that does not exist on the SCM.
+func IsNotFound(err error) bool {
+	if errors.Is(err, ErrNotFound) {
+		return true
+	}
+	var githubErr *github.ErrorResponse
+	if errors.As(err, &githubErr) {
+		return githubErr.Response != nil && githubErr.Response.StatusCode == http.StatusNotFound
+	}
+	var gitlabErr *gitlab.ErrorResponse
+	if errors.As(err, &gitlabErr) {
+		return gitlabErr.Response != nil && gitlabErr.Response.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+// IsNotSupported returns true if the given error was caused by
+// calling a method that is not supported by the SCM.
+func IsNotSupported(err error) bool {
+	var notSupported ErrNotSupported
+	return errors.As(err, &notSupported)
+}
+
+// matchesSearch returns true if the name contains the search string, ignoring case.
+// An empty search string matches all names.
+func matchesSearch(name, search string) bool {
+	return strings.Contains(strings.ToLower(name), strings.ToLower(search))
+}
+
 // Validators //
 
 func (opt OrganizationOptions) valid() bool {
@@ -99,9 +138,12 @@ func (opt NewTeamOptions) valid() bool {
 }
 
 func (opt TeamMembershipOptions) valid() bool {
-	return (opt.TeamID > 0 && opt.OrganizationID > 0 ||
-		opt.TeamName != "" && opt.Organization != "") &&
-		opt.Username != ""
+	return opt.validTeam() && opt.Username != ""
+}
+
+func (opt TeamMembershipOptions) validTeam() bool {
+	return opt.TeamID > 0 && opt.OrganizationID > 0 ||
+		opt.TeamName != "" && opt.Organization != ""
 }
 
 func (opt OrgMembershipOptions) valid() bool {
@@ -130,6 +172,32 @@ func (e ErrNotSupported) Error() string {
 	return "method " + e.Method + " not supported by " + e.SCM + " SCM"
 }
 
+// ErrMissingScopes is returned when the access token used by an SCM client
+// lacks scopes required to manage organizations, teams and repositories.
+type ErrMissingScopes struct {
+	SCM    string
+	Scopes []string
+}
+
+func (e ErrMissingScopes) Error() string {
+	return e.SCM + " access token is missing required scopes: " + strings.Join(e.Scopes, ", ")
+}
+
+// missingScopes returns the required scopes that are not among the granted scopes.
+func missingScopes(granted, required []string) []string {
+	grantedScopes := make(map[string]bool)
+	for _, scope := range granted {
+		grantedScopes[strings.TrimSpace(scope)] = true
+	}
+	var missing []string
+	for _, scope := range required {
+		if !grantedScopes[scope] {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
+
 // ErrMissingFields is returned when scm struct validation fails.
 // This error only used for development/debugging and never goes to frontend user.
 type ErrMissingFields struct {
@@ -154,3 +222,8 @@ type ErrFailedSCM struct {
 func (e ErrFailedSCM) Error() string {
 	return "github method " + e.Method + " failed: " + e.GitError.Error() + "\n" + e.Message
 }
+
+// Unwrap returns the original error from GitHub.
+func (e ErrFailedSCM) Unwrap() error {
+	return e.GitError
+}
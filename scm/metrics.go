@@ -0,0 +1,334 @@
+package scm
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// Metrics is used to observe calls to SCM clients, e.g., to export call
+// counts and latencies to a monitoring system. Implementations must be
+// safe for concurrent use.
+type Metrics interface {
+	// ObserveSCMCall is invoked after each call to an SCM method with the
+	// method name, the time it took to complete and the returned error, if any.
+	ObserveSCMCall(method string, duration time.Duration, err error)
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) ObserveSCMCall(string, time.Duration, error) {}
+
+// metricsHolder wraps Metrics so that atomic.Value always stores the same concrete type.
+type metricsHolder struct {
+	Metrics
+}
+
+var scmMetrics atomic.Value
+
+func init() {
+	scmMetrics.Store(metricsHolder{noopMetrics{}})
+}
+
+// SetMetrics sets the metrics observing calls to SCM clients returned by
+// NewSCMClient. Passing nil disables the metrics.
+func SetMetrics(m Metrics) {
+	if m == nil {
+		m = noopMetrics{}
+	}
+	scmMetrics.Store(metricsHolder{m})
+}
+
+func getMetrics() Metrics {
+	return scmMetrics.Load().(metricsHolder).Metrics
+}
+
+// instrumentedSCM wraps an SCM client, reporting each call to the current Metrics.
+type instrumentedSCM struct {
+	scm SCM
+}
+
+func (s *instrumentedSCM) observe(method string, start time.Time, err *error) {
+	var e error
+	if err != nil {
+		e = *err
+	}
+	getMetrics().ObserveSCMCall(method, time.Since(start), e)
+}
+
+// CreateOrganization implements the SCM interface.
+func (s *instrumentedSCM) CreateOrganization(ctx context.Context, opt *OrganizationOptions) (_ *pb.Organization, err error) {
+	defer s.observe("CreateOrganization", time.Now(), &err)
+	return s.scm.CreateOrganization(ctx, opt)
+}
+
+// EnsureOrganization implements the SCM interface.
+func (s *instrumentedSCM) EnsureOrganization(ctx context.Context, opt *OrganizationOptions) (_ *pb.Organization, err error) {
+	defer s.observe("EnsureOrganization", time.Now(), &err)
+	return s.scm.EnsureOrganization(ctx, opt)
+}
+
+// UpdateOrganization implements the SCM interface.
+func (s *instrumentedSCM) UpdateOrganization(ctx context.Context, opt *OrganizationOptions) (err error) {
+	defer s.observe("UpdateOrganization", time.Now(), &err)
+	return s.scm.UpdateOrganization(ctx, opt)
+}
+
+// UpdateOrganizationVisibility implements the SCM interface.
+func (s *instrumentedSCM) UpdateOrganizationVisibility(ctx context.Context, orgID uint64, private bool) (err error) {
+	defer s.observe("UpdateOrganizationVisibility", time.Now(), &err)
+	return s.scm.UpdateOrganizationVisibility(ctx, orgID, private)
+}
+
+// GetOrganization implements the SCM interface.
+func (s *instrumentedSCM) GetOrganization(ctx context.Context, opt *GetOrgOptions) (_ *pb.Organization, err error) {
+	defer s.observe("GetOrganization", time.Now(), &err)
+	return s.scm.GetOrganization(ctx, opt)
+}
+
+// ListOrganizations implements the SCM interface.
+func (s *instrumentedSCM) ListOrganizations(ctx context.Context, opt *ListOrgOptions) (_ []*pb.Organization, err error) {
+	defer s.observe("ListOrganizations", time.Now(), &err)
+	return s.scm.ListOrganizations(ctx, opt)
+}
+
+// CreateRepository implements the SCM interface.
+func (s *instrumentedSCM) CreateRepository(ctx context.Context, opt *CreateRepositoryOptions) (_ *Repository, err error) {
+	defer s.observe("CreateRepository", time.Now(), &err)
+	return s.scm.CreateRepository(ctx, opt)
+}
+
+// CreateRepositoryFromTemplate implements the SCM interface.
+func (s *instrumentedSCM) CreateRepositoryFromTemplate(ctx context.Context, opt *CreateRepositoryOptions) (_ *Repository, err error) {
+	defer s.observe("CreateRepositoryFromTemplate", time.Now(), &err)
+	return s.scm.CreateRepositoryFromTemplate(ctx, opt)
+}
+
+// GetRepository implements the SCM interface.
+func (s *instrumentedSCM) GetRepository(ctx context.Context, opt *RepositoryOptions) (_ *Repository, err error) {
+	defer s.observe("GetRepository", time.Now(), &err)
+	return s.scm.GetRepository(ctx, opt)
+}
+
+// RepositoryExists implements the SCM interface.
+func (s *instrumentedSCM) RepositoryExists(ctx context.Context, repoID uint64) (_ bool, err error) {
+	defer s.observe("RepositoryExists", time.Now(), &err)
+	return s.scm.RepositoryExists(ctx, repoID)
+}
+
+// GetRepositories implements the SCM interface.
+func (s *instrumentedSCM) GetRepositories(ctx context.Context, org *pb.Organization) (_ []*Repository, err error) {
+	defer s.observe("GetRepositories", time.Now(), &err)
+	return s.scm.GetRepositories(ctx, org)
+}
+
+// DeleteRepository implements the SCM interface.
+func (s *instrumentedSCM) DeleteRepository(ctx context.Context, opt *RepositoryOptions) (err error) {
+	defer s.observe("DeleteRepository", time.Now(), &err)
+	return s.scm.DeleteRepository(ctx, opt)
+}
+
+// UpdateRepoAccess implements the SCM interface.
+func (s *instrumentedSCM) UpdateRepoAccess(ctx context.Context, repo *Repository, user, permission string) (err error) {
+	defer s.observe("UpdateRepoAccess", time.Now(), &err)
+	return s.scm.UpdateRepoAccess(ctx, repo, user, permission)
+}
+
+// RevokeRepoAccess implements the SCM interface.
+func (s *instrumentedSCM) RevokeRepoAccess(ctx context.Context, repo *Repository, user string) (err error) {
+	defer s.observe("RevokeRepoAccess", time.Now(), &err)
+	return s.scm.RevokeRepoAccess(ctx, repo, user)
+}
+
+// RenameRepository implements the SCM interface.
+func (s *instrumentedSCM) RenameRepository(ctx context.Context, repoID uint64, newName string) (_ *Repository, err error) {
+	defer s.observe("RenameRepository", time.Now(), &err)
+	return s.scm.RenameRepository(ctx, repoID, newName)
+}
+
+// RepositoryIsEmpty implements the SCM interface.
+func (s *instrumentedSCM) RepositoryIsEmpty(ctx context.Context, opt *RepositoryOptions) bool {
+	defer s.observe("RepositoryIsEmpty", time.Now(), nil)
+	return s.scm.RepositoryIsEmpty(ctx, opt)
+}
+
+// ListHooks implements the SCM interface.
+func (s *instrumentedSCM) ListHooks(ctx context.Context, repo *Repository, org string) (_ []*Hook, err error) {
+	defer s.observe("ListHooks", time.Now(), &err)
+	return s.scm.ListHooks(ctx, repo, org)
+}
+
+// ListPullRequests implements the SCM interface.
+func (s *instrumentedSCM) ListPullRequests(ctx context.Context, opt *RepositoryOptions) (_ []*PullRequest, err error) {
+	defer s.observe("ListPullRequests", time.Now(), &err)
+	return s.scm.ListPullRequests(ctx, opt)
+}
+
+// GetCommit implements the SCM interface.
+func (s *instrumentedSCM) GetCommit(ctx context.Context, repoID uint64, sha string) (_ *Commit, err error) {
+	defer s.observe("GetCommit", time.Now(), &err)
+	return s.scm.GetCommit(ctx, repoID, sha)
+}
+
+// ListCommits implements the SCM interface.
+func (s *instrumentedSCM) ListCommits(ctx context.Context, repoID uint64, since time.Time) (_ []*Commit, err error) {
+	defer s.observe("ListCommits", time.Now(), &err)
+	return s.scm.ListCommits(ctx, repoID, since)
+}
+
+// CreateHook implements the SCM interface.
+func (s *instrumentedSCM) CreateHook(ctx context.Context, opt *CreateHookOptions) (_ *Hook, err error) {
+	defer s.observe("CreateHook", time.Now(), &err)
+	return s.scm.CreateHook(ctx, opt)
+}
+
+// DeleteHook implements the SCM interface.
+func (s *instrumentedSCM) DeleteHook(ctx context.Context, repoID, hookID uint64) (err error) {
+	defer s.observe("DeleteHook", time.Now(), &err)
+	return s.scm.DeleteHook(ctx, repoID, hookID)
+}
+
+// DeleteOrganizationHook implements the SCM interface.
+func (s *instrumentedSCM) DeleteOrganizationHook(ctx context.Context, org string, hookID uint64) (err error) {
+	defer s.observe("DeleteOrganizationHook", time.Now(), &err)
+	return s.scm.DeleteOrganizationHook(ctx, org, hookID)
+}
+
+// ProtectBranch implements the SCM interface.
+func (s *instrumentedSCM) ProtectBranch(ctx context.Context, repoID uint64, branch string, allowForcePush bool) (err error) {
+	defer s.observe("ProtectBranch", time.Now(), &err)
+	return s.scm.ProtectBranch(ctx, repoID, branch, allowForcePush)
+}
+
+// CreateRepositoryAccessToken implements the SCM interface.
+func (s *instrumentedSCM) CreateRepositoryAccessToken(ctx context.Context, repoID uint64, scopes []string, expiry time.Time) (_ *AccessToken, err error) {
+	defer s.observe("CreateRepositoryAccessToken", time.Now(), &err)
+	return s.scm.CreateRepositoryAccessToken(ctx, repoID, scopes, expiry)
+}
+
+// CreateTeam implements the SCM interface.
+func (s *instrumentedSCM) CreateTeam(ctx context.Context, opt *NewTeamOptions) (_ *Team, err error) {
+	defer s.observe("CreateTeam", time.Now(), &err)
+	return s.scm.CreateTeam(ctx, opt)
+}
+
+// DeleteTeam implements the SCM interface.
+func (s *instrumentedSCM) DeleteTeam(ctx context.Context, opt *TeamOptions) (err error) {
+	defer s.observe("DeleteTeam", time.Now(), &err)
+	return s.scm.DeleteTeam(ctx, opt)
+}
+
+// GetTeam implements the SCM interface.
+func (s *instrumentedSCM) GetTeam(ctx context.Context, opt *TeamOptions) (_ *Team, err error) {
+	defer s.observe("GetTeam", time.Now(), &err)
+	return s.scm.GetTeam(ctx, opt)
+}
+
+// GetTeams implements the SCM interface.
+func (s *instrumentedSCM) GetTeams(ctx context.Context, org *pb.Organization) (_ []*Team, err error) {
+	defer s.observe("GetTeams", time.Now(), &err)
+	return s.scm.GetTeams(ctx, org)
+}
+
+// AddTeamRepo implements the SCM interface.
+func (s *instrumentedSCM) AddTeamRepo(ctx context.Context, opt *AddTeamRepoOptions) (err error) {
+	defer s.observe("AddTeamRepo", time.Now(), &err)
+	return s.scm.AddTeamRepo(ctx, opt)
+}
+
+// AddTeamMember implements the SCM interface.
+func (s *instrumentedSCM) AddTeamMember(ctx context.Context, opt *TeamMembershipOptions) (err error) {
+	defer s.observe("AddTeamMember", time.Now(), &err)
+	return s.scm.AddTeamMember(ctx, opt)
+}
+
+// AddTeamMembers implements the SCM interface.
+func (s *instrumentedSCM) AddTeamMembers(ctx context.Context, opt *TeamMembershipOptions, logins []string) (err error) {
+	defer s.observe("AddTeamMembers", time.Now(), &err)
+	return s.scm.AddTeamMembers(ctx, opt, logins)
+}
+
+// RemoveTeamMember implements the SCM interface.
+func (s *instrumentedSCM) RemoveTeamMember(ctx context.Context, opt *TeamMembershipOptions) (err error) {
+	defer s.observe("RemoveTeamMember", time.Now(), &err)
+	return s.scm.RemoveTeamMember(ctx, opt)
+}
+
+// UpdateTeamMembership implements the SCM interface.
+func (s *instrumentedSCM) UpdateTeamMembership(ctx context.Context, opt *TeamMembershipOptions) (err error) {
+	defer s.observe("UpdateTeamMembership", time.Now(), &err)
+	return s.scm.UpdateTeamMembership(ctx, opt)
+}
+
+// UpdateTeamMembers implements the SCM interface.
+func (s *instrumentedSCM) UpdateTeamMembers(ctx context.Context, opt *UpdateTeamOptions) (err error) {
+	defer s.observe("UpdateTeamMembers", time.Now(), &err)
+	return s.scm.UpdateTeamMembers(ctx, opt)
+}
+
+// GetUserName implements the SCM interface.
+func (s *instrumentedSCM) GetUserName(ctx context.Context) (_ string, err error) {
+	defer s.observe("GetUserName", time.Now(), &err)
+	return s.scm.GetUserName(ctx)
+}
+
+// GetUserNameByID implements the SCM interface.
+func (s *instrumentedSCM) GetUserNameByID(ctx context.Context, remoteID uint64) (_ string, err error) {
+	defer s.observe("GetUserNameByID", time.Now(), &err)
+	return s.scm.GetUserNameByID(ctx, remoteID)
+}
+
+// CreateCloneURL implements the SCM interface.
+func (s *instrumentedSCM) CreateCloneURL(opt *CreateClonePathOptions) string {
+	return s.scm.CreateCloneURL(opt)
+}
+
+// UpdateOrgMembership implements the SCM interface.
+func (s *instrumentedSCM) UpdateOrgMembership(ctx context.Context, opt *OrgMembershipOptions) (err error) {
+	defer s.observe("UpdateOrgMembership", time.Now(), &err)
+	return s.scm.UpdateOrgMembership(ctx, opt)
+}
+
+// RemoveMember implements the SCM interface.
+func (s *instrumentedSCM) RemoveMember(ctx context.Context, opt *OrgMembershipOptions) (err error) {
+	defer s.observe("RemoveMember", time.Now(), &err)
+	return s.scm.RemoveMember(ctx, opt)
+}
+
+// ListOrganizationMembers implements the SCM interface.
+func (s *instrumentedSCM) ListOrganizationMembers(ctx context.Context, org *pb.Organization) (_ []*OrganizationMember, err error) {
+	defer s.observe("ListOrganizationMembers", time.Now(), &err)
+	return s.scm.ListOrganizationMembers(ctx, org)
+}
+
+// IsOrgMember implements the SCM interface.
+func (s *instrumentedSCM) IsOrgMember(ctx context.Context, org, login string) (_ bool, err error) {
+	defer s.observe("IsOrgMember", time.Now(), &err)
+	return s.scm.IsOrgMember(ctx, org, login)
+}
+
+// GetUserScopes implements the SCM interface.
+func (s *instrumentedSCM) GetUserScopes(ctx context.Context) *Authorization {
+	defer s.observe("GetUserScopes", time.Now(), nil)
+	return s.scm.GetUserScopes(ctx)
+}
+
+// VerifyScopes implements the SCM interface.
+func (s *instrumentedSCM) VerifyScopes(ctx context.Context, required []string) (err error) {
+	defer s.observe("VerifyScopes", time.Now(), &err)
+	return s.scm.VerifyScopes(ctx, required)
+}
+
+// GetFileContent implements the SCM interface.
+func (s *instrumentedSCM) GetFileContent(ctx context.Context, opt *FileOptions) (_ string, err error) {
+	defer s.observe("GetFileContent", time.Now(), &err)
+	return s.scm.GetFileContent(ctx, opt)
+}
+
+// ListFiles implements the SCM interface.
+func (s *instrumentedSCM) ListFiles(ctx context.Context, opt *FileOptions) (_ []string, err error) {
+	defer s.observe("ListFiles", time.Now(), &err)
+	return s.scm.ListFiles(ctx, opt)
+}
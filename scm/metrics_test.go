@@ -0,0 +1,55 @@
+package scm_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/autograde/quickfeed/scm"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+)
+
+type observation struct {
+	method string
+	failed bool
+}
+
+type recordingMetrics struct {
+	mu           sync.Mutex
+	observations []observation
+}
+
+func (m *recordingMetrics) ObserveSCMCall(method string, _ time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.observations = append(m.observations, observation{method: method, failed: err != nil})
+}
+
+func TestSCMMetrics(t *testing.T) {
+	metrics := &recordingMetrics{}
+	scm.SetMetrics(metrics)
+	defer scm.SetMetrics(nil)
+
+	s, err := scm.NewSCMClient(zap.NewNop().Sugar(), "fake", "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err := s.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GetRepository(ctx, &scm.RepositoryOptions{ID: 1}); err == nil {
+		t.Fatal("expected error fetching non-existing repository")
+	}
+	s.CreateCloneURL(&scm.CreateClonePathOptions{})
+
+	want := []observation{
+		{method: "CreateOrganization", failed: false},
+		{method: "GetRepository", failed: true},
+	}
+	if diff := cmp.Diff(want, metrics.observations, cmp.AllowUnexported(observation{})); diff != "" {
+		t.Errorf("SCM metrics mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,528 @@
+package scm
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// Call is a single method call recorded by MockSCM.
+type Call struct {
+	// Method is the name of the SCM method that was called.
+	Method string
+	// Args holds the arguments passed to the method, excluding the context.
+	// Pointer arguments are recorded as is, and may be modified by the caller.
+	Args []interface{}
+}
+
+// MockSCM implements the SCM interface for use in tests.
+// The response of each method can be programmed by setting the
+// corresponding <Method>Func field; methods without a programmed
+// response behave like FakeSCM. All calls are recorded in the order
+// they were made, and can be inspected with Calls and Methods.
+type MockSCM struct {
+	CreateOrganizationFunc           func(context.Context, *OrganizationOptions) (*pb.Organization, error)
+	EnsureOrganizationFunc           func(context.Context, *OrganizationOptions) (*pb.Organization, error)
+	UpdateOrganizationFunc           func(context.Context, *OrganizationOptions) error
+	UpdateOrganizationVisibilityFunc func(context.Context, uint64, bool) error
+	GetOrganizationFunc              func(context.Context, *GetOrgOptions) (*pb.Organization, error)
+	ListOrganizationsFunc            func(context.Context, *ListOrgOptions) ([]*pb.Organization, error)
+	CreateRepositoryFunc             func(context.Context, *CreateRepositoryOptions) (*Repository, error)
+	CreateRepositoryFromTemplateFunc func(context.Context, *CreateRepositoryOptions) (*Repository, error)
+	GetRepositoryFunc                func(context.Context, *RepositoryOptions) (*Repository, error)
+	RepositoryExistsFunc             func(context.Context, uint64) (bool, error)
+	GetRepositoriesFunc              func(context.Context, *pb.Organization) ([]*Repository, error)
+	DeleteRepositoryFunc             func(context.Context, *RepositoryOptions) error
+	UpdateRepoAccessFunc             func(context.Context, *Repository, string, string) error
+	RevokeRepoAccessFunc             func(context.Context, *Repository, string) error
+	RenameRepositoryFunc             func(context.Context, uint64, string) (*Repository, error)
+	RepositoryIsEmptyFunc            func(context.Context, *RepositoryOptions) bool
+	ListHooksFunc                    func(context.Context, *Repository, string) ([]*Hook, error)
+	ListPullRequestsFunc             func(context.Context, *RepositoryOptions) ([]*PullRequest, error)
+	GetCommitFunc                    func(context.Context, uint64, string) (*Commit, error)
+	ListCommitsFunc                  func(context.Context, uint64, time.Time) ([]*Commit, error)
+	CreateHookFunc                   func(context.Context, *CreateHookOptions) (*Hook, error)
+	DeleteHookFunc                   func(context.Context, uint64, uint64) error
+	DeleteOrganizationHookFunc       func(context.Context, string, uint64) error
+	ProtectBranchFunc                func(context.Context, uint64, string, bool) error
+	CreateRepositoryAccessTokenFunc  func(context.Context, uint64, []string, time.Time) (*AccessToken, error)
+	CreateTeamFunc                   func(context.Context, *NewTeamOptions) (*Team, error)
+	DeleteTeamFunc                   func(context.Context, *TeamOptions) error
+	GetTeamFunc                      func(context.Context, *TeamOptions) (*Team, error)
+	GetTeamsFunc                     func(context.Context, *pb.Organization) ([]*Team, error)
+	AddTeamRepoFunc                  func(context.Context, *AddTeamRepoOptions) error
+	AddTeamMemberFunc                func(context.Context, *TeamMembershipOptions) error
+	AddTeamMembersFunc               func(context.Context, *TeamMembershipOptions, []string) error
+	RemoveTeamMemberFunc             func(context.Context, *TeamMembershipOptions) error
+	UpdateTeamMembershipFunc         func(context.Context, *TeamMembershipOptions) error
+	UpdateTeamMembersFunc            func(context.Context, *UpdateTeamOptions) error
+	GetUserNameFunc                  func(context.Context) (string, error)
+	GetUserNameByIDFunc              func(context.Context, uint64) (string, error)
+	CreateCloneURLFunc               func(*CreateClonePathOptions) string
+	UpdateOrgMembershipFunc          func(context.Context, *OrgMembershipOptions) error
+	RemoveMemberFunc                 func(context.Context, *OrgMembershipOptions) error
+	ListOrganizationMembersFunc      func(context.Context, *pb.Organization) ([]*OrganizationMember, error)
+	IsOrgMemberFunc                  func(context.Context, string, string) (bool, error)
+	GetUserScopesFunc                func(context.Context) *Authorization
+	VerifyScopesFunc                 func(context.Context, []string) error
+	GetFileContentFunc               func(context.Context, *FileOptions) (string, error)
+	ListFilesFunc                    func(context.Context, *FileOptions) ([]string, error)
+
+	fake  *FakeSCM
+	mu    sync.Mutex
+	calls []Call
+}
+
+// NewMockSCMClient returns a new mock client implementing the SCM interface.
+func NewMockSCMClient() *MockSCM {
+	return &MockSCM{fake: NewFakeSCMClient()}
+}
+
+// Calls returns the recorded method calls in the order they were made.
+func (s *MockSCM) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	calls := make([]Call, len(s.calls))
+	copy(calls, s.calls)
+	return calls
+}
+
+// Methods returns the names of the recorded method calls in the order they were made.
+func (s *MockSCM) Methods() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	methods := make([]string, len(s.calls))
+	for i, call := range s.calls {
+		methods[i] = call.Method
+	}
+	return methods
+}
+
+// Reset clears the recorded method calls.
+func (s *MockSCM) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = nil
+}
+
+func (s *MockSCM) record(method string, args ...interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, Call{Method: method, Args: args})
+}
+
+// CreateOrganization implements the SCM interface.
+func (s *MockSCM) CreateOrganization(ctx context.Context, opt *OrganizationOptions) (*pb.Organization, error) {
+	s.record("CreateOrganization", opt)
+	if s.CreateOrganizationFunc != nil {
+		return s.CreateOrganizationFunc(ctx, opt)
+	}
+	return s.fake.CreateOrganization(ctx, opt)
+}
+
+// EnsureOrganization implements the SCM interface.
+func (s *MockSCM) EnsureOrganization(ctx context.Context, opt *OrganizationOptions) (*pb.Organization, error) {
+	s.record("EnsureOrganization", opt)
+	if s.EnsureOrganizationFunc != nil {
+		return s.EnsureOrganizationFunc(ctx, opt)
+	}
+	return s.fake.EnsureOrganization(ctx, opt)
+}
+
+// UpdateOrganization implements the SCM interface.
+func (s *MockSCM) UpdateOrganization(ctx context.Context, opt *OrganizationOptions) error {
+	s.record("UpdateOrganization", opt)
+	if s.UpdateOrganizationFunc != nil {
+		return s.UpdateOrganizationFunc(ctx, opt)
+	}
+	return s.fake.UpdateOrganization(ctx, opt)
+}
+
+// UpdateOrganizationVisibility implements the SCM interface.
+func (s *MockSCM) UpdateOrganizationVisibility(ctx context.Context, orgID uint64, private bool) error {
+	s.record("UpdateOrganizationVisibility", orgID, private)
+	if s.UpdateOrganizationVisibilityFunc != nil {
+		return s.UpdateOrganizationVisibilityFunc(ctx, orgID, private)
+	}
+	return s.fake.UpdateOrganizationVisibility(ctx, orgID, private)
+}
+
+// GetOrganization implements the SCM interface.
+func (s *MockSCM) GetOrganization(ctx context.Context, opt *GetOrgOptions) (*pb.Organization, error) {
+	s.record("GetOrganization", opt)
+	if s.GetOrganizationFunc != nil {
+		return s.GetOrganizationFunc(ctx, opt)
+	}
+	return s.fake.GetOrganization(ctx, opt)
+}
+
+// ListOrganizations implements the SCM interface.
+func (s *MockSCM) ListOrganizations(ctx context.Context, opt *ListOrgOptions) ([]*pb.Organization, error) {
+	s.record("ListOrganizations", opt)
+	if s.ListOrganizationsFunc != nil {
+		return s.ListOrganizationsFunc(ctx, opt)
+	}
+	return s.fake.ListOrganizations(ctx, opt)
+}
+
+// CreateRepository implements the SCM interface.
+func (s *MockSCM) CreateRepository(ctx context.Context, opt *CreateRepositoryOptions) (*Repository, error) {
+	s.record("CreateRepository", opt)
+	if s.CreateRepositoryFunc != nil {
+		return s.CreateRepositoryFunc(ctx, opt)
+	}
+	return s.fake.CreateRepository(ctx, opt)
+}
+
+// CreateRepositoryFromTemplate implements the SCM interface.
+func (s *MockSCM) CreateRepositoryFromTemplate(ctx context.Context, opt *CreateRepositoryOptions) (*Repository, error) {
+	s.record("CreateRepositoryFromTemplate", opt)
+	if s.CreateRepositoryFromTemplateFunc != nil {
+		return s.CreateRepositoryFromTemplateFunc(ctx, opt)
+	}
+	return s.fake.CreateRepositoryFromTemplate(ctx, opt)
+}
+
+// GetRepository implements the SCM interface.
+func (s *MockSCM) GetRepository(ctx context.Context, opt *RepositoryOptions) (*Repository, error) {
+	s.record("GetRepository", opt)
+	if s.GetRepositoryFunc != nil {
+		return s.GetRepositoryFunc(ctx, opt)
+	}
+	return s.fake.GetRepository(ctx, opt)
+}
+
+// RepositoryExists implements the SCM interface.
+func (s *MockSCM) RepositoryExists(ctx context.Context, repoID uint64) (bool, error) {
+	s.record("RepositoryExists", repoID)
+	if s.RepositoryExistsFunc != nil {
+		return s.RepositoryExistsFunc(ctx, repoID)
+	}
+	return s.fake.RepositoryExists(ctx, repoID)
+}
+
+// GetRepositories implements the SCM interface.
+func (s *MockSCM) GetRepositories(ctx context.Context, org *pb.Organization) ([]*Repository, error) {
+	s.record("GetRepositories", org)
+	if s.GetRepositoriesFunc != nil {
+		return s.GetRepositoriesFunc(ctx, org)
+	}
+	return s.fake.GetRepositories(ctx, org)
+}
+
+// DeleteRepository implements the SCM interface.
+func (s *MockSCM) DeleteRepository(ctx context.Context, opt *RepositoryOptions) error {
+	s.record("DeleteRepository", opt)
+	if s.DeleteRepositoryFunc != nil {
+		return s.DeleteRepositoryFunc(ctx, opt)
+	}
+	return s.fake.DeleteRepository(ctx, opt)
+}
+
+// UpdateRepoAccess implements the SCM interface.
+func (s *MockSCM) UpdateRepoAccess(ctx context.Context, repo *Repository, user, permission string) error {
+	s.record("UpdateRepoAccess", repo, user, permission)
+	if s.UpdateRepoAccessFunc != nil {
+		return s.UpdateRepoAccessFunc(ctx, repo, user, permission)
+	}
+	return s.fake.UpdateRepoAccess(ctx, repo, user, permission)
+}
+
+// RevokeRepoAccess implements the SCM interface.
+func (s *MockSCM) RevokeRepoAccess(ctx context.Context, repo *Repository, user string) error {
+	s.record("RevokeRepoAccess", repo, user)
+	if s.RevokeRepoAccessFunc != nil {
+		return s.RevokeRepoAccessFunc(ctx, repo, user)
+	}
+	return s.fake.RevokeRepoAccess(ctx, repo, user)
+}
+
+// RenameRepository implements the SCM interface.
+func (s *MockSCM) RenameRepository(ctx context.Context, repoID uint64, newName string) (*Repository, error) {
+	s.record("RenameRepository", repoID, newName)
+	if s.RenameRepositoryFunc != nil {
+		return s.RenameRepositoryFunc(ctx, repoID, newName)
+	}
+	return s.fake.RenameRepository(ctx, repoID, newName)
+}
+
+// RepositoryIsEmpty implements the SCM interface.
+func (s *MockSCM) RepositoryIsEmpty(ctx context.Context, opt *RepositoryOptions) bool {
+	s.record("RepositoryIsEmpty", opt)
+	if s.RepositoryIsEmptyFunc != nil {
+		return s.RepositoryIsEmptyFunc(ctx, opt)
+	}
+	return s.fake.RepositoryIsEmpty(ctx, opt)
+}
+
+// ListHooks implements the SCM interface.
+func (s *MockSCM) ListHooks(ctx context.Context, repo *Repository, org string) ([]*Hook, error) {
+	s.record("ListHooks", repo, org)
+	if s.ListHooksFunc != nil {
+		return s.ListHooksFunc(ctx, repo, org)
+	}
+	return s.fake.ListHooks(ctx, repo, org)
+}
+
+// ListPullRequests implements the SCM interface.
+func (s *MockSCM) ListPullRequests(ctx context.Context, opt *RepositoryOptions) ([]*PullRequest, error) {
+	s.record("ListPullRequests", opt)
+	if s.ListPullRequestsFunc != nil {
+		return s.ListPullRequestsFunc(ctx, opt)
+	}
+	return s.fake.ListPullRequests(ctx, opt)
+}
+
+// CreateHook implements the SCM interface.
+func (s *MockSCM) CreateHook(ctx context.Context, opt *CreateHookOptions) (*Hook, error) {
+	s.record("CreateHook", opt)
+	if s.CreateHookFunc != nil {
+		return s.CreateHookFunc(ctx, opt)
+	}
+	return s.fake.CreateHook(ctx, opt)
+}
+
+// DeleteHook implements the SCM interface.
+func (s *MockSCM) DeleteHook(ctx context.Context, repoID, hookID uint64) error {
+	s.record("DeleteHook", repoID, hookID)
+	if s.DeleteHookFunc != nil {
+		return s.DeleteHookFunc(ctx, repoID, hookID)
+	}
+	return s.fake.DeleteHook(ctx, repoID, hookID)
+}
+
+// DeleteOrganizationHook implements the SCM interface.
+func (s *MockSCM) DeleteOrganizationHook(ctx context.Context, org string, hookID uint64) error {
+	s.record("DeleteOrganizationHook", org, hookID)
+	if s.DeleteOrganizationHookFunc != nil {
+		return s.DeleteOrganizationHookFunc(ctx, org, hookID)
+	}
+	return s.fake.DeleteOrganizationHook(ctx, org, hookID)
+}
+
+// ProtectBranch implements the SCM interface.
+func (s *MockSCM) ProtectBranch(ctx context.Context, repoID uint64, branch string, allowForcePush bool) error {
+	s.record("ProtectBranch", repoID, branch, allowForcePush)
+	if s.ProtectBranchFunc != nil {
+		return s.ProtectBranchFunc(ctx, repoID, branch, allowForcePush)
+	}
+	return s.fake.ProtectBranch(ctx, repoID, branch, allowForcePush)
+}
+
+// CreateRepositoryAccessToken implements the SCM interface.
+func (s *MockSCM) CreateRepositoryAccessToken(ctx context.Context, repoID uint64, scopes []string, expiry time.Time) (*AccessToken, error) {
+	s.record("CreateRepositoryAccessToken", repoID, scopes, expiry)
+	if s.CreateRepositoryAccessTokenFunc != nil {
+		return s.CreateRepositoryAccessTokenFunc(ctx, repoID, scopes, expiry)
+	}
+	return s.fake.CreateRepositoryAccessToken(ctx, repoID, scopes, expiry)
+}
+
+// CreateTeam implements the SCM interface.
+func (s *MockSCM) CreateTeam(ctx context.Context, opt *NewTeamOptions) (*Team, error) {
+	s.record("CreateTeam", opt)
+	if s.CreateTeamFunc != nil {
+		return s.CreateTeamFunc(ctx, opt)
+	}
+	return s.fake.CreateTeam(ctx, opt)
+}
+
+// DeleteTeam implements the SCM interface.
+func (s *MockSCM) DeleteTeam(ctx context.Context, opt *TeamOptions) error {
+	s.record("DeleteTeam", opt)
+	if s.DeleteTeamFunc != nil {
+		return s.DeleteTeamFunc(ctx, opt)
+	}
+	return s.fake.DeleteTeam(ctx, opt)
+}
+
+// GetTeam implements the SCM interface.
+func (s *MockSCM) GetTeam(ctx context.Context, opt *TeamOptions) (*Team, error) {
+	s.record("GetTeam", opt)
+	if s.GetTeamFunc != nil {
+		return s.GetTeamFunc(ctx, opt)
+	}
+	return s.fake.GetTeam(ctx, opt)
+}
+
+// GetTeams implements the SCM interface.
+func (s *MockSCM) GetTeams(ctx context.Context, org *pb.Organization) ([]*Team, error) {
+	s.record("GetTeams", org)
+	if s.GetTeamsFunc != nil {
+		return s.GetTeamsFunc(ctx, org)
+	}
+	return s.fake.GetTeams(ctx, org)
+}
+
+// AddTeamRepo implements the SCM interface.
+func (s *MockSCM) AddTeamRepo(ctx context.Context, opt *AddTeamRepoOptions) error {
+	s.record("AddTeamRepo", opt)
+	if s.AddTeamRepoFunc != nil {
+		return s.AddTeamRepoFunc(ctx, opt)
+	}
+	return s.fake.AddTeamRepo(ctx, opt)
+}
+
+// AddTeamMember implements the SCM interface.
+func (s *MockSCM) AddTeamMember(ctx context.Context, opt *TeamMembershipOptions) error {
+	s.record("AddTeamMember", opt)
+	if s.AddTeamMemberFunc != nil {
+		return s.AddTeamMemberFunc(ctx, opt)
+	}
+	return s.fake.AddTeamMember(ctx, opt)
+}
+
+// AddTeamMembers implements the SCM interface.
+func (s *MockSCM) AddTeamMembers(ctx context.Context, opt *TeamMembershipOptions, logins []string) error {
+	s.record("AddTeamMembers", opt, logins)
+	if s.AddTeamMembersFunc != nil {
+		return s.AddTeamMembersFunc(ctx, opt, logins)
+	}
+	return s.fake.AddTeamMembers(ctx, opt, logins)
+}
+
+// RemoveTeamMember implements the SCM interface.
+func (s *MockSCM) RemoveTeamMember(ctx context.Context, opt *TeamMembershipOptions) error {
+	s.record("RemoveTeamMember", opt)
+	if s.RemoveTeamMemberFunc != nil {
+		return s.RemoveTeamMemberFunc(ctx, opt)
+	}
+	return s.fake.RemoveTeamMember(ctx, opt)
+}
+
+// UpdateTeamMembership implements the SCM interface.
+func (s *MockSCM) UpdateTeamMembership(ctx context.Context, opt *TeamMembershipOptions) error {
+	s.record("UpdateTeamMembership", opt)
+	if s.UpdateTeamMembershipFunc != nil {
+		return s.UpdateTeamMembershipFunc(ctx, opt)
+	}
+	return s.fake.UpdateTeamMembership(ctx, opt)
+}
+
+// UpdateTeamMembers implements the SCM interface.
+func (s *MockSCM) UpdateTeamMembers(ctx context.Context, opt *UpdateTeamOptions) error {
+	s.record("UpdateTeamMembers", opt)
+	if s.UpdateTeamMembersFunc != nil {
+		return s.UpdateTeamMembersFunc(ctx, opt)
+	}
+	return s.fake.UpdateTeamMembers(ctx, opt)
+}
+
+// GetUserName implements the SCM interface.
+func (s *MockSCM) GetUserName(ctx context.Context) (string, error) {
+	s.record("GetUserName")
+	if s.GetUserNameFunc != nil {
+		return s.GetUserNameFunc(ctx)
+	}
+	return s.fake.GetUserName(ctx)
+}
+
+// GetUserNameByID implements the SCM interface.
+func (s *MockSCM) GetUserNameByID(ctx context.Context, remoteID uint64) (string, error) {
+	s.record("GetUserNameByID", remoteID)
+	if s.GetUserNameByIDFunc != nil {
+		return s.GetUserNameByIDFunc(ctx, remoteID)
+	}
+	return s.fake.GetUserNameByID(ctx, remoteID)
+}
+
+// CreateCloneURL implements the SCM interface.
+func (s *MockSCM) CreateCloneURL(opt *CreateClonePathOptions) string {
+	s.record("CreateCloneURL", opt)
+	if s.CreateCloneURLFunc != nil {
+		return s.CreateCloneURLFunc(opt)
+	}
+	return s.fake.CreateCloneURL(opt)
+}
+
+// UpdateOrgMembership implements the SCM interface.
+func (s *MockSCM) UpdateOrgMembership(ctx context.Context, opt *OrgMembershipOptions) error {
+	s.record("UpdateOrgMembership", opt)
+	if s.UpdateOrgMembershipFunc != nil {
+		return s.UpdateOrgMembershipFunc(ctx, opt)
+	}
+	return s.fake.UpdateOrgMembership(ctx, opt)
+}
+
+// RemoveMember implements the SCM interface.
+func (s *MockSCM) RemoveMember(ctx context.Context, opt *OrgMembershipOptions) error {
+	s.record("RemoveMember", opt)
+	if s.RemoveMemberFunc != nil {
+		return s.RemoveMemberFunc(ctx, opt)
+	}
+	return s.fake.RemoveMember(ctx, opt)
+}
+
+// ListOrganizationMembers implements the SCM interface.
+func (s *MockSCM) ListOrganizationMembers(ctx context.Context, org *pb.Organization) ([]*OrganizationMember, error) {
+	s.record("ListOrganizationMembers", org)
+	if s.ListOrganizationMembersFunc != nil {
+		return s.ListOrganizationMembersFunc(ctx, org)
+	}
+	return s.fake.ListOrganizationMembers(ctx, org)
+}
+
+// IsOrgMember implements the SCM interface.
+func (s *MockSCM) IsOrgMember(ctx context.Context, org, login string) (bool, error) {
+	s.record("IsOrgMember", org, login)
+	if s.IsOrgMemberFunc != nil {
+		return s.IsOrgMemberFunc(ctx, org, login)
+	}
+	return s.fake.IsOrgMember(ctx, org, login)
+}
+
+// GetUserScopes implements the SCM interface.
+func (s *MockSCM) GetUserScopes(ctx context.Context) *Authorization {
+	s.record("GetUserScopes")
+	if s.GetUserScopesFunc != nil {
+		return s.GetUserScopesFunc(ctx)
+	}
+	return s.fake.GetUserScopes(ctx)
+}
+
+// GetCommit implements the SCM interface.
+func (s *MockSCM) GetCommit(ctx context.Context, repoID uint64, sha string) (*Commit, error) {
+	s.record("GetCommit", repoID, sha)
+	if s.GetCommitFunc != nil {
+		return s.GetCommitFunc(ctx, repoID, sha)
+	}
+	return s.fake.GetCommit(ctx, repoID, sha)
+}
+
+// ListCommits implements the SCM interface.
+func (s *MockSCM) ListCommits(ctx context.Context, repoID uint64, since time.Time) ([]*Commit, error) {
+	s.record("ListCommits", repoID, since)
+	if s.ListCommitsFunc != nil {
+		return s.ListCommitsFunc(ctx, repoID, since)
+	}
+	return s.fake.ListCommits(ctx, repoID, since)
+}
+
+// VerifyScopes implements the SCM interface.
+func (s *MockSCM) VerifyScopes(ctx context.Context, required []string) error {
+	s.record("VerifyScopes", required)
+	if s.VerifyScopesFunc != nil {
+		return s.VerifyScopesFunc(ctx, required)
+	}
+	return s.fake.VerifyScopes(ctx, required)
+}
+
+// GetFileContent implements the SCM interface.
+func (s *MockSCM) GetFileContent(ctx context.Context, opt *FileOptions) (string, error) {
+	s.record("GetFileContent", opt)
+	if s.GetFileContentFunc != nil {
+		return s.GetFileContentFunc(ctx, opt)
+	}
+	return s.fake.GetFileContent(ctx, opt)
+}
+
+// ListFiles implements the SCM interface.
+func (s *MockSCM) ListFiles(ctx context.Context, opt *FileOptions) ([]string, error) {
+	s.record("ListFiles", opt)
+	if s.ListFilesFunc != nil {
+		return s.ListFilesFunc(ctx, opt)
+	}
+	return s.fake.ListFiles(ctx, opt)
+}
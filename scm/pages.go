@@ -0,0 +1,49 @@
+package scm
+
+import (
+	"context"
+	"sync"
+)
+
+// maxConcurrentPages is the maximum number of pages of a list fetched at the same time.
+const maxConcurrentPages = 4
+
+// fetchPages calls fetch for each page from first to last, with at most maxConcurrentPages
+// calls running at a time. Since the pages may be fetched in any order, fetch must store
+// each page's result by its page number to preserve the order of the list. The first error
+// cancels the context of the remaining calls, and no further pages are fetched. Returns the
+// first error, or the context's error if the context is canceled before all pages are fetched.
+func fetchPages(ctx context.Context, first, last int, fetch func(ctx context.Context, page int) error) error {
+	pageCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	sem := make(chan struct{}, maxConcurrentPages)
+	for page := first; page <= last && pageCtx.Err() == nil; page++ {
+		select {
+		case sem <- struct{}{}:
+		case <-pageCtx.Done():
+			continue
+		}
+		wg.Add(1)
+		go func(page int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := fetch(pageCtx, page); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(page)
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,75 @@
+package scm
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFetchPages(t *testing.T) {
+	const last = 20
+	results := make([]int, last-1)
+	var running, maxRunning int32
+	err := fetchPages(context.Background(), 2, last, func(_ context.Context, page int) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		results[page-2] = page
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := make([]int, last-1)
+	for i := range want {
+		want[i] = i + 2
+	}
+	if diff := cmp.Diff(want, results); diff != "" {
+		t.Errorf("fetchPages() mismatch (-want +got):\n%s", diff)
+	}
+	if maxRunning > maxConcurrentPages {
+		t.Errorf("fetched %d pages at the same time, want at most %d", maxRunning, maxConcurrentPages)
+	}
+}
+
+func TestFetchPagesError(t *testing.T) {
+	errPage := errors.New("failed to fetch page")
+	var fetched int32
+	err := fetchPages(context.Background(), 1, 100, func(ctx context.Context, page int) error {
+		atomic.AddInt32(&fetched, 1)
+		if page == 2 {
+			return errPage
+		}
+		// the remaining pages wait until the failed page cancels them
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if !errors.Is(err, errPage) {
+		t.Errorf("fetchPages() = %v, want %v", err, errPage)
+	}
+	if fetched > maxConcurrentPages {
+		t.Errorf("fetched %d pages after an error, want at most %d", fetched, maxConcurrentPages)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	fetched = 0
+	err = fetchPages(ctx, 1, 100, func(context.Context, int) error {
+		atomic.AddInt32(&fetched, 1)
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("fetchPages() = %v, want %v", err, context.Canceled)
+	}
+	if fetched > 0 {
+		t.Errorf("fetched %d pages with a canceled context, want none", fetched)
+	}
+}
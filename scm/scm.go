@@ -3,6 +3,7 @@ package scm
 import (
 	"context"
 	"errors"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"go.uber.org/zap"
@@ -13,30 +14,64 @@ import (
 type SCM interface {
 	// Creates a new organization.
 	CreateOrganization(context.Context, *OrganizationOptions) (*pb.Organization, error)
+	// Gets the organization with the given path, or creates it if it does not exist
+	// and the SCM supports creating organizations.
+	EnsureOrganization(context.Context, *OrganizationOptions) (*pb.Organization, error)
 	// Updates an organization
 	UpdateOrganization(context.Context, *OrganizationOptions) error
+	// UpdateOrganizationVisibility makes the organization with the given ID
+	// visible only to its members if private is true, or to everyone otherwise.
+	UpdateOrganizationVisibility(ctx context.Context, orgID uint64, private bool) error
 	// Gets an organization.
 	GetOrganization(context.Context, *GetOrgOptions) (*pb.Organization, error)
+	// Lists organizations visible to the user.
+	ListOrganizations(context.Context, *ListOrgOptions) ([]*pb.Organization, error)
 	// Create a new repository.
 	CreateRepository(context.Context, *CreateRepositoryOptions) (*Repository, error)
+	// Create a new repository with the content of the options' template repository.
+	CreateRepositoryFromTemplate(context.Context, *CreateRepositoryOptions) (*Repository, error)
 	// Get repository by ID or name
 	GetRepository(context.Context, *RepositoryOptions) (*Repository, error)
+	// RepositoryExists returns false if the repository with the given ID does not exist,
+	// without fetching the repository. Errors are only returned for failed requests.
+	RepositoryExists(ctx context.Context, repoID uint64) (bool, error)
 	// Get repositories within organization.
 	GetRepositories(context.Context, *pb.Organization) ([]*Repository, error)
-	// Delete repository.
+	// Delete repository. Use IsNotFound to detect an already deleted repository.
 	DeleteRepository(context.Context, *RepositoryOptions) error
 	// Add user as repository collaborator with provided permissions
 	UpdateRepoAccess(context.Context, *Repository, string, string) error
+	// Remove user as repository collaborator
+	RevokeRepoAccess(context.Context, *Repository, string) error
+	// Rename the repository with the given ID.
+	RenameRepository(context.Context, uint64, string) (*Repository, error)
 	// Returns true if there are no commits in the given repository
 	RepositoryIsEmpty(context.Context, *RepositoryOptions) bool
 	// List the webhooks associated with the provided repository or organization.
 	ListHooks(context.Context, *Repository, string) ([]*Hook, error)
 	// Creates a new webhook for organization if the name of organization
 	// is provided. Otherwise creates a hook for the given repo.
-	CreateHook(context.Context, *CreateHookOptions) error
-	// Create team.
+	CreateHook(context.Context, *CreateHookOptions) (*Hook, error)
+	// Delete the webhook with the given hook ID from the given repository.
+	DeleteHook(context.Context, uint64, uint64) error
+	// Delete the webhook with the given hook ID from the given organization.
+	DeleteOrganizationHook(ctx context.Context, org string, hookID uint64) error
+	// ProtectBranch protects the given branch of the repository with the given ID
+	// against deletion and, unless allowForcePush is true, against force pushes.
+	ProtectBranch(ctx context.Context, repoID uint64, branch string, allowForcePush bool) error
+	// CreateRepositoryAccessToken creates an access token with the given scopes for the
+	// repository with the given ID, which expires at the given time. Only supported by GitLab.
+	CreateRepositoryAccessToken(ctx context.Context, repoID uint64, scopes []string, expiry time.Time) (*AccessToken, error)
+	// List open pull requests (merge requests on GitLab) for the given repository.
+	ListPullRequests(context.Context, *RepositoryOptions) ([]*PullRequest, error)
+	// GetCommit returns the commit with the given SHA in the repository with the given ID.
+	GetCommit(ctx context.Context, repoID uint64, sha string) (*Commit, error)
+	// ListCommits returns the commits to the default branch of the repository with the given ID
+	// that were committed after the given time, from the most recent to the oldest commit.
+	ListCommits(ctx context.Context, repoID uint64, since time.Time) ([]*Commit, error)
+	// Create team without a repository; use AddTeamRepo to give the team repository access.
 	CreateTeam(context.Context, *NewTeamOptions) (*Team, error)
-	// Delete team.
+	// Delete team. Use IsNotFound to detect an already deleted team.
 	DeleteTeam(context.Context, *TeamOptions) error
 	// Get a single team by ID or name.
 	GetTeam(context.Context, *TeamOptions) (*Team, error)
@@ -46,8 +81,13 @@ type SCM interface {
 	AddTeamRepo(context.Context, *AddTeamRepoOptions) error
 	// AddTeamMember adds a member to a team.
 	AddTeamMember(context.Context, *TeamMembershipOptions) error
+	// AddTeamMembers adds the users with the given logins to a team with the Role in TeamMembershipOptions,
+	// whose Username is ignored. Users that are already members of the team keep their role.
+	AddTeamMembers(ctx context.Context, opt *TeamMembershipOptions, logins []string) error
 	// RemoveTeamMember removes team member.
 	RemoveTeamMember(context.Context, *TeamMembershipOptions) error
+	// UpdateTeamMembership changes the role of an existing team member to the Role in TeamMembershipOptions.
+	UpdateTeamMembership(context.Context, *TeamMembershipOptions) error
 	// UpdateTeamMembers adds or removes members of an existing team based on list of users in TeamOptions.
 	UpdateTeamMembers(context.Context, *UpdateTeamOptions) error
 	// GetUserName returns the currently logged in user's login name.
@@ -60,23 +100,37 @@ type SCM interface {
 	UpdateOrgMembership(context.Context, *OrgMembershipOptions) error
 	// RevokeOrgMembership removes user from the organization.
 	RemoveMember(context.Context, *OrgMembershipOptions) error
+	// Lists the active members of the organization.
+	ListOrganizationMembers(context.Context, *pb.Organization) ([]*OrganizationMember, error)
+	// IsOrgMember returns true if the user with the given login is an active member
+	// of the given organization. Users with pending invitations are not members.
+	IsOrgMember(ctx context.Context, org, login string) (bool, error)
 	// Lists all authorizations for authenticated user.
 	GetUserScopes(context.Context) *Authorization
+	// VerifyScopes returns ErrMissingScopes listing the required scopes
+	// that are not granted to the client's access token.
+	VerifyScopes(ctx context.Context, required []string) error
 	// GetFileContent returns the content of a single file in the given repository.
 	GetFileContent(context.Context, *FileOptions) (string, error)
+	// ListFiles returns the paths of all files below the given path in the given repository.
+	ListFiles(context.Context, *FileOptions) ([]string, error)
 }
 
 // NewSCMClient returns a new provider client implementing the SCM interface.
+// Calls to the client are reported to the metrics set with SetMetrics.
 func NewSCMClient(logger *zap.SugaredLogger, provider, token string) (SCM, error) {
+	var client SCM
 	switch provider {
 	case "github":
-		return NewGithubSCMClient(logger, token), nil
+		client = NewGithubSCMClient(logger, token)
 	case "gitlab":
-		return NewGitlabSCMClient(token), nil
+		client = NewGitlabSCMClient(token)
 	case "fake":
-		return NewFakeSCMClient(), nil
+		client = NewFakeSCMClient()
+	default:
+		return nil, errors.New("invalid provider: " + provider)
 	}
-	return nil, errors.New("invalid provider: " + provider)
+	return &instrumentedSCM{scm: client}, nil
 }
 
 // OrganizationOptions contains information on how an organization should be
@@ -88,6 +142,9 @@ type OrganizationOptions struct {
 	// prohibit students from creating new repos
 	// on the course organization
 	RepoPermissions bool
+	// ParentID is the ID of the parent organization, e.g. a department,
+	// in which to create the organization. Only supported by GitLab.
+	ParentID uint64
 }
 
 // GetOrgOptions contains information on the organization to fetch
@@ -97,6 +154,26 @@ type GetOrgOptions struct {
 	// Username field is used to filter organizations
 	// where the given user has a certain role.
 	Username string
+	// Refresh fetches the organization from the SCM even if
+	// a cached copy is available. Only used by GitLab.
+	Refresh bool
+}
+
+// ListOrgOptions contains information on which organizations to list.
+type ListOrgOptions struct {
+	// Manageable restricts the list to organizations where the user
+	// has owner or maintainer access, and thus can create course repositories.
+	Manageable bool
+	// Owned restricts the list to organizations owned by the user.
+	// GitHub organizations can only be managed by their owners, so on
+	// GitHub, Owned lists the same organizations as Manageable.
+	Owned bool
+	// ParentID restricts the list to organizations nested in the given
+	// parent organization. Only supported by GitLab.
+	ParentID uint64
+	// Search restricts the list to organizations whose name
+	// contains the given string, ignoring case.
+	Search string
 }
 
 // Repository represents a git remote repository.
@@ -109,6 +186,9 @@ type Repository struct {
 	HTTPURL string // HTTP(S) clone URL.
 	OrgID   uint64
 	Size    uint64
+
+	DefaultBranch string
+	Archived      bool
 }
 
 // RepositoryOptions is used to fetch a single repository by ID or name.
@@ -124,6 +204,7 @@ type FileOptions struct {
 	Path       string
 	Owner      string
 	Repository string
+	Ref        string // branch, tag or commit SHA; the default branch if empty
 }
 
 // Hook contains information about a webhook for a repository.
@@ -134,6 +215,36 @@ type Hook struct {
 	Events []string
 }
 
+// PullRequest contains information about a pull request (merge request on GitLab).
+type PullRequest struct {
+	ID           uint64 // Repository specific number of the pull request.
+	Title        string
+	Author       string
+	State        string
+	WebURL       string
+	SourceBranch string
+	SHA          string // Head commit of the source branch.
+}
+
+// AccessToken is a time-limited token giving access to a single repository.
+type AccessToken struct {
+	ID        uint64
+	Name      string
+	Token     string
+	Scopes    []string
+	ExpiresAt time.Time
+}
+
+// Commit contains information about a single commit.
+type Commit struct {
+	SHA       string
+	Author    string
+	Committer string
+	Message   string
+	Date      time.Time // When the commit was committed.
+	Files     []string  // Paths of the files added, modified or removed by the commit; only set by GetCommit.
+}
+
 // CreateRepositoryOptions contains information on how a repository should be created.
 type CreateRepositoryOptions struct {
 	Organization *pb.Organization
@@ -141,6 +252,7 @@ type CreateRepositoryOptions struct {
 	Private      bool
 	Owner        string // The owner of an organization's repo is always the organization itself.
 	Permission   string // Default permission level for the given repo. Can be "read", "write", "admin", "none".
+	Template     string // Name of the repository in the same organization to copy; used by CreateRepositoryFromTemplate.
 }
 
 // CreateHookOptions contains information on how to create a webhook.
@@ -219,6 +331,12 @@ type Team struct {
 	Organization string
 }
 
+// OrganizationMember is an active member of an organization.
+type OrganizationMember struct {
+	ID    uint64 // The user's remote ID.
+	Login string
+}
+
 // Authorization stores information about user scopes
 type Authorization struct {
 	Scopes []string
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -17,6 +19,18 @@ import (
 // ErrInvalidUserInfo is returned to user if user information in context is invalid.
 var ErrInvalidUserInfo = status.Errorf(codes.PermissionDenied, "authorization failed. please try to logout and sign in again")
 
+// ErrEmailNotAllowed is returned when a user attempts to enroll in a course
+// that does not allow self-enrollment for the user's email domain.
+var ErrEmailNotAllowed = status.Errorf(codes.PermissionDenied, "your email domain is not allowed to enroll in this course; ask a teacher to enroll you")
+
+// ErrInvalidEnrollmentCode is returned when a user attempts to enroll
+// in a course with an enrollment code that does not match the course's code.
+var ErrInvalidEnrollmentCode = status.Errorf(codes.PermissionDenied, "invalid enrollment code")
+
+// ErrEnrollOtherUser is returned when a user who is not a teacher of the course
+// attempts to enroll another user in the course.
+var ErrEnrollOtherUser = status.Errorf(codes.PermissionDenied, "only teachers can enroll other users")
+
 func (s *AutograderService) getCurrentUser(ctx context.Context) (*pb.User, error) {
 	// process user id from context
 	meta, ok := metadata.FromIncomingContext(ctx)
@@ -94,7 +108,7 @@ func (s *AutograderService) isValidSubmissionRequest(submission *pb.SubmissionRe
 	}
 	// ensure that student has active enrollment
 	return s.hasCourseAccess(submission.GetUserID(), submission.GetCourseID(), func(e *pb.Enrollment) bool {
-		return e.Status >= pb.Enrollment_STUDENT
+		return e.Status == pb.Enrollment_STUDENT || e.Status == pb.Enrollment_TEACHER
 	})
 }
 
@@ -125,6 +139,20 @@ func (s *AutograderService) isTeacher(userID, courseID uint64) bool {
 	})
 }
 
+// isTeacherOfSubmission returns true if the given user is teacher for the course
+// of the given submission's assignment.
+func (s *AutograderService) isTeacherOfSubmission(userID, submissionID uint64) bool {
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
+	if err != nil {
+		return false
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil {
+		return false
+	}
+	return s.isTeacher(userID, assignment.GetCourseID())
+}
+
 // isCourseCreator returns true if the given user is course creator for the given course.
 func (s *AutograderService) isCourseCreator(courseID, userID uint64) bool {
 	course, _ := s.db.GetCourse(courseID, false)
@@ -134,6 +162,7 @@ func (s *AutograderService) isCourseCreator(courseID, userID uint64) bool {
 // getUserAndSCM returns the current user and scm for the given provider.
 // All errors are logged, but only a single error is returned to the client.
 // This is a helper method to facilitate consistent treatment of errors and logging.
+// The current user is returned even if no scm is found, so that the error can be traced to the user.
 func (s *AutograderService) getUserAndSCM(ctx context.Context, provider string) (*pb.User, scm.SCM, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
@@ -141,11 +170,17 @@ func (s *AutograderService) getUserAndSCM(ctx context.Context, provider string)
 	}
 	scm, err := s.getSCM(ctx, usr, provider)
 	if err != nil {
-		return nil, nil, err
+		return usr, nil, err
 	}
 	return usr, scm, nil
 }
 
+// scmLogger returns a logger that annotates log lines with the given operation,
+// course ID, and user ID, so that failing SCM operations can be traced.
+func (s *AutograderService) scmLogger(operation string, courseID, userID uint64) *zap.SugaredLogger {
+	return s.logger.With("operation", operation, "courseID", courseID, "userID", userID)
+}
+
 // getUserAndSCMForCourse returns the current user and scm for the given course.
 // All errors are logged, but only a single error is returned to the client.
 // This is a helper method to facilitate consistent treatment of errors and logging.
@@ -166,6 +201,16 @@ var teacherScopes = map[string]bool{
 	"admin:org_hook": true,
 }
 
+// requiredTeacherScopes returns the scopes in teacherScopes in sorted order.
+func requiredTeacherScopes() []string {
+	scopes := make([]string, 0, len(teacherScopes))
+	for scope := range teacherScopes {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+	return scopes
+}
+
 // hasTeacherScopes checks whether current user has upgraded scopes on provided scm client.
 func hasTeacherScopes(ctx context.Context, sc scm.SCM) bool {
 	authorization := sc.GetUserScopes(ctx)
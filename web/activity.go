@@ -0,0 +1,135 @@
+package web
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// activityCursor is the position of an event in a course's activity timeline.
+// Events are ordered by date, type and ID, where the ID of an enrollment event
+// is the user's ID and the ID of other events is the submission's ID. Unlike
+// the date alone, the position is unique, even for events in the same second.
+type activityCursor struct {
+	date string
+	typ  pb.ActivityEvent_Type
+	id   uint64
+}
+
+func eventCursor(event *pb.ActivityEvent) activityCursor {
+	id := event.GetSubmissionID()
+	if event.GetType() == pb.ActivityEvent_ENROLLMENT {
+		id = event.GetUserID()
+	}
+	return activityCursor{date: event.GetDate(), typ: event.GetType(), id: id}
+}
+
+// parseActivityCursor parses a cursor returned by activityCursor.String.
+func parseActivityCursor(cursor string) (activityCursor, error) {
+	parts := strings.Split(cursor, "/")
+	if len(parts) != 3 {
+		return activityCursor{}, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	if _, err := time.ParseInLocation(layout, parts[0], time.Local); err != nil {
+		return activityCursor{}, fmt.Errorf("invalid cursor %q: %w", cursor, err)
+	}
+	typ, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil {
+		return activityCursor{}, fmt.Errorf("invalid cursor %q: %w", cursor, err)
+	}
+	id, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return activityCursor{}, fmt.Errorf("invalid cursor %q: %w", cursor, err)
+	}
+	return activityCursor{date: parts[0], typ: pb.ActivityEvent_Type(typ), id: id}, nil
+}
+
+func (c activityCursor) String() string {
+	return fmt.Sprintf("%s/%d/%d", c.date, c.typ, c.id)
+}
+
+// before returns true if c comes before d in the timeline.
+// All dates have the same fixed-width layout, so they sort chronologically as strings.
+func (c activityCursor) before(d activityCursor) bool {
+	if c.date != d.date {
+		return c.date < d.date
+	}
+	if c.typ != d.typ {
+		return c.typ < d.typ
+	}
+	return c.id < d.id
+}
+
+// getCourseActivity returns up to limit events for the given course, ordered from
+// the oldest to the most recent event, and the cursor of the next page, which is
+// empty on the last page. A limit of zero returns all events. If after is non-nil,
+// only events following after are returned; otherwise only events that happened
+// after since are returned. Only the most recent build of each submission is
+// included, since older builds are not kept.
+func (s *AutograderService) getCourseActivity(courseID uint64, since time.Time, after *activityCursor, limit int) ([]*pb.ActivityEvent, string, error) {
+	var from string
+	include := func(activityCursor) bool { return true }
+	switch {
+	case after != nil:
+		from = after.date
+		include = func(c activityCursor) bool { return after.before(c) }
+	case !since.IsZero():
+		from = since.Format(layout)
+		include = func(c activityCursor) bool { return c.date > from }
+	}
+	enrollments, err := s.db.GetEnrollmentsByCourseSince(courseID, from)
+	if err != nil {
+		return nil, "", err
+	}
+	submissions, err := s.db.GetSubmissionsByCourseSince(courseID, from)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var events []*pb.ActivityEvent
+	add := func(event *pb.ActivityEvent, date string) {
+		d, err := time.ParseInLocation(layout, date, time.Local)
+		if err != nil {
+			// skip events without a valid date, e.g., enrollments made before dates were recorded
+			return
+		}
+		event.Date = d.Format(layout)
+		event.CourseID = courseID
+		if include(eventCursor(event)) {
+			events = append(events, event)
+		}
+	}
+	for _, enrollment := range enrollments {
+		add(&pb.ActivityEvent{Type: pb.ActivityEvent_ENROLLMENT, UserID: enrollment.GetUserID()}, enrollment.GetEnrolledDate())
+	}
+	for _, submission := range submissions {
+		event := pb.ActivityEvent{
+			UserID:       submission.GetUserID(),
+			GroupID:      submission.GetGroupID(),
+			AssignmentID: submission.GetAssignmentID(),
+			SubmissionID: submission.GetID(),
+		}
+		built := event
+		built.Type = pb.ActivityEvent_SUBMISSION
+		add(&built, submission.GetBuildDate())
+		if submission.GetStatus() == pb.Submission_APPROVED {
+			approved := event
+			approved.Type = pb.ActivityEvent_APPROVAL
+			add(&approved, submission.GetApprovedDate())
+		}
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return eventCursor(events[i]).before(eventCursor(events[j]))
+	})
+	var next string
+	if limit > 0 && len(events) > limit {
+		events = events[:limit]
+		next = eventCursor(events[limit-1]).String()
+	}
+	return events, next, nil
+}
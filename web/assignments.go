@@ -29,6 +29,71 @@ func (s *AutograderService) getAssignments(courseID uint64) (*pb.Assignments, er
 	return &pb.Assignments{Assignments: allAssignments}, nil
 }
 
+// getAvailableAssignments returns the course assignments available to the given user,
+// that is, assignments without a prerequisite and assignments whose prerequisite
+// has an approved submission by the user or the user's group.
+func (s *AutograderService) getAvailableAssignments(courseID, userID uint64) (*pb.Assignments, error) {
+	allAssignments, err := s.getAssignments(courseID)
+	if err != nil {
+		return nil, err
+	}
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(courseID, userID)
+	if err != nil {
+		return nil, err
+	}
+	queries := []*pb.Submission{{UserID: userID, Status: pb.Submission_APPROVED}}
+	if enrollment.GetGroupID() > 0 {
+		queries = append(queries, &pb.Submission{GroupID: enrollment.GetGroupID(), Status: pb.Submission_APPROVED})
+	}
+	approved := make(map[uint64]bool)
+	for _, query := range queries {
+		submissions, err := s.db.GetSubmissions(query)
+		if err != nil {
+			return nil, err
+		}
+		for _, submission := range submissions {
+			approved[submission.GetAssignmentID()] = true
+		}
+	}
+	approvedOrders := make(map[uint32]bool)
+	for _, assignment := range allAssignments.GetAssignments() {
+		if approved[assignment.GetID()] {
+			approvedOrders[assignment.GetOrder()] = true
+		}
+	}
+
+	available := make([]*pb.Assignment, 0)
+	for _, assignment := range allAssignments.GetAssignments() {
+		if prerequisite := assignment.GetPrerequisite(); prerequisite == 0 || approvedOrders[prerequisite] {
+			available = append(available, assignment)
+		}
+	}
+	return &pb.Assignments{Assignments: available}, nil
+}
+
+// updateAutoApprove enables or disables autoapproval of submissions for the given
+// assignment. Submissions with a score of at least scoreLimit are approved when
+// built; a zero scoreLimit requires a full score. Updating the assignments from
+// the course's tests repository restores the policy given in assignment.yml.
+func (s *AutograderService) updateAutoApprove(courseID, assignmentID uint64, enabled bool, scoreLimit uint32) error {
+	if scoreLimit > 100 {
+		return fmt.Errorf("invalid score limit %d for assignment %d", scoreLimit, assignmentID)
+	}
+	if scoreLimit == 0 {
+		scoreLimit = 100
+	}
+	assignment, _, err := s.getAssignmentWithCourse(&pb.Assignment{
+		CourseID: courseID,
+		ID:       assignmentID,
+	}, false)
+	if err != nil {
+		return err
+	}
+	assignment.AutoApprove = enabled
+	assignment.ScoreLimit = scoreLimit
+	return s.db.UpdateAssignments([]*pb.Assignment{assignment})
+}
+
 // updateAssignments updates the assignments for the given course.
 func (s *AutograderService) updateAssignments(ctx context.Context, sc scm.SCM, courseID uint64) error {
 	course, err := s.db.GetCourse(courseID, false)
@@ -104,6 +169,16 @@ func (s *AutograderService) loadCriteria(ctx context.Context, sc scm.SCM, reques
 		return nil, err
 	}
 
+	return s.createRubric(assignment.GetID(), benchmarks)
+}
+
+// createRubric replaces the grading benchmarks of the given assignment with the given
+// benchmarks and their criteria. Existing reviews of the assignment's submissions are removed.
+func (s *AutograderService) createRubric(assignmentID uint64, benchmarks []*pb.GradingBenchmark) ([]*pb.GradingBenchmark, error) {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: assignmentID})
+	if err != nil {
+		return nil, err
+	}
 	if len(assignment.GradingBenchmarks) > 0 {
 		if err := s.removeOldCriteriaAndReviews(assignment); err != nil {
 			return nil, err
@@ -143,9 +218,59 @@ func (s *AutograderService) createReview(query *pb.Review) (*pb.Review, error) {
 	if err := s.db.CreateReview(query); err != nil {
 		return nil, err
 	}
+	if submission.GetNeedsReview() {
+		// the review removes the submission from the review queue
+		submission.NeedsReview = false
+		if err := s.db.UpdateSubmission(submission); err != nil {
+			return nil, err
+		}
+	}
 	return query, nil
 }
 
+// scoreSubmissionByRubric creates a ready review of the given submission by the given
+// reviewer, grading each criterion of the assignment's rubric with the given grade,
+// keyed by criterion ID. The review's score is computed from the graded criteria.
+func (s *AutograderService) scoreSubmissionByRubric(submissionID, reviewerID uint64, grades map[uint64]pb.GradingCriterion_Grade) (*pb.Review, error) {
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
+	if err != nil {
+		return nil, err
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.AssignmentID})
+	if err != nil {
+		return nil, err
+	}
+	if len(assignment.GradingBenchmarks) == 0 {
+		return nil, fmt.Errorf("assignment %s has no grading rubric", assignment.Name)
+	}
+	graded := 0
+	for _, bm := range assignment.GradingBenchmarks {
+		for _, c := range bm.Criteria {
+			grade, ok := grades[c.ID]
+			if !ok {
+				return nil, fmt.Errorf("criterion %d of assignment %s is not graded", c.ID, assignment.Name)
+			}
+			c.Grade = grade
+			graded++
+		}
+	}
+	if graded != len(grades) {
+		return nil, fmt.Errorf("grades given for criteria not in the rubric of assignment %s", assignment.Name)
+	}
+
+	review := &pb.Review{
+		SubmissionID: submissionID,
+		ReviewerID:   reviewerID,
+		Benchmarks:   assignment.GradingBenchmarks,
+		Ready:        true,
+	}
+	review.ComputeScore()
+	if err := review.MarshalReviewString(); err != nil {
+		return nil, err
+	}
+	return s.createReview(review)
+}
+
 func (s *AutograderService) updateReview(query *pb.Review) error {
 	if query.ID == 0 {
 		return fmt.Errorf("Cannot update review with empty ID")
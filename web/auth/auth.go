@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"encoding/gob"
 	"net/http"
 	"strconv"
@@ -150,8 +151,16 @@ func OAuth2Login(logger *zap.Logger, db database.Database) echo.HandlerFunc {
 	}
 }
 
+// RenameFunc applies the changed login of the user with the given ID to the
+// user's SCM resources, such as repositories and team memberships, and stores
+// the new login.
+type RenameFunc func(ctx context.Context, userID uint64, newLogin string) error
+
 // OAuth2Callback handles the callback from an oauth2 provider.
-func OAuth2Callback(logger *zap.Logger, db database.Database) echo.HandlerFunc {
+// The scm client for a user's replaced access token is removed from scms.
+// If an existing user's login has changed on the provider, rename is called
+// to apply the new login.
+func OAuth2Callback(logger *zap.Logger, db database.Database, scms *Scms, rename RenameFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		logger.Debug("OAuth2Callback: started")
 		w := c.Response()
@@ -199,6 +208,11 @@ func OAuth2Callback(logger *zap.Logger, db database.Database) echo.HandlerFunc {
 
 			// If type assertions fails, the recover middleware will catch the panic and log a stack trace.
 			us := i.(*UserSession)
+			user, err := db.GetUser(us.ID)
+			if err != nil {
+				logger.Error("failed to get logged in user", zap.Error(err))
+				return err
+			}
 			// Associate user with remote identity.
 			if err := db.AssociateUserWithRemoteIdentity(
 				us.ID, provider, remoteID, externalUser.AccessToken,
@@ -206,6 +220,11 @@ func OAuth2Callback(logger *zap.Logger, db database.Database) echo.HandlerFunc {
 				logger.Error("failed to associate user with remote identity", zap.Error(err))
 				return err
 			}
+			removeReplacedSCM(scms, user, &pb.RemoteIdentity{
+				Provider:    provider,
+				RemoteID:    remoteID,
+				AccessToken: externalUser.AccessToken,
+			})
 
 			// Enable provider in session.
 			us.enableProvider(provider)
@@ -231,6 +250,10 @@ func OAuth2Callback(logger *zap.Logger, db database.Database) echo.HandlerFunc {
 				logger.Error("failed to update access token for user", zap.Error(err), zap.String("user", user.String()))
 				return err
 			}
+			removeReplacedSCM(scms, user, remote)
+			if oldLogin, newLogin := user.GetLogin(), externalUser.NickName; oldLogin != "" && newLogin != "" && oldLogin != newLogin {
+				renameUser(r.Context(), logger, rename, user.GetID(), oldLogin, newLogin)
+			}
 		case err == gorm.ErrRecordNotFound:
 			// user not in database; create new user
 			user = &pb.User{
@@ -268,6 +291,28 @@ func OAuth2Callback(logger *zap.Logger, db database.Database) echo.HandlerFunc {
 	}
 }
 
+// renameUser applies the new login of the user with the given ID.
+// Errors are logged without failing the login; the rename is retried on the user's next login,
+// since the user's login is only updated once the rename has completed.
+func renameUser(ctx context.Context, logger *zap.Logger, rename RenameFunc, userID uint64, oldLogin, newLogin string) {
+	if rename == nil {
+		return
+	}
+	if err := rename(ctx, userID, newLogin); err != nil {
+		logger.Error("failed to rename user", zap.Error(err), zap.String("login", oldLogin), zap.String("new_login", newLogin))
+	}
+}
+
+// removeReplacedSCM removes the scm client for the user's previous access token
+// for the given remote identity, since the old token is no longer valid.
+func removeReplacedSCM(scms *Scms, user *pb.User, remote *pb.RemoteIdentity) {
+	for _, rid := range user.GetRemoteIdentities() {
+		if rid.GetProvider() == remote.GetProvider() && rid.GetAccessToken() != remote.GetAccessToken() {
+			scms.RemoveSCM(rid.GetAccessToken())
+		}
+	}
+}
+
 // AccessControl returns an access control middleware. Given a valid context
 // with sufficient access the next handler is called. Missing or invalid
 // credentials results in a 401 unauthorized response.
@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"context"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -10,6 +11,7 @@ import (
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/scm"
 	"github.com/autograde/quickfeed/web/auth"
 	"github.com/gorilla/sessions"
 	_ "github.com/jinzhu/gorm/dialects/sqlite"
@@ -113,7 +115,7 @@ func TestOAuth2CallbackBadRequest(t *testing.T) {
 	db, cleanup := setup(t)
 	defer cleanup()
 
-	authHandler := auth.OAuth2Callback(zap.NewNop(), db)
+	authHandler := auth.OAuth2Callback(zap.NewNop(), db, auth.NewScms(), nil)
 	withSession := session.Middleware(store)(authHandler)
 	err := withSession(c)
 	httpErr, ok := err.(*echo.HTTPError)
@@ -309,7 +311,7 @@ func testOAuth2Callback(t *testing.T, existingUser, haveSession bool) {
 		}
 	}
 
-	authHandler := auth.OAuth2Callback(zap.NewNop(), db)
+	authHandler := auth.OAuth2Callback(zap.NewNop(), db, auth.NewScms(), nil)
 	withSession := session.Middleware(store)(authHandler)
 
 	if err := withSession(c); err != nil {
@@ -324,6 +326,107 @@ func testOAuth2Callback(t *testing.T, existingUser, haveSession bool) {
 	assertCode(t, w.Code, http.StatusFound)
 }
 
+func TestOAuth2CallbackReplacedAccessToken(t *testing.T) {
+	const (
+		provider = "fake"
+		remoteID = 1
+		secret   = "secret"
+	)
+	r := httptest.NewRequest(http.MethodGet, authURL, nil)
+	w := httptest.NewRecorder()
+
+	qv := r.URL.Query()
+	qv.Set(auth.State, "0"+r.URL.Query().Get(auth.Redirect))
+	r.URL.RawQuery = qv.Encode()
+
+	store := newStore()
+	gothic.Store = store
+	if _, err := gothic.GetAuthURL(w, r); err != nil {
+		t.Fatal(err)
+	}
+	c := echo.New().NewContext(r, w)
+
+	db, cleanup := setup(t)
+	defer cleanup()
+	if err := db.CreateUserFromRemoteIdentity(&pb.User{}, &pb.RemoteIdentity{
+		Provider:    provider,
+		RemoteID:    remoteID,
+		AccessToken: secret,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	scms := auth.NewScms()
+	scms.SetSCM(secret, scm.NewFakeSCMClient())
+	authHandler := auth.OAuth2Callback(zap.NewNop(), db, scms, nil)
+	if err := session.Middleware(store)(authHandler)(c); err != nil {
+		t.Error(err)
+	}
+	assertCode(t, w.Code, http.StatusFound)
+
+	// an existing user logging in gets a new access token
+	if _, ok := scms.GetSCM(secret); ok {
+		t.Error("scm client for replaced access token still cached")
+	}
+}
+
+func TestOAuth2CallbackRenamedUser(t *testing.T) {
+	const (
+		provider = "fake"
+		remoteID = 1
+		oldLogin = "old-login"
+		newLogin = "new-login"
+	)
+	r := httptest.NewRequest(http.MethodGet, authURL, nil)
+	w := httptest.NewRecorder()
+
+	qv := r.URL.Query()
+	qv.Set(auth.State, "0"+r.URL.Query().Get(auth.Redirect))
+	r.URL.RawQuery = qv.Encode()
+
+	store := newStore()
+	gothic.Store = store
+
+	if _, err := gothic.GetAuthURL(w, r); err != nil {
+		t.Fatal(err)
+	}
+	// the user's login has changed on the provider
+	fakeSession := auth.FakeSession{ID: "1", NickName: newLogin}
+	if err := gothic.StoreInSession(provider, fakeSession.Marshal(), r, w); err != nil {
+		t.Fatal(err)
+	}
+	c := echo.New().NewContext(r, w)
+
+	db, cleanup := setup(t)
+	defer cleanup()
+	user := &pb.User{Login: oldLogin}
+	if err := db.CreateUserFromRemoteIdentity(user, &pb.RemoteIdentity{
+		Provider:    provider,
+		RemoteID:    remoteID,
+		AccessToken: "secret",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var renamed []string
+	rename := func(_ context.Context, userID uint64, newLogin string) error {
+		if userID != user.GetID() {
+			t.Errorf("rename called for user %d, want %d", userID, user.GetID())
+		}
+		renamed = append(renamed, oldLogin, newLogin)
+		return nil
+	}
+	authHandler := auth.OAuth2Callback(zap.NewNop(), db, auth.NewScms(), rename)
+	if err := session.Middleware(store)(authHandler)(c); err != nil {
+		t.Error(err)
+	}
+	assertCode(t, w.Code, http.StatusFound)
+
+	if !reflect.DeepEqual(renamed, []string{oldLogin, newLogin}) {
+		t.Errorf("have renamed %v want %v", renamed, []string{oldLogin, newLogin})
+	}
+}
+
 func TestAccessControl(t *testing.T) {
 	const (
 		provider = "github"
@@ -26,6 +26,7 @@ type FakeProvider struct {
 type FakeSession struct {
 	ID          string
 	Name        string
+	NickName    string
 	Email       string
 	AuthURL     string
 	AccessToken string
@@ -61,6 +62,7 @@ func (p *FakeProvider) FetchUser(session goth.Session) (goth.User, error) {
 	user := goth.User{
 		UserID:      sess.ID,
 		Name:        sess.Name,
+		NickName:    sess.NickName,
 		Email:       sess.Email,
 		Provider:    p.Name(),
 		AccessToken: sess.AccessToken,
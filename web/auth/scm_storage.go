@@ -27,6 +27,22 @@ func (s *Scms) GetSCM(accessToken string) (sc scm.SCM, ok bool) {
 	return
 }
 
+// SetSCM stores the given scm client for the given access token,
+// replacing any existing client for that token.
+func (s *Scms) SetSCM(accessToken string, sc scm.SCM) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scms[accessToken] = sc
+}
+
+// RemoveSCM removes the scm client for the given access token, if any.
+// Used to evict clients whose access token has been replaced or revoked.
+func (s *Scms) RemoveSCM(accessToken string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.scms, accessToken)
+}
+
 // GetOrCreateSCMEntry returns an scm client for the given remote identity
 // (provider, access token) pair. If no scm client exists for the given
 // remote identity, one will be created and stored for later retrival.
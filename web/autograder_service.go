@@ -3,6 +3,8 @@ package web
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -18,21 +20,23 @@ import (
 // AutograderService holds references to the database and
 // other shared data structures.
 type AutograderService struct {
-	logger *zap.SugaredLogger
-	db     *database.GormDB
-	scms   *auth.Scms
-	bh     BaseHookOptions
-	runner ci.Runner
+	logger   *zap.SugaredLogger
+	db       *database.GormDB
+	scms     *auth.Scms
+	bh       BaseHookOptions
+	runner   ci.Runner
+	notifier Notifier
 }
 
 // NewAutograderService returns an AutograderService object.
 func NewAutograderService(logger *zap.Logger, db *database.GormDB, scms *auth.Scms, bh BaseHookOptions, runner ci.Runner) *AutograderService {
 	return &AutograderService{
-		logger: logger.Sugar(),
-		db:     db,
-		scms:   scms,
-		bh:     bh,
-		runner: runner,
+		logger:   logger.Sugar(),
+		db:       db,
+		scms:     scms,
+		bh:       bh,
+		runner:   runner,
+		notifier: &logNotifier{logger: logger.Sugar()},
 	}
 }
 
@@ -42,12 +46,12 @@ func NewAutograderService(logger *zap.Logger, db *database.GormDB, scms *auth.Sc
 func (s *AutograderService) GetUser(ctx context.Context, in *pb.Void) (*pb.User, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetUser failed: authentication error: %w", err)
+		s.logger.Errorf("GetUser failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	userInfo, err := s.db.GetUserWithEnrollments(usr.GetID())
 	if err != nil {
-		s.logger.Errorf("GetUser failed to get user with enrollments: %w ", err)
+		s.logger.Errorf("GetUser failed to get user with enrollments: %v ", err)
 	}
 	return userInfo, nil
 }
@@ -58,7 +62,7 @@ func (s *AutograderService) GetUser(ctx context.Context, in *pb.Void) (*pb.User,
 func (s *AutograderService) GetUsers(ctx context.Context, in *pb.Void) (*pb.Users, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetUsers failed: authentication error: %w", err)
+		s.logger.Errorf("GetUsers failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	if !usr.IsAdmin {
@@ -67,7 +71,7 @@ func (s *AutograderService) GetUsers(ctx context.Context, in *pb.Void) (*pb.User
 	}
 	users, err := s.getUsers()
 	if err != nil {
-		s.logger.Errorf("GetUsers failed: %w", err)
+		s.logger.Errorf("GetUsers failed: %v", err)
 		return nil, status.Errorf(codes.NotFound, "failed to get users")
 	}
 	return users, nil
@@ -79,7 +83,7 @@ func (s *AutograderService) GetUsers(ctx context.Context, in *pb.Void) (*pb.User
 func (s *AutograderService) GetUserByCourse(ctx context.Context, in *pb.CourseUserRequest) (*pb.User, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetUserByCourse failed: authentication error: %w", err)
+		s.logger.Errorf("GetUserByCourse failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	userInfo, err := s.getUserByCourse(in, usr)
@@ -97,7 +101,7 @@ func (s *AutograderService) GetUserByCourse(ctx context.Context, in *pb.CourseUs
 func (s *AutograderService) UpdateUser(ctx context.Context, in *pb.User) (*pb.Void, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("UpdateUser failed: authentication error: %w", err)
+		s.logger.Errorf("UpdateUser failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	if !(usr.IsAdmin || usr.IsOwner(in.GetID())) {
@@ -105,7 +109,7 @@ func (s *AutograderService) UpdateUser(ctx context.Context, in *pb.User) (*pb.Vo
 		return nil, status.Errorf(codes.PermissionDenied, "only admin can update another user's information")
 	}
 	if _, err = s.updateUser(usr, in); err != nil {
-		s.logger.Errorf("UpdateUser failed to update user %d: %w", in.GetID(), err)
+		s.logger.Errorf("UpdateUser failed to update user %d: %v", in.GetID(), err)
 		err = status.Errorf(codes.InvalidArgument, "failed to update user")
 	}
 	return &pb.Void{}, err
@@ -116,8 +120,9 @@ func (s *AutograderService) UpdateUser(ctx context.Context, in *pb.User) (*pb.Vo
 func (s *AutograderService) IsAuthorizedTeacher(ctx context.Context, in *pb.Void) (*pb.AuthorizationResponse, error) {
 	// Currently hardcoded for github only
 	_, scm, err := s.getUserAndSCM(ctx, "github")
+	logger := s.scmLogger("IsAuthorizedTeacher", 0, 0)
 	if err != nil {
-		s.logger.Errorf("IsAuthorizedTeacher failed: scm authentication error: %w", err)
+		logger.Errorf("IsAuthorizedTeacher failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	return &pb.AuthorizationResponse{
@@ -129,12 +134,13 @@ func (s *AutograderService) IsAuthorizedTeacher(ctx context.Context, in *pb.Void
 // Access policy: Admin.
 func (s *AutograderService) CreateCourse(ctx context.Context, in *pb.Course) (*pb.Course, error) {
 	usr, scm, err := s.getUserAndSCM(ctx, in.Provider)
+	logger := s.scmLogger("CreateCourse", 0, usr.GetID())
 	if err != nil {
-		s.logger.Errorf("CreateCourse failed: scm authentication error: %w", err)
+		logger.Errorf("CreateCourse failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	if !usr.IsAdmin {
-		s.logger.Error("CreateCourse failed: user is not admin")
+		logger.Error("CreateCourse failed: user is not admin")
 		return nil, status.Error(codes.PermissionDenied, "user must be admin to create course")
 	}
 
@@ -142,7 +148,7 @@ func (s *AutograderService) CreateCourse(ctx context.Context, in *pb.Course) (*p
 	in.CourseCreatorID = usr.GetID()
 	course, err := s.createCourse(ctx, scm, in)
 	if err != nil {
-		s.logger.Error("CreateCourse failed: ", err.Error())
+		logger.Error("CreateCourse failed: ", err.Error())
 		// errors informing about requested organization state will have code 9: FailedPrecondition
 		// error message will be displayed to the user
 		if contextCanceled(ctx) {
@@ -151,6 +157,13 @@ func (s *AutograderService) CreateCourse(ctx context.Context, in *pb.Course) (*p
 		if err == ErrAlreadyExists || err == ErrFreePlan {
 			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
 		}
+		var missingScopes scms.ErrMissingScopes
+		if errors.As(err, &missingScopes) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s; please sign in again to grant them", err)
+		}
+		if err == database.ErrDuplicateCourseSlug {
+			return nil, err
+		}
 		if ok, parsedErr := parseSCMError(err); ok {
 			return nil, parsedErr
 		}
@@ -163,21 +176,25 @@ func (s *AutograderService) CreateCourse(ctx context.Context, in *pb.Course) (*p
 // Access policy: Teacher of CourseID.
 func (s *AutograderService) UpdateCourse(ctx context.Context, in *pb.Course) (*pb.Void, error) {
 	usr, scm, err := s.getUserAndSCM(ctx, in.Provider)
+	logger := s.scmLogger("UpdateCourse", in.GetID(), usr.GetID())
 	if err != nil {
-		s.logger.Errorf("UpdateCourse failed: scm authentication error: %w", err)
+		logger.Errorf("UpdateCourse failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	courseID := in.GetID()
 	if !s.isTeacher(usr.GetID(), courseID) {
-		s.logger.Error("UpdateCourse failed: user is not teacher")
+		logger.Error("UpdateCourse failed: user is not teacher")
 		return nil, status.Errorf(codes.PermissionDenied, "only teachers can update course")
 	}
 
 	if err = s.updateCourse(ctx, scm, in); err != nil {
-		s.logger.Errorf("UpdateCourse failed: %w", err)
+		logger.Errorf("UpdateCourse failed: %v", err)
 		if contextCanceled(ctx) {
 			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
 		}
+		if err == database.ErrDuplicateCourseSlug {
+			return nil, err
+		}
 		if ok, parsedErr := parseSCMError(err); ok {
 			return nil, parsedErr
 		}
@@ -186,15 +203,68 @@ func (s *AutograderService) UpdateCourse(ctx context.Context, in *pb.Course) (*p
 	return &pb.Void{}, nil
 }
 
+// UpdateCourseWithWarnings updates a course. With SkipOrganizationCheck, the course's
+// organization is not checked to exist unless the update affects the organization,
+// and a warning is returned instead.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) UpdateCourseWithWarnings(ctx context.Context, in *pb.UpdateCourseRequest) (*pb.UpdateCourseWarnings, error) {
+	course := in.GetCourse()
+	usr, scm, err := s.getUserAndSCM(ctx, course.GetProvider())
+	logger := s.scmLogger("UpdateCourseWithWarnings", course.GetID(), usr.GetID())
+	if err != nil {
+		logger.Errorf("UpdateCourseWithWarnings failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), course.GetID()) {
+		logger.Error("UpdateCourseWithWarnings failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can update course")
+	}
+
+	warnings, err := s.updateCourseWithWarnings(ctx, scm, course, in.GetSkipOrganizationCheck())
+	if err != nil {
+		logger.Errorf("UpdateCourseWithWarnings failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if err == database.ErrDuplicateCourseSlug {
+			return nil, err
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to update course")
+	}
+	for _, warning := range warnings {
+		logger.Warnf("UpdateCourseWithWarnings: %s", warning)
+	}
+	return &pb.UpdateCourseWarnings{Warnings: warnings}, nil
+}
+
 // GetCourse returns course information for the given course.
+// The course is looked up by slug if no course ID is provided.
 // Access policy: Any User.
 func (s *AutograderService) GetCourse(ctx context.Context, in *pb.CourseRequest) (*pb.Course, error) {
 	courseID := in.GetCourseID()
-	course, err := s.getCourse(courseID)
+	if courseID == 0 && in.GetSlug() != "" {
+		course, err := s.getCourseBySlug(in.GetSlug())
+		if err != nil {
+			s.logger.Errorf("GetCourse failed: %v", err)
+			return nil, status.Errorf(codes.NotFound, "course not found")
+		}
+		courseID = course.GetID()
+	}
+	getCourse := s.getCourse
+	if in.GetWithStats() {
+		getCourse = s.getCourseWithStats
+	}
+	course, err := getCourse(courseID)
 	if err != nil {
-		s.logger.Errorf("GetCourse failed: %w", err)
+		s.logger.Errorf("GetCourse failed: %v", err)
 		return nil, status.Errorf(codes.NotFound, "course not found")
 	}
+	if usr, err := s.getCurrentUser(ctx); err != nil || !s.isTeacher(usr.GetID(), courseID) {
+		course.RemoveEnrollmentCode()
+	}
 	return course, nil
 }
 
@@ -203,9 +273,22 @@ func (s *AutograderService) GetCourse(ctx context.Context, in *pb.CourseRequest)
 func (s *AutograderService) GetCourses(ctx context.Context, in *pb.Void) (*pb.Courses, error) {
 	courses, err := s.getCourses()
 	if err != nil {
-		s.logger.Errorf("GetCourses failed: %w", err)
+		s.logger.Errorf("GetCourses failed: %v", err)
+		return nil, status.Errorf(codes.NotFound, "no courses found")
+	}
+	courses.RemoveEnrollmentCodes()
+	return courses, nil
+}
+
+// GetPublicCourses returns the public courses that are not archived, ordered by year and name.
+// Access policy: Any User.
+func (s *AutograderService) GetPublicCourses(ctx context.Context, in *pb.Void) (*pb.Courses, error) {
+	courses, err := s.getPublicCourses()
+	if err != nil {
+		s.logger.Errorf("GetPublicCourses failed: %v", err)
 		return nil, status.Errorf(codes.NotFound, "no courses found")
 	}
+	courses.RemoveEnrollmentCodes()
 	return courses, nil
 }
 
@@ -214,7 +297,7 @@ func (s *AutograderService) GetCourses(ctx context.Context, in *pb.Void) (*pb.Co
 func (s *AutograderService) UpdateCourseVisibility(ctx context.Context, in *pb.Enrollment) (*pb.Void, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("ChangeCourseVisibility failed: authentication error: %w", err)
+		s.logger.Errorf("ChangeCourseVisibility failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	if !usr.IsOwner(in.GetUserID()) {
@@ -223,19 +306,45 @@ func (s *AutograderService) UpdateCourseVisibility(ctx context.Context, in *pb.E
 	}
 	err = s.changeCourseVisibility(in)
 	if err != nil {
-		s.logger.Errorf("ChangeCourseVisibility failed: %w", err)
+		s.logger.Errorf("ChangeCourseVisibility failed: %v", err)
 		err = status.Errorf(codes.InvalidArgument, "failed to update course visibility")
 	}
 	return &pb.Void{}, err
 }
 
+// SetCourseFeature enables or disables the given feature for the given course.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) SetCourseFeature(ctx context.Context, in *pb.CourseFeatureRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("SetCourseFeature failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("SetCourseFeature failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can change course features")
+	}
+	if err := s.setCourseFeature(in.GetCourseID(), in.GetFeature(), in.GetEnabled()); err != nil {
+		s.logger.Errorf("SetCourseFeature failed: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to change course feature")
+	}
+	return &pb.Void{}, nil
+}
+
 // CreateEnrollment enrolls a new student for the course specified in the request.
-// Access policy: Any User.
+// Access policy: Any User enrolling themselves, or Teacher of CourseID.
 func (s *AutograderService) CreateEnrollment(ctx context.Context, in *pb.Enrollment) (*pb.Void, error) {
-	err := s.createEnrollment(in)
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("CreateEnrollment failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	err = s.createEnrollment(ctx, usr, in)
 	if err != nil {
-		s.logger.Errorf("CreateEnrollment failed: %w", err)
-		err = status.Error(codes.InvalidArgument, "failed to create enrollment")
+		s.logger.Errorf("CreateEnrollment failed: %v", err)
+		if !errors.Is(err, ErrEmailNotAllowed) && !errors.Is(err, ErrInvalidEnrollmentCode) && !errors.Is(err, ErrEnrollOtherUser) {
+			err = status.Error(codes.InvalidArgument, "failed to create enrollment")
+		}
 	}
 	return &pb.Void{}, err
 }
@@ -244,24 +353,34 @@ func (s *AutograderService) CreateEnrollment(ctx context.Context, in *pb.Enrollm
 // Access policy: Teacher of CourseID.
 func (s *AutograderService) UpdateEnrollment(ctx context.Context, in *pb.Enrollment) (*pb.Void, error) {
 	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	logger := s.scmLogger("UpdateEnrollment", in.GetCourseID(), usr.GetID())
 	if err != nil {
-		s.logger.Errorf("UpdateEnrollment failed: scm authentication error: %w", err)
+		logger.Errorf("UpdateEnrollment failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
-		s.logger.Error("UpdateEnrollment failed: user is not teacher")
+		logger.Error("UpdateEnrollment failed: user is not teacher")
 		return nil, status.Errorf(codes.PermissionDenied, "only teachers can update enrollment status")
 	}
 	if s.isCourseCreator(in.CourseID, in.UserID) {
-		s.logger.Errorf("UpdateEnrollment failed: user %s attempted to demote course creator", usr.GetName())
+		logger.Errorf("UpdateEnrollment failed: user %s attempted to demote course creator", usr.GetName())
 		return nil, status.Errorf(codes.PermissionDenied, "course creator cannot be demoted")
 	}
 	err = s.updateEnrollment(ctx, scm, usr.Login, in)
 	if err != nil {
-		s.logger.Errorf("UpdateEnrollment failed: %w", err)
+		logger.Errorf("UpdateEnrollment failed: %v", err)
 		if contextCanceled(ctx) {
 			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
 		}
+		if errors.Is(err, ErrInvalidTransition) || errors.Is(err, ErrOrgInvitationPending) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, ErrCourseFull) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		if errors.Is(err, ErrEnrollmentIncomplete) {
+			return nil, status.Error(codes.Aborted, "enrollment incomplete, accept the enrollment again to complete it")
+		}
 		if ok, parsedErr := parseSCMError(err); ok {
 			return nil, parsedErr
 		}
@@ -270,49 +389,322 @@ func (s *AutograderService) UpdateEnrollment(ctx context.Context, in *pb.Enrollm
 	return &pb.Void{}, nil
 }
 
+// ReconcileEnrollments removes records of deleted student repositories and returns
+// the enrollments of students who are no longer members of the course organization.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) ReconcileEnrollments(ctx context.Context, in *pb.CourseRequest) (*pb.Enrollments, error) {
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	logger := s.scmLogger("ReconcileEnrollments", in.GetCourseID(), usr.GetID())
+	if err != nil {
+		logger.Errorf("ReconcileEnrollments failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		logger.Error("ReconcileEnrollments failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can reconcile enrollments")
+	}
+	stale, err := s.reconcileEnrollments(ctx, scm, in.GetCourseID())
+	if err != nil {
+		logger.Errorf("ReconcileEnrollments failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to reconcile enrollments")
+	}
+	return &pb.Enrollments{Enrollments: stale}, nil
+}
+
+// ImportEnrollments enrolls the members of the course organization as students,
+// recording their existing student repositories without provisioning them again.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ImportEnrollments(ctx context.Context, in *pb.CourseRequest) (*pb.EnrollmentImport, error) {
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	logger := s.scmLogger("ImportEnrollments", in.GetCourseID(), usr.GetID())
+	if err != nil {
+		logger.Errorf("ImportEnrollments failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		logger.Error("ImportEnrollments failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can import enrollments")
+	}
+	result, err := s.importEnrollmentsFromSCM(ctx, scm, in.GetCourseID())
+	if err != nil {
+		logger.Errorf("ImportEnrollments failed after importing %d enrollments: %v", len(result.GetImported()), err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to import enrollments")
+	}
+	return result, nil
+}
+
+// LeaveCourse removes the current user, a student, from the given course.
+// The student loses access to the course repositories, but the student's submissions are kept.
+// Access policy: Student of CourseID.
+func (s *AutograderService) LeaveCourse(ctx context.Context, in *pb.CourseRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("LeaveCourse failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	logger := s.scmLogger("LeaveCourse", in.GetCourseID(), usr.GetID())
+	course, err := s.getCourse(in.GetCourseID())
+	if err != nil {
+		logger.Errorf("LeaveCourse failed: %v", err)
+		return nil, status.Errorf(codes.NotFound, "course not found")
+	}
+	// the student's own SCM client cannot change the student's team memberships
+	sc, ok := s.scms.GetSCM(course.GetAccessToken())
+	if !ok {
+		logger.Errorf("LeaveCourse failed: no SCM client for course %d", course.GetID())
+		return nil, status.Error(codes.FailedPrecondition, "failed to leave course")
+	}
+	if err := s.leaveCourse(ctx, sc, course.GetID(), usr.GetID()); err != nil {
+		logger.Errorf("LeaveCourse failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if errors.Is(err, ErrTeacherCannotLeave) {
+			return nil, status.Error(codes.PermissionDenied, "teachers cannot leave a course, but can be demoted by another teacher")
+		}
+		if errors.Is(err, ErrInvalidTransition) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to leave course")
+	}
+	return &pb.Void{}, nil
+}
+
 // UpdateEnrollments changes status of all pending enrollments for the given course to approved
 // Access policy: Teacher of CourseID
 func (s *AutograderService) UpdateEnrollments(ctx context.Context, in *pb.CourseRequest) (*pb.Void, error) {
 	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	logger := s.scmLogger("UpdateEnrollments", in.GetCourseID(), usr.GetID())
 	if err != nil {
-		s.logger.Errorf("UpdateEnrollments failed: scm authentication error: %w", err)
+		logger.Errorf("UpdateEnrollments failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
-		s.logger.Error("UpdateEnrollments failed: user is not teacher")
+		logger.Error("UpdateEnrollments failed: user is not teacher")
 		return nil, status.Errorf(codes.PermissionDenied, "only teachers can update enrollment status")
 	}
-	err = s.updateEnrollments(ctx, scm, in.GetCourseID())
+	invited, err := s.updateEnrollments(ctx, scm, in.GetCourseID())
 	if err != nil {
-		s.logger.Errorf("UpdateEnrollments failed: %w", err)
+		logger.Errorf("UpdateEnrollments failed: %v", err)
 		if contextCanceled(ctx) {
 			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
 		}
+		if errors.Is(err, ErrCourseFull) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		if errors.Is(err, ErrEnrollmentIncomplete) {
+			return nil, status.Error(codes.Aborted, "enrollment incomplete, accept the enrollment again to complete it")
+		}
 		if ok, parsedErr := parseSCMError(err); ok {
 			return nil, parsedErr
 		}
 		err = status.Error(codes.InvalidArgument, "failed to update pending enrollments")
 	}
+	if err == nil && len(invited) > 0 {
+		// all other pending users have been enrolled
+		err = status.Errorf(codes.FailedPrecondition, "users %s must accept the invitation to the course organization before enrollment", strings.Join(invited, ", "))
+	}
 	return &pb.Void{}, err
 }
 
+// RejectEnrollments rejects all pending enrollments for the given course,
+// and returns the number of rejected enrollments.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) RejectEnrollments(ctx context.Context, in *pb.RejectEnrollmentsRequest) (*pb.EnrollmentCount, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("RejectEnrollments failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("RejectEnrollments failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can reject enrollments")
+	}
+	count, err := s.rejectAllPending(in.GetCourseID(), in.GetReason())
+	if err != nil {
+		s.logger.Errorf("RejectEnrollments failed: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to reject pending enrollments")
+	}
+	return &pb.EnrollmentCount{Count: count}, nil
+}
+
+// GetPendingEnrollmentCount returns the number of pending enrollments for the given course.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) GetPendingEnrollmentCount(ctx context.Context, in *pb.CourseRequest) (*pb.EnrollmentCount, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetPendingEnrollmentCount failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("GetPendingEnrollmentCount failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can get pending enrollments")
+	}
+	count, err := s.getPendingEnrollmentCount(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetPendingEnrollmentCount failed: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to count pending enrollments")
+	}
+	return &pb.EnrollmentCount{Count: count}, nil
+}
+
 // GetCoursesByUser returns all courses the given user is enrolled into with the given status.
 // Access policy: Any User.
 func (s *AutograderService) GetCoursesByUser(ctx context.Context, in *pb.EnrollmentStatusRequest) (*pb.Courses, error) {
 	courses, err := s.getCoursesByUser(in)
 	if err != nil {
-		s.logger.Errorf("GetCoursesWithEnrollment failed: %w", err)
+		s.logger.Errorf("GetCoursesWithEnrollment failed: %v", err)
 		return nil, status.Errorf(codes.NotFound, "no courses with enrollment found")
 	}
+	courses.RemoveEnrollmentCodes()
+	return courses, nil
+}
+
+// GetCoursesByIDs returns the courses with the given IDs, in the requested order.
+// Unknown course IDs are skipped.
+// Access policy: Any User.
+func (s *AutograderService) GetCoursesByIDs(ctx context.Context, in *pb.CoursesRequest) (*pb.Courses, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetCoursesByIDs failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	coursesByID, err := s.getCoursesByIDs(in.GetCourseIDs())
+	if err != nil {
+		s.logger.Errorf("GetCoursesByIDs failed: %v", err)
+		return nil, status.Errorf(codes.NotFound, "no courses found")
+	}
+	courses := make([]*pb.Course, 0, len(coursesByID))
+	for _, courseID := range in.GetCourseIDs() {
+		course, ok := coursesByID[courseID]
+		if !ok {
+			continue
+		}
+		// avoid returning the same course twice for duplicate IDs
+		delete(coursesByID, courseID)
+		if !s.isTeacher(usr.GetID(), courseID) {
+			course.RemoveEnrollmentCode()
+		}
+		courses = append(courses, course)
+	}
+	return &pb.Courses{Courses: courses}, nil
+}
+
+// GetCourseActivity returns a page of the given course's activity timeline,
+// ordered from the oldest to the most recent event, with the cursor of the next page.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GetCourseActivity(ctx context.Context, in *pb.CourseActivityRequest) (*pb.CourseActivity, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetCourseActivity failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("GetCourseActivity failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can get course activity")
+	}
+	var since time.Time
+	if in.GetSince() != "" {
+		if since, err = time.ParseInLocation(layout, in.GetSince(), time.Local); err != nil {
+			s.logger.Errorf("GetCourseActivity failed: %v", err)
+			return nil, status.Errorf(codes.InvalidArgument, "invalid date %q", in.GetSince())
+		}
+	}
+	var after *activityCursor
+	if in.GetCursor() != "" {
+		cursor, err := parseActivityCursor(in.GetCursor())
+		if err != nil {
+			s.logger.Errorf("GetCourseActivity failed: %v", err)
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor %q", in.GetCursor())
+		}
+		after = &cursor
+	}
+	events, next, err := s.getCourseActivity(in.GetCourseID(), since, after, int(in.GetLimit()))
+	if err != nil {
+		s.logger.Errorf("GetCourseActivity failed: %v", err)
+		return nil, status.Errorf(codes.NotFound, "failed to get course activity")
+	}
+	return &pb.CourseActivity{Events: events, NextCursor: next}, nil
+}
+
+// GetMyActiveCourses returns the courses in which the current user is enrolled as a student or a teacher.
+// Access policy: Any User.
+func (s *AutograderService) GetMyActiveCourses(ctx context.Context, in *pb.Void) (*pb.Courses, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetMyActiveCourses failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	courses, err := s.getMyActiveCourses(usr)
+	if err != nil {
+		s.logger.Errorf("GetMyActiveCourses failed: %v", err)
+		return nil, status.Errorf(codes.NotFound, "no courses found")
+	}
+	courses.RemoveEnrollmentCodes()
 	return courses, nil
 }
 
+// GetCoursesWithEnrollment returns all courses the given user is enrolled into with the given status,
+// each with the user's enrollment in the course.
+// Access policy: user with userID or admin
+func (s *AutograderService) GetCoursesWithEnrollment(ctx context.Context, in *pb.EnrollmentStatusRequest) (*pb.CourseEnrollments, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetCoursesWithEnrollment failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if usr.GetID() != in.GetUserID() && !usr.IsAdmin {
+		s.logger.Errorf("GetCoursesWithEnrollment failed: current user ID: %d, but requested user ID is %d", usr.ID, in.UserID)
+		return nil, status.Errorf(codes.PermissionDenied, "only admins can request enrollments for other users")
+	}
+	courseEnrollments, err := s.getCoursesWithEnrollment(in)
+	if err != nil {
+		s.logger.Errorf("GetCoursesWithEnrollment failed: %v", err)
+		return nil, status.Errorf(codes.NotFound, "no courses with enrollment found")
+	}
+	for _, courseEnrollment := range courseEnrollments.GetCourseEnrollments() {
+		if courseEnrollment.GetCourse().GetEnrolled() != pb.Enrollment_TEACHER {
+			courseEnrollment.GetCourse().RemoveEnrollmentCode()
+		}
+	}
+	return courseEnrollments, nil
+}
+
+// GetEnrollment returns the current user's enrollment in the given course,
+// optionally with the user's group and repository URL.
+// Access policy: Any User enrolled in CourseID.
+func (s *AutograderService) GetEnrollment(ctx context.Context, in *pb.EnrollmentDetailsRequest) (*pb.Enrollment, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetEnrollment failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	enrollment, err := s.getEnrollment(usr, in.GetCourseID(), in.GetWithDetails())
+	if err != nil {
+		s.logger.Errorf("GetEnrollment failed: %v", err)
+		return nil, status.Errorf(codes.NotFound, "failed to get enrollment")
+	}
+	return enrollment, nil
+}
+
 // GetEnrollmentsByUser returns all enrollments for the given user and enrollment status with preloaded courses and groups.
 // Access policy: user with userID or admin
 func (s *AutograderService) GetEnrollmentsByUser(ctx context.Context, in *pb.EnrollmentStatusRequest) (*pb.Enrollments, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetEnrollmentsByUser failed: authentication error: %w", err)
+		s.logger.Errorf("GetEnrollmentsByUser failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	if usr.GetID() != in.GetUserID() && !usr.IsAdmin {
@@ -333,7 +725,7 @@ func (s *AutograderService) GetEnrollmentsByUser(ctx context.Context, in *pb.Enr
 func (s *AutograderService) GetEnrollmentsByCourse(ctx context.Context, in *pb.EnrollmentRequest) (*pb.Enrollments, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetEnrollmentsByCourse failed: authentication error: %w", err)
+		s.logger.Errorf("GetEnrollmentsByCourse failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	if !s.isEnrolled(usr.GetID(), in.GetCourseID()) {
@@ -343,7 +735,11 @@ func (s *AutograderService) GetEnrollmentsByCourse(ctx context.Context, in *pb.E
 
 	enrolls, err := s.getEnrollmentsByCourse(in)
 	if err != nil {
-		s.logger.Errorf("GetEnrollmentsByCourse failed: %w", err)
+		s.logger.Errorf("GetEnrollmentsByCourse failed: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to get enrollments for given course")
+	}
+	if err := s.redactEnrollments(usr, in.GetCourseID(), enrolls); err != nil {
+		s.logger.Errorf("GetEnrollmentsByCourse failed: %v", err)
 		return nil, status.Errorf(codes.InvalidArgument, "failed to get enrollments for given course")
 	}
 	return enrolls, nil
@@ -354,12 +750,12 @@ func (s *AutograderService) GetEnrollmentsByCourse(ctx context.Context, in *pb.E
 func (s *AutograderService) GetGroup(ctx context.Context, in *pb.GetGroupRequest) (*pb.Group, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetGroup failed: authentication error: %w", err)
+		s.logger.Errorf("GetGroup failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	group, err := s.getGroup(in)
 	if err != nil {
-		s.logger.Errorf("GetGroup failed: %w", err)
+		s.logger.Errorf("GetGroup failed: %v", err)
 		return nil, status.Errorf(codes.NotFound, "failed to get group")
 	}
 	if !(group.Contains(usr) || s.isTeacher(usr.GetID(), group.GetCourseID())) {
@@ -374,7 +770,7 @@ func (s *AutograderService) GetGroup(ctx context.Context, in *pb.GetGroupRequest
 func (s *AutograderService) GetGroupsByCourse(ctx context.Context, in *pb.CourseRequest) (*pb.Groups, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetGroups failed: authentication error: %w", err)
+		s.logger.Errorf("GetGroups failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	courseID := in.GetCourseID()
@@ -384,7 +780,7 @@ func (s *AutograderService) GetGroupsByCourse(ctx context.Context, in *pb.Course
 	}
 	groups, err := s.getGroups(in)
 	if err != nil {
-		s.logger.Errorf("GetGroups failed: %w", err)
+		s.logger.Errorf("GetGroups failed: %v", err)
 		return nil, status.Errorf(codes.NotFound, "failed to get groups")
 	}
 	return groups, nil
@@ -395,13 +791,13 @@ func (s *AutograderService) GetGroupsByCourse(ctx context.Context, in *pb.Course
 func (s *AutograderService) GetGroupByUserAndCourse(ctx context.Context, in *pb.GroupRequest) (*pb.Group, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetGroupByUserAndCourse failed: authentication error: %w", err)
+		s.logger.Errorf("GetGroupByUserAndCourse failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	group, err := s.getGroupByUserAndCourse(in)
 	if err != nil {
 		if err != ErrUserNotInGroup {
-			s.logger.Errorf("GetGroupByUserAndCourse failed: %w", err)
+			s.logger.Errorf("GetGroupByUserAndCourse failed: %v", err)
 		}
 		return nil, status.Errorf(codes.NotFound, "failed to get group for given user and course")
 	}
@@ -417,7 +813,7 @@ func (s *AutograderService) GetGroupByUserAndCourse(ctx context.Context, in *pb.
 func (s *AutograderService) CreateGroup(ctx context.Context, in *pb.Group) (*pb.Group, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("CreateGroup failed: authentication error: %w", err)
+		s.logger.Errorf("CreateGroup failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	if !s.isEnrolled(usr.GetID(), in.GetCourseID()) {
@@ -430,10 +826,10 @@ func (s *AutograderService) CreateGroup(ctx context.Context, in *pb.Group) (*pb.
 	}
 	group, err := s.createGroup(in)
 	if err != nil {
-		if err == ErrGroupNameDuplicate {
+		if err == ErrGroupNameDuplicate || err == ErrGroupsDisabled {
 			return nil, err
 		}
-		s.logger.Errorf("CreateGroup failed: %w", err)
+		s.logger.Errorf("CreateGroup failed: %v", err)
 		return nil, status.Error(codes.InvalidArgument, "failed to create group")
 	}
 	return group, nil
@@ -443,17 +839,18 @@ func (s *AutograderService) CreateGroup(ctx context.Context, in *pb.Group) (*pb.
 // Access policy: Teacher of CourseID.
 func (s *AutograderService) UpdateGroup(ctx context.Context, in *pb.Group) (*pb.Void, error) {
 	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	logger := s.scmLogger("UpdateGroup", in.GetCourseID(), usr.GetID())
 	if err != nil {
-		s.logger.Errorf("UpdateGroup failed: scm authentication error: %w", err)
+		logger.Errorf("UpdateGroup failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
-		s.logger.Error("UpdateGroup failed: user is not teacher")
+		logger.Error("UpdateGroup failed: user is not teacher")
 		return nil, status.Errorf(codes.PermissionDenied, "only teachers can update groups")
 	}
 	err = s.updateGroup(ctx, scm, in)
 	if err != nil {
-		s.logger.Errorf("UpdateGroup failed: %w", err)
+		logger.Errorf("UpdateGroup failed: %v", err)
 		if contextCanceled(ctx) {
 			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
 		}
@@ -469,21 +866,22 @@ func (s *AutograderService) UpdateGroup(ctx context.Context, in *pb.Group) (*pb.
 // Access policy: Teacher of CourseID.
 func (s *AutograderService) DeleteGroup(ctx context.Context, in *pb.GroupRequest) (*pb.Void, error) {
 	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	logger := s.scmLogger("DeleteGroup", in.GetCourseID(), usr.GetID())
 	if err != nil {
-		s.logger.Errorf("DeleteGroup failed: scm authentication error: %w", err)
+		logger.Errorf("DeleteGroup failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	grp, err := s.getGroup(&pb.GetGroupRequest{GroupID: in.GetGroupID()})
 	if err != nil {
-		s.logger.Errorf("DeleteGroup failed: %w", err)
+		logger.Errorf("DeleteGroup failed: %v", err)
 		return nil, status.Errorf(codes.NotFound, "failed to get group")
 	}
 	if !s.isTeacher(usr.GetID(), grp.GetCourseID()) {
-		s.logger.Error("DeleteGroup failed: user is not teacher")
+		logger.Error("DeleteGroup failed: user is not teacher")
 		return nil, status.Errorf(codes.PermissionDenied, "only teachers can delete groups")
 	}
 	if err = s.deleteGroup(ctx, scm, in); err != nil {
-		s.logger.Errorf("DeleteGroup failed: %w", err)
+		logger.Errorf("DeleteGroup failed: %v", err)
 		if contextCanceled(ctx) {
 			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
 		}
@@ -495,6 +893,39 @@ func (s *AutograderService) DeleteGroup(ctx context.Context, in *pb.GroupRequest
 	return &pb.Void{}, nil
 }
 
+// ReassignGroup moves the user with UserID from the user's current group, if any,
+// to the group with GroupID, updating the memberships of the groups' SCM teams.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ReassignGroup(ctx context.Context, in *pb.GroupRequest) (*pb.Void, error) {
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	logger := s.scmLogger("ReassignGroup", in.GetCourseID(), usr.GetID())
+	if err != nil {
+		logger.Errorf("ReassignGroup failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		logger.Error("ReassignGroup failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can reassign group members")
+	}
+	if in.GetUserID() == 0 || in.GetGroupID() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "both user and group must be specified")
+	}
+	if err := s.reassignEnrollmentGroup(ctx, scm, in.GetCourseID(), in.GetUserID(), in.GetGroupID()); err != nil {
+		logger.Errorf("ReassignGroup failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		if ok, parsedErr := parseSCMError(errors.Unwrap(err)); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to reassign group")
+	}
+	return &pb.Void{}, nil
+}
+
 // GetSubmissions returns the submissions matching the query encoded in the action request.
 // Access policy:
 // Admin enrolled in CourseID,
@@ -504,7 +935,7 @@ func (s *AutograderService) DeleteGroup(ctx context.Context, in *pb.GroupRequest
 func (s *AutograderService) GetSubmissions(ctx context.Context, in *pb.SubmissionRequest) (*pb.Submissions, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetSubmissions failed: authentication error: %w", err)
+		s.logger.Errorf("GetSubmissions failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 
@@ -521,61 +952,286 @@ func (s *AutograderService) GetSubmissions(ctx context.Context, in *pb.Submissio
 	}
 	submissions, err := s.getSubmissions(in)
 	if err != nil {
-		s.logger.Errorf("GetSubmissions failed: %w", err)
+		s.logger.Errorf("GetSubmissions failed: %v", err)
 		return nil, status.Errorf(codes.NotFound, "no submissions found")
 	}
 	return submissions, nil
 }
 
-// GetSubmissionsByCourse returns all the latest submissions
-// for every individual or group course assignment for all course students/groups.
-// Access policy: Admin enrolled in CourseID, Teacher of CourseID.
-func (s *AutograderService) GetSubmissionsByCourse(ctx context.Context, in *pb.SubmissionsForCourseRequest) (*pb.CourseSubmissions, error) {
+// GetSubmission returns the current user's latest submission for the given assignment.
+// For a group assignment, the latest submission of the user's group is returned.
+// Access policy: Any User enrolled in CourseID.
+func (s *AutograderService) GetSubmission(ctx context.Context, in *pb.AssignmentSubmissionRequest) (*pb.Submission, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetCourseLabSubmissions failed: authentication error: %w", err)
+		s.logger.Errorf("GetSubmission failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !(s.isTeacher(usr.GetID(), in.GetCourseID()) || usr.IsAdmin && s.isEnrolled(usr.GetID(), in.GetCourseID())) {
-		s.logger.Errorf("GetCourseLabSubmissions failed: user %s is not teacher or submission author", usr.GetLogin())
-		return nil, status.Errorf(codes.PermissionDenied, "only teachers can get all lab submissions")
+	if !s.isEnrolled(usr.GetID(), in.GetCourseID()) {
+		s.logger.Errorf("GetSubmission failed: user %s is not enrolled in course %d", usr.GetLogin(), in.GetCourseID())
+		return nil, status.Errorf(codes.PermissionDenied, "only enrolled users can get submissions")
 	}
-	s.logger.Debugf("GetCourseLabSubmissions: %v", in)
+	submission, err := s.getSubmission(usr, in.GetCourseID(), in.GetAssignmentID())
+	if err != nil {
+		if err == ErrUserNotInGroup {
+			return nil, err
+		}
+		s.logger.Errorf("GetSubmission failed: %v", err)
+		return nil, status.Errorf(codes.NotFound, "no submission found")
+	}
+	return submission, nil
+}
 
-	courseLinks, err := s.getAllCourseSubmissions(in)
+// GetSubmissionByID returns the submission with the given ID.
+// Access policy: Teacher of the submission's course, or the student or group that made the submission.
+func (s *AutograderService) GetSubmissionByID(ctx context.Context, in *pb.SubmissionIDRequest) (*pb.Submission, error) {
+	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetCourseLabSubmissions failed: %w", err)
-		return nil, status.Errorf(codes.NotFound, "no submissions found")
+		s.logger.Errorf("GetSubmissionByID failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
 	}
-	return courseLinks, nil
+	submission, err := s.getSubmissionByID(usr, in.GetSubmissionID())
+	if err != nil {
+		s.logger.Errorf("GetSubmissionByID failed: %v", err)
+		if errors.Is(err, ErrNoSubmissionAccess) {
+			return nil, status.Errorf(codes.PermissionDenied, "no access to the submission")
+		}
+		return nil, status.Errorf(codes.NotFound, "no submission found")
+	}
+	return submission, nil
 }
 
-// UpdateSubmission is called to approve the given submission or to undo approval.
+// GetSubmissionByCommit returns the submission built from the given commit
+// in the given student or group repository of the course.
 // Access policy: Teacher of CourseID.
-func (s *AutograderService) UpdateSubmission(ctx context.Context, in *pb.UpdateSubmissionRequest) (*pb.Void, error) {
-	if !s.isValidSubmission(in.SubmissionID) {
-		s.logger.Errorf("UpdateSubmission failed: submission author has no access to the course")
-		return nil, status.Errorf(codes.PermissionDenied, "submission author has no course access")
-	}
+func (s *AutograderService) GetSubmissionByCommit(ctx context.Context, in *pb.CommitSubmissionRequest) (*pb.Submission, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("UpdateSubmission failed: authentication error: %w", err)
+		s.logger.Errorf("GetSubmissionByCommit failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isTeacher(usr.ID, in.GetCourseID()) {
-		s.logger.Error("UpdateSubmission failed: user is not teacher")
-		return nil, status.Errorf(codes.PermissionDenied, "only teachers can approve submissions")
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("GetSubmissionByCommit failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can look up submissions by commit")
 	}
-	err = s.updateSubmission(in.GetCourseID(), in.GetSubmissionID(), in.GetStatus(), in.GetReleased(), in.GetScore())
+	submission, err := s.getSubmissionByCommit(in.GetCourseID(), in.GetRepositoryID(), in.GetCommitHash())
 	if err != nil {
-		s.logger.Errorf("UpdateSubmission failed: %w", err)
-		err = status.Errorf(codes.InvalidArgument, "failed to approve submission")
+		s.logger.Errorf("GetSubmissionByCommit failed: %v", err)
+		if errors.Is(err, ErrAmbiguousCommit) {
+			return nil, status.Errorf(codes.InvalidArgument, "commit SHA %s matches more than one submission", in.GetCommitHash())
+		}
+		return nil, status.Errorf(codes.NotFound, "no submission found")
 	}
-	return &pb.Void{}, err
+	return submission, nil
 }
 
-// RebuildSubmission rebuilds the submission with the given ID
-func (s *AutograderService) RebuildSubmission(ctx context.Context, in *pb.RebuildRequest) (*pb.Submission, error) {
+// GetSubmissionHistory returns the builds of the given user's submission for the given
+// assignment, ordered from the oldest to the most recent build.
+// Access policy: Current User if CurrentUser.ID == UserID, or Teacher of CourseID.
+func (s *AutograderService) GetSubmissionHistory(ctx context.Context, in *pb.SubmissionHistoryRequest) (*pb.SubmissionBuilds, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetSubmissionHistory failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	submissions, err := s.getSubmissionHistory(usr, in.GetCourseID(), in.GetUserID(), in.GetAssignmentID())
+	if err != nil {
+		s.logger.Errorf("GetSubmissionHistory failed: %v", err)
+		if errors.Is(err, ErrNoSubmissionAccess) {
+			return nil, status.Errorf(codes.PermissionDenied, "only the user and course teachers can get the submission history")
+		}
+		return nil, status.Errorf(codes.NotFound, "no submissions found")
+	}
+	return submissions, nil
+}
+
+// GetSubmissionBuildLog returns the build log of the given submission.
+// Access policy: Teacher of the submission's course, or the student or group that made the submission.
+func (s *AutograderService) GetSubmissionBuildLog(ctx context.Context, in *pb.SubmissionIDRequest) (*pb.BuildLog, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetSubmissionBuildLog failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	buildLog, err := s.getSubmissionBuildLog(usr, in.GetSubmissionID())
+	if err != nil {
+		s.logger.Errorf("GetSubmissionBuildLog failed: %v", err)
+		if errors.Is(err, ErrNoSubmissionAccess) {
+			return nil, status.Errorf(codes.PermissionDenied, "no access to the submission's build log")
+		}
+		return nil, status.Errorf(codes.NotFound, "no build log found")
+	}
+	return &pb.BuildLog{Log: buildLog}, nil
+}
+
+// AddSubmissionComment adds a comment by the current user to the given submission.
+// Access policy: Teacher of the submission's course, or the student or group that made the submission.
+func (s *AutograderService) AddSubmissionComment(ctx context.Context, in *pb.SubmissionComment) (*pb.SubmissionComment, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("AddSubmissionComment failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	comment, err := s.addSubmissionComment(in.GetSubmissionID(), usr.GetID(), in.GetText())
+	if err != nil {
+		s.logger.Errorf("AddSubmissionComment failed: %v", err)
+		if errors.Is(err, ErrNoSubmissionAccess) {
+			return nil, status.Errorf(codes.PermissionDenied, "no access to comment on the submission")
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to add comment")
+	}
+	return comment, nil
+}
+
+// GetSubmissionComments returns the comments on the given submission, oldest first.
+// Access policy: Teacher of the submission's course, or the student or group that made the submission.
+func (s *AutograderService) GetSubmissionComments(ctx context.Context, in *pb.SubmissionIDRequest) (*pb.SubmissionComments, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetSubmissionComments failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	comments, err := s.getSubmissionComments(in.GetSubmissionID(), usr.GetID())
+	if err != nil {
+		s.logger.Errorf("GetSubmissionComments failed: %v", err)
+		if errors.Is(err, ErrNoSubmissionAccess) {
+			return nil, status.Errorf(codes.PermissionDenied, "no access to the submission's comments")
+		}
+		return nil, status.Errorf(codes.NotFound, "no comments found")
+	}
+	return comments, nil
+}
+
+// GetCourseProgress returns every assignment of the given course with the current user's
+// latest submission, or the latest submission of the user's group for group assignments.
+// Access policy: Any User enrolled in CourseID.
+func (s *AutograderService) GetCourseProgress(ctx context.Context, in *pb.CourseRequest) (*pb.EnrollmentLink, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetCourseProgress failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isEnrolled(usr.GetID(), in.GetCourseID()) {
+		s.logger.Errorf("GetCourseProgress failed: user %s is not enrolled in course %d", usr.GetLogin(), in.GetCourseID())
+		return nil, status.Errorf(codes.PermissionDenied, "only enrolled users can get course progress")
+	}
+	progress, err := s.getStudentCourseProgress(usr, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetCourseProgress failed: %v", err)
+		return nil, status.Errorf(codes.NotFound, "failed to get course progress")
+	}
+	return progress, nil
+}
+
+// GetSubmissionsByCourse returns all the latest submissions
+// for every individual or group course assignment for all course students/groups.
+// Access policy: Admin enrolled in CourseID, Teacher of CourseID.
+func (s *AutograderService) GetSubmissionsByCourse(ctx context.Context, in *pb.SubmissionsForCourseRequest) (*pb.CourseSubmissions, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetCourseLabSubmissions failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !(s.isTeacher(usr.GetID(), in.GetCourseID()) || usr.IsAdmin && s.isEnrolled(usr.GetID(), in.GetCourseID())) {
+		s.logger.Errorf("GetCourseLabSubmissions failed: user %s is not teacher or submission author", usr.GetLogin())
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can get all lab submissions")
+	}
+	s.logger.Debugf("GetCourseLabSubmissions: %v", in)
+
+	courseLinks, err := s.getAllCourseSubmissions(in)
+	if err != nil {
+		s.logger.Errorf("GetCourseLabSubmissions failed: %v", err)
+		return nil, status.Errorf(codes.NotFound, "no submissions found")
+	}
+	return courseLinks, nil
+}
+
+// GetGroupSubmissions returns the latest submission of every course group for the given group assignment.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GetGroupSubmissions(ctx context.Context, in *pb.AssignmentRequest) (*pb.Submissions, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetGroupSubmissions failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("GetGroupSubmissions failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can get group submissions")
+	}
+	submissions, err := s.getGroupSubmissions(in.GetCourseID(), in.GetAssignmentID())
+	if err != nil {
+		s.logger.Errorf("GetGroupSubmissions failed: %v", err)
+		return nil, status.Errorf(codes.NotFound, "no group submissions found")
+	}
+	return submissions, nil
+}
+
+// ExportCourseGrades returns the grades of all students in the given course as CSV.
+// Access policy: Admin enrolled in CourseID, Teacher of CourseID.
+func (s *AutograderService) ExportCourseGrades(ctx context.Context, in *pb.CourseRequest) (*pb.CourseGrades, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("ExportCourseGrades failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !(s.isTeacher(usr.GetID(), in.GetCourseID()) || usr.IsAdmin && s.isEnrolled(usr.GetID(), in.GetCourseID())) {
+		s.logger.Errorf("ExportCourseGrades failed: user %s is not teacher", usr.GetLogin())
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can export grades")
+	}
+	grades, err := s.exportCourseGrades(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("ExportCourseGrades failed: %v", err)
+		return nil, status.Errorf(codes.NotFound, "failed to export grades")
+	}
+	return &pb.CourseGrades{Csv: grades}, nil
+}
+
+// ExportEnrollments returns the roster of the given course as CSV.
+// Access policy: Student or Teacher of CourseID.
+func (s *AutograderService) ExportEnrollments(ctx context.Context, in *pb.CourseRequest) (*pb.CourseRoster, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("ExportEnrollments failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isEnrolled(usr.GetID(), in.GetCourseID()) {
+		s.logger.Errorf("ExportEnrollments failed: user %s is not enrolled", usr.GetLogin())
+		return nil, status.Errorf(codes.PermissionDenied, "only enrolled users can export the course roster")
+	}
+	var roster strings.Builder
+	if err := s.exportEnrollments(usr, in.GetCourseID(), &roster); err != nil {
+		s.logger.Errorf("ExportEnrollments failed: %v", err)
+		return nil, status.Errorf(codes.NotFound, "failed to export enrollments")
+	}
+	return &pb.CourseRoster{Csv: roster.String()}, nil
+}
+
+// UpdateSubmission is called to approve the given submission or to undo approval.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) UpdateSubmission(ctx context.Context, in *pb.UpdateSubmissionRequest) (*pb.Void, error) {
+	if !s.isValidSubmission(in.SubmissionID) {
+		s.logger.Errorf("UpdateSubmission failed: submission author has no access to the course")
+		return nil, status.Errorf(codes.PermissionDenied, "submission author has no course access")
+	}
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UpdateSubmission failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.ID, in.GetCourseID()) {
+		s.logger.Error("UpdateSubmission failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can approve submissions")
+	}
+	err = s.updateSubmission(in.GetCourseID(), in.GetSubmissionID(), in.GetStatus(), in.GetReleased(), in.GetScore(), in.GetExtraAttempts())
+	if err != nil {
+		s.logger.Errorf("UpdateSubmission failed: %v", err)
+		err = status.Errorf(codes.InvalidArgument, "failed to approve submission")
+	}
+	return &pb.Void{}, err
+}
+
+// RebuildSubmission rebuilds the submission with the given ID
+func (s *AutograderService) RebuildSubmission(ctx context.Context, in *pb.RebuildRequest) (*pb.Submission, error) {
 	if !s.isValidSubmission(in.GetSubmissionID()) {
 		s.logger.Errorf("ApproveSubmission failed: submitter has no access to the course")
 		return nil, status.Errorf(codes.PermissionDenied, "submitter has no course access")
@@ -587,6 +1243,87 @@ func (s *AutograderService) RebuildSubmission(ctx context.Context, in *pb.Rebuil
 	return submission, nil
 }
 
+// ApplyLatePenalty recomputes the score of the given submission, deducting the
+// assignment's late penalty if the submission was built after the deadline.
+// Access policy: Teacher of the submission's course.
+func (s *AutograderService) ApplyLatePenalty(ctx context.Context, in *pb.SubmissionIDRequest) (*pb.Submission, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("ApplyLatePenalty failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacherOfSubmission(usr.GetID(), in.GetSubmissionID()) {
+		s.logger.Error("ApplyLatePenalty failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can apply late penalties")
+	}
+	submission, err := s.applyLatePenalty(in.GetSubmissionID())
+	if err != nil {
+		s.logger.Errorf("ApplyLatePenalty failed: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to apply late penalty")
+	}
+	return submission, nil
+}
+
+// SubmitPullRequests creates submissions from the open pull requests
+// on the student and group repositories of the given course.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) SubmitPullRequests(ctx context.Context, in *pb.CourseRequest) (*pb.Void, error) {
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	logger := s.scmLogger("SubmitPullRequests", in.GetCourseID(), usr.GetID())
+	if err != nil {
+		logger.Errorf("SubmitPullRequests failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		logger.Error("SubmitPullRequests failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can submit pull requests")
+	}
+	if err := s.submitPullRequests(ctx, scm, in.GetCourseID()); err != nil {
+		logger.Errorf("SubmitPullRequests failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if err == ErrPullRequestsDisabled {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to submit pull requests")
+	}
+	return &pb.Void{}, nil
+}
+
+// ReplayMissedSubmissions grades the commits pushed to the student and group repositories
+// of the given course since the given time, whose push events were never processed.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ReplayMissedSubmissions(ctx context.Context, in *pb.ReplayRequest) (*pb.SubmissionCount, error) {
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	logger := s.scmLogger("ReplayMissedSubmissions", in.GetCourseID(), usr.GetID())
+	if err != nil {
+		logger.Errorf("ReplayMissedSubmissions failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		logger.Error("ReplayMissedSubmissions failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can replay submissions")
+	}
+	since, err := time.ParseInLocation(layout, in.GetSince(), time.Local)
+	if err != nil {
+		logger.Errorf("ReplayMissedSubmissions failed: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid date %q", in.GetSince())
+	}
+	replayed, err := s.replayMissedSubmissions(ctx, scm, in.GetCourseID(), since)
+	if err != nil {
+		logger.Errorf("ReplayMissedSubmissions failed after replaying %d submissions: %v", replayed, err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to replay submissions")
+	}
+	return &pb.SubmissionCount{Count: uint32(replayed)}, nil
+}
+
 // CreateBenchmark adds a new grading benchmark for an assignment
 // Access policy: Teacher of CourseID
 func (s *AutograderService) CreateBenchmark(ctx context.Context, in *pb.GradingBenchmark) (*pb.GradingBenchmark, error) {
@@ -657,18 +1394,19 @@ func (s *AutograderService) DeleteCriterion(ctx context.Context, in *pb.GradingC
 // Access policy: Teacher of CourseID
 func (s *AutograderService) LoadCriteria(ctx context.Context, in *pb.LoadCriteriaRequest) (*pb.Benchmarks, error) {
 	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	logger := s.scmLogger("LoadCriteria", in.GetCourseID(), usr.GetID())
 	if err != nil {
-		s.logger.Errorf("LoadCriteria failed: scm authentication error: %w", err)
+		logger.Errorf("LoadCriteria failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
-		s.logger.Error("LoadCriteria failed: user is not teacher")
+		logger.Error("LoadCriteria failed: user is not teacher")
 		return nil, status.Errorf(codes.PermissionDenied, "only teachers can load grading criteria")
 	}
 
 	benchmarks, err := s.loadCriteria(ctx, scm, in)
 	if err != nil {
-		s.logger.Errorf("LoadCriteria failed for course %d and assignment %d: %s", in.CourseID, in.AssignmentID, err)
+		logger.Errorf("LoadCriteria failed for course %d and assignment %d: %s", in.CourseID, in.AssignmentID, err)
 		return nil, status.Errorf(codes.InvalidArgument, "failed to load grading criteria for assignment")
 	}
 	return &pb.Benchmarks{Benchmarks: benchmarks}, nil
@@ -679,7 +1417,7 @@ func (s *AutograderService) LoadCriteria(ctx context.Context, in *pb.LoadCriteri
 func (s *AutograderService) CreateReview(ctx context.Context, in *pb.ReviewRequest) (*pb.Review, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("CreateReview failed: authentication error: %w", err)
+		s.logger.Errorf("CreateReview failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	if !s.isTeacher(usr.ID, in.GetCourseID()) {
@@ -706,7 +1444,7 @@ func (s *AutograderService) CreateReview(ctx context.Context, in *pb.ReviewReque
 func (s *AutograderService) UpdateReview(ctx context.Context, in *pb.ReviewRequest) (*pb.Void, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("UpdateReview failed: authentication error: %w", err)
+		s.logger.Errorf("UpdateReview failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	if !s.isTeacher(usr.ID, in.GetCourseID()) {
@@ -727,13 +1465,34 @@ func (s *AutograderService) UpdateReview(ctx context.Context, in *pb.ReviewReque
 	return &pb.Void{}, err
 }
 
+// ScoreSubmissionByRubric creates a ready review by the current user of the given submission,
+// scored from the grades given to the criteria of the assignment's rubric.
+// Access policy: Teacher of the submission's course.
+func (s *AutograderService) ScoreSubmissionByRubric(ctx context.Context, in *pb.RubricScoreRequest) (*pb.Review, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("ScoreSubmissionByRubric failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacherOfSubmission(usr.GetID(), in.GetSubmissionID()) {
+		s.logger.Error("ScoreSubmissionByRubric failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can add reviews")
+	}
+	review, err := s.scoreSubmissionByRubric(in.GetSubmissionID(), usr.GetID(), in.GetGrades())
+	if err != nil {
+		s.logger.Errorf("ScoreSubmissionByRubric failed for request %+v: %s", in, err)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to score submission")
+	}
+	return review, nil
+}
+
 // UpdateSubmissions approves and/or releases all manual reviews for student submission for the given assignment
 // with the given score.
 // Access policy: Creator of CourseID
 func (s *AutograderService) UpdateSubmissions(ctx context.Context, in *pb.UpdateSubmissionsRequest) (*pb.Void, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("UpdateSubmissions failed: authentication error: %w", err)
+		s.logger.Errorf("UpdateSubmissions failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	if !s.isCourseCreator(in.CourseID, usr.ID) {
@@ -748,12 +1507,52 @@ func (s *AutograderService) UpdateSubmissions(ctx context.Context, in *pb.Update
 	return &pb.Void{}, err
 }
 
+// ApproveSubmissions approves many submissions for the given assignment at once.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) ApproveSubmissions(ctx context.Context, in *pb.ApproveSubmissionsRequest) (*pb.SubmissionApprovals, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("ApproveSubmissions failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("ApproveSubmissions failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can approve submissions")
+	}
+	approvals, err := s.approveSubmissions(in.GetCourseID(), in.GetAssignmentID(), in.GetSubmissionIDs())
+	if err != nil {
+		s.logger.Errorf("ApproveSubmissions failed for request %+v: %v", in, err)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to approve submissions")
+	}
+	return approvals, nil
+}
+
+// ApproveSubmissionsAfterDeadline approves the passing submissions for the given assignment
+// that were built before the assignment's deadline, once the deadline has passed.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) ApproveSubmissionsAfterDeadline(ctx context.Context, in *pb.AssignmentRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("ApproveSubmissionsAfterDeadline failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("ApproveSubmissionsAfterDeadline failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can approve submissions")
+	}
+	if err := s.autoApproveAfterDeadline(in.GetCourseID(), in.GetAssignmentID()); err != nil {
+		s.logger.Errorf("ApproveSubmissionsAfterDeadline failed for request %+v: %v", in, err)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to approve submissions")
+	}
+	return &pb.Void{}, nil
+}
+
 // GetReviewers returns names of all active reviewers for a student submission
 // Access policy: Teacher of CourseID
 func (s *AutograderService) GetReviewers(ctx context.Context, in *pb.SubmissionReviewersRequest) (*pb.Reviewers, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetReviewers failed: authentication error: %w", err)
+		s.logger.Errorf("GetReviewers failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
 	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
@@ -768,35 +1567,163 @@ func (s *AutograderService) GetReviewers(ctx context.Context, in *pb.SubmissionR
 	return &pb.Reviewers{Reviewers: reviewers}, err
 }
 
+// AssignGrader assigns the given students to the given grader.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) AssignGrader(ctx context.Context, in *pb.AssignGraderRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("AssignGrader failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("AssignGrader failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can assign graders")
+	}
+	if err := s.assignGrader(in.GetCourseID(), in.GetGraderID(), in.GetStudentIDs()); err != nil {
+		s.logger.Errorf("AssignGrader failed: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to assign grader")
+	}
+	return &pb.Void{}, nil
+}
+
+// FlagForReview flags the given submission for manual review.
+// Access policy: Teacher of the submission's course.
+func (s *AutograderService) FlagForReview(ctx context.Context, in *pb.SubmissionIDRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("FlagForReview failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacherOfSubmission(usr.GetID(), in.GetSubmissionID()) {
+		s.logger.Error("FlagForReview failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can flag submissions for review")
+	}
+	if err := s.flagForReview(in.GetSubmissionID()); err != nil {
+		s.logger.Errorf("FlagForReview failed: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to flag submission for review")
+	}
+	return &pb.Void{}, nil
+}
+
+// GetSubmissionsNeedingReview returns the submissions in the given course that are flagged for manual review.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GetSubmissionsNeedingReview(ctx context.Context, in *pb.CourseRequest) (*pb.Submissions, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetSubmissionsNeedingReview failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("GetSubmissionsNeedingReview failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can get the review queue")
+	}
+	submissions, err := s.db.GetSubmissionsNeedingReview(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetSubmissionsNeedingReview failed: %v", err)
+		return nil, status.Errorf(codes.NotFound, "no submissions found")
+	}
+	return &pb.Submissions{Submissions: submissions}, nil
+}
+
+// GetSubmissionSimilarity returns the pairs of latest submissions for the given assignment
+// that are suspiciously similar, ordered from the most to the least similar pair.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GetSubmissionSimilarity(ctx context.Context, in *pb.AssignmentRequest) (*pb.SubmissionSimilarities, error) {
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	logger := s.scmLogger("GetSubmissionSimilarity", in.GetCourseID(), usr.GetID())
+	if err != nil {
+		logger.Errorf("GetSubmissionSimilarity failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		logger.Error("GetSubmissionSimilarity failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can compare submissions")
+	}
+	similar, err := s.getSubmissionSimilarity(ctx, scm, in.GetCourseID(), in.GetAssignmentID())
+	if err != nil {
+		logger.Errorf("GetSubmissionSimilarity failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to compare submissions")
+	}
+	return &pb.SubmissionSimilarities{Similarities: similar}, nil
+}
+
 // GetAssignments returns a list of all assignments for the given course.
 // Access policy: Any User.
 func (s *AutograderService) GetAssignments(ctx context.Context, in *pb.CourseRequest) (*pb.Assignments, error) {
 	courseID := in.GetCourseID()
 	assignments, err := s.getAssignments(courseID)
 	if err != nil {
-		s.logger.Errorf("GetAssignments failed: %w", err)
+		s.logger.Errorf("GetAssignments failed: %v", err)
 		return nil, status.Errorf(codes.NotFound, "no assignments found for course")
 	}
 	return assignments, nil
 }
 
+// GetAvailableAssignments returns the assignments of the given course that are available
+// to the current user, that is, assignments whose prerequisite the user has completed.
+// Access policy: Student or Teacher of CourseID.
+func (s *AutograderService) GetAvailableAssignments(ctx context.Context, in *pb.CourseRequest) (*pb.Assignments, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetAvailableAssignments failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isEnrolled(usr.GetID(), in.GetCourseID()) {
+		s.logger.Errorf("GetAvailableAssignments failed: user %s not enrolled in course %d", usr.GetLogin(), in.GetCourseID())
+		return nil, status.Errorf(codes.PermissionDenied, "user not enrolled in course")
+	}
+	assignments, err := s.getAvailableAssignments(in.GetCourseID(), usr.GetID())
+	if err != nil {
+		s.logger.Errorf("GetAvailableAssignments failed: %v", err)
+		return nil, status.Errorf(codes.NotFound, "no assignments found for course")
+	}
+	return assignments, nil
+}
+
+// GetCourseCalendar returns the assignment deadlines of the given course as an iCalendar feed.
+// Access policy: Student or Teacher of CourseID.
+func (s *AutograderService) GetCourseCalendar(ctx context.Context, in *pb.CourseRequest) (*pb.CourseCalendar, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetCourseCalendar failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isEnrolled(usr.GetID(), in.GetCourseID()) {
+		s.logger.Errorf("GetCourseCalendar failed: user %s not enrolled in course %d", usr.GetLogin(), in.GetCourseID())
+		return nil, status.Errorf(codes.PermissionDenied, "user not enrolled in course")
+	}
+	var calendar strings.Builder
+	if err := s.getCourseCalendar(usr, in.GetCourseID(), &calendar); err != nil {
+		s.logger.Errorf("GetCourseCalendar failed: %v", err)
+		return nil, status.Errorf(codes.NotFound, "failed to get course calendar")
+	}
+	return &pb.CourseCalendar{Ics: calendar.String()}, nil
+}
+
 // UpdateAssignments updates the assignments record in the database
 // by fetching assignment information from the course's test repository.
 // Access policy: Teacher of CourseID.
 func (s *AutograderService) UpdateAssignments(ctx context.Context, in *pb.CourseRequest) (*pb.Void, error) {
 	courseID := in.GetCourseID()
 	usr, scm, err := s.getUserAndSCMForCourse(ctx, courseID)
+	logger := s.scmLogger("UpdateAssignments", courseID, usr.GetID())
 	if err != nil {
-		s.logger.Errorf("UpdateAssignments failed: scm authentication error: %w", err)
+		logger.Errorf("UpdateAssignments failed: scm authentication error: %v", err)
 		return nil, err
 	}
 	if !s.isTeacher(usr.ID, courseID) {
-		s.logger.Error("UpdateAssignments failed: user is not teacher")
+		logger.Error("UpdateAssignments failed: user is not teacher")
 		return nil, status.Errorf(codes.PermissionDenied, "only teachers can update course assignments")
 	}
 	err = s.updateAssignments(ctx, scm, courseID)
 	if err != nil {
-		s.logger.Errorf("UpdateAssignments failed: %w", err)
+		logger.Errorf("UpdateAssignments failed: %v", err)
 		if contextCanceled(ctx) {
 			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
 		}
@@ -808,6 +1735,25 @@ func (s *AutograderService) UpdateAssignments(ctx context.Context, in *pb.Course
 	return &pb.Void{}, nil
 }
 
+// UpdateAutoApprove enables or disables autoapproval of the given assignment's submissions.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) UpdateAutoApprove(ctx context.Context, in *pb.AutoApproveRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UpdateAutoApprove failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("UpdateAutoApprove failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can update autoapproval")
+	}
+	if err := s.updateAutoApprove(in.GetCourseID(), in.GetAssignmentID(), in.GetEnabled(), in.GetScoreLimit()); err != nil {
+		s.logger.Errorf("UpdateAutoApprove failed: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to update autoapproval")
+	}
+	return &pb.Void{}, nil
+}
+
 // GetProviders returns a list of SCM providers supported by the backend.
 // Access policy: Any User.
 func (s *AutograderService) GetProviders(ctx context.Context, in *pb.Void) (*pb.Providers, error) {
@@ -823,17 +1769,18 @@ func (s *AutograderService) GetProviders(ctx context.Context, in *pb.Void) (*pb.
 // Access policy: Admin
 func (s *AutograderService) GetOrganization(ctx context.Context, in *pb.OrgRequest) (*pb.Organization, error) {
 	usr, scm, err := s.getUserAndSCM(ctx, "github")
+	logger := s.scmLogger("GetOrganization", 0, usr.GetID())
 	if err != nil {
-		s.logger.Errorf("GetOrganization failed: scm authentication error: %w", err)
+		logger.Errorf("GetOrganization failed: scm authentication error: %v", err)
 		return nil, err
 	}
 	if !usr.IsAdmin {
-		s.logger.Error("GetOrganization failed: user is not admin")
+		logger.Error("GetOrganization failed: user is not admin")
 		return nil, status.Errorf(codes.PermissionDenied, "only admin can access organizations")
 	}
 	org, err := s.getOrganization(ctx, scm, in.GetOrgName(), usr.GetLogin())
 	if err != nil {
-		s.logger.Errorf("GetOrganization failed: %w", err)
+		logger.Errorf("GetOrganization failed: %v", err)
 		if contextCanceled(ctx) {
 			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
 		}
@@ -856,35 +1803,85 @@ func (s *AutograderService) GetOrganization(ctx context.Context, in *pb.OrgReque
 func (s *AutograderService) GetRepositories(ctx context.Context, in *pb.URLRequest) (*pb.Repositories, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetRepositories failed: authentication error: %w", err)
+		s.logger.Errorf("GetRepositories failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	urls := make(map[string]string)
-	for _, repoType := range in.GetRepoTypes() {
-		repo, _ := s.getRepositoryURL(usr, in.GetCourseID(), repoType)
-		// we do not care if some repo was not found, this will append an empty url string in that case
-		// frontend will take care of the rest
-		urls[repoType.String()] = repo
+	// repositories that are not found have an empty url; frontend will take care of the rest
+	urls, err := s.getRepositoryURLs(usr, in.GetCourseID(), in.GetUserID(), in.GetRepoTypes())
+	if err != nil {
+		s.logger.Errorf("GetRepositories failed: %v", err)
+		if errors.Is(err, ErrNoRepoAccess) {
+			return nil, status.Errorf(codes.PermissionDenied, "only the repository owner and teachers can get repositories")
+		}
+		return nil, status.Errorf(codes.NotFound, "failed to get repositories")
 	}
 	return &pb.Repositories{URLs: urls}, nil
 }
 
+// CreateRepositoryAccessToken issues a time-limited token for pulling a student or group repository.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) CreateRepositoryAccessToken(ctx context.Context, in *pb.RepositoryRequest) (*pb.RepositoryAccessToken, error) {
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	logger := s.scmLogger("CreateRepositoryAccessToken", in.GetCourseID(), usr.GetID())
+	if err != nil {
+		logger.Errorf("CreateRepositoryAccessToken failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		logger.Error("CreateRepositoryAccessToken failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can get repository access tokens")
+	}
+	token, err := s.createRepositoryAccessToken(ctx, scm, usr, in)
+	if err != nil {
+		logger.Errorf("CreateRepositoryAccessToken failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to create repository access token")
+	}
+	return token, nil
+}
+
+// GetSCMAuditLog returns the SCM operations performed when provisioning students and groups in the given course.
+// Access policy: Teacher of CourseID, or Admin.
+func (s *AutograderService) GetSCMAuditLog(ctx context.Context, in *pb.CourseRequest) (*pb.SCMAuditLog, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetSCMAuditLog failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !(usr.IsAdmin || s.isTeacher(usr.GetID(), in.GetCourseID())) {
+		s.logger.Error("GetSCMAuditLog failed: user is not teacher or admin")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers and admins can access the SCM audit log")
+	}
+	log, err := s.getSCMAuditLog(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetSCMAuditLog failed: %v", err)
+		return nil, status.Errorf(codes.NotFound, "failed to get SCM audit log")
+	}
+	return log, nil
+}
+
 // IsEmptyRepo ensures that group repository is empty and can be deleted
 // Access policy: Teacher of Course ID
 func (s *AutograderService) IsEmptyRepo(ctx context.Context, in *pb.RepositoryRequest) (*pb.Void, error) {
 	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	logger := s.scmLogger("IsEmptyRepo", in.GetCourseID(), usr.GetID())
 	if err != nil {
-		s.logger.Errorf("IsEmptyRepo failed: scm authentication error: %w", err)
+		logger.Errorf("IsEmptyRepo failed: scm authentication error: %v", err)
 		return nil, err
 	}
 
 	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
-		s.logger.Error("IsEmptyRepo failed: user is not teacher")
+		logger.Error("IsEmptyRepo failed: user is not teacher")
 		return nil, status.Errorf(codes.PermissionDenied, "only teachers can access repository info")
 	}
 
 	if err := s.isEmptyRepo(ctx, scm, in); err != nil {
-		s.logger.Errorf("IsEmptyRepo failed: %w", err)
+		logger.Errorf("IsEmptyRepo failed: %v", err)
 		if contextCanceled(ctx) {
 			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
 		}
@@ -0,0 +1,68 @@
+package web
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/gosimple/slug"
+)
+
+// gradeOnEnroll grades the starter code in the given new student repository, so that a student
+// enrolling after the course's assignments have been published sees a baseline score for each
+// assignment right away. The builds are queued in the background, and do not delay the enrollment.
+func (s *AutograderService) gradeOnEnroll(ctx context.Context, sc scm.SCM, course *pb.Course, user *pb.User, repo *pb.Repository) {
+	runs, err := s.baselineRuns(ctx, sc, course, user, repo)
+	if err != nil {
+		s.scmLogger("gradeOnEnroll", course.GetID(), user.GetID()).Errorf("Failed to prepare grading of starter code in %s: %v", repo.GetHTMLURL(), err)
+		return
+	}
+	go func() {
+		for _, runData := range runs {
+			ci.RunTests(s.logger, s.db, s.runner, runData)
+		}
+	}()
+}
+
+// baselineRuns returns the builds of the head commit of the default branch of the given student
+// repository, one for each individual assignment with tests. No builds are returned if the course
+// does not accept submissions at this time, if its submissions are created from pull requests,
+// or if the repository has no commits.
+func (s *AutograderService) baselineRuns(ctx context.Context, sc scm.SCM, course *pb.Course, user *pb.User, repo *pb.Repository) ([]*ci.RunData, error) {
+	if course.HasFeature(pb.Course_PULL_REQUEST_SUBMISSIONS) {
+		return nil, nil
+	}
+	if ok, err := course.AcceptsSubmissionsAt(time.Now()); err == nil && !ok {
+		return nil, nil
+	}
+	commits, err := sc.ListCommits(ctx, repo.GetRepositoryID(), time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	if len(commits) == 0 {
+		return nil, nil
+	}
+	assignments, err := s.db.GetAssignmentsByCourse(course.GetID(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	head := commits[0].SHA
+	var runs []*ci.RunData
+	for _, assignment := range assignments {
+		if assignment.GetIsGroupLab() || assignment.GetSkipTests() {
+			continue
+		}
+		runs = append(runs, &ci.RunData{
+			Course:     course,
+			Assignment: assignment,
+			Repo:       repo,
+			CommitID:   head,
+			JobOwner:   slug.Make(user.GetLogin()),
+			Checkout:   head,
+		})
+	}
+	return runs, nil
+}
@@ -0,0 +1,98 @@
+package web
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// icalLayout is the layout of UTC date-times in iCalendar feeds.
+const icalLayout = "20060102T150405Z"
+
+// icalEscaper escapes the characters with special meaning in iCalendar text values.
+var icalEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+
+// getCourseCalendar writes the deadlines of the given course's assignments to w
+// as an iCalendar (.ics) feed, with one event per assignment with a deadline.
+// Slip days used by the current user on an assignment extend the user's deadline,
+// such that a student's calendar shows the deadlines that apply to the student.
+func (s *AutograderService) getCourseCalendar(currentUser *pb.User, courseID uint64, w io.Writer) error {
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(courseID, currentUser.GetID())
+	if err != nil || !enrollment.HasRepoAccess() {
+		return ErrInvalidUserInfo
+	}
+	course := enrollment.GetCourse()
+	assignments, err := s.db.GetAssignmentsByCourse(courseID, false)
+	if err != nil {
+		return err
+	}
+	sort.Slice(assignments, func(i, j int) bool {
+		return assignments[i].GetOrder() < assignments[j].GetOrder()
+	})
+	extensions := make(map[uint64]uint32)
+	if enrollment.IsStudent() {
+		for _, used := range enrollment.GetUsedSlipDays() {
+			extensions[used.GetAssignmentID()] = used.GetUsedSlipDays()
+		}
+	}
+
+	cal := &icalWriter{w: w}
+	cal.line("BEGIN:VCALENDAR")
+	cal.line("VERSION:2.0")
+	cal.line("PRODID:-//QuickFeed//Course Calendar//EN")
+	cal.line("X-WR-CALNAME:" + icalEscaper.Replace(course.GetCode()+" "+course.GetName()))
+	stamp := time.Now().UTC().Format(icalLayout)
+	for _, assignment := range assignments {
+		deadline, err := time.ParseInLocation(layout, assignment.GetDeadline(), time.Local)
+		if err != nil {
+			// assignments without a valid deadline have no calendar event
+			continue
+		}
+		description := "Deadline for " + assignment.GetName()
+		if days := extensions[assignment.GetID()]; days > 0 {
+			deadline = deadline.AddDate(0, 0, int(days))
+			description += fmt.Sprintf(", extended by %d slip days", days)
+		}
+		cal.line("BEGIN:VEVENT")
+		cal.line(fmt.Sprintf("UID:quickfeed-course-%d-assignment-%d", courseID, assignment.GetID()))
+		cal.line("DTSTAMP:" + stamp)
+		cal.line("DTSTART:" + deadline.UTC().Format(icalLayout))
+		cal.line("DTEND:" + deadline.UTC().Format(icalLayout))
+		cal.line("SUMMARY:" + icalEscaper.Replace(course.GetCode()+": "+assignment.GetName()))
+		cal.line("DESCRIPTION:" + icalEscaper.Replace(description))
+		cal.line("END:VEVENT")
+	}
+	cal.line("END:VCALENDAR")
+	return cal.err
+}
+
+// icalWriter writes content lines of an iCalendar feed, keeping the first write error.
+type icalWriter struct {
+	w   io.Writer
+	err error
+}
+
+// line writes the given content line, folding it into lines of at most 75 octets,
+// where continuation lines start with a space, as required by RFC 5545.
+func (c *icalWriter) line(s string) {
+	if c.err != nil {
+		return
+	}
+	var b strings.Builder
+	// continuation lines hold one octet less, since they start with a space
+	for limit := 75; len(s) > limit; limit = 74 {
+		// avoid splitting a multi-byte UTF-8 character
+		n := limit
+		for n > 0 && s[n]&0xC0 == 0x80 {
+			n--
+		}
+		b.WriteString(s[:n] + "\r\n ")
+		s = s[n:]
+	}
+	b.WriteString(s + "\r\n")
+	_, c.err = io.WriteString(c.w, b.String())
+}
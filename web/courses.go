@@ -3,13 +3,16 @@ package web
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/scm"
+	"github.com/jinzhu/gorm"
 )
 
 var layout = "2006-01-02T15:04:05"
@@ -23,6 +26,15 @@ func (s *AutograderService) getCourses() (*pb.Courses, error) {
 	return &pb.Courses{Courses: courses}, nil
 }
 
+// getPublicCourses returns the courses shown in the public course catalog.
+func (s *AutograderService) getPublicCourses() (*pb.Courses, error) {
+	courses, err := s.db.GetPublicCourses()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Courses{Courses: courses}, nil
+}
+
 // getCoursesByUser returns all courses that match the provided enrollment status.
 func (s *AutograderService) getCoursesByUser(request *pb.EnrollmentStatusRequest) (*pb.Courses, error) {
 	courses, err := s.db.GetCoursesByUser(request.GetUserID(), request.Statuses...)
@@ -32,6 +44,44 @@ func (s *AutograderService) getCoursesByUser(request *pb.EnrollmentStatusRequest
 	return &pb.Courses{Courses: courses}, nil
 }
 
+// getCoursesWithEnrollment returns the same courses as getCoursesByUser, each with
+// the user's enrollment in the course. Courses in which the user is not enrolled
+// with one of the requested statuses have no enrollment.
+func (s *AutograderService) getCoursesWithEnrollment(request *pb.EnrollmentStatusRequest) (*pb.CourseEnrollments, error) {
+	courses, err := s.db.GetCoursesByUser(request.GetUserID(), request.Statuses...)
+	if err != nil {
+		return nil, err
+	}
+	enrollments, err := s.db.GetEnrollmentsByUser(request.GetUserID(), request.Statuses...)
+	if err != nil {
+		return nil, err
+	}
+	courseEnrollments := make(map[uint64]*pb.Enrollment)
+	for _, enrollment := range enrollments {
+		enrollment.SetSlipDays(enrollment.Course)
+		// the course is already included in the response
+		enrollment.Course = nil
+		courseEnrollments[enrollment.GetCourseID()] = enrollment
+	}
+	result := &pb.CourseEnrollments{}
+	for _, course := range courses {
+		result.CourseEnrollments = append(result.CourseEnrollments, &pb.CourseEnrollment{
+			Course:     course,
+			Enrollment: courseEnrollments[course.GetID()],
+		})
+	}
+	return result, nil
+}
+
+// getMyActiveCourses returns the courses in which the current user
+// is enrolled as a student or a teacher.
+func (s *AutograderService) getMyActiveCourses(currentUser *pb.User) (*pb.Courses, error) {
+	return s.getCoursesByUser(&pb.EnrollmentStatusRequest{
+		UserID:   currentUser.GetID(),
+		Statuses: []pb.Enrollment_UserStatus{pb.Enrollment_STUDENT, pb.Enrollment_TEACHER},
+	})
+}
+
 // getEnrollmentsByUser returns all enrollments for the given user with preloaded
 // courses and groups
 func (s *AutograderService) getEnrollmentsByUser(request *pb.EnrollmentStatusRequest) (*pb.Enrollments, error) {
@@ -39,117 +89,942 @@ func (s *AutograderService) getEnrollmentsByUser(request *pb.EnrollmentStatusReq
 	if err != nil {
 		return nil, err
 	}
-	for _, enrollment := range enrollments {
-		enrollment.SetSlipDays(enrollment.Course)
+	for _, enrollment := range enrollments {
+		enrollment.SetSlipDays(enrollment.Course)
+	}
+	return &pb.Enrollments{Enrollments: enrollments}, nil
+}
+
+// getEnrollment returns the user's enrollment in the given course. If withDetails is set,
+// the enrollment also includes the user's group and the URL of the user's course repository.
+func (s *AutograderService) getEnrollment(user *pb.User, courseID uint64, withDetails bool) (*pb.Enrollment, error) {
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(courseID, user.GetID())
+	if err != nil {
+		return nil, err
+	}
+	enrollment.SetSlipDays(enrollment.Course)
+	if enrollment.Course != nil && enrollment.GetStatus() != pb.Enrollment_TEACHER {
+		enrollment.Course.RemoveEnrollmentCode()
+	}
+	if !withDetails {
+		return enrollment, nil
+	}
+	if enrollment.GetGroupID() > 0 {
+		group, err := s.db.GetGroup(enrollment.GetGroupID())
+		if err != nil {
+			return nil, err
+		}
+		enrollment.Group = group
+	}
+	if !enrollment.HasRepoAccess() {
+		// pending and rejected users have no repository
+		return enrollment, nil
+	}
+	urls, err := s.getRepositoryURLs(user, courseID, 0, []pb.Repository_Type{pb.Repository_USER})
+	if err != nil {
+		return nil, err
+	}
+	enrollment.RepositoryURL = urls[pb.Repository_USER.String()]
+	return enrollment, nil
+}
+
+// getEnrollmentsByCourse returns all enrollments for a course that match the given enrollment request.
+func (s *AutograderService) getEnrollmentsByCourse(request *pb.EnrollmentRequest) (*pb.Enrollments, error) {
+	if request.OnlyGroupMembers {
+		return s.getGroupMemberEnrollments(request)
+	}
+	enrollments, err := s.db.GetEnrollmentsByCourse(request.CourseID, request.Statuses...)
+	if err != nil {
+		return nil, err
+	}
+	if request.WithActivity {
+		enrollments, err = s.getEnrollmentsWithActivity(request.CourseID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// to populate response only with users who are not member of any group, we must filter the result
+	if request.IgnoreGroupMembers {
+		enrollmentsWithoutGroups := make([]*pb.Enrollment, 0)
+		for _, enrollment := range enrollments {
+			if enrollment.GroupID == 0 {
+				enrollmentsWithoutGroups = append(enrollmentsWithoutGroups, enrollment)
+			}
+		}
+		enrollments = enrollmentsWithoutGroups
+	}
+
+	for _, enrollment := range enrollments {
+		enrollment.SetSlipDays(enrollment.Course)
+	}
+	return &pb.Enrollments{Enrollments: enrollments}, nil
+}
+
+// getGroupMemberEnrollments returns the enrollments of group members for a course that match
+// the given enrollment request, ordered by group, such that the members of a group are adjacent.
+func (s *AutograderService) getGroupMemberEnrollments(request *pb.EnrollmentRequest) (*pb.Enrollments, error) {
+	enrollments, err := s.db.GetGroupMemberEnrollmentsByCourse(request.CourseID, request.Statuses...)
+	if err != nil {
+		return nil, err
+	}
+	if request.WithActivity {
+		withActivity, err := s.getEnrollmentsWithActivity(request.CourseID)
+		if err != nil {
+			return nil, err
+		}
+		activity := make(map[uint64]*pb.Enrollment)
+		for _, enrollment := range withActivity {
+			activity[enrollment.GetID()] = enrollment
+		}
+		// keep the group order of the group members' enrollments
+		for i, enrollment := range enrollments {
+			if enrollmentWithActivity, ok := activity[enrollment.GetID()]; ok {
+				enrollments[i] = enrollmentWithActivity
+			}
+		}
+	}
+	for _, enrollment := range enrollments {
+		enrollment.SetSlipDays(enrollment.Course)
+	}
+	return &pb.Enrollments{Enrollments: enrollments}, nil
+}
+
+// redactEnrollments removes sensitive user information from the given course enrollments,
+// depending on the current user's enrollment status in the course. Teachers see all
+// user information, while others only see the names and logins of other users.
+func (s *AutograderService) redactEnrollments(currentUser *pb.User, courseID uint64, enrollments *pb.Enrollments) error {
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(courseID, currentUser.GetID())
+	if err != nil {
+		return err
+	}
+	if enrollment.GetStatus() == pb.Enrollment_TEACHER {
+		return nil
+	}
+	for _, enrollment := range enrollments.GetEnrollments() {
+		if enrollment.User != nil && !currentUser.IsOwner(enrollment.GetUserID()) {
+			enrollment.User = enrollment.User.Redacted()
+		}
+	}
+	return nil
+}
+
+// createEnrollment creates a pending enrollment for the given user and course.
+// Users can only enroll themselves, unless the current user is a teacher of the course.
+// Users whose email domain is not in the course's allowlist can only be
+// enrolled by a teacher of the course.
+// If the course has auto-enrollment enabled, the new enrollment is approved
+// using the course creator's SCM client, if available.
+func (s *AutograderService) createEnrollment(ctx context.Context, curUser *pb.User, request *pb.Enrollment) error {
+	course, err := s.getCourse(request.GetCourseID())
+	if err != nil {
+		return err
+	}
+	isTeacher := s.isTeacher(curUser.GetID(), course.GetID())
+	if request.GetUserID() != curUser.GetID() && !isTeacher {
+		return ErrEnrollOtherUser
+	}
+	user, err := s.db.GetUser(request.GetUserID())
+	if err != nil {
+		return err
+	}
+	if !course.AllowsEmail(user.GetEmail()) && !isTeacher {
+		return ErrEmailNotAllowed
+	}
+	// a valid enrollment code enrolls the student without teacher approval
+	validCode := course.ValidEnrollmentCode(request.GetEnrollmentCode())
+	if request.GetEnrollmentCode() != "" && !validCode {
+		return ErrInvalidEnrollmentCode
+	}
+
+	enrollment := pb.Enrollment{
+		UserID:       request.GetUserID(),
+		CourseID:     request.GetCourseID(),
+		Status:       pb.Enrollment_PENDING,
+		EnrolledDate: time.Now().Format(layout),
+	}
+	if err := s.db.CreateEnrollment(&enrollment); err != nil {
+		return err
+	}
+	if !course.HasFeature(pb.Course_AUTO_ENROLL) && !validCode {
+		return nil
+	}
+	sc, ok := s.scms.GetSCM(course.GetAccessToken())
+	if !ok {
+		// leave the enrollment pending for the teacher to approve
+		s.scmLogger("createEnrollment", course.GetID(), enrollment.UserID).Debugf("Auto-enrollment skipped for user %d in course %s: no SCM client for course creator", enrollment.UserID, course.Name)
+		return nil
+	}
+	enrollment.Status = pb.Enrollment_STUDENT
+	if err := s.updateEnrollment(ctx, sc, "", &enrollment); err != nil {
+		// the enrollment was created; leave it pending for the teacher to approve
+		s.scmLogger("createEnrollment", course.GetID(), enrollment.UserID).Errorf("Auto-enrollment failed for user %d in course %s: %v", enrollment.UserID, course.Name, err)
+	}
+	return nil
+}
+
+// ErrCourseFull is returned when a student cannot be enrolled because the course
+// has reached its student limit. The enrollment remains pending until the limit is raised.
+var ErrCourseFull = errors.New("course full")
+
+// ErrEnrollmentIncomplete is returned when a student's repository was provisioned on the SCM,
+// but the enrollment could not be stored. Accepting the enrollment again completes it.
+var ErrEnrollmentIncomplete = errors.New("enrollment incomplete")
+
+// ErrInvalidTransition is returned when an enrollment cannot change from its current status to the requested status.
+var ErrInvalidTransition = errors.New("invalid enrollment status change")
+
+// validTransitions maps each enrollment status to the statuses it may change to.
+// Rejected (NONE) enrollments must be renewed by the user, which makes them pending again.
+// Pending enrollments must be accepted as students before they can be promoted to teachers,
+// since only accepting an enrollment creates the user's repository and team memberships.
+// Students who left the course may enroll again, or be accepted back as students.
+var validTransitions = map[pb.Enrollment_UserStatus][]pb.Enrollment_UserStatus{
+	pb.Enrollment_PENDING: {pb.Enrollment_STUDENT, pb.Enrollment_NONE},
+	pb.Enrollment_STUDENT: {pb.Enrollment_TEACHER, pb.Enrollment_NONE},
+	pb.Enrollment_TEACHER: {pb.Enrollment_STUDENT, pb.Enrollment_NONE},
+	pb.Enrollment_LEFT:    {pb.Enrollment_PENDING, pb.Enrollment_STUDENT},
+}
+
+// validTransition returns true if an enrollment may change from one status to the other.
+func validTransition(from, to pb.Enrollment_UserStatus) bool {
+	for _, status := range validTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// updateEnrollment changes the status of the given course enrollment.
+func (s *AutograderService) updateEnrollment(ctx context.Context, sc scm.SCM, curUser string, request *pb.Enrollment) error {
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(request.CourseID, request.UserID)
+	if err != nil {
+		return err
+	}
+	if !validTransition(enrollment.Status, request.Status) {
+		return fmt.Errorf("%w: from %s to %s", ErrInvalidTransition, enrollment.Status, request.Status)
+	}
+	// log changes to teacher status
+	if enrollment.Status == pb.Enrollment_TEACHER || request.Status == pb.Enrollment_TEACHER {
+		s.scmLogger("updateEnrollment", enrollment.CourseID, enrollment.UserID).Debugf("User %s attempting to change enrollment status of user %d from %s to %s", curUser, enrollment.UserID, enrollment.Status, request.Status)
+	}
+
+	switch request.Status {
+	case pb.Enrollment_NONE:
+		return s.rejectEnrollment(ctx, sc, enrollment, request.GetRejectReason())
+
+	case pb.Enrollment_STUDENT:
+		if sc == nil {
+			return fmt.Errorf("cannot enroll user %d as student: %w", enrollment.UserID, ErrMissingSCM)
+		}
+		if enrollment.Status == pb.Enrollment_PENDING || enrollment.Status == pb.Enrollment_LEFT {
+			if err := s.checkCourseCapacity(enrollment.CourseID); err != nil {
+				return err
+			}
+		}
+		return s.enrollStudent(ctx, sc, enrollment, false)
+
+	case pb.Enrollment_TEACHER:
+		if sc == nil {
+			return fmt.Errorf("cannot enroll user %d as teacher: %w", enrollment.UserID, ErrMissingSCM)
+		}
+		return s.enrollTeacher(ctx, sc, enrollment)
+	}
+	return fmt.Errorf("unknown enrollment")
+}
+
+// checkCourseCapacity returns ErrCourseFull if the course cannot admit another student.
+func (s *AutograderService) checkCourseCapacity(courseID uint64) error {
+	course, err := s.getCourseWithStats(courseID)
+	if err != nil {
+		return err
+	}
+	if course.IsFull(course.GetNumStudents()) {
+		return fmt.Errorf("cannot enroll more than %d students: %w", course.GetMaxStudents(), ErrCourseFull)
+	}
+	return nil
+}
+
+// updateEnrollments enrolls all students with pending enrollments into course.
+// The pending users that are members of the course organization are added to the
+// organization's students team at once, rather than one at a time while enrolling.
+// If the course has a student limit, only as many pending users as there is room for
+// are enrolled; the others remain pending, and ErrCourseFull is returned.
+// Pending users that must first accept their invitation to the course organization
+// are skipped and remain pending; their logins are returned. The skipped users still
+// count against the room in the course, so some seats may be left for the next call.
+func (s *AutograderService) updateEnrollments(ctx context.Context, sc scm.SCM, cid uint64) ([]string, error) {
+	enrolls, err := s.db.GetEnrollmentsByCourse(cid, pb.Enrollment_PENDING)
+	if err != nil {
+		return nil, err
+	}
+	if len(enrolls) == 0 {
+		return nil, nil
+	}
+	if sc == nil {
+		return nil, fmt.Errorf("cannot enroll pending users in course %d: %w", cid, ErrMissingSCM)
+	}
+	enrolls, capacityErr := s.limitToCourseCapacity(cid, enrolls)
+	if len(enrolls) == 0 {
+		return nil, capacityErr
+	}
+	inStudentsTeam, err := s.addPendingToStudentsTeam(ctx, sc, enrolls)
+	if err != nil {
+		return nil, err
+	}
+	var invited []string
+	for _, enrol := range enrolls {
+		login := enrol.GetUser().GetLogin()
+		if err := s.enrollStudent(ctx, sc, enrol, inStudentsTeam[login]); err != nil {
+			if errors.Is(err, ErrOrgInvitationPending) {
+				invited = append(invited, login)
+				continue
+			}
+			return invited, err
+		}
+	}
+	return invited, capacityErr
+}
+
+// limitToCourseCapacity returns the first of the given pending enrollments that
+// there is room for in the course. If some enrollments do not fit, ErrCourseFull
+// is returned along with the enrollments that fit.
+func (s *AutograderService) limitToCourseCapacity(courseID uint64, enrolls []*pb.Enrollment) ([]*pb.Enrollment, error) {
+	course, err := s.getCourseWithStats(courseID)
+	if err != nil {
+		return nil, err
+	}
+	if course.GetMaxStudents() == 0 {
+		return enrolls, nil
+	}
+	remaining := 0
+	if course.GetNumStudents() < course.GetMaxStudents() {
+		remaining = int(course.GetMaxStudents() - course.GetNumStudents())
+	}
+	if remaining >= len(enrolls) {
+		return enrolls, nil
+	}
+	return enrolls[:remaining], fmt.Errorf("cannot enroll more than %d students: %w", course.GetMaxStudents(), ErrCourseFull)
+}
+
+// addPendingToStudentsTeam adds the users of the given pending enrollments that are members
+// of the course organization to the organization's students team, and returns their logins.
+// Users that are not yet members must accept an invitation to the organization first.
+func (s *AutograderService) addPendingToStudentsTeam(ctx context.Context, sc scm.SCM, enrolls []*pb.Enrollment) (map[string]bool, error) {
+	course := enrolls[0].GetCourse()
+	members, err := sc.ListOrganizationMembers(ctx, &pb.Organization{
+		ID:   course.GetOrganizationID(),
+		Path: course.GetOrganizationPath(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	isMember := make(map[string]bool)
+	for _, member := range members {
+		isMember[strings.ToLower(member.Login)] = true
+	}
+	var logins []string
+	for _, enrol := range enrolls {
+		if login := enrol.GetUser().GetLogin(); isMember[strings.ToLower(login)] {
+			logins = append(logins, login)
+		}
+	}
+	if len(logins) == 0 {
+		return nil, nil
+	}
+	sc = s.auditSCM(sc, course, strings.Join(logins, ", "))
+	if err := sc.AddTeamMembers(ctx, &scm.TeamMembershipOptions{
+		Organization: course.GetOrganizationPath(),
+		TeamName:     scm.StudentsTeam,
+		Role:         scm.TeamMember,
+	}, logins); err != nil {
+		return nil, fmt.Errorf("failed to add pending users to the students team: %w", err)
+	}
+	inStudentsTeam := make(map[string]bool)
+	for _, login := range logins {
+		inStudentsTeam[login] = true
+	}
+	return inStudentsTeam, nil
+}
+
+// rejectAllPending rejects all pending enrollments in the given course with the given reason,
+// and returns the number of rejected enrollments. Pending users have no repositories yet,
+// so there is nothing to remove from the SCM.
+func (s *AutograderService) rejectAllPending(courseID uint64, reason string) (uint32, error) {
+	return s.db.RejectPendingEnrollments(courseID, reason)
+}
+
+// reconcileEnrollments returns the student enrollments of the given course
+// whose users are no longer active members of the course organization,
+// e.g., because their SCM accounts have been deleted or blocked.
+// Teachers can use the result to clean up stale enrollments.
+// The records of student and group repositories deleted on the SCM are removed.
+func (s *AutograderService) reconcileEnrollments(ctx context.Context, sc scm.SCM, courseID uint64) ([]*pb.Enrollment, error) {
+	course, err := s.getCourse(courseID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.pruneDeletedRepositories(ctx, sc, course); err != nil {
+		return nil, err
+	}
+	members, err := sc.ListOrganizationMembers(ctx, &pb.Organization{
+		ID:   course.GetOrganizationID(),
+		Path: course.GetOrganizationPath(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	activeMembers := make(map[uint64]bool)
+	for _, member := range members {
+		activeMembers[member.ID] = true
+	}
+
+	enrollments, err := s.db.GetEnrollmentsByCourse(courseID, pb.Enrollment_STUDENT)
+	if err != nil {
+		return nil, err
+	}
+	var stale []*pb.Enrollment
+	for _, enrollment := range enrollments {
+		// enrolled users are not loaded with remote identities
+		user, err := s.db.GetUser(enrollment.GetUserID())
+		if err != nil {
+			return nil, err
+		}
+		remoteID := user.GetRemoteIDFor(course.GetProvider())
+		if remoteID == nil || !activeMembers[remoteID.GetRemoteID()] {
+			stale = append(stale, enrollment)
+		}
+	}
+	return stale, nil
+}
+
+// pruneDeletedRepositories removes the records of the given course's student and group
+// repositories that no longer exist on the SCM. Course repositories are kept, since
+// the course cannot work without them, and a missing one must be restored instead.
+func (s *AutograderService) pruneDeletedRepositories(ctx context.Context, sc scm.SCM, course *pb.Course) error {
+	repos, err := s.db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
+	if err != nil {
+		return err
+	}
+	for _, repo := range repos {
+		if !repo.IsStudentRepo() {
+			continue
+		}
+		exists, err := sc.RepositoryExists(ctx, repo.GetRepositoryID())
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		s.scmLogger("pruneDeletedRepositories", course.GetID(), repo.GetUserID()).Debugf("Removing record of deleted repository %s (ID %d)", repo.GetHTMLURL(), repo.GetRepositoryID())
+		if err := s.db.DeleteRepositoryByRemoteID(repo.GetRepositoryID()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importEnrollmentsFromSCM enrolls the members of the course organization as students,
+// e.g., when migrating a course whose organization has already been set up on the SCM.
+// Members are matched to QuickFeed users by their remote identity for the course's provider;
+// logins are not matched, since a login may belong to another user on another provider.
+// The student repositories must already exist; they are recorded in the database as is,
+// without provisioning the repositories or teams again. Users already enrolled as students
+// or teachers, and users whose enrollment was rejected, are left unchanged. Members without
+// a student repository, and members beyond the course's student limit, are left with a pending
+// enrollment, which teachers can accept to provision the repository as usual.
+func (s *AutograderService) importEnrollmentsFromSCM(ctx context.Context, sc scm.SCM, courseID uint64) (*pb.EnrollmentImport, error) {
+	course, err := s.getCourseWithStats(courseID)
+	if err != nil {
+		return nil, err
+	}
+	org := &pb.Organization{ID: course.GetOrganizationID(), Path: course.GetOrganizationPath()}
+	members, err := sc.ListOrganizationMembers(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	numStudents := course.GetNumStudents()
+
+	result := &pb.EnrollmentImport{}
+	for _, member := range members {
+		user, err := s.db.GetUserByRemoteIdentity(&pb.RemoteIdentity{Provider: course.GetProvider(), RemoteID: member.ID})
+		if err != nil {
+			if err != gorm.ErrRecordNotFound {
+				return result, err
+			}
+			result.Unmatched = append(result.Unmatched, member.Login)
+			continue
+		}
+		enrollment, err := s.db.GetEnrollmentByCourseAndUser(courseID, user.GetID())
+		switch {
+		case err == gorm.ErrRecordNotFound:
+			enrollment = &pb.Enrollment{UserID: user.GetID(), CourseID: courseID}
+			if err := s.db.CreateEnrollment(enrollment); err != nil {
+				return result, err
+			}
+		case err != nil:
+			return result, err
+		case enrollment.IsStudent() || enrollment.IsTeacher():
+			continue
+		case enrollment.GetStatus() == pb.Enrollment_NONE:
+			// the enrollment was rejected
+			result.Rejected = append(result.Rejected, member.Login)
+			continue
+		}
+		repo, err := sc.GetRepository(ctx, &scm.RepositoryOptions{
+			Owner: org.GetPath(),
+			Path:  pb.StudentRepoName(user.GetLogin()),
+		})
+		if err != nil {
+			if !scm.IsNotFound(err) {
+				return result, err
+			}
+			result.NoRepository = append(result.NoRepository, member.Login)
+			continue
+		}
+		if course.IsFull(numStudents) {
+			result.CourseFull = append(result.CourseFull, member.Login)
+			continue
+		}
+		enrollment.Status = pb.Enrollment_STUDENT
+		if err := s.db.EnrollStudent(enrollment, &pb.Repository{
+			OrganizationID: course.GetOrganizationID(),
+			RepositoryID:   repo.ID,
+			UserID:         user.GetID(),
+			HTMLURL:        repo.WebURL,
+			RepoType:       pb.Repository_USER,
+		}); err != nil {
+			return result, err
+		}
+		numStudents++
+		result.Imported = append(result.Imported, enrollment)
+	}
+	return result, nil
+}
+
+// getCourse returns a course object for the given course id.
+func (s *AutograderService) getCourse(courseID uint64) (*pb.Course, error) {
+	return s.db.GetCourse(courseID, false)
+}
+
+// getCourseBySlug returns the course with the given slug.
+func (s *AutograderService) getCourseBySlug(slug string) (*pb.Course, error) {
+	return s.db.GetCourseBySlug(slug)
+}
+
+// getCoursesByIDs returns the courses with the given IDs, keyed by course ID,
+// using a single database query. Course IDs not found are absent from the result.
+func (s *AutograderService) getCoursesByIDs(ids []uint64) (map[uint64]*pb.Course, error) {
+	coursesByID := make(map[uint64]*pb.Course)
+	if len(ids) == 0 {
+		// GetCourses returns all courses when no IDs are given
+		return coursesByID, nil
+	}
+	courses, err := s.db.GetCourses(ids...)
+	if err != nil {
+		return nil, err
+	}
+	for _, course := range courses {
+		coursesByID[course.GetID()] = course
+	}
+	return coursesByID, nil
+}
+
+// setCourseFeature enables or disables the given feature for the given course.
+func (s *AutograderService) setCourseFeature(courseID uint64, feature pb.Course_Feature, enabled bool) error {
+	course, err := s.getCourse(courseID)
+	if err != nil {
+		return err
+	}
+	course.SetFeature(feature, enabled)
+	return s.db.UpdateCourseFeatures(courseID, course.GetFeatures())
+}
+
+// bumpGradingConfigVersion marks a change to the given course's tests, so that
+// submissions graded with the previous tests can be identified for re-grading.
+// It returns the course's new grading configuration version.
+func (s *AutograderService) bumpGradingConfigVersion(courseID uint64) (uint32, error) {
+	return s.db.BumpGradingConfigVersion(courseID)
+}
+
+// getPendingEnrollmentCount returns the number of pending enrollments in the given course.
+func (s *AutograderService) getPendingEnrollmentCount(courseID uint64) (uint32, error) {
+	return s.db.GetEnrollmentCountByCourse(courseID, pb.Enrollment_PENDING)
+}
+
+// getCourseWithStats returns a course with the number of students,
+// teachers, and pending enrollments in the course.
+func (s *AutograderService) getCourseWithStats(courseID uint64) (*pb.Course, error) {
+	course, err := s.db.GetCourse(courseID, false)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := s.db.GetEnrollmentCountsByCourse(courseID)
+	if err != nil {
+		return nil, err
+	}
+	course.NumStudents = counts[pb.Enrollment_STUDENT]
+	course.NumTeachers = counts[pb.Enrollment_TEACHER]
+	course.NumPending = counts[pb.Enrollment_PENDING]
+	return course, nil
+}
+
+// ErrAmbiguousCommit is returned when an abbreviated commit SHA matches more than one submission.
+var ErrAmbiguousCommit = errors.New("ambiguous commit SHA")
+
+// getSubmissionByCommit returns the submission for the given course built from
+// the commit with the given SHA in the repository with the given SCM repository ID.
+// The SHA may be abbreviated; if it matches more than one submission, ErrAmbiguousCommit
+// is returned. If no such submission exists, an error wrapping gorm.ErrRecordNotFound is returned.
+func (s *AutograderService) getSubmissionByCommit(courseID, repoID uint64, sha string) (*pb.Submission, error) {
+	if sha == "" {
+		return nil, fmt.Errorf("missing commit SHA")
+	}
+	if strings.Trim(strings.ToLower(sha), "0123456789abcdef") != "" {
+		return nil, fmt.Errorf("invalid commit SHA %q", sha)
+	}
+	course, err := s.getCourse(courseID)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := s.db.GetRepositoryByRemoteID(repoID)
+	if err != nil {
+		return nil, err
+	}
+	if repo.GetOrganizationID() != course.GetOrganizationID() {
+		return nil, fmt.Errorf("repository %d does not belong to course %d", repoID, courseID)
+	}
+	if repo.GetUserID() == 0 && repo.GetGroupID() == 0 {
+		return nil, fmt.Errorf("repository %d is not a student or group repository", repoID)
+	}
+
+	submissions, err := s.db.GetSubmissionsByCommit(courseID, &pb.Submission{
+		UserID:  repo.GetUserID(),
+		GroupID: repo.GetGroupID(),
+	}, sha)
+	if err != nil {
+		return nil, err
+	}
+	switch len(submissions) {
+	case 0:
+		return nil, fmt.Errorf("no submission found for commit %s: %w", sha, gorm.ErrRecordNotFound)
+	case 1:
+		return submissions[0], nil
+	default:
+		return nil, fmt.Errorf("commit %s matches %d submissions: %w", sha, len(submissions), ErrAmbiguousCommit)
+	}
+}
+
+// getSubmissions returns all the latests submissions for a user of the given course,
+// optionally only the approved or unapproved submissions, and only the submissions
+// for assignments in the given category.
+func (s *AutograderService) getSubmissions(request *pb.SubmissionRequest) (*pb.Submissions, error) {
+	// only one of user ID and group ID will be set; enforced by IsValid on pb.SubmissionRequest
+	query := &pb.Submission{
+		UserID:  request.GetUserID(),
+		GroupID: request.GetGroupID(),
+	}
+	submissions, err := s.db.GetFilteredLastSubmissions(request.GetCourseID(), query, request.GetFilter(), request.GetOrder())
+	if err != nil {
+		return nil, err
+	}
+	if request.GetCategory() != "" {
+		if submissions, err = s.filterSubmissionsByCategory(request.GetCourseID(), submissions, request.GetCategory()); err != nil {
+			return nil, err
+		}
+	}
+	for _, sbm := range submissions {
+		err = sbm.MakeSubmissionReviews()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &pb.Submissions{Submissions: submissions}, nil
+}
+
+// filterSubmissionsByCategory returns the submissions for assignments
+// of the given course in the given category, keeping their order.
+func (s *AutograderService) filterSubmissionsByCategory(courseID uint64, submissions []*pb.Submission, category string) ([]*pb.Submission, error) {
+	assignments, err := s.db.GetAssignmentsByCourse(courseID, false)
+	if err != nil {
+		return nil, err
+	}
+	inCategory := make(map[uint64]bool)
+	for _, assignment := range assignments {
+		if assignment.InCategory(category) {
+			inCategory[assignment.GetID()] = true
+		}
+	}
+	filtered := make([]*pb.Submission, 0)
+	for _, submission := range submissions {
+		if inCategory[submission.GetAssignmentID()] {
+			filtered = append(filtered, submission)
+		}
+	}
+	return filtered, nil
+}
+
+// getSubmission returns the user's latest submission for the given assignment.
+// For a group assignment, the submission of the user's group is returned.
+func (s *AutograderService) getSubmission(user *pb.User, courseID, assignmentID uint64) (*pb.Submission, error) {
+	assignment, _, err := s.getAssignmentWithCourse(&pb.Assignment{CourseID: courseID, ID: assignmentID}, false)
+	if err != nil {
+		return nil, err
+	}
+	query := &pb.Submission{AssignmentID: assignmentID, UserID: user.GetID()}
+	if assignment.GetIsGroupLab() {
+		group, err := s.getGroupByUserAndCourse(&pb.GroupRequest{UserID: user.GetID(), CourseID: courseID})
+		if err != nil {
+			return nil, err
+		}
+		query = &pb.Submission{AssignmentID: assignmentID, GroupID: group.GetID()}
+	}
+	submission, err := s.db.GetSubmission(query)
+	if err != nil {
+		return nil, err
+	}
+	if err := submission.MakeSubmissionReviews(); err != nil {
+		return nil, err
+	}
+	return submission, nil
+}
+
+// getStudentCourseProgress returns the current user's enrollment in the given course with
+// every course assignment, ordered by assignment order, linked to the user's latest submission
+// for individual assignments and the latest submission of the user's group for group assignments.
+// Assignments that have not been attempted yet are linked to no submission.
+func (s *AutograderService) getStudentCourseProgress(currentUser *pb.User, courseID uint64) (*pb.EnrollmentLink, error) {
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(courseID, currentUser.GetID())
+	if err != nil {
+		return nil, err
+	}
+	if !enrollment.HasRepoAccess() {
+		return nil, ErrInvalidUserInfo
+	}
+	enrollment.SetSlipDays(enrollment.GetCourse())
+	assignments, err := s.db.GetAssignmentsByCourse(courseID, false)
+	if err != nil {
+		return nil, err
+	}
+	submissions, err := s.db.GetStudentLastSubmissions(courseID, currentUser.GetID(), enrollment.GetGroupID())
+	if err != nil {
+		return nil, err
+	}
+	latest := make(map[uint64]*pb.Submission)
+	for _, submission := range submissions {
+		if err := submission.MakeSubmissionReviews(); err != nil {
+			return nil, err
+		}
+		latest[submission.GetAssignmentID()] = submission
+	}
+
+	links := make([]*pb.SubmissionLink, 0, len(assignments))
+	for _, assignment := range assignments {
+		links = append(links, &pb.SubmissionLink{
+			Assignment: assignment,
+			Submission: latest[assignment.GetID()],
+		})
+	}
+	return &pb.EnrollmentLink{
+		Enrollment:  enrollment,
+		Submissions: sortSubmissionsByAssignmentOrder(links),
+	}, nil
+}
+
+// ErrNoSubmissionAccess is returned when a user requests a submission that the user has no access to.
+var ErrNoSubmissionAccess = errors.New("no submission access")
+
+// getSubmissionByID returns the submission with the given ID. Only the student who made
+// the submission, the members of the group that made it, and the teachers of the course
+// of the submission's assignment can get the submission.
+func (s *AutograderService) getSubmissionByID(currentUser *pb.User, submissionID uint64) (*pb.Submission, error) {
+	if submissionID == 0 {
+		return nil, fmt.Errorf("missing submission ID")
+	}
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
+	if err != nil {
+		return nil, err
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil {
+		return nil, err
+	}
+	if !s.isTeacher(currentUser.GetID(), assignment.GetCourseID()) {
+		owner := currentUser.IsOwner(submission.GetUserID())
+		if submission.GetGroupID() > 0 {
+			group, err := s.db.GetGroup(submission.GetGroupID())
+			if err != nil {
+				return nil, err
+			}
+			owner = group.Contains(currentUser)
+		}
+		if !owner {
+			return nil, ErrNoSubmissionAccess
+		}
+	}
+	if err := submission.MakeSubmissionReviews(); err != nil {
+		return nil, err
+	}
+	return submission, nil
+}
+
+// getGroupSubmissions returns the latest submission of every course group
+// for the given group assignment. Groups without a submission are skipped.
+func (s *AutograderService) getGroupSubmissions(courseID, assignmentID uint64) (*pb.Submissions, error) {
+	assignment, _, err := s.getAssignmentWithCourse(&pb.Assignment{CourseID: courseID, ID: assignmentID}, false)
+	if err != nil {
+		return nil, err
+	}
+	if !assignment.GetIsGroupLab() {
+		return nil, fmt.Errorf("assignment %s is not a group assignment", assignment.GetName())
+	}
+	groups, err := s.db.GetGroupsByCourse(courseID)
+	if err != nil {
+		return nil, err
+	}
+	var submissions []*pb.Submission
+	for _, group := range groups {
+		submission, err := s.db.GetSubmission(&pb.Submission{AssignmentID: assignmentID, GroupID: group.GetID()})
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				continue
+			}
+			return nil, err
+		}
+		if err := submission.MakeSubmissionReviews(); err != nil {
+			return nil, err
+		}
+		submissions = append(submissions, submission)
+	}
+	return &pb.Submissions{Submissions: submissions}, nil
+}
+
+// getSubmissionHistory returns the builds of the given user's submission for the given
+// assignment, ordered from the oldest to the most recent build. For group assignments,
+// the builds of the user's group's submission are returned.
+// Only the user and the teachers of the course can get the user's submission history.
+func (s *AutograderService) getSubmissionHistory(currentUser *pb.User, courseID, userID, assignmentID uint64) (*pb.SubmissionBuilds, error) {
+	if !currentUser.IsOwner(userID) && !s.isTeacher(currentUser.GetID(), courseID) {
+		return nil, ErrNoSubmissionAccess
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: assignmentID})
+	if err != nil {
+		return nil, err
+	}
+	if assignment.GetCourseID() != courseID {
+		return nil, fmt.Errorf("assignment %d does not belong to course %d", assignmentID, courseID)
+	}
+	query := &pb.Submission{AssignmentID: assignmentID, UserID: userID}
+	if assignment.GetIsGroupLab() {
+		enrollment, err := s.db.GetEnrollmentByCourseAndUser(courseID, userID)
+		if err != nil {
+			return nil, err
+		}
+		query = &pb.Submission{AssignmentID: assignmentID, GroupID: enrollment.GetGroupID()}
+	}
+	builds, err := s.db.GetSubmissionHistory(query)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SubmissionBuilds{Builds: builds}, nil
+}
+
+// getSubmissionBuildLog returns the build log of the given submission.
+// Teachers get the full build log, whereas the student or group that made
+// the submission get the build log without the test setup details.
+func (s *AutograderService) getSubmissionBuildLog(currentUser *pb.User, submissionID uint64) (string, error) {
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
+	if err != nil {
+		return "", err
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil {
+		return "", err
+	}
+	teacher := s.isTeacher(currentUser.GetID(), assignment.GetCourseID())
+	if !teacher && !s.isSubmitter(currentUser.GetID(), assignment.GetCourseID(), submission) {
+		return "", ErrNoSubmissionAccess
+	}
+	if submission.GetBuildInfo() == "" {
+		return "", nil
+	}
+	var buildInfo ci.BuildInfo
+	if err := json.Unmarshal([]byte(submission.GetBuildInfo()), &buildInfo); err != nil {
+		return "", fmt.Errorf("failed to unmarshal build info for submission %d: %w", submissionID, err)
 	}
-	return &pb.Enrollments{Enrollments: enrollments}, nil
+	if teacher {
+		return buildInfo.BuildLog, nil
+	}
+	return buildInfo.StudentBuildLog(), nil
 }
 
-// getEnrollmentsByCourse returns all enrollments for a course that match the given enrollment request.
-func (s *AutograderService) getEnrollmentsByCourse(request *pb.EnrollmentRequest) (*pb.Enrollments, error) {
-	enrollments, err := s.db.GetEnrollmentsByCourse(request.CourseID, request.Statuses...)
-	if err != nil {
-		return nil, err
+// addSubmissionComment adds a comment by the given user to the given submission.
+// Only the submitting student, members of the submitting group, and course teachers may comment.
+func (s *AutograderService) addSubmissionComment(submissionID, userID uint64, text string) (*pb.SubmissionComment, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, fmt.Errorf("empty comment on submission %d", submissionID)
 	}
-	if request.WithActivity {
-		enrollments, err = s.getEnrollmentsWithActivity(request.CourseID)
-		if err != nil {
-			return nil, err
-		}
+	if err := s.checkSubmissionAccess(submissionID, userID); err != nil {
+		return nil, err
 	}
-
-	// to populate response only with users who are not member of any group, we must filter the result
-	if request.IgnoreGroupMembers {
-		enrollmentsWithoutGroups := make([]*pb.Enrollment, 0)
-		for _, enrollment := range enrollments {
-			if enrollment.GroupID == 0 {
-				enrollmentsWithoutGroups = append(enrollmentsWithoutGroups, enrollment)
-			}
-		}
-		enrollments = enrollmentsWithoutGroups
+	comment := &pb.SubmissionComment{
+		SubmissionID: submissionID,
+		UserID:       userID,
+		Text:         text,
+		Date:         time.Now().Format(layout),
 	}
-
-	for _, enrollment := range enrollments {
-		enrollment.SetSlipDays(enrollment.Course)
+	if err := s.db.CreateSubmissionComment(comment); err != nil {
+		return nil, err
 	}
-	return &pb.Enrollments{Enrollments: enrollments}, nil
+	return comment, nil
 }
 
-// createEnrollment creates a pending enrollment for the given user and course.
-func (s *AutograderService) createEnrollment(request *pb.Enrollment) error {
-	enrollment := pb.Enrollment{
-		UserID:   request.GetUserID(),
-		CourseID: request.GetCourseID(),
-		Status:   pb.Enrollment_PENDING,
+// getSubmissionComments returns the comments on the given submission, oldest first.
+// Only the submitting student, members of the submitting group, and course teachers may read them.
+func (s *AutograderService) getSubmissionComments(submissionID, userID uint64) (*pb.SubmissionComments, error) {
+	if err := s.checkSubmissionAccess(submissionID, userID); err != nil {
+		return nil, err
+	}
+	comments, err := s.db.GetSubmissionComments(submissionID)
+	if err != nil {
+		return nil, err
 	}
-	return s.db.CreateEnrollment(&enrollment)
+	return &pb.SubmissionComments{Comments: comments}, nil
 }
 
-// updateEnrollment changes the status of the given course enrollment.
-func (s *AutograderService) updateEnrollment(ctx context.Context, sc scm.SCM, curUser string, request *pb.Enrollment) error {
-	enrollment, err := s.db.GetEnrollmentByCourseAndUser(request.CourseID, request.UserID)
+// checkSubmissionAccess returns ErrNoSubmissionAccess if the given user
+// is neither a course teacher nor the submitter of the given submission.
+func (s *AutograderService) checkSubmissionAccess(submissionID, userID uint64) error {
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
 	if err != nil {
 		return err
 	}
-	// log changes to teacher status
-	if enrollment.Status == pb.Enrollment_TEACHER || request.Status == pb.Enrollment_TEACHER {
-		s.logger.Debugf("User %s attempting to change enrollment status of user %d from %s to %s", curUser, enrollment.UserID, enrollment.Status, request.Status)
-	}
-
-	switch request.Status {
-	case pb.Enrollment_NONE:
-		return s.rejectEnrollment(ctx, sc, enrollment)
-
-	case pb.Enrollment_STUDENT:
-		return s.enrollStudent(ctx, sc, enrollment)
-
-	case pb.Enrollment_TEACHER:
-		return s.enrollTeacher(ctx, sc, enrollment)
-	}
-	return fmt.Errorf("unknown enrollment")
-}
-
-// updateEnrollments enrolls all students with pending enrollments into course
-func (s *AutograderService) updateEnrollments(ctx context.Context, sc scm.SCM, cid uint64) error {
-	enrolls, err := s.db.GetEnrollmentsByCourse(cid, pb.Enrollment_PENDING)
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
 	if err != nil {
 		return err
 	}
-	for _, enrol := range enrolls {
-		enrol.Status = pb.Enrollment_STUDENT
-		if err = s.updateEnrollment(ctx, sc, "", enrol); err != nil {
-			return err
-		}
+	courseID := assignment.GetCourseID()
+	if !s.isTeacher(userID, courseID) && !s.isSubmitter(userID, courseID, submission) {
+		return ErrNoSubmissionAccess
 	}
 	return nil
 }
 
-// getCourse returns a course object for the given course id.
-func (s *AutograderService) getCourse(courseID uint64) (*pb.Course, error) {
-	return s.db.GetCourse(courseID, false)
-}
-
-// getSubmissions returns all the latests submissions for a user of the given course.
-func (s *AutograderService) getSubmissions(request *pb.SubmissionRequest) (*pb.Submissions, error) {
-	// only one of user ID and group ID will be set; enforced by IsValid on pb.SubmissionRequest
-	query := &pb.Submission{
-		UserID:  request.GetUserID(),
-		GroupID: request.GetGroupID(),
-	}
-	submissions, err := s.db.GetLastSubmissions(request.GetCourseID(), query)
-	if err != nil {
-		return nil, err
-	}
-	for _, sbm := range submissions {
-		err = sbm.MakeSubmissionReviews()
-		if err != nil {
-			return nil, err
-		}
+// isSubmitter returns true if the given user made the given submission,
+// either individually or as a member of the submitting group.
+func (s *AutograderService) isSubmitter(userID, courseID uint64, submission *pb.Submission) bool {
+	if submission.GetUserID() > 0 {
+		return submission.GetUserID() == userID
 	}
-	return &pb.Submissions{Submissions: submissions}, nil
+	return s.hasCourseAccess(userID, courseID, func(e *pb.Enrollment) bool {
+		return e.GetGroupID() > 0 && e.GetGroupID() == submission.GetGroupID()
+	})
 }
 
-// getAllCourseSubmissions returns all individual lab submissions by students enrolled in the specified course.
+// getAllCourseSubmissions returns all individual lab submissions by students enrolled in the specified course,
+// optionally only the submissions for assignments in the given category.
 func (s *AutograderService) getAllCourseSubmissions(request *pb.SubmissionsForCourseRequest) (*pb.CourseSubmissions, error) {
 	var getCourseSubFn func(uint64, pb.SubmissionsForCourseRequest_Type) ([]*pb.Assignment, error)
 	if request.GetSkipBuildInfo() {
@@ -157,10 +1032,16 @@ func (s *AutograderService) getAllCourseSubmissions(request *pb.SubmissionsForCo
 	} else {
 		getCourseSubFn = s.db.GetCourseAssignmentsWithSubmissions
 	}
-	assignments, err := getCourseSubFn(request.GetCourseID(), request.Type)
+	courseAssignments, err := getCourseSubFn(request.GetCourseID(), request.Type)
 	if err != nil {
 		return nil, err
 	}
+	assignments := make([]*pb.Assignment, 0, len(courseAssignments))
+	for _, a := range courseAssignments {
+		if a.InCategory(request.GetCategory()) {
+			assignments = append(assignments, a)
+		}
+	}
 	// fetch course record with all assignments and active enrollments
 	course, err := s.db.GetCourse(request.GetCourseID(), true)
 	if err != nil {
@@ -192,9 +1073,39 @@ func (s *AutograderService) getAllCourseSubmissions(request *pb.SubmissionsForCo
 	default:
 		enrolLinks = append(enrolLinks, makeResults(course, assignments, true)...)
 	}
+	if request.GetGraderID() > 0 {
+		studentIDs, err := s.db.GetGraderStudents(course.GetID(), request.GetGraderID())
+		if err != nil {
+			return nil, err
+		}
+		enrolLinks = filterGraderResults(course, enrolLinks, studentIDs, request.Type == pb.SubmissionsForCourseRequest_GROUP)
+	}
 	return &pb.CourseSubmissions{Course: course, Links: enrolLinks}, nil
 }
 
+// filterGraderResults returns the enrollment links of the given students, or,
+// if groups is true, the links of the groups that any of the given students are members of.
+func filterGraderResults(course *pb.Course, enrolLinks []*pb.EnrollmentLink, studentIDs []uint64, groups bool) []*pb.EnrollmentLink {
+	students := make(map[uint64]bool)
+	for _, id := range studentIDs {
+		students[id] = true
+	}
+	studentGroups := make(map[uint64]bool)
+	for _, enrol := range course.Enrollments {
+		if students[enrol.GetUserID()] && enrol.GetGroupID() > 0 {
+			studentGroups[enrol.GetGroupID()] = true
+		}
+	}
+	filtered := make([]*pb.EnrollmentLink, 0)
+	for _, link := range enrolLinks {
+		enrol := link.GetEnrollment()
+		if (!groups && students[enrol.GetUserID()]) || (groups && studentGroups[enrol.GetGroupID()]) {
+			filtered = append(filtered, link)
+		}
+	}
+	return filtered
+}
+
 // makeResults generates enrollment-assignment-submissions links
 // for all course students and all individual and group assignments.
 func makeResults(course *pb.Course, assignments []*pb.Assignment, addGroups bool) []*pb.EnrollmentLink {
@@ -262,7 +1173,8 @@ func (s *AutograderService) makeGroupResults(course *pb.Course, assignments []*p
 }
 
 // updateSubmission updates submission status or sets a submission score based on a manual review.
-func (s *AutograderService) updateSubmission(courseID, submissionID uint64, status pb.Submission_Status, released bool, score uint32) error {
+// A non-zero extraAttempts grants the student or group extra attempts beyond the assignment's max attempts.
+func (s *AutograderService) updateSubmission(courseID, submissionID uint64, status pb.Submission_Status, released bool, score, extraAttempts uint32) error {
 	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
 	if err != nil {
 		return err
@@ -279,6 +1191,14 @@ func (s *AutograderService) updateSubmission(courseID, submissionID uint64, stat
 	submission.Released = released
 	if score > 0 {
 		submission.Score = score
+	} else if status == pb.Submission_APPROVED {
+		// without an explicit score, approved submissions are scored by their rubric reviews
+		if reviewScore, ok := submission.ReviewScore(); ok {
+			submission.Score = reviewScore
+		}
+	}
+	if extraAttempts > 0 {
+		submission.ExtraAttempts = extraAttempts
 	}
 	return s.db.UpdateSubmission(submission)
 }
@@ -305,6 +1225,138 @@ func (s *AutograderService) updateSubmissions(request *pb.UpdateSubmissionsReque
 	return s.db.UpdateSubmissions(request.CourseID, query)
 }
 
+// approveSubmissions approves the given submissions for the given assignment at once,
+// and returns whether each submission was approved, in the order of the given IDs.
+// Submissions that do not belong to the assignment are not approved.
+func (s *AutograderService) approveSubmissions(courseID, assignmentID uint64, submissionIDs []uint64) (*pb.SubmissionApprovals, error) {
+	if _, _, err := s.getAssignmentWithCourse(&pb.Assignment{
+		CourseID: courseID,
+		ID:       assignmentID,
+	}, false); err != nil {
+		return nil, err
+	}
+	found, err := s.db.ApproveSubmissions(assignmentID, submissionIDs, time.Now().Format(layout))
+	if err != nil {
+		return nil, err
+	}
+	approved := make(map[uint64]bool)
+	for _, id := range found {
+		approved[id] = true
+	}
+
+	approvals := &pb.SubmissionApprovals{}
+	for _, id := range submissionIDs {
+		approval := &pb.SubmissionApproval{SubmissionID: id, Approved: approved[id]}
+		if !approval.Approved {
+			approval.Error = fmt.Sprintf("no submission %d for assignment %d", id, assignmentID)
+		}
+		approvals.Approvals = append(approvals.Approvals, approval)
+	}
+	return approvals, nil
+}
+
+// applyLatePenalty recomputes the score of the given submission from its raw score,
+// deducting the assignment's late penalty if the submission was built after the deadline.
+// Both the raw and the adjusted score are stored, making it safe to call repeatedly,
+// e.g., after the assignment's deadline or penalty has changed.
+func (s *AutograderService) applyLatePenalty(submissionID uint64) (*pb.Submission, error) {
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
+	if err != nil {
+		return nil, err
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil {
+		return nil, err
+	}
+	var buildInfo ci.BuildInfo
+	if err := json.Unmarshal([]byte(submission.GetBuildInfo()), &buildInfo); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal build info for submission %d: %w", submissionID, err)
+	}
+	buildDate, err := time.ParseInLocation(layout, buildInfo.BuildDate, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("invalid build date for submission %d: %w", submissionID, err)
+	}
+	penalty, err := assignment.LatePenaltyAt(buildDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid deadline for assignment %s: %w", assignment.GetName(), err)
+	}
+	submission.ApplyLatePenalty(penalty)
+	if err := s.db.UpdateSubmission(submission); err != nil {
+		return nil, err
+	}
+	return submission, nil
+}
+
+// autoApproveAfterDeadline approves the latest submission of each student and group for
+// the given assignment if it passes and was built before the assignment's deadline, once
+// the deadline has passed. Approved, rejected and failing submissions are skipped, making
+// it safe to call repeatedly; like autoapproval, it does not override a teacher's decision.
+// The submissions keep their scores.
+func (s *AutograderService) autoApproveAfterDeadline(courseID, assignmentID uint64) error {
+	assignment, course, err := s.getAssignmentWithCourse(&pb.Assignment{
+		CourseID: courseID,
+		ID:       assignmentID,
+	}, false)
+	if err != nil {
+		return err
+	}
+	if course.HasFeature(pb.Course_MANUAL_GRADING) {
+		return nil
+	}
+	deadline, err := time.ParseInLocation(layout, assignment.GetDeadline(), time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid deadline for assignment %s: %w", assignment.GetName(), err)
+	}
+	if time.Now().Before(deadline) {
+		// nothing to approve before the deadline has passed
+		return nil
+	}
+
+	submissions, err := s.db.GetAssignmentLastSubmissions(assignmentID)
+	if err != nil {
+		return err
+	}
+	var approve []uint64
+	for _, submission := range submissions {
+		switch submission.GetStatus() {
+		case pb.Submission_APPROVED, pb.Submission_REJECTED:
+			continue
+		}
+		if submission.GetScore() < assignment.GetScoreLimit() {
+			continue
+		}
+		var buildInfo ci.BuildInfo
+		if err := json.Unmarshal([]byte(submission.GetBuildInfo()), &buildInfo); err != nil {
+			s.logger.Errorf("Failed to unmarshal build info for submission %d: %s", submission.GetID(), err)
+			continue
+		}
+		buildDate, err := time.ParseInLocation(layout, buildInfo.BuildDate, time.Local)
+		if err != nil || buildDate.After(deadline) {
+			continue
+		}
+		if err := s.setLastApprovedAssignment(submission, courseID); err != nil {
+			return err
+		}
+		approve = append(approve, submission.GetID())
+	}
+	_, err = s.db.ApproveSubmissions(assignmentID, approve, time.Now().Format(layout))
+	return err
+}
+
+// flagForReview flags the given submission for manual review by a grader,
+// adding it to the course's review queue. The flag is cleared when the submission is reviewed.
+func (s *AutograderService) flagForReview(submissionID uint64) error {
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
+	if err != nil {
+		return err
+	}
+	if submission.GetNeedsReview() {
+		return nil
+	}
+	submission.NeedsReview = true
+	return s.db.UpdateSubmission(submission)
+}
+
 func (s *AutograderService) getReviewers(submissionID uint64) ([]*pb.User, error) {
 	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
 	if err != nil {
@@ -320,58 +1372,182 @@ func (s *AutograderService) getReviewers(submissionID uint64) ([]*pb.User, error
 	return names, nil
 }
 
+// assignGrader assigns the given students to the given grader, who must be a teacher of the course.
+// The students' submissions are then included in the grader's queue.
+func (s *AutograderService) assignGrader(courseID, graderID uint64, studentIDs []uint64) error {
+	if !s.isTeacher(graderID, courseID) {
+		return fmt.Errorf("grader %d is not a teacher of course %d", graderID, courseID)
+	}
+	for _, studentID := range studentIDs {
+		enrollment, err := s.db.GetEnrollmentByCourseAndUser(courseID, studentID)
+		if err != nil {
+			return err
+		}
+		if enrollment.GetStatus() != pb.Enrollment_STUDENT {
+			return fmt.Errorf("user %d is not a student of course %d", studentID, courseID)
+		}
+	}
+	return s.db.AssignGrader(courseID, graderID, studentIDs)
+}
+
 // updateCourse updates an existing course.
 func (s *AutograderService) updateCourse(ctx context.Context, sc scm.SCM, request *pb.Course) error {
+	_, err := s.updateCourseWithWarnings(ctx, sc, request, false)
+	return err
+}
+
+// updateCourseWithWarnings updates the course, and returns warnings about checks that were skipped.
+// With skipOrgCheck, the course's organization is only checked to exist if the update changes
+// the organization or its visibility, such that teachers can update other course fields while
+// the SCM is unreachable. The organization's path is then kept from the stored course.
+func (s *AutograderService) updateCourseWithWarnings(ctx context.Context, sc scm.SCM, request *pb.Course, skipOrgCheck bool) ([]string, error) {
 	// ensure the course exists
-	_, err := s.db.GetCourse(request.ID, false)
+	course, err := s.db.GetCourse(request.ID, false)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	// ensure the organization exists
-	org, err := sc.GetOrganization(ctx, &scm.GetOrgOptions{ID: request.OrganizationID})
-	if err != nil {
+	var warnings []string
+	// the private and archived flags are only changed if listed in the update mask
+	private, archived := course.GetPrivate(), course.GetArchived()
+	if request.UpdatesFlag("private") {
+		private = request.GetPrivate()
+	}
+	if request.UpdatesFlag("archived") {
+		archived = request.GetArchived()
+	}
+	orgChanged := request.GetOrganizationID() != course.GetOrganizationID() || private != course.GetPrivate()
+	if skipOrgCheck && !orgChanged {
+		request.OrganizationPath = course.GetOrganizationPath()
+		warnings = append(warnings, fmt.Sprintf("organization %s was not checked to exist", course.GetOrganizationPath()))
+	} else {
+		// ensure the organization exists
+		org, err := sc.GetOrganization(ctx, &scm.GetOrgOptions{ID: request.OrganizationID})
+		if err != nil {
+			return nil, err
+		}
+		request.OrganizationPath = org.GetPath()
+	}
+	// ensure the course's start and end dates are valid
+	if _, err := request.AcceptsSubmissionsAt(time.Now()); err != nil {
+		return nil, err
+	}
+	// keep the organization's visibility in sync with the course
+	visibilityChanged := false
+	if private != course.GetPrivate() {
+		err := sc.UpdateOrganizationVisibility(ctx, request.GetOrganizationID(), private)
+		if err != nil && !scm.IsNotSupported(err) {
+			return nil, err
+		}
+		visibilityChanged = err == nil
+	}
+	if err := s.updateCourseRecord(course, request, private, archived); err != nil {
+		if visibilityChanged {
+			// restore the visibility, since the course keeps its old value
+			if revertErr := sc.UpdateOrganizationVisibility(ctx, request.GetOrganizationID(), course.GetPrivate()); revertErr != nil {
+				s.logger.Errorf("failed to restore visibility of organization %d: %v", request.GetOrganizationID(), revertErr)
+			}
+		}
+		return nil, err
+	}
+	// archived courses receive no pushes; deleting their hooks is retried on every update
+	var hookErr error
+	switch {
+	case archived:
+		hookErr = s.deleteCourseHooks(ctx, sc, course)
+	case course.GetArchived():
+		hookErr = s.createCourseHooks(ctx, sc, course)
+	}
+	if hookErr != nil {
+		if !skipOrgCheck {
+			return nil, hookErr
+		}
+		warnings = append(warnings, fmt.Sprintf("webhooks of organization %s were not updated: %s", course.GetOrganizationPath(), hookErr))
+	}
+	return warnings, nil
+}
+
+// updateCourseRecord stores the updated course with the given private and archived
+// flags, which are only written if they differ from the stored course.
+func (s *AutograderService) updateCourseRecord(stored, request *pb.Course, private, archived bool) error {
+	if err := s.db.UpdateCourse(request); err != nil {
 		return err
 	}
-	request.OrganizationPath = org.GetPath()
-	return s.db.UpdateCourse(request)
+	if private != stored.GetPrivate() {
+		if err := s.db.UpdateCoursePrivate(request.GetID(), private); err != nil {
+			return err
+		}
+	}
+	if archived != stored.GetArchived() {
+		return s.db.UpdateCourseArchived(request.GetID(), archived)
+	}
+	return nil
 }
 
 func (s *AutograderService) changeCourseVisibility(enrollment *pb.Enrollment) error {
 	return s.db.UpdateEnrollment(enrollment)
 }
 
-// getRepositoryURL returns URL of a course repository of the given type.
-func (s *AutograderService) getRepositoryURL(currentUser *pb.User, courseID uint64, repoType pb.Repository_Type) (string, error) {
+// ErrNoRepoAccess is returned when a user requests the URL of a repository that the user has no access to.
+var ErrNoRepoAccess = errors.New("no repository access")
+
+// getRepositoryURLs returns the URLs of the course repositories of the given types,
+// keyed by repository type. User and group repositories are those of the given owner
+// and the owner's group; an owner ID of zero refers to the current user. Only students
+// and teachers of the course can get repository URLs, and only teachers can get the
+// repositories of another user. Repositories that are not found, e.g., the group
+// repository of a user without a group, have an empty URL.
+func (s *AutograderService) getRepositoryURLs(currentUser *pb.User, courseID, ownerID uint64, repoTypes []pb.Repository_Type) (map[string]string, error) {
 	course, err := s.db.GetCourse(courseID, false)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	userRepoQuery := &pb.Repository{
-		OrganizationID: course.GetOrganizationID(),
-		RepoType:       repoType,
+	enrol, err := s.db.GetEnrollmentByCourseAndUser(courseID, currentUser.GetID())
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("user %d is not enrolled in course %d: %w", currentUser.GetID(), courseID, ErrNoRepoAccess)
+		}
+		return nil, err
 	}
+	if !enrol.HasRepoAccess() {
+		return nil, fmt.Errorf("user %d has %s enrollment in course %d: %w", currentUser.GetID(), enrol.GetStatus(), courseID, ErrNoRepoAccess)
+	}
+	if ownerID == 0 {
+		ownerID = currentUser.GetID()
+	}
+	if ownerID != currentUser.GetID() {
+		if !enrol.IsTeacher() {
+			return nil, fmt.Errorf("user %d is not a teacher of course %d: %w", currentUser.GetID(), courseID, ErrNoRepoAccess)
+		}
+		// an owner that is not enrolled has no group
+		enrol, _ = s.db.GetEnrollmentByCourseAndUser(courseID, ownerID)
+	}
+	groupID := enrol.GetGroupID()
 
-	switch repoType {
-	case pb.Repository_USER:
-		userRepoQuery.UserID = currentUser.GetID()
-	case pb.Repository_GROUP:
-		enrol, err := s.db.GetEnrollmentByCourseAndUser(courseID, currentUser.GetID())
+	urls := make(map[string]string)
+	for _, repoType := range repoTypes {
+		urls[repoType.String()] = ""
+		query := &pb.Repository{
+			OrganizationID: course.GetOrganizationID(),
+			RepoType:       repoType,
+		}
+		switch repoType {
+		case pb.Repository_USER:
+			query.UserID = ownerID
+		case pb.Repository_GROUP:
+			if groupID == 0 {
+				continue
+			}
+			query.GroupID = groupID
+		}
+		repos, err := s.db.GetRepositories(query)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
-		if enrol.GetGroupID() > 0 {
-			userRepoQuery.GroupID = enrol.GroupID
+		if len(repos) == 1 {
+			urls[repoType.String()] = repos[0].GetHTMLURL()
 		}
 	}
-
-	repos, err := s.db.GetRepositories(userRepoQuery)
-	if err != nil {
-		return "", err
-	}
-	if len(repos) != 1 {
-		return "", fmt.Errorf("found %d repositories for query %+v", len(repos), userRepoQuery)
-	}
-	return repos[0].HTMLURL, nil
+	return urls, nil
 }
 
 // isEmptyRepo returns nil if all repositories for the given course and student or group are empty,
@@ -392,7 +1568,8 @@ func (s *AutograderService) isEmptyRepo(ctx context.Context, sc scm.SCM, request
 }
 
 // rejectEnrollment rejects a student enrollment, if a student repo exists for the given course, removes it from the SCM and database.
-func (s *AutograderService) rejectEnrollment(ctx context.Context, sc scm.SCM, enrolled *pb.Enrollment) error {
+// The enrollment is kept with status NONE and the given reason, so that the student can see why the enrollment was rejected.
+func (s *AutograderService) rejectEnrollment(ctx context.Context, sc scm.SCM, enrolled *pb.Enrollment, reason string) error {
 	// course and user are both preloaded, no need to query the database
 	course, user := enrolled.GetCourse(), enrolled.GetUser()
 	repos, err := s.db.GetRepositories(&pb.Repository{
@@ -403,27 +1580,71 @@ func (s *AutograderService) rejectEnrollment(ctx context.Context, sc scm.SCM, en
 	if err != nil {
 		return err
 	}
+	logger := s.scmLogger("rejectEnrollment", course.GetID(), user.GetID())
 	for _, repo := range repos {
 		// we do not care about errors here, even if the github repo does not exists,
 		// log the error and go on with deleting database entries
-		if err := removeUserFromCourse(ctx, sc, user.GetLogin(), repo); err != nil {
-			s.logger.Debug("updateEnrollment: rejectUserFromCourse failed (expected behavior): ", err)
+		if sc == nil {
+			logger.Debug("updateEnrollment: no SCM client; skipping removal of repository ", repo.GetRepositoryID())
+		} else if err := removeUserFromCourse(ctx, sc, user.GetLogin(), repo); err != nil {
+			logger.Debug("updateEnrollment: rejectUserFromCourse failed (expected behavior): ", err)
 		}
 
 		if err := s.db.DeleteRepositoryByRemoteID(repo.GetRepositoryID()); err != nil {
 			return err
 		}
 	}
-	return s.db.RejectEnrollment(user.ID, course.ID)
+	// keep the enrollment so that the user can see the reason, if any
+	return s.db.RejectEnrollmentWithReason(user.ID, course.ID, reason)
+}
+
+// ErrTeacherCannotLeave is returned when a teacher attempts to leave a course.
+// Teachers must be demoted to students by another teacher instead.
+var ErrTeacherCannotLeave = errors.New("teachers cannot leave a course")
+
+// leaveCourse changes the given student's enrollment in the given course to LEFT, removing the
+// student from the course organization's students team and revoking the student's access to the
+// course repositories. The student's repository and submissions are kept, and the student can
+// enroll again later.
+func (s *AutograderService) leaveCourse(ctx context.Context, sc scm.SCM, courseID, userID uint64) error {
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(courseID, userID)
+	if err != nil {
+		return err
+	}
+	if enrollment.IsTeacher() {
+		return ErrTeacherCannotLeave
+	}
+	if !enrollment.IsStudent() {
+		return fmt.Errorf("%w: from %s to %s", ErrInvalidTransition, enrollment.GetStatus(), pb.Enrollment_LEFT)
+	}
+	// course and user are both preloaded, no need to query the database
+	course, user := enrollment.GetCourse(), enrollment.GetUser()
+	repos, err := s.db.GetRepositories(&pb.Repository{
+		OrganizationID: course.GetOrganizationID(),
+		UserID:         user.GetID(),
+		RepoType:       pb.Repository_USER,
+	})
+	if err != nil {
+		return err
+	}
+	if err := revokeStudentAccess(ctx, s.auditSCM(sc, course, user.GetLogin()), course.GetOrganizationPath(), user.GetLogin(), repos); err != nil {
+		return err
+	}
+	return s.db.UpdateEnrollment(&pb.Enrollment{
+		UserID:   user.GetID(),
+		CourseID: course.GetID(),
+		Status:   pb.Enrollment_LEFT,
+	})
 }
 
 // enrollStudent enrolls the given user as a student into the given course.
-func (s *AutograderService) enrollStudent(ctx context.Context, sc scm.SCM, enrolled *pb.Enrollment) error {
+func (s *AutograderService) enrollStudent(ctx context.Context, sc scm.SCM, enrolled *pb.Enrollment, inStudentsTeam bool) error {
 	// course and user are both preloaded, no need to query the database
 	course, user := enrolled.GetCourse(), enrolled.GetUser()
+	sc = s.auditSCM(sc, course, user.GetLogin())
 
 	// check whether user repo already exists,
-	// which could happen if accepting a previously rejected student
+	// which could happen if accepting a previously rejected student or a student who left
 	userRepoQuery := &pb.Repository{
 		OrganizationID: course.GetOrganizationID(),
 		UserID:         user.GetID(),
@@ -439,25 +1660,35 @@ func (s *AutograderService) enrollStudent(ctx context.Context, sc scm.SCM, enrol
 		return err
 	}
 
+	logger := s.scmLogger("enrollStudent", course.GetID(), user.GetID())
 	if enrolled.Status == pb.Enrollment_TEACHER {
 		err = revokeTeacherStatus(ctx, sc, course.GetOrganizationPath(), user.GetLogin())
 		if err != nil {
-			s.logger.Errorf("Revoking teacher status failed for user %s and course %s: %s", user.Login, course.Name, err)
+			logger.Errorf("Revoking teacher status failed for user %s and course %s: %s", user.Login, course.Name, err)
 		}
 	} else {
 
-		s.logger.Debug("Enrolling student: ", user.GetLogin(), " have database repos: ", len(repos))
+		logger.Debug("Enrolling student: ", user.GetLogin(), " have database repos: ", len(repos))
 		if len(repos) > 0 {
-			// repo already exist, update enrollment in database
-			return s.db.UpdateEnrollment(userEnrolQuery)
+			// repo already exist; restore the access revoked if the student left the course
+			if err := restoreStudentAccess(ctx, sc, course.GetOrganizationPath(), user.GetLogin(), repos, inStudentsTeam); err != nil {
+				logger.Errorf("failed to restore repo access or team membership for student %s: %s", user.Login, err.Error())
+				return err
+			}
+			if err := s.db.UpdateEnrollment(userEnrolQuery); err != nil {
+				return err
+			}
+			s.notifyStudentEnrolled(course, user, repos[0].GetHTMLURL())
+			return nil
 		}
 		// create user repo, user team, and add user to students team
-		repo, err := updateReposAndTeams(ctx, sc, course, user.GetLogin(), pb.Enrollment_STUDENT)
+		tracker := &repoCreationTracker{SCM: sc}
+		repo, err := updateReposAndTeams(ctx, tracker, course, user.GetLogin(), pb.Enrollment_STUDENT, inStudentsTeam)
 		if err != nil {
-			s.logger.Errorf("failed to update repos or team membersip for student %s: %s", user.Login, err.Error())
+			logger.Errorf("failed to update repos or team membersip for student %s: %s", user.Login, err.Error())
 			return err
 		}
-		s.logger.Debug("Enrolling student: ", user.GetLogin(), " repo and team update done")
+		logger.Debug("Enrolling student: ", user.GetLogin(), " repo and team update done")
 
 		// add student repo to database if SCM interaction above was successful
 		userRepo := pb.Repository{
@@ -468,9 +1699,30 @@ func (s *AutograderService) enrollStudent(ctx context.Context, sc scm.SCM, enrol
 			RepoType:       pb.Repository_USER,
 		}
 
-		if err := s.db.CreateRepository(&userRepo); err != nil {
-			return err
+		if err := s.db.EnrollStudent(userEnrolQuery, &userRepo); err != nil {
+			logger.Errorf("Failed to store enrollment of student %s in course %s with repository %s (ID %d): %s",
+				user.Login, course.Name, repo.WebURL, repo.ID, err)
+			// the repositories created above have no database record; repositories
+			// reused from an earlier attempt are kept, since they may hold the student's work
+			if len(tracker.created) == 0 {
+				logger.Errorf("Kept existing repository %s (ID %d) without a database record", repo.WebURL, repo.ID)
+			}
+			for _, created := range tracker.created {
+				opt := &scm.RepositoryOptions{ID: created.ID, Path: created.Path, Owner: created.Owner}
+				if cleanupErr := sc.DeleteRepository(ctx, opt); cleanupErr != nil {
+					logger.Errorf("Failed to delete repository %s (ID %d) without a database record: %s", created.WebURL, created.ID, cleanupErr)
+					continue
+				}
+				logger.Debugf("Deleted repository %s (ID %d) without a database record", created.WebURL, created.ID)
+			}
+			return fmt.Errorf("%w: student repository %s created, but storing the enrollment failed: %v", ErrEnrollmentIncomplete, repo.WebURL, err)
 		}
+		// notify only after the enrollment has been stored
+		s.notifyStudentEnrolled(course, user, userRepo.GetHTMLURL())
+		if course.HasFeature(pb.Course_GRADE_ON_ENROLL) {
+			s.gradeOnEnroll(ctx, sc, course, user, &userRepo)
+		}
+		return nil
 	}
 
 	return s.db.UpdateEnrollment(userEnrolQuery)
@@ -480,10 +1732,11 @@ func (s *AutograderService) enrollStudent(ctx context.Context, sc scm.SCM, enrol
 func (s *AutograderService) enrollTeacher(ctx context.Context, sc scm.SCM, enrolled *pb.Enrollment) error {
 	// course and user are both preloaded, no need to query the database
 	course, user := enrolled.GetCourse(), enrolled.GetUser()
+	sc = s.auditSCM(sc, course, user.GetLogin())
 
 	// make owner, remove from students, add to teachers
-	if _, err := updateReposAndTeams(ctx, sc, course, user.GetLogin(), pb.Enrollment_TEACHER); err != nil {
-		s.logger.Errorf("failed to update team membership for teacher %s: %s", user.Login, err.Error())
+	if _, err := updateReposAndTeams(ctx, sc, course, user.GetLogin(), pb.Enrollment_TEACHER, false); err != nil {
+		s.scmLogger("enrollTeacher", course.GetID(), user.GetID()).Errorf("failed to update team membership for teacher %s: %s", user.Login, err.Error())
 		return err
 	}
 	return s.db.UpdateEnrollment(&pb.Enrollment{
@@ -513,14 +1766,15 @@ func (s *AutograderService) getEnrollmentsWithActivity(courseID uint64) ([]*pb.E
 				if submissionLink.Submission.Status == pb.Submission_APPROVED {
 					totalApproved++
 				}
-				if enrol.LastActivityDate == "" {
-					submissionDate = s.extractSubmissionDate(submissionLink.Submission, submissionDate)
-				}
+				submissionDate = s.extractSubmissionDate(submissionLink.Submission, submissionDate)
 			}
 		}
 		enrol.TotalApproved = totalApproved
-		if enrol.LastActivityDate == "" && !submissionDate.IsZero() {
-			enrol.LastActivityDate = submissionDate.Format("02 Jan")
+		if !submissionDate.IsZero() {
+			enrol.LastActivityAt = submissionDate.Format(layout)
+			if enrol.LastActivityDate == "" {
+				enrol.LastActivityDate = submissionDate.Format("02 Jan")
+			}
 		}
 		enrollmentsWithActivity = append(enrollmentsWithActivity, enrol)
 	}
@@ -5,8 +5,11 @@ import (
 	"fmt"
 
 	"github.com/autograde/quickfeed/web/auth"
+	"github.com/gosimple/slug"
+	"github.com/jinzhu/gorm"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
 	"github.com/autograde/quickfeed/scm"
 )
 
@@ -24,9 +27,27 @@ var RepoPaths = map[string]bool{
 }
 
 // createCourse creates a new course for the directory specified in the request
-// and creates the repositories for the course. Requires that the directory
-// does not contain the Autograder repositories that will be created.
+// and creates the repositories for the course. If the request has no directory ID,
+// the directory with the request's organization path is created, unless it exists.
+// Requires that the directory does not already belong to a course. Course repositories
+// and teams left in the directory by an earlier attempt to create the course are reused.
 func (s *AutograderService) createCourse(ctx context.Context, sc scm.SCM, request *pb.Course) (*pb.Course, error) {
+	logger := s.scmLogger("createCourse", 0, request.GetCourseCreatorID())
+	if err := s.setCourseSlug(request); err != nil {
+		return nil, err
+	}
+	// fail early if the token cannot manage the course's organization, teams and repositories
+	if err := sc.VerifyScopes(ctx, requiredTeacherScopes()); err != nil && !scm.IsNotSupported(err) {
+		return nil, err
+	}
+	if request.GetOrganizationID() == 0 {
+		// provision the course's organization, unless it already exists
+		org, _, err := createCourseOrganization(ctx, sc, request.GetOrganizationPath())
+		if err != nil {
+			return nil, err
+		}
+		request.OrganizationID = org.GetID()
+	}
 	org, err := sc.GetOrganization(ctx, &scm.GetOrgOptions{ID: request.OrganizationID})
 	if err != nil {
 		return nil, err
@@ -38,8 +59,8 @@ func (s *AutograderService) createCourse(ctx context.Context, sc scm.SCM, reques
 	if err != nil {
 		return nil, err
 	}
-	if isDirty(repos) {
-		return nil, ErrAlreadyExists
+	if err := s.checkCourseRepos(org, repos); err != nil {
+		return nil, err
 	}
 	// set default repository access level for all students to "none"
 	// will not affect organization owners (teachers)
@@ -49,7 +70,7 @@ func (s *AutograderService) createCourse(ctx context.Context, sc scm.SCM, reques
 		RepoPermissions:   false,
 	}
 	if err = sc.UpdateOrganization(ctx, orgOptions); err != nil {
-		s.logger.Debugf("createCourse: failed to update permissions for GitHub organization %s: %s", orgOptions.Path, err)
+		logger.Debugf("createCourse: failed to update permissions for GitHub organization %s: %s", orgOptions.Path, err)
 	}
 
 	// create a push hook on organization level
@@ -59,34 +80,19 @@ func (s *AutograderService) createCourse(ctx context.Context, sc scm.SCM, reques
 		Organization: org.Path,
 	}
 
-	err = sc.CreateHook(ctx, hookOptions)
-	if err != nil {
-		s.logger.Debugf("createCourse: failed to create organization hook for %s: %s", org.GetPath(), err)
+	hook, hookErr := sc.CreateHook(ctx, hookOptions)
+	if hookErr != nil {
+		logger.Debugf("createCourse: failed to create organization hook for %s: %s", org.GetPath(), hookErr)
+	} else {
+		// record the hook, so that it can be removed when the course is archived
+		request.HookID = hook.ID
 	}
 
-	// create course repos and webhooks for each repo
-	for path, private := range RepoPaths {
-
-		repoOptions := &scm.CreateRepositoryOptions{
-			Path:         path,
-			Organization: org,
-			Private:      private,
-		}
-		repo, err := sc.CreateRepository(ctx, repoOptions)
-		if err != nil {
-			return nil, err
-		}
-
-		dbRepo := pb.Repository{
-			OrganizationID: org.ID,
-			RepositoryID:   repo.ID,
-			HTMLURL:        repo.WebURL,
-			RepoType:       pb.RepoType(path),
-		}
-		if err := s.db.CreateRepository(&dbRepo); err != nil {
-			s.logger.Debugf("createCourse: failed to create database record for repository %s: %s", path, err)
-			return nil, err
-		}
+	// create course repos and their database records; fall back to
+	// repository hooks if the organization hook could not be created
+	request.OrganizationPath = org.GetPath()
+	if err := s.bootstrapCourseRepos(ctx, sc, request, repos, hookErr != nil); err != nil {
+		return nil, err
 	}
 
 	// add course creator to teacher team
@@ -94,47 +100,246 @@ func (s *AutograderService) createCourse(ctx context.Context, sc scm.SCM, reques
 	if err != nil {
 		return nil, fmt.Errorf("createCourse: failed to get course creator record from database: %w", err)
 	}
-	// create teacher team with course creator
-	opt := &scm.NewTeamOptions{
-		Organization: org.Path,
-		TeamName:     scm.TeachersTeam,
-		Users:        []string{courseCreator.GetLogin()},
-	}
-	if _, err = sc.CreateTeam(ctx, opt); err != nil {
-		s.logger.Debugf("createCourse: failed to create teachers team: %s", err)
-		return nil, err
-	}
-	// create student team without any members
-	studOpt := &scm.NewTeamOptions{Organization: org.Path, TeamName: scm.StudentsTeam}
-	if _, err = sc.CreateTeam(ctx, studOpt); err != nil {
-		s.logger.Debugf("createCourse: failed to create students team: %s", err)
+	// create teacher team with course creator, and student team without any members
+	if _, err := createCourseTeams(ctx, sc, org, courseCreator.GetLogin()); err != nil {
+		logger.Debugf("createCourse: %s", err)
 		return nil, err
 	}
 
-	// add student repo for the course creator
-	scmRepo, err := createStudentRepo(ctx, sc, org, pb.StudentRepoName(courseCreator.GetLogin()), courseCreator.GetLogin())
+	// add student repo for the course creator; the course's template repository
+	// cannot have been set up yet, so the repo is created empty
+	scmRepo, err := createStudentRepo(ctx, sc, org, pb.StudentRepoName(courseCreator.GetLogin()), courseCreator.GetLogin(), "")
 	if err != nil {
 		return nil, err
 	}
 	repoQuery := &pb.Repository{
 		OrganizationID: org.GetID(),
-		RepositoryID:   scmRepo.ID,
 		UserID:         courseCreator.ID,
-		HTMLURL:        scmRepo.WebURL,
 		RepoType:       pb.Repository_USER,
 	}
-	if err := s.db.CreateRepository(repoQuery); err != nil {
+	creatorRepos, err := s.db.GetRepositories(repoQuery)
+	if err != nil {
 		return nil, err
 	}
+	if len(creatorRepos) == 0 {
+		repoQuery.RepositoryID = scmRepo.ID
+		repoQuery.HTMLURL = scmRepo.WebURL
+		if err := s.db.CreateRepository(repoQuery); err != nil {
+			return nil, err
+		}
+	}
 
-	request.OrganizationPath = org.GetPath()
 	if err := s.db.CreateCourse(request.GetCourseCreatorID(), request); err != nil {
-		s.logger.Debugf("createCourse: failed to create database record for course %s: %s", request.Name, err)
+		logger.Debugf("createCourse: failed to create database record for course %s: %s", request.Name, err)
 		return nil, err
 	}
 	return request, nil
 }
 
+// bootstrapCourseRepos creates the standard course repositories (course-info,
+// assignments, and tests) in the course's organization and records them in
+// the database. Repositories that already exist on the SCM or in the database
+// are reused, so that it is safe to call this function repeatedly.
+// The existing repositories are those currently found in the course's
+// organization on the SCM. If repoHooks is true, a webhook is created for
+// each repository that does not already have one recorded in the database.
+func (s *AutograderService) bootstrapCourseRepos(ctx context.Context, sc scm.SCM, course *pb.Course, existing []*scm.Repository, repoHooks bool) error {
+	logger := s.scmLogger("bootstrapCourseRepos", course.GetID(), course.GetCourseCreatorID())
+	org := &pb.Organization{ID: course.GetOrganizationID(), Path: course.GetOrganizationPath()}
+	scmRepos := make(map[string]*scm.Repository)
+	for _, repo := range existing {
+		scmRepos[repo.Path] = repo
+	}
+
+	for path, private := range RepoPaths {
+		repo, ok := scmRepos[path]
+		if !ok {
+			var err error
+			repo, err = sc.CreateRepository(ctx, &scm.CreateRepositoryOptions{
+				Path:         path,
+				Organization: org,
+				Private:      private,
+			})
+			if err != nil {
+				return err
+			}
+		} else {
+			logger.Debugf("bootstrapCourseRepos: repository %s already exists for organization %s", path, org.GetPath())
+		}
+
+		dbRepos, err := s.db.GetRepositories(&pb.Repository{
+			OrganizationID: org.GetID(),
+			RepoType:       pb.RepoType(path),
+		})
+		if err != nil {
+			return err
+		}
+		var dbRepo *pb.Repository
+		if len(dbRepos) > 0 {
+			// repository already recorded in the database
+			dbRepo = dbRepos[0]
+		} else {
+			dbRepo = &pb.Repository{
+				OrganizationID: org.GetID(),
+				RepositoryID:   repo.ID,
+				HTMLURL:        repo.WebURL,
+				RepoType:       pb.RepoType(path),
+			}
+			if err := s.db.CreateRepository(dbRepo); err != nil {
+				logger.Debugf("bootstrapCourseRepos: failed to create database record for repository %s: %s", path, err)
+				return err
+			}
+		}
+		if repoHooks && dbRepo.GetHookID() == 0 {
+			if err := s.createRepoHook(ctx, sc, course.GetProvider(), repo, dbRepo); err != nil {
+				logger.Debugf("bootstrapCourseRepos: failed to create hook for repository %s: %s", path, err)
+			}
+		}
+	}
+	return nil
+}
+
+// createRepoHook creates a webhook for the given repository and records
+// the hook's ID on the repository's database record, so that the hook
+// can be removed when the course is torn down.
+func (s *AutograderService) createRepoHook(ctx context.Context, sc scm.SCM, provider string, scmRepo *scm.Repository, repo *pb.Repository) error {
+	hook, err := sc.CreateHook(ctx, &scm.CreateHookOptions{
+		URL:        auth.GetEventsURL(s.bh.BaseURL, provider),
+		Secret:     s.bh.Secret,
+		Repository: scmRepo,
+	})
+	if err != nil {
+		return err
+	}
+	repo.HookID = hook.ID
+	return s.db.UpdateRepository(repo)
+}
+
+// createCourseHooks creates a webhook for the course's organization and records its ID.
+// If the organization hook cannot be created, a webhook is created for each course
+// repository without one, like when the course was created.
+func (s *AutograderService) createCourseHooks(ctx context.Context, sc scm.SCM, course *pb.Course) error {
+	hook, err := sc.CreateHook(ctx, &scm.CreateHookOptions{
+		URL:          auth.GetEventsURL(s.bh.BaseURL, course.GetProvider()),
+		Secret:       s.bh.Secret,
+		Organization: course.GetOrganizationPath(),
+	})
+	if err == nil {
+		course.HookID = hook.ID
+		return s.db.UpdateCourseHookID(course.GetID(), hook.ID)
+	}
+	s.scmLogger("createCourseHooks", course.GetID(), 0).Debugf("createCourseHooks: failed to create organization hook for %s: %s", course.GetOrganizationPath(), err)
+	repos, err := s.db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
+	if err != nil {
+		return err
+	}
+	for _, repo := range repos {
+		if repo.IsStudentRepo() || repo.GetHookID() != 0 {
+			continue
+		}
+		scmRepo, err := sc.GetRepository(ctx, &scm.RepositoryOptions{ID: repo.GetRepositoryID()})
+		if err != nil {
+			return err
+		}
+		if err := s.createRepoHook(ctx, sc, course.GetProvider(), scmRepo, repo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteCourseHooks removes the webhook recorded for the course's organization and
+// the webhooks recorded on the repositories of the course's organization.
+// Hooks that have already been deleted on the SCM are ignored.
+func (s *AutograderService) deleteCourseHooks(ctx context.Context, sc scm.SCM, course *pb.Course) error {
+	if course.GetHookID() != 0 {
+		if err := sc.DeleteOrganizationHook(ctx, course.GetOrganizationPath(), course.GetHookID()); err != nil && !scm.IsNotFound(err) {
+			return fmt.Errorf("deleteCourseHooks: failed to delete hook %d for organization %s: %w", course.GetHookID(), course.GetOrganizationPath(), err)
+		}
+		if err := s.db.UpdateCourseHookID(course.GetID(), 0); err != nil {
+			return err
+		}
+		course.HookID = 0
+	}
+	repos, err := s.db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
+	if err != nil {
+		return err
+	}
+	for _, repo := range repos {
+		if repo.GetHookID() == 0 {
+			continue
+		}
+		if err := sc.DeleteHook(ctx, repo.GetRepositoryID(), repo.GetHookID()); err != nil && !scm.IsNotFound(err) {
+			return fmt.Errorf("deleteCourseHooks: failed to delete hook %d for repository %d: %w", repo.GetHookID(), repo.GetRepositoryID(), err)
+		}
+		repo.HookID = 0
+		if err := s.db.UpdateRepository(repo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setCourseSlug ensures that the course's slug is not already in use. A course
+// without a slug is given one based on its code and year; if that slug is taken,
+// a numeric suffix is added, e.g., dat320-2024-2.
+func (s *AutograderService) setCourseSlug(course *pb.Course) error {
+	if course.GetSlug() != "" {
+		inUse, err := s.slugInUse(course.GetSlug())
+		if err != nil {
+			return err
+		}
+		if inUse {
+			return database.ErrDuplicateCourseSlug
+		}
+		return nil
+	}
+	baseSlug := slug.Make(fmt.Sprintf("%s-%d", course.GetCode(), course.GetYear()))
+	courseSlug := baseSlug
+	for suffix := 2; ; suffix++ {
+		inUse, err := s.slugInUse(courseSlug)
+		if err != nil {
+			return err
+		}
+		if !inUse {
+			break
+		}
+		courseSlug = fmt.Sprintf("%s-%d", baseSlug, suffix)
+	}
+	course.Slug = courseSlug
+	return nil
+}
+
+// slugInUse returns true if a course with the given slug exists.
+func (s *AutograderService) slugInUse(courseSlug string) (bool, error) {
+	_, err := s.db.GetCourseBySlug(courseSlug)
+	switch {
+	case err == nil:
+		return true, nil
+	case err == gorm.ErrRecordNotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// checkCourseRepos returns ErrAlreadyExists if the given repositories of the organization
+// include course repositories of an existing course. Course repositories without a course,
+// left by an earlier attempt to create the course that failed, are not an error.
+func (s *AutograderService) checkCourseRepos(org *pb.Organization, repos []*scm.Repository) error {
+	if !isDirty(repos) {
+		return nil
+	}
+	_, err := s.db.GetCourseByOrganizationID(org.GetID())
+	switch {
+	case err == nil:
+		return ErrAlreadyExists
+	case err == gorm.ErrRecordNotFound:
+		return nil
+	}
+	return err
+}
+
 // isDirty returns true if the list of provided repositories contains
 // any of the repositories that Autograder wants to create.
 func isDirty(repos []*scm.Repository) bool {
@@ -2,14 +2,19 @@ package web_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/google/go-cmp/cmp"
 	"github.com/markbates/goth"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -107,6 +112,15 @@ func fakeProviderMap(t *testing.T) (scm.SCM, *auth.Scms) {
 	return scm, scms
 }
 
+// mockProviderMap is a test helper function to create an SCM map with a mock SCM.
+func mockProviderMap(t *testing.T) (*scm.MockSCM, *auth.Scms) {
+	t.Helper()
+	scms := auth.NewScms()
+	mockSCM := scm.NewMockSCMClient()
+	scms.SetSCM("token", mockSCM)
+	return mockSCM, scms
+}
+
 func fakeGothProvider() {
 	baseURL := "fake"
 	goth.UseProviders(&auth.FakeProvider{
@@ -155,6 +169,53 @@ func TestNewCourse(t *testing.T) {
 	}
 }
 
+func TestGetCourseBySlug(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	admin := createFakeUser(t, db, 10)
+	ctx := withUserContext(context.Background(), admin)
+	fakeProvider, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+
+	if _, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
+		t.Fatal(err)
+	}
+	course := &pb.Course{Name: "Operating Systems", Code: "DAT320", Year: 2024, Provider: "fake", OrganizationID: 1}
+	if _, err := ags.CreateCourse(ctx, course); err != nil {
+		t.Fatal(err)
+	}
+	if course.GetSlug() != "dat320-2024" {
+		t.Errorf("have slug %q, want %q", course.GetSlug(), "dat320-2024")
+	}
+
+	gotCourse, err := ags.GetCourse(ctx, &pb.CourseRequest{Slug: "dat320-2024"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotCourse.GetID() != course.GetID() {
+		t.Errorf("have course %d, want %d", gotCourse.GetID(), course.GetID())
+	}
+	if _, err := ags.GetCourse(ctx, &pb.CourseRequest{Slug: "dat320-2025"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetCourse(unknown slug) = %v, want NotFound", err)
+	}
+
+	// courses with the same code and year get a numeric suffix
+	for _, wantSlug := range []string{"dat320-2024-2", "dat320-2024-3"} {
+		org, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: wantSlug, Name: wantSlug})
+		if err != nil {
+			t.Fatal(err)
+		}
+		course := &pb.Course{Name: "Operating Systems", Code: "DAT320", Year: 2024, Provider: "fake", OrganizationID: org.GetID()}
+		if _, err := ags.CreateCourse(ctx, course); err != nil {
+			t.Fatal(err)
+		}
+		if course.GetSlug() != wantSlug {
+			t.Errorf("have slug %q, want %q", course.GetSlug(), wantSlug)
+		}
+	}
+}
+
 func TestNewCourseExistingRepos(t *testing.T) {
 	db, cleanup := setup(t)
 	defer cleanup()
@@ -173,470 +234,3061 @@ func TestNewCourseExistingRepos(t *testing.T) {
 		}
 	}
 
-	course, err := ags.CreateCourse(ctx, allCourses[0])
+	// course repositories without a course are reused
+	course, err := ags.CreateCourse(ctx, &pb.Course{Name: "Distributed Systems", Code: "DAT520", Year: 2018, Provider: "fake", OrganizationID: directory.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repos, err := fakeProvider.GetRepositories(ctx, directory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the course repositories and the course creator's repository
+	if len(repos) != len(web.RepoPaths)+1 {
+		t.Errorf("have %d repositories, want %d", len(repos), len(web.RepoPaths)+1)
+	}
+	dbRepos, err := db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dbRepos) != len(web.RepoPaths)+1 {
+		t.Errorf("have %d repository records, want %d", len(dbRepos), len(web.RepoPaths)+1)
+	}
+
+	// the organization already has a course
+	course, err = ags.CreateCourse(ctx, &pb.Course{Name: "Distributed Systems", Code: "DAT520", Year: 2019, Provider: "fake", OrganizationID: directory.GetID()})
 	if course != nil {
 		t.Fatal("expected CreateCourse to fail with AlreadyExists")
 	}
-	if err != nil && status.Code(err) != codes.FailedPrecondition {
+	if status.Code(err) != codes.FailedPrecondition {
 		t.Fatalf("expected CreateCourse to fail with AlreadyExists, but got: %v", err)
 	}
 }
 
-func TestEnrollmentProcess(t *testing.T) {
+func TestNewCourseRetryAfterFailure(t *testing.T) {
 	db, cleanup := setup(t)
 	defer cleanup()
 
-	admin := createFakeUser(t, db, 1)
+	admin := createFakeUser(t, db, 10)
 	ctx := withUserContext(context.Background(), admin)
-	fakeProvider, scms := fakeProviderMap(t)
+	mockSCM, scms := mockProviderMap(t)
 	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
-	_, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
-	if err != nil {
-		t.Fatal(err)
-	}
 
-	course, err := ags.CreateCourse(ctx, allCourses[0])
+	org, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
 	if err != nil {
 		t.Fatal(err)
 	}
-
-	stud1 := createFakeUser(t, db, 2)
-	enrollStud1 := &pb.Enrollment{CourseID: course.ID, UserID: stud1.ID}
-	if _, err = ags.CreateEnrollment(ctx, enrollStud1); err != nil {
-		t.Fatal(err)
+	// the first attempt fails after the course repositories, the teams,
+	// and the course creator's repository are created
+	mockSCM.UpdateRepoAccessFunc = func(context.Context, *scm.Repository, string, string) error {
+		return errors.New("failed to update repository access")
 	}
+	newCourse := func() *pb.Course {
+		return &pb.Course{Name: "Distributed Systems", Code: "DAT520", Year: 2018, Provider: "fake", OrganizationID: org.GetID()}
+	}
+	if _, err := ags.CreateCourse(ctx, newCourse()); err == nil {
+		t.Fatal("expected first CreateCourse to fail")
+	}
+	mockSCM.UpdateRepoAccessFunc = nil
 
-	// verify that a pending enrollment was indeed created.
-	pendingEnrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, stud1.ID)
+	course, err := ags.CreateCourse(ctx, newCourse())
 	if err != nil {
 		t.Fatal(err)
 	}
-	wantEnrollment := &pb.Enrollment{
-		ID:           pendingEnrollment.ID,
-		CourseID:     course.ID,
-		UserID:       stud1.ID,
-		Status:       pb.Enrollment_PENDING,
-		State:        pb.Enrollment_VISIBLE,
-		Course:       course,
-		User:         stud1,
-		UsedSlipDays: []*pb.UsedSlipDays{},
+	repos, err := mockSCM.GetRepositories(ctx, org)
+	if err != nil {
+		t.Fatal(err)
 	}
-	// can't use: wantEnrollment.User.RemoveRemoteID()
-	wantEnrollment.User.RemoteIdentities = nil
-	if !cmp.Equal(pendingEnrollment, wantEnrollment) {
-		t.Errorf("enrollment\nhave %+v\nwant %+v\n", pendingEnrollment, wantEnrollment)
+	if len(repos) != len(web.RepoPaths)+1 {
+		t.Errorf("have %d repositories, want %d", len(repos), len(web.RepoPaths)+1)
 	}
-
-	enrollStud1.Status = pb.Enrollment_STUDENT
-	if _, err = ags.UpdateEnrollment(ctx, enrollStud1); err != nil {
+	teams, err := mockSCM.GetTeams(ctx, org)
+	if err != nil {
 		t.Fatal(err)
 	}
-
-	// verify that the enrollment was updated to student status.
-	acceptedEnrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, stud1.ID)
+	if len(teams) != 2 {
+		t.Errorf("have %d teams, want 2", len(teams))
+	}
+	dbRepos, err := db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
 	if err != nil {
 		t.Fatal(err)
 	}
-	wantEnrollment.Status = pb.Enrollment_STUDENT
-	if !cmp.Equal(acceptedEnrollment, wantEnrollment) {
-		t.Errorf("enrollment\nhave %+v\nwant %+v\n", acceptedEnrollment, wantEnrollment)
+	if len(dbRepos) != len(web.RepoPaths)+1 {
+		t.Errorf("have %d repository records, want %d", len(dbRepos), len(web.RepoPaths)+1)
 	}
+	if courses, err := db.GetCourses(); err != nil || len(courses) != 1 {
+		t.Errorf("have courses %v (err: %v), want one", courses, err)
+	}
+}
 
-	// create another user and enroll as student
+func TestNewCourseProvisionsOrganization(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
 
-	stud2 := createFakeUser(t, db, 3)
-	enrollStud2 := &pb.Enrollment{CourseID: course.ID, UserID: stud2.ID}
-	if _, err = ags.CreateEnrollment(ctx, enrollStud2); err != nil {
-		t.Fatal(err)
-	}
-	enrollStud2.Status = pb.Enrollment_STUDENT
-	if _, err = ags.UpdateEnrollment(ctx, enrollStud2); err != nil {
+	admin := createFakeUser(t, db, 10)
+	ctx := withUserContext(context.Background(), admin)
+	mockSCM, scms := mockProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+
+	existing, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "dat520-2018", Name: "DAT520"})
+	if err != nil {
 		t.Fatal(err)
 	}
-	// verify that the stud2 was enrolled with student status.
-	acceptedEnrollment, err = db.GetEnrollmentByCourseAndUser(course.ID, stud2.ID)
+	course, err := ags.CreateCourse(ctx, &pb.Course{Name: "Distributed Systems", Code: "DAT520", Year: 2018, Tag: "Spring", Provider: "fake", OrganizationPath: "dat520-2018"})
 	if err != nil {
 		t.Fatal(err)
 	}
-	wantEnrollment.ID = acceptedEnrollment.ID
-	wantEnrollment.Status = pb.Enrollment_STUDENT
-	wantEnrollment.UserID = stud2.ID
-	wantEnrollment.User = stud2
-	wantEnrollment.User.RemoteIdentities = nil
-	if !cmp.Equal(acceptedEnrollment, wantEnrollment) {
-		t.Errorf("enrollment\nhave %+v\nwant %+v\n", acceptedEnrollment, wantEnrollment)
+	if course.GetOrganizationID() != existing.GetID() {
+		t.Errorf("have organization %d, want existing organization %d", course.GetOrganizationID(), existing.GetID())
 	}
 
-	// promote stud2 to teaching assistant
-
-	enrollStud2.Status = pb.Enrollment_TEACHER
-	if _, err = ags.UpdateEnrollment(ctx, enrollStud2); err != nil {
+	course, err = ags.CreateCourse(ctx, &pb.Course{Name: "Operating Systems", Code: "DAT320", Year: 2018, Tag: "Fall", Provider: "fake", OrganizationPath: "dat320-2018"})
+	if err != nil {
 		t.Fatal(err)
 	}
-	// verify that the stud2 was promoted to teacher status.
-	acceptedEnrollment, err = db.GetEnrollmentByCourseAndUser(course.ID, stud2.ID)
+	org, err := mockSCM.GetOrganization(ctx, &scm.GetOrgOptions{ID: course.GetOrganizationID()})
 	if err != nil {
 		t.Fatal(err)
 	}
-	wantEnrollment.ID = acceptedEnrollment.ID
-	wantEnrollment.Status = pb.Enrollment_TEACHER
-	if !cmp.Equal(acceptedEnrollment, wantEnrollment) {
-		t.Errorf("have enrollment %+v want %+v", acceptedEnrollment, wantEnrollment)
+	if org.GetPath() != "dat320-2018" || org.GetID() == existing.GetID() {
+		t.Errorf("have organization %+v, want new organization dat320-2018", org)
+	}
+	ensured, listed := 0, 0
+	for _, method := range mockSCM.Methods() {
+		switch method {
+		case "EnsureOrganization":
+			ensured++
+		case "GetRepositories":
+			listed++
+		}
+	}
+	if ensured != 2 {
+		t.Errorf("have %d EnsureOrganization calls, want 2", ensured)
+	}
+	// the organization's repositories are listed once per course
+	if listed != 2 {
+		t.Errorf("have %d GetRepositories calls, want 2", listed)
 	}
 }
 
-func TestListCoursesWithEnrollment(t *testing.T) {
+func TestNewCourseRepoHooks(t *testing.T) {
 	db, cleanup := setup(t)
 	defer cleanup()
 
-	admin := createFakeUser(t, db, 1)
-	user := createFakeUser(t, db, 2)
-	_, scms := fakeProviderMap(t)
+	fakeGothProvider()
+	admin := createFakeUser(t, db, 10)
+	ctx := withUserContext(context.Background(), admin)
+	mockSCM, scms := mockProviderMap(t)
 	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
 
-	var testCourses []*pb.Course
-	for _, course := range allCourses {
-		testCourse := *course
-		err := db.CreateCourse(admin.ID, &testCourse)
-		if err != nil {
-			t.Fatal(err)
+	// organization hooks are not supported; repository hooks get increasing IDs
+	var hookID uint64
+	mockSCM.CreateHookFunc = func(_ context.Context, opt *scm.CreateHookOptions) (*scm.Hook, error) {
+		if opt.Repository == nil {
+			return nil, scm.ErrNotSupported{SCM: "mock", Method: "CreateHook"}
 		}
-		testCourses = append(testCourses, &testCourse)
+		hookID++
+		return &scm.Hook{ID: hookID, URL: opt.URL}, nil
+	}
+	var deleted []uint64
+	mockSCM.DeleteHookFunc = func(_ context.Context, repoID, hookID uint64) error {
+		deleted = append(deleted, hookID)
+		return nil
 	}
 
-	if err := db.CreateEnrollment(&pb.Enrollment{
-		UserID:   user.ID,
-		CourseID: testCourses[0].ID,
-	}); err != nil {
+	if _, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
 		t.Fatal(err)
 	}
-	if err := db.CreateEnrollment(&pb.Enrollment{
-		UserID:   user.ID,
-		CourseID: testCourses[1].ID,
-	}); err != nil {
+	course, err := ags.CreateCourse(ctx, allCourses[0])
+	if err != nil {
 		t.Fatal(err)
 	}
-	if err := db.CreateEnrollment(&pb.Enrollment{
-		UserID:   user.ID,
-		CourseID: testCourses[2].ID,
-	}); err != nil {
+
+	repos, err := db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
+	if err != nil {
 		t.Fatal(err)
 	}
-	if err := db.RejectEnrollment(user.ID, testCourses[1].ID); err != nil {
-		t.Fatal(err)
+	hooks := 0
+	for _, repo := range repos {
+		if repo.GetRepoType() == pb.Repository_USER {
+			continue
+		}
+		if repo.GetHookID() == 0 {
+			t.Errorf("expected hook ID to be recorded for %s repository", repo.GetRepoType())
+		}
+		hooks++
 	}
-	query := &pb.Enrollment{
-		UserID:   user.ID,
-		CourseID: testCourses[2].ID,
-		Status:   pb.Enrollment_STUDENT,
+	if hooks != len(web.RepoPaths) {
+		t.Errorf("have %d course repositories, want %d", hooks, len(web.RepoPaths))
 	}
-	if err := db.UpdateEnrollment(query); err != nil {
+
+	// archiving the course deletes its hooks
+	course.Archived = true
+	course.UpdateMask = []string{"archived"}
+	if _, err := ags.UpdateCourse(ctx, course); err != nil {
 		t.Fatal(err)
 	}
-
-	courses_request := &pb.EnrollmentStatusRequest{UserID: user.ID}
-	courses, err := ags.GetCoursesByUser(context.Background(), courses_request)
+	if len(deleted) != len(web.RepoPaths) {
+		t.Errorf("have %d deleted hooks, want %d", len(deleted), len(web.RepoPaths))
+	}
+	repos, err = db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
 	if err != nil {
 		t.Fatal(err)
 	}
+	for _, repo := range repos {
+		if repo.GetHookID() != 0 {
+			t.Errorf("expected hook ID to be cleared for %s repository, got %d", repo.GetRepoType(), repo.GetHookID())
+		}
+	}
 
-	wantCourses := []*pb.Course{
-		{ID: testCourses[0].ID, Enrolled: pb.Enrollment_PENDING},
-		{ID: testCourses[1].ID, Enrolled: pb.Enrollment_NONE},
-		{ID: testCourses[2].ID, Enrolled: pb.Enrollment_STUDENT},
-		{ID: testCourses[3].ID, Enrolled: pb.Enrollment_NONE},
+	// unarchiving the course restores its hooks
+	course.Archived = false
+	if _, err := ags.UpdateCourse(ctx, course); err != nil {
+		t.Fatal(err)
 	}
-	for i, course := range courses.Courses {
-		if course.ID != wantCourses[i].ID {
-			t.Errorf("have course %+v want %+v", course.ID, wantCourses[i].ID)
-		}
-		if course.Enrolled != wantCourses[i].Enrolled {
-			t.Errorf("have course %+v want %+v", course.Enrolled, wantCourses[i].Enrolled)
+	// the course is shared with other tests
+	course.UpdateMask = nil
+	repos, err = db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, repo := range repos {
+		if repo.GetRepoType() != pb.Repository_USER && repo.GetHookID() <= uint64(len(web.RepoPaths)) {
+			t.Errorf("expected new hook ID to be recorded for %s repository, got %d", repo.GetRepoType(), repo.GetHookID())
 		}
 	}
 }
 
-func TestListCoursesWithEnrollmentStatuses(t *testing.T) {
+func TestArchiveCourseOrganizationHook(t *testing.T) {
 	db, cleanup := setup(t)
 	defer cleanup()
 
-	admin := createFakeUser(t, db, 1)
-	var testCourses []*pb.Course
-	for _, course := range allCourses {
-		testCourse := *course
-		err := db.CreateCourse(admin.ID, &testCourse)
-		if err != nil {
-			t.Fatal(err)
-		}
-		testCourses = append(testCourses, &testCourse)
-	}
-
-	user := createFakeUser(t, db, 2)
-	_, scms := fakeProviderMap(t)
+	fakeGothProvider()
+	admin := createFakeUser(t, db, 10)
+	ctx := withUserContext(context.Background(), admin)
+	mockSCM, scms := mockProviderMap(t)
 	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
 
-	if err := db.CreateEnrollment(&pb.Enrollment{
-		UserID:   user.ID,
-		CourseID: testCourses[0].ID,
-	}); err != nil {
+	if _, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
 		t.Fatal(err)
 	}
-	if err := db.CreateEnrollment(&pb.Enrollment{
-		UserID:   user.ID,
-		CourseID: testCourses[1].ID,
-	}); err != nil {
+	course, err := ags.CreateCourse(ctx, allCourses[0])
+	if err != nil {
 		t.Fatal(err)
 	}
-	if err := db.CreateEnrollment(&pb.Enrollment{
-		UserID:   user.ID,
-		CourseID: testCourses[2].ID,
-	}); err != nil {
+	stored, err := db.GetCourse(course.GetID(), false)
+	if err != nil {
 		t.Fatal(err)
 	}
+	if stored.GetHookID() == 0 {
+		t.Fatal("expected organization hook ID to be recorded")
+	}
 
-	// user enrollment is rejected for course 1 and enrolled for course 2, still pending for course 0
-	if err := db.RejectEnrollment(user.ID, testCourses[1].ID); err != nil {
-		t.Fatal(err)
+	var deleted []uint64
+	mockSCM.DeleteOrganizationHookFunc = func(_ context.Context, org string, hookID uint64) error {
+		if org != course.GetOrganizationPath() {
+			t.Errorf("have organization %q want %q", org, course.GetOrganizationPath())
+		}
+		deleted = append(deleted, hookID)
+		return nil
+	}
+	// archiving the course twice deletes the organization hook once
+	course.Archived = true
+	course.UpdateMask = []string{"archived"}
+	for i := 0; i < 2; i++ {
+		if _, err := ags.UpdateCourse(ctx, course); err != nil {
+			t.Fatal(err)
+		}
 	}
-	query := &pb.Enrollment{
-		UserID:   user.ID,
-		CourseID: testCourses[2].ID,
-		Status:   pb.Enrollment_STUDENT,
+	if diff := cmp.Diff([]uint64{stored.GetHookID()}, deleted); diff != "" {
+		t.Errorf("mismatch in deleted hooks (-want +got):\n%s", diff)
 	}
-	if err := db.UpdateEnrollment(query); err != nil {
+	if stored, err = db.GetCourse(course.GetID(), false); err != nil {
 		t.Fatal(err)
 	}
+	if stored.GetHookID() != 0 {
+		t.Errorf("expected organization hook ID to be cleared, got %d", stored.GetHookID())
+	}
 
-	stats := make([]pb.Enrollment_UserStatus, 0)
-	stats = append(stats, pb.Enrollment_STUDENT)
-	course_req := &pb.EnrollmentStatusRequest{UserID: user.ID, Statuses: stats}
-	courses, err := ags.GetCoursesByUser(context.Background(), course_req)
-	if err != nil {
+	// unarchiving the course creates a new organization hook
+	course.Archived = false
+	if _, err := ags.UpdateCourse(ctx, course); err != nil {
 		t.Fatal(err)
 	}
-	wantCourses, err := db.GetCoursesByUser(user.ID, pb.Enrollment_STUDENT)
-	if err != nil {
+	// the course is shared with other tests
+	course.UpdateMask = nil
+	if stored, err = db.GetCourse(course.GetID(), false); err != nil {
 		t.Fatal(err)
 	}
-	if !cmp.Equal(courses.Courses, wantCourses) {
-		t.Errorf("have course %+v want %+v", courses, wantCourses)
+	if stored.GetHookID() == 0 {
+		t.Error("expected new organization hook ID to be recorded")
 	}
 }
 
-func TestGetCourse(t *testing.T) {
+func TestNewCourseMissingScopes(t *testing.T) {
 	db, cleanup := setup(t)
 	defer cleanup()
 
-	admin := createFakeUser(t, db, 1)
-	course := *allCourses[0]
-	err := db.CreateCourse(admin.ID, &course)
-	if err != nil {
-		t.Fatal(err)
-	}
-	_, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	admin := createFakeUser(t, db, 10)
+	ctx := withUserContext(context.Background(), admin)
+	mockSCM, scms := mockProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+
+	mockSCM.VerifyScopesFunc = func(_ context.Context, required []string) error {
+		return scm.ErrMissingScopes{SCM: "mock", Scopes: []string{"admin:org_hook"}}
+	}
+	if _, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
+		t.Fatal(err)
+	}
+	mockSCM.Reset()
+	_, err := ags.CreateCourse(ctx, &pb.Course{Name: "Test Course", Code: "DAT100", Year: 2021, Tag: "Spring", Provider: "fake", OrganizationID: 1})
+	if status.Code(err) != codes.FailedPrecondition || !strings.Contains(err.Error(), "admin:org_hook") {
+		t.Errorf("CreateCourse() = %v, want FailedPrecondition naming the missing scope", err)
+	}
+	if methods := mockSCM.Methods(); len(methods) != 1 || methods[0] != "VerifyScopes" {
+		t.Errorf("have SCM calls %v, want no calls after VerifyScopes", methods)
+	}
+	if courses, err := db.GetCourses(); err != nil || len(courses) != 0 {
+		t.Errorf("have courses %v (err: %v), want none", courses, err)
+	}
+}
+
+func TestUpdateCourseVisibilityOfOrganization(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+	fakeGothProvider()
+
+	teacher := createFakeUser(t, db, 1)
+	ctx := withUserContext(context.Background(), teacher)
+	mockSCM, scms := mockProviderMap(t)
+	org, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	course := &pb.Course{Name: "Test Course", Provider: "fake", OrganizationID: org.GetID()}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+
+	tests := []struct {
+		private  bool
+		wantCall bool
+	}{
+		{private: true, wantCall: true},
+		{private: true, wantCall: false},
+		{private: false, wantCall: true},
+	}
+	course.UpdateMask = []string{"private"}
+	for _, test := range tests {
+		mockSCM.Reset()
+		course.Private = test.private
+		if _, err := ags.UpdateCourse(ctx, course); err != nil {
+			t.Fatal(err)
+		}
+		gotCourse, err := db.GetCourse(course.ID, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gotCourse.GetPrivate() != test.private {
+			t.Errorf("have private course %t, want %t", gotCourse.GetPrivate(), test.private)
+		}
+		var calls []scm.Call
+		for _, call := range mockSCM.Calls() {
+			if call.Method == "UpdateOrganizationVisibility" {
+				calls = append(calls, call)
+			}
+		}
+		wantCalls := []scm.Call{{Method: "UpdateOrganizationVisibility", Args: []interface{}{org.GetID(), test.private}}}
+		if !test.wantCall {
+			wantCalls = nil
+		}
+		if diff := cmp.Diff(wantCalls, calls); diff != "" {
+			t.Errorf("UpdateCourse(private=%t) SCM calls mismatch (-want +got):\n%s", test.private, diff)
+		}
+	}
+
+	// an update without private in its mask keeps the course private, although private is false
+	if _, err := ags.UpdateCourse(ctx, &pb.Course{ID: course.ID, Name: "Test Course", Provider: "fake", OrganizationID: org.GetID(), Private: true, UpdateMask: []string{"private"}}); err != nil {
+		t.Fatal(err)
+	}
+	mockSCM.Reset()
+	if _, err := ags.UpdateCourse(ctx, &pb.Course{ID: course.ID, Name: "Renamed Course", Provider: "fake", OrganizationID: org.GetID()}); err != nil {
+		t.Fatal(err)
+	}
+	if gotCourse, err := db.GetCourse(course.ID, false); err != nil || !gotCourse.GetPrivate() || gotCourse.GetName() != "Renamed Course" {
+		t.Errorf("have course %+v (err: %v), want private course named %q", gotCourse, err, "Renamed Course")
+	}
+	for _, call := range mockSCM.Calls() {
+		if call.Method == "UpdateOrganizationVisibility" {
+			t.Errorf("have call %+v for update without private in its mask, want none", call)
+		}
+	}
+	course.Private = false
+	if _, err := ags.UpdateCourse(ctx, course); err != nil {
+		t.Fatal(err)
+	}
+
+	// the organization's visibility is restored if the course cannot be updated
+	otherOrg, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "other", Name: "other"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := &pb.Course{Name: "Other Course", Provider: "fake", OrganizationID: otherOrg.GetID(), Slug: "other-course"}
+	if err := db.CreateCourse(teacher.ID, other); err != nil {
+		t.Fatal(err)
+	}
+	mockSCM.Reset()
+	course.Private = true
+	course.Slug = other.Slug
+	if _, err := ags.UpdateCourse(ctx, course); err == nil {
+		t.Fatal("UpdateCourse() with a taken slug succeeded, want error")
+	}
+	var calls []scm.Call
+	for _, call := range mockSCM.Calls() {
+		if call.Method == "UpdateOrganizationVisibility" {
+			calls = append(calls, call)
+		}
+	}
+	wantCalls := []scm.Call{
+		{Method: "UpdateOrganizationVisibility", Args: []interface{}{org.GetID(), true}},
+		{Method: "UpdateOrganizationVisibility", Args: []interface{}{org.GetID(), false}},
+	}
+	if diff := cmp.Diff(wantCalls, calls); diff != "" {
+		t.Errorf("UpdateCourse() SCM calls mismatch (-want +got):\n%s", diff)
+	}
+	if gotCourse, err := db.GetCourse(course.ID, false); err != nil || gotCourse.GetPrivate() {
+		t.Errorf("have course %+v (err: %v), want public course", gotCourse, err)
+	}
+}
+
+func TestUpdateCourseWithWarningsSkipsOrganizationCheck(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	ctx := withUserContext(context.Background(), teacher)
+	mockSCM, scms := mockProviderMap(t)
+	org, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	course := &pb.Course{Name: "Test Course", Code: "DAT100", Year: 2021, Tag: "Spring", Provider: "fake", OrganizationID: org.GetID(), OrganizationPath: org.GetPath()}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+
+	// the organization is temporarily unreachable
+	mockSCM.GetOrganizationFunc = func(context.Context, *scm.GetOrgOptions) (*pb.Organization, error) {
+		return nil, errors.New("service unavailable")
+	}
+	course.Name = "Renamed Course"
+	if _, err := ags.UpdateCourseWithWarnings(ctx, &pb.UpdateCourseRequest{Course: course}); err == nil {
+		t.Error("UpdateCourseWithWarnings() without skipping the organization check succeeded, want error")
+	}
+	got, err := ags.UpdateCourseWithWarnings(ctx, &pb.UpdateCourseRequest{Course: course, SkipOrganizationCheck: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.GetWarnings()) != 1 {
+		t.Errorf("UpdateCourseWithWarnings() = %v, want one warning", got.GetWarnings())
+	}
+	gotCourse, err := db.GetCourse(course.ID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotCourse.GetName() != "Renamed Course" || gotCourse.GetOrganizationPath() != "path" {
+		t.Errorf("have course %q in organization %q, want %q in %q", gotCourse.GetName(), gotCourse.GetOrganizationPath(), "Renamed Course", "path")
+	}
+
+	// changing the organization is still checked
+	course.OrganizationID = org.GetID() + 1
+	if _, err := ags.UpdateCourseWithWarnings(ctx, &pb.UpdateCourseRequest{Course: course, SkipOrganizationCheck: true}); err == nil {
+		t.Error("UpdateCourseWithWarnings() changing the organization succeeded, want error")
+	}
+}
+
+func TestUpdateEnrollmentLogsSCMContext(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "unknown", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	core, logs := observer.New(zap.DebugLevel)
+	ags := web.NewAutograderService(zap.New(core), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+
+	// no SCM client exists for the course's provider
+	ctx := withUserContext(context.Background(), teacher)
+	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{CourseID: course.ID, UserID: teacher.ID}); err == nil {
+		t.Fatal("expected UpdateEnrollment to fail without SCM client")
+	}
+	entries := logs.FilterField(zap.String("operation", "UpdateEnrollment")).All()
+	if len(entries) != 1 {
+		t.Fatalf("have %d log entries for UpdateEnrollment, want 1", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	if fields["courseID"] != course.ID || fields["userID"] != teacher.ID {
+		t.Errorf("have log fields %v, want courseID=%d and userID=%d", fields, course.ID, teacher.ID)
+	}
+}
+
+func TestEnrollmentProcess(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	admin := createFakeUser(t, db, 1)
+	ctx := withUserContext(context.Background(), admin)
+	fakeProvider, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	_, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	course, err := ags.CreateCourse(ctx, allCourses[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stud1 := createFakeUser(t, db, 2)
+	enrollStud1 := &pb.Enrollment{CourseID: course.ID, UserID: stud1.ID}
+	if _, err = ags.CreateEnrollment(ctx, enrollStud1); err != nil {
+		t.Fatal(err)
+	}
+
+	// verify that a pending enrollment was indeed created.
+	pendingEnrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, stud1.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pendingEnrollment.GetEnrolledDate() == "" {
+		t.Error("have no enrolled date for pending enrollment")
+	}
+	wantEnrollment := &pb.Enrollment{
+		ID:           pendingEnrollment.ID,
+		CourseID:     course.ID,
+		UserID:       stud1.ID,
+		Status:       pb.Enrollment_PENDING,
+		State:        pb.Enrollment_VISIBLE,
+		Course:       course,
+		User:         stud1,
+		UsedSlipDays: []*pb.UsedSlipDays{},
+		EnrolledDate: pendingEnrollment.EnrolledDate,
+	}
+	// can't use: wantEnrollment.User.RemoveRemoteID()
+	wantEnrollment.User.RemoteIdentities = nil
+	if !cmp.Equal(pendingEnrollment, wantEnrollment) {
+		t.Errorf("enrollment\nhave %+v\nwant %+v\n", pendingEnrollment, wantEnrollment)
+	}
+
+	enrollStud1.Status = pb.Enrollment_STUDENT
+	if _, err = ags.UpdateEnrollment(ctx, enrollStud1); err != nil {
+		t.Fatal(err)
+	}
+
+	// verify that the enrollment was updated to student status.
+	acceptedEnrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, stud1.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantEnrollment.Status = pb.Enrollment_STUDENT
+	if !cmp.Equal(acceptedEnrollment, wantEnrollment) {
+		t.Errorf("enrollment\nhave %+v\nwant %+v\n", acceptedEnrollment, wantEnrollment)
+	}
+
+	// create another user and enroll as student
+
+	stud2 := createFakeUser(t, db, 3)
+	enrollStud2 := &pb.Enrollment{CourseID: course.ID, UserID: stud2.ID}
+	if _, err = ags.CreateEnrollment(ctx, enrollStud2); err != nil {
+		t.Fatal(err)
+	}
+	enrollStud2.Status = pb.Enrollment_STUDENT
+	if _, err = ags.UpdateEnrollment(ctx, enrollStud2); err != nil {
+		t.Fatal(err)
+	}
+	// verify that the stud2 was enrolled with student status.
+	acceptedEnrollment, err = db.GetEnrollmentByCourseAndUser(course.ID, stud2.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantEnrollment.ID = acceptedEnrollment.ID
+	wantEnrollment.Status = pb.Enrollment_STUDENT
+	wantEnrollment.EnrolledDate = acceptedEnrollment.EnrolledDate
+	wantEnrollment.UserID = stud2.ID
+	wantEnrollment.User = stud2
+	wantEnrollment.User.RemoteIdentities = nil
+	if !cmp.Equal(acceptedEnrollment, wantEnrollment) {
+		t.Errorf("enrollment\nhave %+v\nwant %+v\n", acceptedEnrollment, wantEnrollment)
+	}
+
+	// promote stud2 to teaching assistant
+
+	enrollStud2.Status = pb.Enrollment_TEACHER
+	if _, err = ags.UpdateEnrollment(ctx, enrollStud2); err != nil {
+		t.Fatal(err)
+	}
+	// verify that the stud2 was promoted to teacher status.
+	acceptedEnrollment, err = db.GetEnrollmentByCourseAndUser(course.ID, stud2.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantEnrollment.ID = acceptedEnrollment.ID
+	wantEnrollment.Status = pb.Enrollment_TEACHER
+	if !cmp.Equal(acceptedEnrollment, wantEnrollment) {
+		t.Errorf("have enrollment %+v want %+v", acceptedEnrollment, wantEnrollment)
+	}
+}
+
+func TestListCoursesWithEnrollment(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	admin := createFakeUser(t, db, 1)
+	user := createFakeUser(t, db, 2)
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+
+	var testCourses []*pb.Course
+	for _, course := range allCourses {
+		testCourse := *course
+		err := db.CreateCourse(admin.ID, &testCourse)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testCourses = append(testCourses, &testCourse)
+	}
+
+	if err := db.CreateEnrollment(&pb.Enrollment{
+		UserID:   user.ID,
+		CourseID: testCourses[0].ID,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateEnrollment(&pb.Enrollment{
+		UserID:   user.ID,
+		CourseID: testCourses[1].ID,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateEnrollment(&pb.Enrollment{
+		UserID:   user.ID,
+		CourseID: testCourses[2].ID,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.RejectEnrollment(user.ID, testCourses[1].ID); err != nil {
+		t.Fatal(err)
+	}
+	query := &pb.Enrollment{
+		UserID:   user.ID,
+		CourseID: testCourses[2].ID,
+		Status:   pb.Enrollment_STUDENT,
+	}
+	if err := db.UpdateEnrollment(query); err != nil {
+		t.Fatal(err)
+	}
+
+	courses_request := &pb.EnrollmentStatusRequest{UserID: user.ID}
+	courses, err := ags.GetCoursesByUser(context.Background(), courses_request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantCourses := []*pb.Course{
+		{ID: testCourses[0].ID, Enrolled: pb.Enrollment_PENDING},
+		{ID: testCourses[1].ID, Enrolled: pb.Enrollment_NONE},
+		{ID: testCourses[2].ID, Enrolled: pb.Enrollment_STUDENT},
+		{ID: testCourses[3].ID, Enrolled: pb.Enrollment_NONE},
+	}
+	for i, course := range courses.Courses {
+		if course.ID != wantCourses[i].ID {
+			t.Errorf("have course %+v want %+v", course.ID, wantCourses[i].ID)
+		}
+		if course.Enrolled != wantCourses[i].Enrolled {
+			t.Errorf("have course %+v want %+v", course.Enrolled, wantCourses[i].Enrolled)
+		}
+	}
+}
+
+func TestGetCoursesWithEnrollment(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	admin := createFakeUser(t, db, 1)
+	user := createFakeUser(t, db, 2)
+	other := createFakeUser(t, db, 3)
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+
+	var testCourses []*pb.Course
+	for _, course := range allCourses[:3] {
+		testCourse := *course
+		if err := db.CreateCourse(admin.ID, &testCourse); err != nil {
+			t.Fatal(err)
+		}
+		testCourses = append(testCourses, &testCourse)
+	}
+	for _, course := range testCourses[:2] {
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{
+		UserID:   user.ID,
+		CourseID: testCourses[1].ID,
+		Status:   pb.Enrollment_STUDENT,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	request := &pb.EnrollmentStatusRequest{UserID: user.ID}
+	if _, err := ags.GetCoursesWithEnrollment(withUserContext(context.Background(), other), request); err == nil {
+		t.Error("expected other users not to be allowed to get the user's enrollments")
+	}
+
+	tests := []struct {
+		statuses    []pb.Enrollment_UserStatus
+		wantCourses []uint64
+		wantStatus  []pb.Enrollment_UserStatus
+	}{
+		{nil, []uint64{testCourses[0].ID, testCourses[1].ID, testCourses[2].ID}, []pb.Enrollment_UserStatus{pb.Enrollment_PENDING, pb.Enrollment_STUDENT, pb.Enrollment_NONE}},
+		{[]pb.Enrollment_UserStatus{pb.Enrollment_STUDENT}, []uint64{testCourses[1].ID}, []pb.Enrollment_UserStatus{pb.Enrollment_STUDENT}},
+	}
+	for _, test := range tests {
+		request.Statuses = test.statuses
+		courseEnrollments, err := ags.GetCoursesWithEnrollment(withUserContext(context.Background(), user), request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var gotCourses []uint64
+		var gotStatus []pb.Enrollment_UserStatus
+		for _, ce := range courseEnrollments.GetCourseEnrollments() {
+			gotCourses = append(gotCourses, ce.GetCourse().GetID())
+			// a missing enrollment has status NONE
+			gotStatus = append(gotStatus, ce.GetEnrollment().GetStatus())
+			if ce.GetEnrollment() != nil && ce.GetEnrollment().GetCourseID() != ce.GetCourse().GetID() {
+				t.Errorf("have enrollment for course %d paired with course %d", ce.GetEnrollment().GetCourseID(), ce.GetCourse().GetID())
+			}
+		}
+		if diff := cmp.Diff(test.wantCourses, gotCourses); diff != "" {
+			t.Errorf("statuses %v: mismatch in courses (-want +got):\n%s", test.statuses, diff)
+		}
+		if diff := cmp.Diff(test.wantStatus, gotStatus); diff != "" {
+			t.Errorf("statuses %v: mismatch in enrollment statuses (-want +got):\n%s", test.statuses, diff)
+		}
+	}
+}
+
+func TestListCoursesWithEnrollmentStatuses(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	admin := createFakeUser(t, db, 1)
+	var testCourses []*pb.Course
+	for _, course := range allCourses {
+		testCourse := *course
+		err := db.CreateCourse(admin.ID, &testCourse)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testCourses = append(testCourses, &testCourse)
+	}
+
+	user := createFakeUser(t, db, 2)
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+
+	if err := db.CreateEnrollment(&pb.Enrollment{
+		UserID:   user.ID,
+		CourseID: testCourses[0].ID,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateEnrollment(&pb.Enrollment{
+		UserID:   user.ID,
+		CourseID: testCourses[1].ID,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateEnrollment(&pb.Enrollment{
+		UserID:   user.ID,
+		CourseID: testCourses[2].ID,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// user enrollment is rejected for course 1 and enrolled for course 2, still pending for course 0
+	if err := db.RejectEnrollment(user.ID, testCourses[1].ID); err != nil {
+		t.Fatal(err)
+	}
+	query := &pb.Enrollment{
+		UserID:   user.ID,
+		CourseID: testCourses[2].ID,
+		Status:   pb.Enrollment_STUDENT,
+	}
+	if err := db.UpdateEnrollment(query); err != nil {
+		t.Fatal(err)
+	}
+
+	stats := make([]pb.Enrollment_UserStatus, 0)
+	stats = append(stats, pb.Enrollment_STUDENT)
+	course_req := &pb.EnrollmentStatusRequest{UserID: user.ID, Statuses: stats}
+	courses, err := ags.GetCoursesByUser(context.Background(), course_req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantCourses, err := db.GetCoursesByUser(user.ID, pb.Enrollment_STUDENT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(courses.Courses, wantCourses) {
+		t.Errorf("have course %+v want %+v", courses, wantCourses)
+	}
+}
+
+func TestGetMyActiveCourses(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	admin := createFakeUser(t, db, 1)
+	var testCourses []*pb.Course
+	for _, course := range allCourses {
+		testCourse := *course
+		if err := db.CreateCourse(admin.ID, &testCourse); err != nil {
+			t.Fatal(err)
+		}
+		testCourses = append(testCourses, &testCourse)
+	}
+
+	user := createFakeUser(t, db, 2)
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+
+	for _, course := range testCourses[:3] {
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// user enrollment is rejected for course 1 and enrolled for course 2, still pending for course 0
+	if err := db.RejectEnrollment(user.ID, testCourses[1].ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{
+		UserID:   user.ID,
+		CourseID: testCourses[2].ID,
+		Status:   pb.Enrollment_STUDENT,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	courses, err := ags.GetMyActiveCourses(withUserContext(context.Background(), user), &pb.Void{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(courses.Courses) != 1 || courses.Courses[0].ID != testCourses[2].ID {
+		t.Errorf("have courses %+v want only course %d", courses.Courses, testCourses[2].ID)
+	}
+
+	// the course creator is enrolled as teacher in all courses
+	courses, err = ags.GetMyActiveCourses(withUserContext(context.Background(), admin), &pb.Void{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(courses.Courses) != len(testCourses) {
+		t.Errorf("have %d courses want %d", len(courses.Courses), len(testCourses))
+	}
+}
+
+func TestGetCoursesByIDs(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	admin := createFakeUser(t, db, 1)
+	var testCourses []*pb.Course
+	for _, course := range allCourses {
+		testCourse := *course
+		if err := db.CreateCourse(admin.ID, &testCourse); err != nil {
+			t.Fatal(err)
+		}
+		testCourses = append(testCourses, &testCourse)
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+
+	ctx := withUserContext(context.Background(), admin)
+	const missingID = 1000
+	courses, err := ags.GetCoursesByIDs(ctx, &pb.CoursesRequest{CourseIDs: []uint64{testCourses[2].ID, missingID, testCourses[0].ID}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotCodes []string
+	for _, course := range courses.GetCourses() {
+		gotCodes = append(gotCodes, course.GetCode())
+	}
+	// courses are returned in the requested order, without the missing course
+	wantCodes := []string{testCourses[2].Code, testCourses[0].Code}
+	if diff := cmp.Diff(wantCodes, gotCodes); diff != "" {
+		t.Errorf("mismatch in course codes (-want +got):\n%s", diff)
+	}
+
+	if _, err := ags.GetCoursesByIDs(context.Background(), &pb.CoursesRequest{CourseIDs: []uint64{testCourses[0].ID}}); err == nil {
+		t.Error("expected error for unauthenticated user")
+	}
+}
+
+func TestGetCourse(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	admin := createFakeUser(t, db, 1)
+	course := *allCourses[0]
+	err := db.CreateCourse(admin.ID, &course)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+
+	foundCourse, err := ags.GetCourse(context.Background(), &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !cmp.Equal(foundCourse, &course) {
+		t.Errorf("have course %+v want %+v", foundCourse, course)
+	}
+}
+
+func TestPromoteDemoteRejectTeacher(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	fakeGothProvider()
+
+	teacher := createFakeUser(t, db, 10)
+	student1 := createFakeUser(t, db, 11)
+	student2 := createFakeUser(t, db, 12)
+	ta := createFakeUser(t, db, 13)
+
+	course := *allCourses[0]
+	err := db.CreateCourse(teacher.ID, &course)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fakeProvider, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+
+	if err := db.CreateEnrollment(&pb.Enrollment{
+		UserID:   student1.ID,
+		CourseID: course.ID,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateEnrollment(&pb.Enrollment{
+		UserID:   student2.ID,
+		CourseID: course.ID,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateEnrollment(&pb.Enrollment{
+		UserID:   ta.ID,
+		CourseID: course.ID,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	query := &pb.Enrollment{
+		UserID:   teacher.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_TEACHER,
+	}
+	if err := db.UpdateEnrollment(query); err != nil {
+		t.Fatal(err)
+	}
+	query.UserID = student1.ID
+	query.Status = pb.Enrollment_STUDENT
+	if err := db.UpdateEnrollment(query); err != nil {
+		t.Fatal(err)
+	}
+	query.UserID = student2.ID
+	if err := db.UpdateEnrollment(query); err != nil {
+		t.Fatal(err)
+	}
+	query.UserID = ta.ID
+	if err := db.UpdateEnrollment(query); err != nil {
+		t.Fatal(err)
+	}
+
+	// student1 attempts to promote student2 to teacher, must fail
+	ctx := withUserContext(context.Background(), student1)
+	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
+		UserID:   student2.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_TEACHER,
+	}); err == nil {
+		t.Errorf("expected error: 'only teachers can update enrollment status'")
+	}
+
+	// teacher promotes students to teachers, must succeed
+	ctx = withUserContext(context.Background(), teacher)
+	_, err = fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
+		UserID:   student1.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_TEACHER,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
+		UserID:   student2.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_TEACHER,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// promote the TA to teacher as well
+	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
+		UserID:   ta.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_TEACHER,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// TA attempts to demote self, must succeed
+	ctx = withUserContext(context.Background(), ta)
+	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
+		UserID:   ta.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_STUDENT,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// student2 attempts to demote course creator, must fail
+	ctx = withUserContext(context.Background(), student2)
+	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
+		UserID:   teacher.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_STUDENT,
+	}); err == nil {
+		t.Error("expected error: 'only course creator can change status of other teachers'")
+	}
+
+	// student2 attempts to reject course creator, must fail
+	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
+		UserID:   teacher.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_NONE,
+	}); err == nil {
+		t.Error("expected error: 'only course creator can change status of other teachers'")
+	}
+
+	// teacher demotes student1, must succeed
+	ctx = withUserContext(context.Background(), teacher)
+	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
+		UserID:   student1.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_STUDENT,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// check that student1 is now enrolled as student
+	enrol, err := db.GetEnrollmentByCourseAndUser(course.ID, student1.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrol.Status != pb.Enrollment_STUDENT {
+		t.Errorf("expected status %s, got %s", pb.Enrollment_STUDENT, enrol.Status)
+	}
+
+	// teacher rejects student2, must succeed
+	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
+		UserID:   student2.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_NONE,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// ensure that student2 is no longer enrolled in the course
+	enrol, err = db.GetEnrollmentByCourseAndUser(course.ID, student2.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrol.Status != pb.Enrollment_NONE {
+		t.Errorf("expected status %s, got %s", pb.Enrollment_NONE, enrol.Status)
+	}
+
+	// justice is served
+
+	// course creator attempts to demote himself, must fail as well
+	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
+		UserID:   teacher.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_STUDENT,
+	}); err == nil {
+		t.Error("expected error 'course creator cannot be demoted'")
+	}
+
+	// same when rejecting
+	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
+		UserID:   teacher.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_NONE,
+	}); err == nil {
+		t.Error("expected error 'course creator cannot be demoted'")
+	}
+
+	// ta attempts to demote course creator, must fail
+	ctx = withUserContext(context.Background(), ta)
+	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
+		UserID:   teacher.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_STUDENT,
+	}); err == nil {
+		t.Error("expected error 'ta cannot be demoted course creator'")
+	}
+}
+
+func TestUpdateEnrollmentSCMCalls(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	fakeGothProvider()
+
+	teacher := createFakeUser(t, db, 1)
+	student := createFakeUser(t, db, 2)
+	course := *allCourses[0]
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	mockSCM, scms := mockProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), teacher)
+	if _, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
+		t.Fatal(err)
+	}
+
+	type teamChange struct {
+		method, team string
+	}
+	tests := []struct {
+		name        string
+		status      pb.Enrollment_UserStatus
+		wantMethods []string
+		wantTeams   []teamChange
+	}{
+		{
+			name:   "pending to student",
+			status: pb.Enrollment_STUDENT,
+			wantMethods: []string{
+				"GetOrganization", "IsOrgMember", "UpdateRepoAccess", "UpdateRepoAccess", "AddTeamMember",
+				"GetRepository", "CreateRepository", "UpdateRepoAccess",
+			},
+			wantTeams: []teamChange{{"AddTeamMember", scm.StudentsTeam}},
+		},
+		{
+			name:        "student to teacher",
+			status:      pb.Enrollment_TEACHER,
+			wantMethods: []string{"GetOrganization", "UpdateOrgMembership", "RemoveTeamMember", "AddTeamMember"},
+			wantTeams:   []teamChange{{"RemoveTeamMember", scm.StudentsTeam}, {"AddTeamMember", scm.TeachersTeam}},
+		},
+		{
+			name:        "teacher to student",
+			status:      pb.Enrollment_STUDENT,
+			wantMethods: []string{"RemoveTeamMember", "AddTeamMember", "UpdateOrgMembership"},
+			wantTeams:   []teamChange{{"RemoveTeamMember", scm.TeachersTeam}, {"AddTeamMember", scm.StudentsTeam}},
+		},
+		{
+			name:        "student to rejected",
+			status:      pb.Enrollment_NONE,
+			wantMethods: []string{"GetOrganization", "RemoveMember", "DeleteRepository"},
+		},
+	}
+
+	// record team changes when they happen, since callers may reuse the options
+	var gotTeams []teamChange
+	mockSCM.AddTeamMemberFunc = func(_ context.Context, opt *scm.TeamMembershipOptions) error {
+		gotTeams = append(gotTeams, teamChange{"AddTeamMember", opt.TeamName})
+		return nil
+	}
+	mockSCM.RemoveTeamMemberFunc = func(_ context.Context, opt *scm.TeamMembershipOptions) error {
+		gotTeams = append(gotTeams, teamChange{"RemoveTeamMember", opt.TeamName})
+		return nil
+	}
+
+	for _, tt := range tests {
+		mockSCM.Reset()
+		gotTeams = nil
+		if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
+			UserID:   student.ID,
+			CourseID: course.ID,
+			Status:   tt.status,
+		}); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if diff := cmp.Diff(tt.wantMethods, mockSCM.Methods()); diff != "" {
+			t.Errorf("%s: mismatch SCM calls (-want +got):\n%s", tt.name, diff)
+		}
+		if diff := cmp.Diff(tt.wantTeams, gotTeams, cmp.AllowUnexported(teamChange{})); diff != "" {
+			t.Errorf("%s: mismatch team changes (-want +got):\n%s", tt.name, diff)
+		}
+	}
+}
+
+func TestUpdateEnrollmentOrgInvitation(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	student := createFakeUser(t, db, 2)
+	course := *allCourses[0]
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	mockSCM, scms := mockProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), teacher)
+	if _, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
+		t.Fatal(err)
+	}
+	mockSCM.Reset()
+	// the student has not yet joined the course organization
+	mockSCM.IsOrgMemberFunc = func(context.Context, string, string) (bool, error) {
+		return false, nil
+	}
+	var invitedRole string
+	mockSCM.UpdateOrgMembershipFunc = func(_ context.Context, opt *scm.OrgMembershipOptions) error {
+		invitedRole = opt.Role
+		return nil
+	}
+
+	_, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
+		UserID:   student.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_STUDENT,
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UpdateEnrollment() = %v, want FailedPrecondition", err)
+	}
+	wantMethods := []string{"GetOrganization", "IsOrgMember", "UpdateOrgMembership"}
+	if diff := cmp.Diff(wantMethods, mockSCM.Methods()); diff != "" {
+		t.Errorf("mismatch SCM calls (-want +got):\n%s", diff)
+	}
+	if invitedRole != scm.OrgMember {
+		t.Errorf("have invitation role %q, want %q", invitedRole, scm.OrgMember)
+	}
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetStatus() != pb.Enrollment_PENDING {
+		t.Errorf("have status %s, want %s", enrollment.GetStatus(), pb.Enrollment_PENDING)
+	}
+}
+
+func TestUpdateEnrollmentNilSCM(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	student := createFakeUser(t, db, 2)
+	course := *allCourses[0]
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	ctx := context.Background()
+
+	err := ags.UpdateEnrollmentWithSCM(ctx, nil, teacher.Login, &pb.Enrollment{
+		UserID:   student.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_STUDENT,
+	})
+	if !errors.Is(err, web.ErrMissingSCM) {
+		t.Errorf("UpdateEnrollment(STUDENT) with nil SCM: have error %v want %v", err, web.ErrMissingSCM)
+	}
+	// pending enrollments must be accepted before they can be promoted
+	err = ags.UpdateEnrollmentWithSCM(ctx, nil, teacher.Login, &pb.Enrollment{
+		UserID:   student.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_TEACHER,
+	})
+	if !errors.Is(err, web.ErrInvalidTransition) {
+		t.Errorf("UpdateEnrollment(TEACHER) of pending enrollment: have error %v want %v", err, web.ErrInvalidTransition)
+	}
+
+	// rejecting an enrollment does not require the SCM
+	if err := ags.UpdateEnrollmentWithSCM(ctx, nil, teacher.Login, &pb.Enrollment{
+		UserID:   student.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_NONE,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetStatus() != pb.Enrollment_NONE {
+		t.Errorf("have status %s, want %s", enrollment.GetStatus(), pb.Enrollment_NONE)
+	}
+}
+
+func TestReconcileEnrollments(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := *allCourses[0]
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	var students []*pb.User
+	for remoteID := uint64(2); remoteID <= 4; remoteID++ {
+		student := createFakeUser(t, db, remoteID)
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+		students = append(students, student)
+	}
+
+	// the second student has left the organization
+	fakeGothProvider()
+	mockSCM, scms := mockProviderMap(t)
+	mockSCM.ListOrganizationMembersFunc = func(_ context.Context, org *pb.Organization) ([]*scm.OrganizationMember, error) {
+		if org.GetID() != course.OrganizationID {
+			t.Errorf("have organization ID %d want %d", org.GetID(), course.OrganizationID)
+		}
+		return []*scm.OrganizationMember{{ID: 1}, {ID: 2}, {ID: 4}}, nil
+	}
+	// the second student's repository has been deleted, like the tests repository
+	for _, repo := range []*pb.Repository{
+		{OrganizationID: course.OrganizationID, RepositoryID: 10, UserID: students[0].ID, RepoType: pb.Repository_USER},
+		{OrganizationID: course.OrganizationID, RepositoryID: 11, UserID: students[1].ID, RepoType: pb.Repository_USER},
+		{OrganizationID: course.OrganizationID, RepositoryID: 12, RepoType: pb.Repository_TESTS},
+	} {
+		if err := db.CreateRepository(repo); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mockSCM.RepositoryExistsFunc = func(_ context.Context, repoID uint64) (bool, error) {
+		return repoID != 11 && repoID != 12, nil
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+
+	// students cannot reconcile enrollments
+	_, err := ags.ReconcileEnrollments(withUserContext(context.Background(), students[0]), &pb.CourseRequest{CourseID: course.ID})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("have error %v want %v", err, codes.PermissionDenied)
+	}
+
+	ctx := withUserContext(context.Background(), teacher)
+	stale, err := ags.ReconcileEnrollments(ctx, &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stale.GetEnrollments()) != 1 || stale.GetEnrollments()[0].GetUserID() != students[1].ID {
+		t.Errorf("have stale enrollments %+v want only enrollment for user %d", stale.GetEnrollments(), students[1].ID)
+	}
+	repos, err := db.GetRepositories(&pb.Repository{OrganizationID: course.OrganizationID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	remaining := make(map[uint64]bool)
+	for _, repo := range repos {
+		remaining[repo.GetRepositoryID()] = true
+	}
+	if diff := cmp.Diff(map[uint64]bool{10: true, 12: true}, remaining); diff != "" {
+		t.Errorf("mismatch in remaining repositories (-want +got):\n%s", diff)
+	}
+
+	mockSCM.ListOrganizationMembersFunc = func(context.Context, *pb.Organization) ([]*scm.OrganizationMember, error) {
+		return nil, errors.New("organization not found")
+	}
+	if _, err := ags.ReconcileEnrollments(ctx, &pb.CourseRequest{CourseID: course.ID}); err == nil {
+		t.Error("expected error when organization members cannot be listed")
+	}
+}
+
+func TestImportEnrollments(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	mockSCM, scms := mockProviderMap(t)
+	ctx := context.Background()
+	org, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	teacher := createFakeUser(t, db, 1)
+	course := *allCourses[0]
+	course.OrganizationID = org.ID
+	course.OrganizationPath = org.Path
+	course.MaxStudents = 2
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	var users []*pb.User
+	for i, login := range []string{"alice", "bob", "carol", "frank", "grace", "heidi"} {
+		user := createFakeUser(t, db, uint64(2+i))
+		user.Login = login
+		if err := db.UpdateUser(user); err != nil {
+			t.Fatal(err)
+		}
+		users = append(users, user)
+	}
+	alice, bob, carol, frank, grace, heidi := users[0], users[1], users[2], users[3], users[4], users[5]
+	// bob and grace have already asked to enroll, and grace was rejected
+	for _, user := range []*pb.User{bob, grace} {
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.RejectEnrollmentWithReason(grace.ID, course.ID, "not taking the course"); err != nil {
+		t.Fatal(err)
+	}
+	repos := make(map[string]*scm.Repository)
+	for _, login := range []string{alice.Login, bob.Login, grace.Login, heidi.Login} {
+		repo, err := mockSCM.CreateRepository(ctx, &scm.CreateRepositoryOptions{Organization: org, Path: pb.StudentRepoName(login)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		repos[login] = repo
+	}
+	mockSCM.ListOrganizationMembersFunc = func(context.Context, *pb.Organization) ([]*scm.OrganizationMember, error) {
+		return []*scm.OrganizationMember{
+			{ID: 1, Login: teacher.Login},
+			{ID: 2, Login: alice.Login},
+			{ID: 3, Login: bob.Login},
+			{ID: 4, Login: carol.Login},
+			{ID: 50, Login: "dave"},
+			// another user with frank's login, e.g., on another provider; users are not matched by login
+			{ID: 98, Login: frank.Login},
+			{ID: 6, Login: grace.Login},
+			// the course has room for two students
+			{ID: 7, Login: heidi.Login},
+		}, nil
+	}
+	mockSCM.Reset()
+
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	// students cannot import enrollments
+	if _, err := ags.ImportEnrollments(withUserContext(ctx, bob), &pb.CourseRequest{CourseID: course.ID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("have error %v want %v", err, codes.PermissionDenied)
+	}
+	result, err := ags.ImportEnrollments(withUserContext(ctx, teacher), &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var imported []uint64
+	for _, enrollment := range result.GetImported() {
+		imported = append(imported, enrollment.GetUserID())
+	}
+	if diff := cmp.Diff([]uint64{alice.ID, bob.ID}, imported); diff != "" {
+		t.Errorf("imported users mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"dave", frank.Login}, result.GetUnmatched()); diff != "" {
+		t.Errorf("unmatched members mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{carol.Login}, result.GetNoRepository()); diff != "" {
+		t.Errorf("members without repository mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{grace.Login}, result.GetRejected()); diff != "" {
+		t.Errorf("rejected members mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{heidi.Login}, result.GetCourseFull()); diff != "" {
+		t.Errorf("members beyond the student limit mismatch (-want +got):\n%s", diff)
+	}
+
+	for _, user := range []*pb.User{alice, bob} {
+		enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, user.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if enrollment.GetStatus() != pb.Enrollment_STUDENT {
+			t.Errorf("%s: enrollment status = %v, want %v", user.Login, enrollment.GetStatus(), pb.Enrollment_STUDENT)
+		}
+		userRepos, err := db.GetRepositories(&pb.Repository{UserID: user.ID, RepoType: pb.Repository_USER})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(userRepos) != 1 || userRepos[0].GetRepositoryID() != repos[user.Login].ID {
+			t.Errorf("%s: repositories = %+v, want repository %d", user.Login, userRepos, repos[user.Login].ID)
+		}
+	}
+	for user, wantStatus := range map[*pb.User]pb.Enrollment_UserStatus{
+		carol: pb.Enrollment_PENDING,
+		grace: pb.Enrollment_NONE,
+		heidi: pb.Enrollment_PENDING,
+	} {
+		enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, user.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if enrollment.GetStatus() != wantStatus {
+			t.Errorf("%s: enrollment status = %v, want %v", user.Login, enrollment.GetStatus(), wantStatus)
+		}
+	}
+	if enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, frank.ID); err == nil {
+		t.Errorf("%s: have enrollment %+v, want none", frank.Login, enrollment)
+	}
+	// the repositories and teams are not provisioned again
+	for _, method := range mockSCM.Methods() {
+		if method != "ListOrganizationMembers" && method != "GetRepository" {
+			t.Errorf("unexpected SCM call %s", method)
+		}
+	}
+}
+
+type recordingNotifier struct {
+	events []*web.EnrollmentEvent
+}
+
+func (n *recordingNotifier) StudentEnrolled(event *web.EnrollmentEvent) {
+	n.events = append(n.events, event)
+}
+
+func TestUpdateEnrollmentNotifiesStudent(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := *allCourses[0]
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	var students []*pb.User
+	for _, remoteID := range []uint64{2, 3} {
+		student := createFakeUser(t, db, remoteID)
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		students = append(students, student)
+	}
+
+	mockSCM := scm.NewMockSCMClient()
+	ctx := context.Background()
+	if _, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
+		t.Fatal(err)
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	notifier := &recordingNotifier{}
+	ags.SetNotifier(notifier)
+
+	// failing to create the student repository must not notify the student
+	mockSCM.CreateRepositoryFunc = func(context.Context, *scm.CreateRepositoryOptions) (*scm.Repository, error) {
+		return nil, errors.New("failed to create repository")
+	}
+	if err := ags.UpdateEnrollmentWithSCM(ctx, mockSCM, teacher.Login, &pb.Enrollment{
+		UserID:   students[0].ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_STUDENT,
+	}); err == nil {
+		t.Fatal("expected enrollment to fail")
+	}
+	if len(notifier.events) != 0 {
+		t.Errorf("have events %+v for failed enrollment want none", notifier.events)
+	}
+
+	mockSCM.CreateRepositoryFunc = nil
+	if err := ags.UpdateEnrollmentWithSCM(ctx, mockSCM, teacher.Login, &pb.Enrollment{
+		UserID:   students[1].ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_STUDENT,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(notifier.events) != 1 {
+		t.Fatalf("have %d events want 1", len(notifier.events))
+	}
+	event := notifier.events[0]
+	repos, err := db.GetRepositories(&pb.Repository{UserID: students[1].ID, RepoType: pb.Repository_USER})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 1 {
+		t.Fatalf("have %d student repositories want 1", len(repos))
+	}
+	if event.User.GetID() != students[1].ID || event.Course.GetID() != course.ID || event.RepoURL != repos[0].GetHTMLURL() {
+		t.Errorf("have event %+v want user %d, course %d and repository URL %s", event, students[1].ID, course.ID, repos[0].GetHTMLURL())
+	}
+}
+
+func TestUpdateEnrollmentResumesAfterFailure(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := *allCourses[0]
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	mockSCM := scm.NewMockSCMClient()
+	ctx := context.Background()
+	if _, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
+		t.Fatal(err)
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	request := &pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}
+	hasMethod := func(method string) bool {
+		for _, m := range mockSCM.Methods() {
+			if m == method {
+				return true
+			}
+		}
+		return false
+	}
+
+	// a failed repository lookup must not be mistaken for a missing repository
+	mockSCM.GetRepositoryFunc = func(context.Context, *scm.RepositoryOptions) (*scm.Repository, error) {
+		return nil, errors.New("connection reset")
+	}
+	if err := ags.UpdateEnrollmentWithSCM(ctx, mockSCM, teacher.Login, request); err == nil {
+		t.Fatal("expected enrollment to fail")
+	}
+	if hasMethod("CreateRepository") {
+		t.Error("expected no repository to be created after a failed lookup")
+	}
+
+	// fail after the student repository has been created
+	mockSCM.GetRepositoryFunc = nil
+	mockSCM.UpdateRepoAccessFunc = func(_ context.Context, _ *scm.Repository, _, permission string) error {
+		if permission == scm.RepoPush {
+			return errors.New("failed to grant push access")
+		}
+		return nil
+	}
+	if err := ags.UpdateEnrollmentWithSCM(ctx, mockSCM, teacher.Login, request); err == nil {
+		t.Fatal("expected enrollment to fail")
+	}
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetStatus() != pb.Enrollment_PENDING {
+		t.Errorf("have status %s after failed enrollment, want %s", enrollment.GetStatus(), pb.Enrollment_PENDING)
+	}
+
+	// accepting the enrollment again reuses the repository created by the failed attempt
+	mockSCM.UpdateRepoAccessFunc = nil
+	mockSCM.Reset()
+	if err := ags.UpdateEnrollmentWithSCM(ctx, mockSCM, teacher.Login, request); err != nil {
+		t.Fatal(err)
+	}
+	if hasMethod("CreateRepository") {
+		t.Error("expected the repository of the failed attempt to be reused")
+	}
+	repos, err := db.GetRepositories(&pb.Repository{UserID: student.ID, RepoType: pb.Repository_USER})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 1 {
+		t.Fatalf("have %d student repositories, want 1", len(repos))
+	}
+	enrollment, err = db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetStatus() != pb.Enrollment_STUDENT {
+		t.Errorf("have status %s, want %s", enrollment.GetStatus(), pb.Enrollment_STUDENT)
+	}
+}
+
+func TestUpdateEnrollmentDeletesRepoWithoutRecord(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    string
+		existing    bool
+		wantDeleted bool
+	}{
+		{name: "CreatedRepo", wantDeleted: true},
+		// repositories created from a template are never empty, but are still deleted
+		{name: "CreatedFromTemplate", template: "template", wantDeleted: true},
+		// repositories reused from an earlier attempt may hold the student's work
+		{name: "ExistingRepo", existing: true, wantDeleted: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, cleanup := setup(t)
+			defer cleanup()
+
+			teacher := createFakeUser(t, db, 1)
+			course := *allCourses[0]
+			course.TemplateRepo = tt.template
+			if err := db.CreateCourse(teacher.ID, &course); err != nil {
+				t.Fatal(err)
+			}
+			student := createFakeUser(t, db, 2)
+			if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+				t.Fatal(err)
+			}
+
+			mockSCM := scm.NewMockSCMClient()
+			ctx := context.Background()
+			if _, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
+				t.Fatal(err)
+			}
+			// a repository without ID cannot be stored in the database
+			noID := func(_ context.Context, opt *scm.CreateRepositoryOptions) (*scm.Repository, error) {
+				return &scm.Repository{Path: opt.Path, Owner: opt.Organization.GetPath()}, nil
+			}
+			mockSCM.CreateRepositoryFunc = noID
+			mockSCM.CreateRepositoryFromTemplateFunc = noID
+			if tt.existing {
+				mockSCM.GetRepositoryFunc = func(_ context.Context, opt *scm.RepositoryOptions) (*scm.Repository, error) {
+					return &scm.Repository{Path: opt.Path, Owner: opt.Owner}, nil
+				}
+			}
+			mockSCM.RepositoryIsEmptyFunc = func(context.Context, *scm.RepositoryOptions) bool {
+				return tt.template == ""
+			}
+			var deleted []*scm.RepositoryOptions
+			mockSCM.DeleteRepositoryFunc = func(_ context.Context, opt *scm.RepositoryOptions) error {
+				deleted = append(deleted, opt)
+				return nil
+			}
+			ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+			err := ags.UpdateEnrollmentWithSCM(ctx, mockSCM, teacher.Login, &pb.Enrollment{
+				UserID:   student.ID,
+				CourseID: course.ID,
+				Status:   pb.Enrollment_STUDENT,
+			})
+			if !errors.Is(err, web.ErrEnrollmentIncomplete) {
+				t.Errorf("UpdateEnrollment() = %v, want %v", err, web.ErrEnrollmentIncomplete)
+			}
+			var wantDeleted []*scm.RepositoryOptions
+			if tt.wantDeleted {
+				wantDeleted = []*scm.RepositoryOptions{{Path: pb.StudentRepoName(student.Login), Owner: "path"}}
+			}
+			if diff := cmp.Diff(wantDeleted, deleted); diff != "" {
+				t.Errorf("deleted repositories mismatch (-want +got):\n%s", diff)
+			}
+			enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if enrollment.GetStatus() != pb.Enrollment_PENDING {
+				t.Errorf("have status %s, want %s", enrollment.GetStatus(), pb.Enrollment_PENDING)
+			}
+		})
+	}
+}
+
+func TestGetAvailableAssignments(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	var course pb.Course
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+		t.Fatal(err)
+	}
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Deadline: "2020-01-10T12:00:00"}
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2, Deadline: "2020-02-10T12:00:00", Prerequisite: 1}
+	lab3 := &pb.Assignment{CourseID: course.ID, Name: "lab3", Order: 3, Deadline: "2020-03-10T12:00:00", Prerequisite: 2}
+	for _, a := range []*pb.Assignment{lab1, lab2, lab3} {
+		if err := db.CreateAssignment(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+
+	// users not enrolled in the course cannot get its assignments
+	outsider := createFakeUser(t, db, 3)
+	_, err := ags.GetAvailableAssignments(withUserContext(context.Background(), outsider), &pb.CourseRequest{CourseID: course.ID})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("have error %v want %v", err, codes.PermissionDenied)
+	}
+
+	ctx := withUserContext(context.Background(), student)
+	availableNames := func() []string {
+		t.Helper()
+		available, err := ags.GetAvailableAssignments(ctx, &pb.CourseRequest{CourseID: course.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, a := range available.GetAssignments() {
+			names = append(names, a.GetName())
+		}
+		return names
+	}
+
+	if diff := cmp.Diff([]string{"lab1"}, availableNames()); diff != "" {
+		t.Errorf("mismatch in available assignments before approval (-want +got):\n%s", diff)
+	}
+
+	// a pending submission for lab1 does not unlock lab2
+	submission := &pb.Submission{AssignmentID: lab1.ID, UserID: student.ID, Score: 90}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"lab1"}, availableNames()); diff != "" {
+		t.Errorf("mismatch in available assignments with pending submission (-want +got):\n%s", diff)
+	}
+
+	submission.Status = pb.Submission_APPROVED
+	if err := db.UpdateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"lab1", "lab2"}, availableNames()); diff != "" {
+		t.Errorf("mismatch in available assignments after approval (-want +got):\n%s", diff)
+	}
+}
+
+func TestCreateEnrollmentEmailDomainAllowlist(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{OrganizationID: 1, EmailDomainAllowlist: "university.edu, @staff.university.edu"}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+
+	tests := []struct {
+		email     string
+		byTeacher bool
+		wantErr   error
+	}{
+		{"student@university.edu", false, nil},
+		{"student@Staff.University.edu", false, nil},
+		{"student@gmail.com", false, web.ErrEmailNotAllowed},
+		{"student@university.edu.example.com", false, web.ErrEmailNotAllowed},
+		{"student@gmail.com", true, nil},
+	}
+	for i, test := range tests {
+		user := createFakeUser(t, db, uint64(i+2))
+		user.Email = test.email
+		if err := db.UpdateUser(user); err != nil {
+			t.Fatal(err)
+		}
+		ctx := withUserContext(context.Background(), user)
+		if test.byTeacher {
+			ctx = withUserContext(context.Background(), teacher)
+		}
+		_, err := ags.CreateEnrollment(ctx, &pb.Enrollment{CourseID: course.ID, UserID: user.ID})
+		if err != test.wantErr {
+			t.Errorf("CreateEnrollment(%s, by teacher: %t) = %v, want %v", test.email, test.byTeacher, err, test.wantErr)
+		}
+		_, err = db.GetEnrollmentByCourseAndUser(course.ID, user.ID)
+		if enrolled := err == nil; enrolled != (test.wantErr == nil) {
+			t.Errorf("%s: have enrollment %t, want %t", test.email, enrolled, test.wantErr == nil)
+		}
+	}
+}
+
+func TestCreateEnrollmentWithCode(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	mockSCM, scms := mockProviderMap(t)
+	ctx := withUserContext(context.Background(), teacher)
+	org, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	course := &pb.Course{Provider: "fake", CourseCreatorID: teacher.ID, OrganizationID: org.ID, OrganizationPath: org.Path, EnrollmentCode: "s3cret"}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+
+	tests := []struct {
+		code       string
+		wantErr    error
+		wantStatus pb.Enrollment_UserStatus
+	}{
+		{"", nil, pb.Enrollment_PENDING},
+		{"wrong", web.ErrInvalidEnrollmentCode, pb.Enrollment_NONE},
+		{"s3cret", nil, pb.Enrollment_STUDENT},
+	}
+	for i, test := range tests {
+		user := createFakeUser(t, db, uint64(i+2))
+		ctx := withUserContext(context.Background(), user)
+		_, err := ags.CreateEnrollment(ctx, &pb.Enrollment{CourseID: course.ID, UserID: user.ID, EnrollmentCode: test.code})
+		if err != test.wantErr {
+			t.Errorf("CreateEnrollment(code %q) = %v, want %v", test.code, err, test.wantErr)
+		}
+		enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, user.ID)
+		if test.wantErr != nil {
+			if err == nil {
+				t.Errorf("CreateEnrollment(code %q) created enrollment %v, want none", test.code, enrollment)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if enrollment.GetStatus() != test.wantStatus {
+			t.Errorf("CreateEnrollment(code %q) status = %v, want %v", test.code, enrollment.GetStatus(), test.wantStatus)
+		}
+
+		// the enrollment code is only visible to teachers
+		gotCourse, err := ags.GetCourse(ctx, &pb.CourseRequest{CourseID: course.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gotCourse.GetEnrollmentCode() != "" {
+			t.Errorf("GetCourse() revealed enrollment code %q to user %d", gotCourse.GetEnrollmentCode(), user.ID)
+		}
+	}
+}
+
+func TestCreateEnrollmentForOtherUser(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	mockSCM, scms := mockProviderMap(t)
+	ctx := withUserContext(context.Background(), teacher)
+	org, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	course := &pb.Course{Provider: "fake", CourseCreatorID: teacher.ID, OrganizationID: org.ID, OrganizationPath: org.Path, EnrollmentCode: "s3cret"}
+	course.SetFeature(pb.Course_AUTO_ENROLL, true)
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+
+	student := createFakeUser(t, db, 2)
+	victim := createFakeUser(t, db, 3)
+
+	// a student cannot enroll another user, with or without the enrollment code
+	studentCtx := withUserContext(context.Background(), student)
+	for _, code := range []string{"", "s3cret"} {
+		_, err := ags.CreateEnrollment(studentCtx, &pb.Enrollment{CourseID: course.ID, UserID: victim.ID, EnrollmentCode: code})
+		if err != web.ErrEnrollOtherUser {
+			t.Errorf("CreateEnrollment(code %q) = %v, want %v", code, err, web.ErrEnrollOtherUser)
+		}
+	}
+	if enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, victim.ID); err == nil {
+		t.Errorf("CreateEnrollment() created enrollment %v for another user", enrollment)
+	}
+
+	// a teacher can enroll another user
+	if _, err := ags.CreateEnrollment(ctx, &pb.Enrollment{CourseID: course.ID, UserID: victim.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.GetEnrollmentByCourseAndUser(course.ID, victim.ID); err != nil {
+		t.Errorf("CreateEnrollment() by teacher did not create enrollment: %v", err)
+	}
+}
+
+func TestValidTransition(t *testing.T) {
+	tests := []struct {
+		from, to pb.Enrollment_UserStatus
+		want     bool
+	}{
+		{pb.Enrollment_PENDING, pb.Enrollment_STUDENT, true},
+		{pb.Enrollment_PENDING, pb.Enrollment_TEACHER, false},
+		{pb.Enrollment_PENDING, pb.Enrollment_NONE, true},
+		{pb.Enrollment_PENDING, pb.Enrollment_PENDING, false},
+		{pb.Enrollment_STUDENT, pb.Enrollment_TEACHER, true},
+		{pb.Enrollment_STUDENT, pb.Enrollment_NONE, true},
+		{pb.Enrollment_STUDENT, pb.Enrollment_PENDING, false},
+		{pb.Enrollment_STUDENT, pb.Enrollment_STUDENT, false},
+		{pb.Enrollment_TEACHER, pb.Enrollment_STUDENT, true},
+		{pb.Enrollment_TEACHER, pb.Enrollment_NONE, true},
+		{pb.Enrollment_TEACHER, pb.Enrollment_PENDING, false},
+		{pb.Enrollment_NONE, pb.Enrollment_STUDENT, false},
+		{pb.Enrollment_NONE, pb.Enrollment_TEACHER, false},
+		{pb.Enrollment_NONE, pb.Enrollment_PENDING, false},
+		{pb.Enrollment_LEFT, pb.Enrollment_PENDING, true},
+		{pb.Enrollment_LEFT, pb.Enrollment_STUDENT, true},
+		{pb.Enrollment_LEFT, pb.Enrollment_TEACHER, false},
+		{pb.Enrollment_LEFT, pb.Enrollment_NONE, false},
+	}
+	for _, test := range tests {
+		if got := web.ValidTransition(test.from, test.to); got != test.want {
+			t.Errorf("validTransition(%s, %s) = %t, want %t", test.from, test.to, got, test.want)
+		}
+	}
+}
+
+func TestUpdateEnrollmentInvalidTransition(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	var course pb.Course
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.RejectEnrollmentWithReason(student.ID, course.ID, "not registered for the course"); err != nil {
+		t.Fatal(err)
+	}
+
+	mockSCM, scms := mockProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	err := ags.UpdateEnrollmentWithSCM(context.Background(), mockSCM, teacher.Login, &pb.Enrollment{
+		UserID:   student.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_TEACHER,
+	})
+	if !errors.Is(err, web.ErrInvalidTransition) {
+		t.Errorf("UpdateEnrollment(rejected to teacher) = %v, want %v", err, web.ErrInvalidTransition)
+	}
+	if len(mockSCM.Methods()) > 0 {
+		t.Errorf("expected no SCM calls for an invalid transition, got %v", mockSCM.Methods())
+	}
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetStatus() != pb.Enrollment_NONE {
+		t.Errorf("have status %s, want %s", enrollment.GetStatus(), pb.Enrollment_NONE)
+	}
+}
+
+func TestUpdateEnrollmentCourseFull(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{OrganizationID: 1, MaxStudents: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	waiting := createFakeUser(t, db, 3)
+	for _, user := range []*pb.User{student, waiting} {
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{
+		UserID:   student.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_STUDENT,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	mockSCM, scms := mockProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	request := &pb.Enrollment{
+		UserID:   waiting.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_STUDENT,
+	}
+	err := ags.UpdateEnrollmentWithSCM(context.Background(), mockSCM, teacher.Login, request)
+	if !errors.Is(err, web.ErrCourseFull) {
+		t.Errorf("UpdateEnrollment(course full) = %v, want %v", err, web.ErrCourseFull)
+	}
+	if len(mockSCM.Methods()) > 0 {
+		t.Errorf("expected no SCM calls for a full course, got %v", mockSCM.Methods())
+	}
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, waiting.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetStatus() != pb.Enrollment_PENDING {
+		t.Errorf("have status %s, want %s", enrollment.GetStatus(), pb.Enrollment_PENDING)
+	}
+
+	// raising the limit admits the waiting student
+	if err := db.UpdateCourse(&pb.Course{ID: course.ID, MaxStudents: 2}); err != nil {
+		t.Fatal(err)
+	}
+	err = ags.UpdateEnrollmentWithSCM(context.Background(), mockSCM, teacher.Login, request)
+	if errors.Is(err, web.ErrCourseFull) {
+		t.Errorf("UpdateEnrollment(limit raised) = %v, want no %v", err, web.ErrCourseFull)
+	}
+	if len(mockSCM.Methods()) == 0 {
+		t.Error("expected SCM calls to enroll the waiting student")
+	}
+}
+
+func TestRejectEnrollmentWithReason(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	var course pb.Course
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	const reason = "not registered for the course"
+	if err := ags.UpdateEnrollmentWithSCM(context.Background(), nil, teacher.Login, &pb.Enrollment{
+		UserID:       student.ID,
+		CourseID:     course.ID,
+		Status:       pb.Enrollment_NONE,
+		RejectReason: reason,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := withUserContext(context.Background(), student)
+	enrollments, err := ags.GetEnrollmentsByUser(ctx, &pb.EnrollmentStatusRequest{
+		UserID:   student.ID,
+		Statuses: []pb.Enrollment_UserStatus{pb.Enrollment_NONE},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enrollments.GetEnrollments()) != 1 || enrollments.GetEnrollments()[0].GetRejectReason() != reason {
+		t.Fatalf("have enrollments %v, want one rejected enrollment with reason %q", enrollments.GetEnrollments(), reason)
+	}
+
+	// enrolling again reuses the rejected enrollment
+	if _, err := ags.CreateEnrollment(ctx, &pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetStatus() != pb.Enrollment_PENDING || enrollment.GetRejectReason() != "" {
+		t.Errorf("have status %s, reason %q want %s, no reason", enrollment.GetStatus(), enrollment.GetRejectReason(), pb.Enrollment_PENDING)
+	}
+
+	// rejecting without a reason keeps the enrollment, like rejecting with a reason
+	if err := ags.UpdateEnrollmentWithSCM(context.Background(), nil, teacher.Login, &pb.Enrollment{
+		UserID:   student.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_NONE,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	enrollment, err = db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetStatus() != pb.Enrollment_NONE || enrollment.GetRejectReason() != "" {
+		t.Errorf("have status %s, reason %q want %s, no reason", enrollment.GetStatus(), enrollment.GetRejectReason(), pb.Enrollment_NONE)
+	}
+}
+
+func TestGetCourseActivity(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	// both students enroll in the same second
+	other := createFakeUser(t, db, 3)
+	for _, user := range []*pb.User{student, other} {
+		if err := db.CreateEnrollment(&pb.Enrollment{
+			UserID:       user.ID,
+			CourseID:     course.ID,
+			EnrolledDate: "2021-01-10T12:00:00",
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{
+		AssignmentID: assignment.ID,
+		UserID:       student.ID,
+		BuildInfo:    `{"builddate": "2021-01-20T12:00:00"}`,
+		Status:       pb.Submission_APPROVED,
+		ApprovedDate: "2021-01-25T12:00:00",
+	}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+
+	// students cannot get the course activity
+	_, err := ags.GetCourseActivity(withUserContext(context.Background(), student), &pb.CourseActivityRequest{CourseID: course.ID})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("have error %v want %v", err, codes.PermissionDenied)
+	}
+
+	ctx := withUserContext(context.Background(), teacher)
+	wantTypes := []pb.ActivityEvent_Type{pb.ActivityEvent_ENROLLMENT, pb.ActivityEvent_ENROLLMENT, pb.ActivityEvent_SUBMISSION, pb.ActivityEvent_APPROVAL}
+	wantUsers := []uint64{student.ID, other.ID, student.ID, student.ID}
+	activity, err := ags.GetCourseActivity(ctx, &pb.CourseActivityRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := activity.GetEvents()
+	if len(events) != len(wantTypes) {
+		t.Fatalf("have %d events want %d", len(events), len(wantTypes))
+	}
+	for i, event := range events {
+		if event.Type != wantTypes[i] || event.UserID != wantUsers[i] {
+			t.Errorf("event %d: have %+v want type %s for user %d", i, event, wantTypes[i], wantUsers[i])
+		}
+	}
+	if events[3].SubmissionID != submission.ID || events[3].AssignmentID != assignment.ID {
+		t.Errorf("have approval event %+v want submission %d for assignment %d", events[3], submission.ID, assignment.ID)
+	}
+	if activity.GetNextCursor() != "" {
+		t.Errorf("have next cursor %q for all events want none", activity.GetNextCursor())
+	}
+
+	// load the timeline one event at a time; no event is lost, even if two happened in the same second
+	var cursor string
+	for i := range wantTypes {
+		page, err := ags.GetCourseActivity(ctx, &pb.CourseActivityRequest{CourseID: course.ID, Cursor: cursor, Limit: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page.GetEvents()) != 1 || page.GetEvents()[0].GetType() != wantTypes[i] || page.GetEvents()[0].GetUserID() != wantUsers[i] {
+			t.Fatalf("page %d: have %+v want one event of type %s for user %d", i, page.GetEvents(), wantTypes[i], wantUsers[i])
+		}
+		wantNext := i < len(wantTypes)-1
+		if (page.GetNextCursor() != "") != wantNext {
+			t.Fatalf("page %d: have next cursor %q, want next cursor: %t", i, page.GetNextCursor(), wantNext)
+		}
+		cursor = page.GetNextCursor()
+	}
+
+	// events in the second of since are not included
+	page, err := ags.GetCourseActivity(ctx, &pb.CourseActivityRequest{CourseID: course.ID, Since: "2021-01-10T12:00:00"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.GetEvents()) != 2 || page.GetEvents()[0].GetType() != pb.ActivityEvent_SUBMISSION {
+		t.Errorf("have events %+v after the enrollments want the submission and approval", page.GetEvents())
+	}
+
+	_, err = ags.GetCourseActivity(ctx, &pb.CourseActivityRequest{CourseID: course.ID, Cursor: "2021-01-10T12:00:00"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("have error %v for invalid cursor want %v", err, codes.InvalidArgument)
+	}
+}
+
+func TestGetEnrollmentWithDetails(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{OrganizationID: 1, EnrollmentCode: "secret"}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{
+		UserID:   student.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_STUDENT,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	group := &pb.Group{Name: "group", CourseID: course.ID, Users: []*pb.User{student}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	repo := &pb.Repository{OrganizationID: 1, RepositoryID: 1, RepoType: pb.Repository_USER, UserID: student.ID, HTMLURL: "student-labs"}
+	if err := db.CreateRepository(repo); err != nil {
+		t.Fatal(err)
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), student)
+
+	enrollment, err := ags.GetEnrollment(ctx, &pb.EnrollmentDetailsRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetGroup() != nil || enrollment.GetRepositoryURL() != "" {
+		t.Errorf("GetEnrollment() without details = %+v, want no group or repository URL", enrollment)
+	}
+	if code := enrollment.GetCourse().GetEnrollmentCode(); code != "" {
+		t.Errorf("GetEnrollment() returned enrollment code %q to a student", code)
+	}
+
+	enrollment, err = ags.GetEnrollment(ctx, &pb.EnrollmentDetailsRequest{CourseID: course.ID, WithDetails: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetGroup().GetName() != group.Name {
+		t.Errorf("GetEnrollment() group = %v, want %s", enrollment.GetGroup(), group.Name)
+	}
+	if enrollment.GetRepositoryURL() != repo.HTMLURL {
+		t.Errorf("GetEnrollment() repository URL = %q, want %q", enrollment.GetRepositoryURL(), repo.HTMLURL)
+	}
+
+	// a user that is not enrolled has no enrollment
+	other := createFakeUser(t, db, 3)
+	if _, err := ags.GetEnrollment(withUserContext(context.Background(), other), &pb.EnrollmentDetailsRequest{CourseID: course.ID}); err == nil {
+		t.Error("expected error for user not enrolled in the course")
+	}
+}
+
+func TestGetRepositoryURLs(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	enroll := func(user *pb.User, courseID uint64, status pb.Enrollment_UserStatus) {
+		t.Helper()
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: courseID}); err != nil {
+			t.Fatal(err)
+		}
+		if status == pb.Enrollment_PENDING {
+			return
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: courseID, Status: status}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	student := createFakeUser(t, db, 2)
+	enroll(student, course.ID, pb.Enrollment_STUDENT)
+	other := createFakeUser(t, db, 3)
+	enroll(other, course.ID, pb.Enrollment_STUDENT)
+	pending := createFakeUser(t, db, 4)
+	enroll(pending, course.ID, pb.Enrollment_PENDING)
+	group := &pb.Group{Name: "other", CourseID: course.ID, Users: []*pb.User{other}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+
+	// a second course with its own teacher and student
+	otherTeacher := createFakeUser(t, db, 5)
+	if err := db.UpdateUser(&pb.User{ID: otherTeacher.ID, IsAdmin: true}); err != nil {
+		t.Fatal(err)
+	}
+	otherCourse := &pb.Course{OrganizationID: 2}
+	if err := db.CreateCourse(otherTeacher.ID, otherCourse); err != nil {
+		t.Fatal(err)
+	}
+	otherStudent := createFakeUser(t, db, 6)
+	enroll(otherStudent, otherCourse.ID, pb.Enrollment_STUDENT)
+
+	repos := []*pb.Repository{
+		{OrganizationID: 1, RepositoryID: 1, RepoType: pb.Repository_ASSIGNMENTS, HTMLURL: "assignments"},
+		{OrganizationID: 1, RepositoryID: 2, RepoType: pb.Repository_USER, UserID: teacher.ID, HTMLURL: "teacher-labs"},
+		{OrganizationID: 1, RepositoryID: 3, RepoType: pb.Repository_USER, UserID: student.ID, HTMLURL: "student-labs"},
+		// group repository of another student's group
+		{OrganizationID: 1, RepositoryID: 4, RepoType: pb.Repository_GROUP, GroupID: group.ID, HTMLURL: "group-labs"},
+		{OrganizationID: 2, RepositoryID: 5, RepoType: pb.Repository_ASSIGNMENTS, HTMLURL: "other-assignments"},
+		{OrganizationID: 2, RepositoryID: 6, RepoType: pb.Repository_USER, UserID: otherStudent.ID, HTMLURL: "other-student-labs"},
+	}
+	for _, repo := range repos {
+		if err := db.CreateRepository(repo); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+
+	repoTypes := []pb.Repository_Type{pb.Repository_ASSIGNMENTS, pb.Repository_USER, pb.Repository_GROUP}
+	urls := func(assignments, user, group string) map[string]string {
+		return map[string]string{
+			pb.Repository_ASSIGNMENTS.String(): assignments,
+			pb.Repository_USER.String():        user,
+			pb.Repository_GROUP.String():       group,
+		}
+	}
+	tests := []struct {
+		name        string
+		currentUser *pb.User
+		courseID    uint64
+		ownerID     uint64
+		wantURLs    map[string]string
+		wantErr     bool
+	}{
+		// the student has no group, so the group repository must not be found
+		{"student", student, course.ID, 0, urls("assignments", "student-labs", ""), false},
+		{"student as owner", student, course.ID, student.ID, urls("assignments", "student-labs", ""), false},
+		{"teacher", teacher, course.ID, 0, urls("assignments", "teacher-labs", ""), false},
+		{"teacher for student", teacher, course.ID, student.ID, urls("assignments", "student-labs", ""), false},
+		{"teacher for student with group", teacher, course.ID, other.ID, urls("assignments", "", "group-labs"), false},
+		{"student for another student", student, course.ID, other.ID, nil, true},
+		{"pending user", pending, course.ID, 0, nil, true},
+		{"student in another course", student, otherCourse.ID, 0, nil, true},
+		{"teacher for student in another course", teacher, otherCourse.ID, otherStudent.ID, nil, true},
+		{"teacher for student of another course", teacher, course.ID, otherStudent.ID, urls("assignments", "", ""), false},
+		{"unknown course", student, 123, 0, nil, true},
+	}
+	for _, test := range tests {
+		gotURLs, err := ags.GetRepositoryURLs(test.currentUser, test.courseID, test.ownerID, repoTypes)
+		if (err != nil) != test.wantErr {
+			t.Errorf("GetRepositoryURLs(%s) error = %v, want error: %t", test.name, err, test.wantErr)
+			continue
+		}
+		if diff := cmp.Diff(test.wantURLs, gotURLs); diff != "" {
+			t.Errorf("GetRepositoryURLs(%s) mismatch (-want +got):\n%s", test.name, diff)
+		}
+	}
+
+	// users without access to the requested repositories are denied
+	ctx := withUserContext(context.Background(), teacher)
+	if _, err := ags.GetRepositories(ctx, &pb.URLRequest{CourseID: otherCourse.ID, UserID: otherStudent.ID, RepoTypes: repoTypes}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetRepositories() for another course: have error %v, want %s", err, codes.PermissionDenied)
+	}
+}
+
+func TestUpdateEnrollmentCreatesRepoFromTemplate(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := *allCourses[0]
+	course.TemplateRepo = "lab-template"
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	mockSCM := scm.NewMockSCMClient()
+	ctx := context.Background()
+	org, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mockSCM.CreateRepository(ctx, &scm.CreateRepositoryOptions{Organization: org, Path: course.TemplateRepo}); err != nil {
+		t.Fatal(err)
+	}
+	mockSCM.Reset()
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	request := &pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}
+	if err := ags.UpdateEnrollmentWithSCM(ctx, mockSCM, teacher.Login, request); err != nil {
+		t.Fatal(err)
+	}
+
+	var templates []string
+	for _, call := range mockSCM.Calls() {
+		switch call.Method {
+		case "CreateRepository":
+			t.Errorf("expected student repository to be created from template, got %s call", call.Method)
+		case "CreateRepositoryFromTemplate":
+			templates = append(templates, call.Args[0].(*scm.CreateRepositoryOptions).Template)
+		}
+	}
+	if diff := cmp.Diff([]string{course.TemplateRepo}, templates); diff != "" {
+		t.Errorf("CreateRepositoryFromTemplate templates mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestUpdateEnrollmentsAddsStudentsTeamMembersAtOnce(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := *allCourses[0]
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	var pending []*pb.User
+	for i := 0; i < 3; i++ {
+		user := createFakeUser(t, db, uint64(10+i))
+		user.Login = fmt.Sprintf("student%d", i)
+		if err := db.UpdateUser(user); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		pending = append(pending, user)
+	}
+
+	mockSCM := scm.NewMockSCMClient()
+	ctx := context.Background()
+	if _, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
+		t.Fatal(err)
+	}
+	// the last pending user has not yet been listed as a member of the organization
+	mockSCM.ListOrganizationMembersFunc = func(context.Context, *pb.Organization) ([]*scm.OrganizationMember, error) {
+		return []*scm.OrganizationMember{
+			{ID: pending[0].ID, Login: pending[0].Login},
+			{ID: pending[1].ID, Login: pending[1].Login},
+			{ID: teacher.ID, Login: teacher.Login},
+		}, nil
+	}
+	mockSCM.Reset()
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	if _, err := ags.UpdateEnrollmentsWithSCM(ctx, mockSCM, course.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	var batches [][]string
+	var added []string
+	for _, call := range mockSCM.Calls() {
+		switch call.Method {
+		case "AddTeamMembers":
+			if teamName := call.Args[0].(*scm.TeamMembershipOptions).TeamName; teamName != scm.StudentsTeam {
+				t.Errorf("AddTeamMembers team = %s, want %s", teamName, scm.StudentsTeam)
+			}
+			batches = append(batches, call.Args[1].([]string))
+		case "AddTeamMember":
+			added = append(added, call.Args[0].(*scm.TeamMembershipOptions).Username)
+		}
+	}
+	if diff := cmp.Diff([][]string{{pending[0].Login, pending[1].Login}}, batches); diff != "" {
+		t.Errorf("AddTeamMembers logins mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{pending[2].Login}, added); diff != "" {
+		t.Errorf("AddTeamMember logins mismatch (-want +got):\n%s", diff)
+	}
+
+	enrollments, err := db.GetEnrollmentsByCourse(course.ID, pb.Enrollment_STUDENT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enrollments) != len(pending) {
+		t.Errorf("got %d enrolled students, want %d", len(enrollments), len(pending))
+	}
+}
+
+func TestUpdateEnrollmentsSkipsPendingInvitations(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := *allCourses[0]
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	var pending []*pb.User
+	for i := 0; i < 3; i++ {
+		user := createFakeUser(t, db, uint64(10+i))
+		user.Login = fmt.Sprintf("student%d", i)
+		if err := db.UpdateUser(user); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		pending = append(pending, user)
+	}
+
+	mockSCM := scm.NewMockSCMClient()
+	ctx := context.Background()
+	if _, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
+		t.Fatal(err)
+	}
+	// the first pending user has not joined the organization yet
+	mockSCM.IsOrgMemberFunc = func(_ context.Context, _, login string) (bool, error) {
+		return login != pending[0].Login, nil
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	invited, err := ags.UpdateEnrollmentsWithSCM(ctx, mockSCM, course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{pending[0].Login}, invited); diff != "" {
+		t.Errorf("UpdateEnrollments() invited users mismatch (-want +got):\n%s", diff)
+	}
+	wantStatus := []pb.Enrollment_UserStatus{pb.Enrollment_PENDING, pb.Enrollment_STUDENT, pb.Enrollment_STUDENT}
+	for i, user := range pending {
+		enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, user.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if enrollment.GetStatus() != wantStatus[i] {
+			t.Errorf("enrollment status of %s = %v, want %v", user.Login, enrollment.GetStatus(), wantStatus[i])
+		}
+	}
+}
+
+func TestUpdateEnrollmentsCourseCapacity(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := *allCourses[0]
+	course.MaxStudents = 2
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	var pending []*pb.User
+	var members []*scm.OrganizationMember
+	for i := 0; i < 3; i++ {
+		user := createFakeUser(t, db, uint64(10+i))
+		user.Login = fmt.Sprintf("student%d", i)
+		if err := db.UpdateUser(user); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		pending = append(pending, user)
+		members = append(members, &scm.OrganizationMember{ID: user.ID, Login: user.Login})
+	}
 
-	foundCourse, err := ags.GetCourse(context.Background(), &pb.CourseRequest{CourseID: course.ID})
+	mockSCM := scm.NewMockSCMClient()
+	ctx := context.Background()
+	if _, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
+		t.Fatal(err)
+	}
+	mockSCM.ListOrganizationMembersFunc = func(context.Context, *pb.Organization) ([]*scm.OrganizationMember, error) {
+		return members, nil
+	}
+	mockSCM.Reset()
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	if _, err := ags.UpdateEnrollmentsWithSCM(ctx, mockSCM, course.ID); !errors.Is(err, web.ErrCourseFull) {
+		t.Errorf("UpdateEnrollments() = %v, want %v", err, web.ErrCourseFull)
+	}
+
+	// only the students that fit in the course are added to the students team
+	var batches [][]string
+	for _, call := range mockSCM.Calls() {
+		if call.Method == "AddTeamMembers" {
+			batches = append(batches, call.Args[1].([]string))
+		}
+	}
+	if diff := cmp.Diff([][]string{{pending[0].Login, pending[1].Login}}, batches); diff != "" {
+		t.Errorf("AddTeamMembers logins mismatch (-want +got):\n%s", diff)
+	}
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, pending[2].ID)
 	if err != nil {
 		t.Fatal(err)
 	}
+	if enrollment.GetStatus() != pb.Enrollment_PENDING {
+		t.Errorf("enrollment status = %v, want %v", enrollment.GetStatus(), pb.Enrollment_PENDING)
+	}
 
-	if !cmp.Equal(foundCourse, &course) {
-		t.Errorf("have course %+v want %+v", foundCourse, course)
+	// the course is full; no one is added to the students team
+	mockSCM.Reset()
+	if _, err := ags.UpdateEnrollmentsWithSCM(ctx, mockSCM, course.ID); !errors.Is(err, web.ErrCourseFull) {
+		t.Errorf("UpdateEnrollments() = %v, want %v", err, web.ErrCourseFull)
+	}
+	if methods := mockSCM.Methods(); len(methods) > 0 {
+		t.Errorf("expected no SCM calls for a full course, got %v", methods)
 	}
 }
 
-func TestPromoteDemoteRejectTeacher(t *testing.T) {
+func TestSCMAuditLog(t *testing.T) {
 	db, cleanup := setup(t)
 	defer cleanup()
 
-	fakeGothProvider()
+	teacher := createFakeUser(t, db, 1)
+	course := *allCourses[0]
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
 
-	teacher := createFakeUser(t, db, 10)
-	student1 := createFakeUser(t, db, 11)
-	student2 := createFakeUser(t, db, 12)
-	ta := createFakeUser(t, db, 13)
+	mockSCM := scm.NewMockSCMClient()
+	ctx := context.Background()
+	if _, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
+		t.Fatal(err)
+	}
+	mockSCM.AddTeamMemberFunc = func(context.Context, *scm.TeamMembershipOptions) error {
+		return errors.New("team not found")
+	}
 
-	course := *allCourses[0]
-	err := db.CreateCourse(teacher.ID, &course)
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	request := &pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}
+	if err := ags.UpdateEnrollmentWithSCM(ctx, mockSCM, teacher.Login, request); err == nil {
+		t.Fatal("expected enrollment to fail when adding the student to the students team fails")
+	}
+	// the student is enrolled once the students team is fixed
+	mockSCM.AddTeamMemberFunc = nil
+	if err := ags.UpdateEnrollmentWithSCM(ctx, mockSCM, teacher.Login, request); err != nil {
+		t.Fatal(err)
+	}
+
+	auditLog, err := ags.GetSCMAuditLog(withUserContext(ctx, teacher), &pb.CourseRequest{CourseID: course.ID})
 	if err != nil {
 		t.Fatal(err)
 	}
+	type operation struct{ Method, User, Error string }
+	var got []operation
+	for _, entry := range auditLog.GetEntries() {
+		if entry.GetCourseID() != course.ID || entry.GetDate() == "" {
+			t.Errorf("unexpected audit log entry %+v", entry)
+		}
+		got = append(got, operation{Method: entry.GetMethod(), User: entry.GetUser(), Error: entry.GetError()})
+	}
+	want := []operation{
+		{"UpdateRepoAccess", student.Login, ""},
+		{"UpdateRepoAccess", student.Login, ""},
+		{"AddTeamMember", student.Login, "team not found"},
+		{"UpdateRepoAccess", student.Login, ""},
+		{"UpdateRepoAccess", student.Login, ""},
+		{"AddTeamMember", student.Login, ""},
+		{"CreateRepository", student.Login, ""},
+		{"UpdateRepoAccess", student.Login, ""},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetSCMAuditLog() mismatch (-want +got):\n%s", diff)
+	}
 
-	fakeProvider, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	if _, err := ags.GetSCMAuditLog(withUserContext(ctx, student), &pb.CourseRequest{CourseID: course.ID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetSCMAuditLog() by student: got %v, want PermissionDenied", err)
+	}
+}
 
-	if err := db.CreateEnrollment(&pb.Enrollment{
-		UserID:   student1.ID,
-		CourseID: course.ID,
-	}); err != nil {
+func TestCreateRepositoryAccessToken(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	teacher.Login = "teacher"
+	if err := db.UpdateUser(teacher); err != nil {
 		t.Fatal(err)
 	}
-	if err := db.CreateEnrollment(&pb.Enrollment{
-		UserID:   student2.ID,
-		CourseID: course.ID,
-	}); err != nil {
+	course := *allCourses[0]
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
 		t.Fatal(err)
 	}
-	if err := db.CreateEnrollment(&pb.Enrollment{
-		UserID:   ta.ID,
-		CourseID: course.ID,
-	}); err != nil {
+	student := createFakeUser(t, db, 2)
+
+	mockSCM := scm.NewMockSCMClient()
+	ctx := context.Background()
+	scmRepo, err := mockSCM.CreateRepository(ctx, &scm.CreateRepositoryOptions{
+		Organization: &pb.Organization{ID: course.OrganizationID, Path: "path"},
+		Path:         "student-labs",
+	})
+	if err != nil {
 		t.Fatal(err)
 	}
-	query := &pb.Enrollment{
-		UserID:   teacher.ID,
-		CourseID: course.ID,
-		Status:   pb.Enrollment_TEACHER,
+	repo := &pb.Repository{
+		OrganizationID: course.OrganizationID,
+		RepositoryID:   scmRepo.ID,
+		UserID:         student.ID,
+		HTMLURL:        "https://example.com/path/student-labs",
+		RepoType:       pb.Repository_USER,
 	}
-	if err := db.UpdateEnrollment(query); err != nil {
+	if err := db.CreateRepository(repo); err != nil {
 		t.Fatal(err)
 	}
-	query.UserID = student1.ID
-	query.Status = pb.Enrollment_STUDENT
-	if err := db.UpdateEnrollment(query); err != nil {
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	before := time.Now()
+	token, err := ags.CreateRepositoryAccessTokenWithSCM(ctx, mockSCM, teacher, &pb.RepositoryRequest{CourseID: course.ID, UserID: student.ID})
+	if err != nil {
 		t.Fatal(err)
 	}
-	query.UserID = student2.ID
-	if err := db.UpdateEnrollment(query); err != nil {
+	if token.GetToken() == "" || token.GetRepositoryURL() != repo.HTMLURL {
+		t.Errorf("have token %+v, want token for %s", token, repo.HTMLURL)
+	}
+	expiresAt, err := time.ParseInLocation("2006-01-02T15:04:05", token.GetExpiresAt(), time.Local)
+	if err != nil {
 		t.Fatal(err)
 	}
-	query.UserID = ta.ID
-	if err := db.UpdateEnrollment(query); err != nil {
+	if !expiresAt.After(before) || expiresAt.After(before.Add(48*time.Hour)) {
+		t.Errorf("token expires at %v, want within two days of %v", expiresAt, before)
+	}
+	calls := mockSCM.Calls()
+	if last := calls[len(calls)-1]; last.Method != "CreateRepositoryAccessToken" || last.Args[0] != scmRepo.ID {
+		t.Errorf("have SCM call %+v, want CreateRepositoryAccessToken for repository %d", last, scmRepo.ID)
+	}
+
+	auditLog, err := db.GetSCMAuditLog(course.ID)
+	if err != nil {
 		t.Fatal(err)
 	}
+	if len(auditLog) != 1 || auditLog[0].GetMethod() != "CreateRepositoryAccessToken" || auditLog[0].GetUser() != teacher.Login {
+		t.Errorf("have audit log %+v, want token issued to %s", auditLog, teacher.Login)
+	}
 
-	// student1 attempts to promote student2 to teacher, must fail
-	ctx := withUserContext(context.Background(), student1)
-	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
-		UserID:   student2.ID,
-		CourseID: course.ID,
-		Status:   pb.Enrollment_TEACHER,
-	}); err == nil {
-		t.Errorf("expected error: 'only teachers can update enrollment status'")
+	// there is no repository to pull for a user without a repository in the course
+	if _, err := ags.CreateRepositoryAccessTokenWithSCM(ctx, mockSCM, teacher, &pb.RepositoryRequest{CourseID: course.ID, UserID: teacher.ID}); err == nil {
+		t.Error("CreateRepositoryAccessToken() for a user without a repository succeeded, want error")
 	}
+	if _, err := ags.CreateRepositoryAccessToken(withUserContext(ctx, student), &pb.RepositoryRequest{CourseID: course.ID, UserID: student.ID}); err == nil {
+		t.Error("CreateRepositoryAccessToken() by a student succeeded, want error")
+	}
+}
 
-	// teacher promotes students to teachers, must succeed
-	ctx = withUserContext(context.Background(), teacher)
-	_, err = fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+func TestCreateCourseOrganization(t *testing.T) {
+	mockSCM := scm.NewMockSCMClient()
+	ctx := context.Background()
+	org, teams, err := web.CreateCourseOrganization(ctx, mockSCM, "DAT320 Operating Systems")
 	if err != nil {
 		t.Fatal(err)
 	}
+	if org.GetPath() != "dat320-operating-systems" {
+		t.Errorf("have organization path %q, want %q", org.GetPath(), "dat320-operating-systems")
+	}
+	for name, id := range map[string]uint64{scm.TeachersTeam: teams.TeachersTeamID, scm.StudentsTeam: teams.StudentsTeamID} {
+		team, err := mockSCM.GetTeam(ctx, &scm.TeamOptions{TeamID: id})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if team.Name != name || team.Organization != org.GetPath() {
+			t.Errorf("have team %+v, want team %s in organization %s", team, name, org.GetPath())
+		}
+	}
 
-	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
-		UserID:   student1.ID,
-		CourseID: course.ID,
-		Status:   pb.Enrollment_TEACHER,
-	}); err != nil {
+	// creating the same organization again reuses the organization and its teams
+	org2, teams2, err := web.CreateCourseOrganization(ctx, mockSCM, "DAT320 Operating Systems")
+	if err != nil {
 		t.Fatal(err)
 	}
-
-	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
-		UserID:   student2.ID,
-		CourseID: course.ID,
-		Status:   pb.Enrollment_TEACHER,
-	}); err != nil {
+	if org2.GetID() != org.GetID() {
+		t.Errorf("have organization ID %d, want %d", org2.GetID(), org.GetID())
+	}
+	if diff := cmp.Diff(teams, teams2); diff != "" {
+		t.Errorf("CreateCourseOrganization() mismatch (-want +got):\n%s", diff)
+	}
+	orgTeams, err := mockSCM.GetTeams(ctx, org)
+	if err != nil {
 		t.Fatal(err)
 	}
+	if len(orgTeams) != 2 {
+		t.Errorf("have %d teams in organization %s, want 2", len(orgTeams), org.GetPath())
+	}
 
-	// promote the TA to teacher as well
-	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
-		UserID:   ta.ID,
-		CourseID: course.ID,
-		Status:   pb.Enrollment_TEACHER,
-	}); err != nil {
+	mockSCM.CreateTeamFunc = func(context.Context, *scm.NewTeamOptions) (*scm.Team, error) {
+		return nil, errors.New("team already exists")
+	}
+	if _, _, err := web.CreateCourseOrganization(ctx, mockSCM, "DAT520"); err == nil {
+		t.Error("CreateCourseOrganization() succeeded without default teams, want error")
+	}
+}
+
+func TestLeaveCourse(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := *allCourses[0]
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	student.Login = "student"
+	if err := db.UpdateUser(student); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
 		t.Fatal(err)
 	}
 
-	// TA attempts to demote self, must succeed
-	ctx = withUserContext(context.Background(), ta)
-	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
-		UserID:   ta.ID,
-		CourseID: course.ID,
-		Status:   pb.Enrollment_STUDENT,
-	}); err != nil {
+	mockSCM := scm.NewMockSCMClient()
+	ctx := context.Background()
+	if _, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
+		t.Fatal(err)
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	if err := ags.UpdateEnrollmentWithSCM(ctx, mockSCM, teacher.Login, &pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, Score: 80}); err != nil {
 		t.Fatal(err)
 	}
 
-	// student2 attempts to demote course creator, must fail
-	ctx = withUserContext(context.Background(), student2)
-	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
-		UserID:   teacher.ID,
-		CourseID: course.ID,
-		Status:   pb.Enrollment_STUDENT,
-	}); err == nil {
-		t.Error("expected error: 'only course creator can change status of other teachers'")
+	if err := ags.LeaveCourseWithSCM(ctx, mockSCM, course.ID, teacher.ID); !errors.Is(err, web.ErrTeacherCannotLeave) {
+		t.Errorf("LeaveCourse(teacher) = %v, want %v", err, web.ErrTeacherCannotLeave)
 	}
 
-	// student2 attempts to reject course creator, must fail
-	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
-		UserID:   teacher.ID,
-		CourseID: course.ID,
-		Status:   pb.Enrollment_NONE,
-	}); err == nil {
-		t.Error("expected error: 'only course creator can change status of other teachers'")
+	mockSCM.Reset()
+	if err := ags.LeaveCourseWithSCM(ctx, mockSCM, course.ID, student.ID); err != nil {
+		t.Fatal(err)
+	}
+	var revoked []string
+	for _, call := range mockSCM.Calls() {
+		switch call.Method {
+		case "RemoveTeamMember":
+			if opt := call.Args[0].(*scm.TeamMembershipOptions); opt.TeamName != scm.StudentsTeam || opt.Username != student.Login {
+				t.Errorf("have RemoveTeamMember(%+v), want %s removed from %s", opt, student.Login, scm.StudentsTeam)
+			}
+		case "RevokeRepoAccess":
+			revoked = append(revoked, call.Args[0].(*scm.Repository).Path)
+		}
+	}
+	wantRevoked := []string{pb.InfoRepo, pb.AssignmentRepo, pb.StudentRepoName(student.Login)}
+	if diff := cmp.Diff(wantRevoked, revoked); diff != "" {
+		t.Errorf("LeaveCourse() revoked access mismatch (-want +got):\n%s", diff)
 	}
 
-	// teacher demotes student1, must succeed
-	ctx = withUserContext(context.Background(), teacher)
-	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
-		UserID:   student1.ID,
-		CourseID: course.ID,
-		Status:   pb.Enrollment_STUDENT,
-	}); err != nil {
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !enrollment.HasLeft() {
+		t.Errorf("have enrollment status %s, want %s", enrollment.GetStatus(), pb.Enrollment_LEFT)
+	}
+	submissions, err := db.GetSubmissions(&pb.Submission{UserID: student.ID})
+	if err != nil {
 		t.Fatal(err)
 	}
+	if len(submissions) != 1 {
+		t.Errorf("have %d submissions after leaving the course, want 1", len(submissions))
+	}
+	if err := ags.LeaveCourseWithSCM(ctx, mockSCM, course.ID, student.ID); !errors.Is(err, web.ErrInvalidTransition) {
+		t.Errorf("LeaveCourse() twice = %v, want %v", err, web.ErrInvalidTransition)
+	}
 
-	// check that student1 is now enrolled as student
-	enrol, err := db.GetEnrollmentByCourseAndUser(course.ID, student1.ID)
+	// the student can enroll again
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	enrollment, err = db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
 	if err != nil {
 		t.Fatal(err)
 	}
-	if enrol.Status != pb.Enrollment_STUDENT {
-		t.Errorf("expected status %s, got %s", pb.Enrollment_STUDENT, enrol.Status)
+	if enrollment.GetStatus() != pb.Enrollment_PENDING {
+		t.Errorf("have enrollment status %s after enrolling again, want %s", enrollment.GetStatus(), pb.Enrollment_PENDING)
 	}
 
-	// teacher rejects student2, must succeed
-	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
-		UserID:   student2.ID,
-		CourseID: course.ID,
-		Status:   pb.Enrollment_NONE,
-	}); err != nil {
+	// accepting the student again restores the revoked access to the existing repository
+	mockSCM.Reset()
+	if err := ags.UpdateEnrollmentWithSCM(ctx, mockSCM, teacher.Login, &pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+		t.Fatal(err)
+	}
+	var restored []string
+	addedToTeam := false
+	for _, call := range mockSCM.Calls() {
+		switch call.Method {
+		case "AddTeamMember":
+			addedToTeam = true
+			if opt := call.Args[0].(*scm.TeamMembershipOptions); opt.TeamName != scm.StudentsTeam || opt.Username != student.Login {
+				t.Errorf("have AddTeamMember(%+v), want %s added to %s", opt, student.Login, scm.StudentsTeam)
+			}
+		case "UpdateRepoAccess":
+			restored = append(restored, call.Args[0].(*scm.Repository).Path)
+		case "CreateRepository", "CreateRepositoryFromTemplate":
+			t.Errorf("have %s call, want the existing repository reused", call.Method)
+		}
+	}
+	if diff := cmp.Diff(wantRevoked, restored); diff != "" {
+		t.Errorf("UpdateEnrollment() restored access mismatch (-want +got):\n%s", diff)
+	}
+	if !addedToTeam {
+		t.Errorf("have SCM calls %v, want the student added to the students team", mockSCM.Methods())
+	}
+	enrollment, err = db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
 		t.Fatal(err)
 	}
+	if !enrollment.IsStudent() {
+		t.Errorf("have enrollment status %s after accepting again, want %s", enrollment.GetStatus(), pb.Enrollment_STUDENT)
+	}
+}
 
-	// ensure that student2 is no longer enrolled in the course
-	if _, err := db.GetEnrollmentByCourseAndUser(course.ID, student2.ID); err == nil {
-		t.Error("expected error 'record not found'")
+func TestRejectEnrollments(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	var course pb.Course
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	var pending []*pb.User
+	for i := 0; i < 3; i++ {
+		user := createFakeUser(t, db, uint64(10+i))
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		pending = append(pending, user)
+	}
+	student := createFakeUser(t, db, 20)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+		t.Fatal(err)
 	}
 
-	// justice is served
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	const reason = "the add/drop deadline has passed"
+	request := &pb.RejectEnrollmentsRequest{CourseID: course.ID, Reason: reason}
 
-	// course creator attempts to demote himself, must fail as well
-	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
-		UserID:   teacher.ID,
-		CourseID: course.ID,
-		Status:   pb.Enrollment_STUDENT,
-	}); err == nil {
-		t.Error("expected error 'course creator cannot be demoted'")
+	// students cannot reject enrollments
+	if _, err := ags.RejectEnrollments(withUserContext(context.Background(), student), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RejectEnrollments() by student: have error %v, want %s", err, codes.PermissionDenied)
 	}
 
-	// same when rejecting
-	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
-		UserID:   teacher.ID,
-		CourseID: course.ID,
-		Status:   pb.Enrollment_NONE,
-	}); err == nil {
-		t.Error("expected error 'course creator cannot be demoted'")
+	count, err := ags.RejectEnrollments(withUserContext(context.Background(), teacher), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count.GetCount() != uint32(len(pending)) {
+		t.Errorf("RejectEnrollments() rejected %d enrollments, want %d", count.GetCount(), len(pending))
+	}
+	for _, user := range pending {
+		enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, user.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if enrollment.GetStatus() != pb.Enrollment_NONE || enrollment.GetRejectReason() != reason {
+			t.Errorf("have enrollment with status %s and reason %q, want %s and %q", enrollment.GetStatus(), enrollment.GetRejectReason(), pb.Enrollment_NONE, reason)
+		}
+	}
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetStatus() != pb.Enrollment_STUDENT || enrollment.GetRejectReason() != "" {
+		t.Errorf("accepted student has status %s and reason %q, want %s and no reason", enrollment.GetStatus(), enrollment.GetRejectReason(), pb.Enrollment_STUDENT)
 	}
 
-	// ta attempts to demote course creator, must fail
-	ctx = withUserContext(context.Background(), ta)
-	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
-		UserID:   teacher.ID,
-		CourseID: course.ID,
-		Status:   pb.Enrollment_STUDENT,
-	}); err == nil {
-		t.Error("expected error 'ta cannot be demoted course creator'")
+	// nothing is left to reject
+	count, err = ags.RejectEnrollments(withUserContext(context.Background(), teacher), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count.GetCount() != 0 {
+		t.Errorf("RejectEnrollments() rejected %d enrollments, want 0", count.GetCount())
 	}
 }
@@ -0,0 +1,67 @@
+package web
+
+import (
+	"context"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// CreateRubric exports createRubric for testing.
+func (s *AutograderService) CreateRubric(assignmentID uint64, benchmarks []*pb.GradingBenchmark) ([]*pb.GradingBenchmark, error) {
+	return s.createRubric(assignmentID, benchmarks)
+}
+
+// BumpGradingConfigVersion exports bumpGradingConfigVersion for testing.
+func (s *AutograderService) BumpGradingConfigVersion(courseID uint64) (uint32, error) {
+	return s.bumpGradingConfigVersion(courseID)
+}
+
+// GetRepositoryURLs exports getRepositoryURLs for testing.
+func (s *AutograderService) GetRepositoryURLs(currentUser *pb.User, courseID, ownerID uint64, repoTypes []pb.Repository_Type) (map[string]string, error) {
+	return s.getRepositoryURLs(currentUser, courseID, ownerID, repoTypes)
+}
+
+// HandleUserRename exports handleUserRename for testing.
+func (s *AutograderService) HandleUserRename(ctx context.Context, userID uint64, newLogin string) error {
+	return s.handleUserRename(ctx, userID, newLogin)
+}
+
+// ProvisionGroup exports provisionGroup for testing.
+func (s *AutograderService) ProvisionGroup(ctx context.Context, sc scm.SCM, course *pb.Course, group *pb.Group) (*scm.Team, error) {
+	return s.provisionGroup(ctx, sc, course, group)
+}
+
+// CreateRepositoryAccessTokenWithSCM exports createRepositoryAccessToken for testing.
+func (s *AutograderService) CreateRepositoryAccessTokenWithSCM(ctx context.Context, sc scm.SCM, currentUser *pb.User, request *pb.RepositoryRequest) (*pb.RepositoryAccessToken, error) {
+	return s.createRepositoryAccessToken(ctx, sc, currentUser, request)
+}
+
+// CreateCourseOrganization exports createCourseOrganization for testing.
+func CreateCourseOrganization(ctx context.Context, sc scm.SCM, name string) (*pb.Organization, *CourseTeams, error) {
+	return createCourseOrganization(ctx, sc, name)
+}
+
+// LeaveCourseWithSCM exports leaveCourse for testing.
+func (s *AutograderService) LeaveCourseWithSCM(ctx context.Context, sc scm.SCM, courseID, userID uint64) error {
+	return s.leaveCourse(ctx, sc, courseID, userID)
+}
+
+// UpdateEnrollmentsWithSCM exports updateEnrollments for testing with a given SCM client.
+func (s *AutograderService) UpdateEnrollmentsWithSCM(ctx context.Context, sc scm.SCM, courseID uint64) ([]string, error) {
+	return s.updateEnrollments(ctx, sc, courseID)
+}
+
+// UpdateEnrollmentWithSCM exports updateEnrollment for testing with a given SCM client.
+func (s *AutograderService) UpdateEnrollmentWithSCM(ctx context.Context, sc scm.SCM, curUser string, request *pb.Enrollment) error {
+	return s.updateEnrollment(ctx, sc, curUser, request)
+}
+
+// ValidTransition exports validTransition for testing.
+var ValidTransition = validTransition
+
+// BaselineRuns exports baselineRuns for testing.
+func (s *AutograderService) BaselineRuns(ctx context.Context, sc scm.SCM, course *pb.Course, user *pb.User, repo *pb.Repository) ([]*ci.RunData, error) {
+	return s.baselineRuns(ctx, sc, course, user, repo)
+}
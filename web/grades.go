@@ -0,0 +1,128 @@
+package web
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// exportCourseGrades returns the grades of all students in the given course as CSV,
+// with one row per student and a score and a status column per assignment.
+// The columns are blank for assignments without a submission by the student or group.
+func (s *AutograderService) exportCourseGrades(courseID uint64) (string, error) {
+	assignments, err := s.db.GetAssignmentsByCourse(courseID, false)
+	if err != nil {
+		return "", err
+	}
+	sort.Slice(assignments, func(i, j int) bool {
+		return assignments[i].GetOrder() < assignments[j].GetOrder()
+	})
+	enrollments, err := s.db.GetEnrollmentsByCourse(courseID, pb.Enrollment_STUDENT)
+	if err != nil {
+		return "", err
+	}
+	sort.Slice(enrollments, func(i, j int) bool {
+		return enrollments[i].GetUser().GetName() < enrollments[j].GetUser().GetName()
+	})
+	grades, err := s.db.GetCourseGrades(courseID)
+	if err != nil {
+		return "", err
+	}
+	// grades are ordered by submission; later submissions replace earlier ones
+	userGrades := make(map[uint64]map[uint64]*pb.Grade)
+	for _, grade := range grades {
+		if userGrades[grade.GetUserID()] == nil {
+			userGrades[grade.GetUserID()] = make(map[uint64]*pb.Grade)
+		}
+		userGrades[grade.GetUserID()][grade.GetAssignmentID()] = grade
+	}
+
+	header := []string{"Name", "Student ID", "Login"}
+	for _, assignment := range assignments {
+		header = append(header, assignment.GetName()+" score", assignment.GetName()+" status")
+	}
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return "", err
+	}
+	for _, enrollment := range enrollments {
+		user := enrollment.GetUser()
+		row := []string{user.GetName(), user.GetStudentID(), user.GetLogin()}
+		for _, assignment := range assignments {
+			grade, ok := userGrades[user.GetID()][assignment.GetID()]
+			if !ok {
+				row = append(row, "", "")
+				continue
+			}
+			row = append(row, strconv.FormatUint(uint64(grade.GetScore()), 10), grade.GetStatus().String())
+		}
+		if err := w.Write(row); err != nil {
+			return "", err
+		}
+	}
+	w.Flush()
+	return buf.String(), w.Error()
+}
+
+// exportEnrollments writes the course roster as CSV to w, one row per enrollment,
+// with the user's name, login, email, enrollment status, group and overall score.
+// The overall score is the average of the latest scores for all course assignments,
+// where assignments without a submission count as zero. Rows are written as they
+// are produced. Users other than teachers only see the email and score of themselves.
+func (s *AutograderService) exportEnrollments(currentUser *pb.User, courseID uint64, w io.Writer) error {
+	teacher := s.isTeacher(currentUser.GetID(), courseID)
+	assignments, err := s.db.GetAssignmentsByCourse(courseID, false)
+	if err != nil {
+		return err
+	}
+	enrollments, err := s.db.GetEnrollmentsByCourse(courseID)
+	if err != nil {
+		return err
+	}
+	sort.Slice(enrollments, func(i, j int) bool {
+		return enrollments[i].GetUser().GetName() < enrollments[j].GetUser().GetName()
+	})
+	grades, err := s.db.GetCourseGrades(courseID)
+	if err != nil {
+		return err
+	}
+	// grades are ordered by submission; later submissions replace earlier ones
+	latestScores := make(map[uint64]map[uint64]uint32)
+	for _, grade := range grades {
+		if latestScores[grade.GetUserID()] == nil {
+			latestScores[grade.GetUserID()] = make(map[uint64]uint32)
+		}
+		latestScores[grade.GetUserID()][grade.GetAssignmentID()] = grade.GetScore()
+	}
+
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write([]string{"Name", "Login", "Email", "Status", "Group", "Score"}); err != nil {
+		return err
+	}
+	for _, enrollment := range enrollments {
+		user := enrollment.GetUser()
+		showAll := teacher || currentUser.IsOwner(user.GetID())
+		var email, score string
+		if showAll {
+			email = user.GetEmail()
+			if enrollment.GetStatus() == pb.Enrollment_STUDENT && len(assignments) > 0 {
+				var total uint32
+				for _, assignmentScore := range latestScores[user.GetID()] {
+					total += assignmentScore
+				}
+				score = strconv.Itoa(int(total) / len(assignments))
+			}
+		}
+		row := []string{user.GetName(), user.GetLogin(), email, enrollment.GetStatus().String(), enrollment.GetGroup().GetName(), score}
+		if err := csvWriter.Write(row); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
@@ -16,6 +16,9 @@ import (
 var (
 	ErrGroupNameDuplicate = status.Errorf(codes.AlreadyExists, "group with this name already exists. Please choose another name")
 	ErrUserNotInGroup     = status.Errorf(codes.NotFound, "user is not in group")
+	ErrGroupsDisabled     = status.Errorf(codes.FailedPrecondition, "group work is disabled for this course")
+	ErrGroupFull          = status.Errorf(codes.FailedPrecondition, "group has reached the maximum group size")
+	ErrLastGroupMember    = status.Errorf(codes.FailedPrecondition, "cannot remove the last member of a group; delete the group instead")
 )
 
 // getGroup returns the group for the given group ID.
@@ -78,6 +81,13 @@ func (s *AutograderService) deleteGroup(ctx context.Context, sc scm.SCM, request
 // a group, which will later be (optionally) edited and approved
 // by a teacher of the course using the updateGroup function below.
 func (s *AutograderService) createGroup(request *pb.Group) (*pb.Group, error) {
+	course, err := s.getCourse(request.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	if course.HasFeature(pb.Course_GROUPS_DISABLED) {
+		return nil, ErrGroupsDisabled
+	}
 	if !s.isValidGroupName(request.GetCourseID(), request.GetName()) {
 		return nil, ErrGroupNameDuplicate
 	}
@@ -138,26 +148,23 @@ func (s *AutograderService) updateGroup(ctx context.Context, sc scm.SCM, request
 			// update group name only if team not already created on SCM
 			newGroup.Name = request.Name
 		}
-		repo, team, err := createRepoAndTeam(ctx, sc, course, newGroup)
+		team, err := s.provisionGroup(ctx, sc, course, newGroup)
 		if err != nil {
 			return err
 		}
-		s.logger.Debugf("Creating group repo in the database: %+v", repo)
-		if err := s.db.CreateRepository(repo); err != nil {
-			return err
-		}
 		newGroup.TeamID = team.ID
 		// when updating a group for an existing team, name changes are not allowed.
 		// this to avoid a mismatch between database group name and SCM team name
-		s.logger.Debugf("updateGroup: SCM team name: %s, requested group name: %s", team.Name, request.Name)
+		s.scmLogger("updateGroup", course.GetID(), 0).Debugf("updateGroup: SCM team name: %s, requested group name: %s", team.Name, request.Name)
 		if team.Name != request.Name {
 			newGroup.Name = team.Name
 		}
 	}
 
-	// if there are changes in group membership, update SCM team
-	if !group.ContainsAll(newGroup) {
-		if err := updateGroupTeam(ctx, sc, newGroup, course.GetOrganizationID()); err != nil {
+	// if there are changes in group membership of an existing team, update SCM team;
+	// a newly created team already contains all group members
+	if len(repos) > 0 && !group.ContainsAll(newGroup) {
+		if err := updateGroupTeam(ctx, sc, group, newGroup, course.GetOrganizationID(), repos); err != nil {
 			return err
 		}
 	}
@@ -167,6 +174,117 @@ func (s *AutograderService) updateGroup(ctx context.Context, sc scm.SCM, request
 	return s.db.UpdateGroup(newGroup)
 }
 
+// reassignEnrollmentGroup moves the user with the given ID to the group with the given ID in
+// the given course. If the new group has a team on the SCM, the user is added to it, gaining
+// access to the group's repository, and if the user's former group has a team, the user is
+// removed from it. The user cannot leave a group without members, nor join a group that has
+// reached the course's group size limit. A user without a group simply joins the new group.
+func (s *AutograderService) reassignEnrollmentGroup(ctx context.Context, sc scm.SCM, courseID, userID, newGroupID uint64) error {
+	course, err := s.getCourse(courseID)
+	if err != nil {
+		return err
+	}
+	if course.HasFeature(pb.Course_GROUPS_DISABLED) {
+		return ErrGroupsDisabled
+	}
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(courseID, userID)
+	if err != nil {
+		return err
+	}
+	if !enrollment.HasRepoAccess() {
+		return status.Errorf(codes.FailedPrecondition, "user is not enrolled in the course")
+	}
+	if enrollment.GetGroupID() == newGroupID {
+		return nil
+	}
+	newGroup, err := s.db.GetGroup(newGroupID)
+	if err != nil {
+		return err
+	}
+	if newGroup.GetCourseID() != courseID {
+		return status.Errorf(codes.NotFound, "group %d not found in course %d", newGroupID, courseID)
+	}
+	if course.IsGroupFull(uint32(len(newGroup.GetUsers()))) {
+		return ErrGroupFull
+	}
+	var oldGroup *pb.Group
+	if enrollment.GetGroupID() > 0 {
+		if oldGroup, err = s.db.GetGroup(enrollment.GetGroupID()); err != nil {
+			return err
+		}
+		if len(oldGroup.GetUsers()) < 2 {
+			return ErrLastGroupMember
+		}
+	}
+
+	user := enrollment.GetUser()
+	sc = s.auditSCM(sc, course, user.GetLogin())
+	// the user joins the new team before leaving the old one, so that a failure
+	// does not leave the user without access to any group repository
+	newMembers := append(append([]*pb.User{}, newGroup.GetUsers()...), user)
+	if err := s.updateGroupMembers(ctx, sc, course, newGroup, newMembers); err != nil {
+		return err
+	}
+	if oldGroup != nil {
+		var oldMembers []*pb.User
+		for _, member := range oldGroup.GetUsers() {
+			if member.GetID() != userID {
+				oldMembers = append(oldMembers, member)
+			}
+		}
+		if err := s.updateGroupMembers(ctx, sc, course, oldGroup, oldMembers); err != nil {
+			return err
+		}
+	}
+	return s.db.UpdateEnrollmentGroup(courseID, userID, newGroupID)
+}
+
+// updateGroupMembers changes the members of the given group's SCM team to the given users.
+// Groups without a team, i.e., groups that have not been approved yet, are left unchanged.
+func (s *AutograderService) updateGroupMembers(ctx context.Context, sc scm.SCM, course *pb.Course, group *pb.Group, users []*pb.User) error {
+	if group.GetTeamID() == 0 {
+		return nil
+	}
+	repos, err := s.db.GetRepositories(&pb.Repository{
+		OrganizationID: course.GetOrganizationID(),
+		GroupID:        group.GetID(),
+		RepoType:       pb.Repository_GROUP,
+	})
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return err
+	}
+	newGroup := &pb.Group{ID: group.GetID(), TeamID: group.GetTeamID(), Users: users}
+	return updateGroupTeam(ctx, sc, group, newGroup, course.GetOrganizationID(), repos)
+}
+
+// provisionGroup creates a shared repository and team for the given group on the SCM,
+// with all group members added to the team, and stores the group repository in the database.
+// Group members that are not yet members of the course organization are added to it first.
+func (s *AutograderService) provisionGroup(ctx context.Context, sc scm.SCM, course *pb.Course, group *pb.Group) (*scm.Team, error) {
+	sc = s.auditSCM(sc, course, group.GetName())
+	if course.GetOrganizationPath() == "" {
+		org, err := sc.GetOrganization(ctx, &scm.GetOrgOptions{ID: course.GetOrganizationID()})
+		if err != nil {
+			return nil, fmt.Errorf("provisionGroup: organization not found: %w", err)
+		}
+		course.OrganizationPath = org.GetPath()
+	}
+	org := &pb.Organization{ID: course.GetOrganizationID(), Path: course.GetOrganizationPath()}
+	if err := addMissingOrgMembers(ctx, sc, org, group.UserNames()); err != nil {
+		return nil, err
+	}
+
+	repo, team, err := createRepoAndTeam(ctx, sc, course, group)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Debugf("Creating group repo in the database: %+v", repo)
+	if err := s.db.CreateRepository(repo); err != nil {
+		return nil, err
+	}
+	return team, nil
+}
+
 // getGroupUsers returns the users of the specified group request, and checks
 // that the group's users are enrolled in the course,
 // that the enrollment has been accepted, and
@@ -189,7 +307,7 @@ func (s *AutograderService) getGroupUsers(request *pb.Group) ([]*pb.User, error)
 		case enrollment.GroupID > 0 && enrollment.GroupID != request.ID:
 			// update group check (request group ID should be non-0)
 			return nil, status.Errorf(codes.InvalidArgument, "user already enrolled in another group")
-		case enrollment.Status < pb.Enrollment_STUDENT:
+		case !enrollment.HasRepoAccess():
 			return nil, status.Errorf(codes.InvalidArgument, "user not yet accepted for this course")
 		}
 		userIds = append(userIds, user.ID)
@@ -2,16 +2,21 @@ package web_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/scm"
 	"github.com/autograde/quickfeed/web"
+	"github.com/autograde/quickfeed/web/auth"
 	_ "github.com/mattn/go-sqlite3"
 )
 
@@ -70,6 +75,55 @@ func TestNewGroup(t *testing.T) {
 	}
 }
 
+func TestNewGroupDisabledForCourse(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	admin := createFakeUser(t, db, 1)
+	var course pb.Course
+	course.Provider = "fake"
+	course.OrganizationID = 1
+	if err := db.CreateCourse(admin.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	user := createFakeUser(t, db, 2)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{
+		UserID:   user.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_STUDENT,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	teacherCtx := withUserContext(context.Background(), admin)
+	ctx := withUserContext(context.Background(), user)
+	disable := &pb.CourseFeatureRequest{CourseID: course.ID, Feature: pb.Course_GROUPS_DISABLED, Enabled: true}
+	if _, err := ags.SetCourseFeature(ctx, disable); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("SetCourseFeature() by student = %v, want PermissionDenied", err)
+	}
+	if _, err := ags.SetCourseFeature(teacherCtx, disable); err != nil {
+		t.Fatal(err)
+	}
+
+	group := &pb.Group{Name: "group", CourseID: course.ID, Users: []*pb.User{{ID: user.ID}}}
+	if _, err := ags.CreateGroup(ctx, group); err != web.ErrGroupsDisabled {
+		t.Errorf("have error %v want %v", err, web.ErrGroupsDisabled)
+	}
+
+	// enable group work again
+	if _, err := ags.SetCourseFeature(teacherCtx, &pb.CourseFeatureRequest{CourseID: course.ID, Feature: pb.Course_GROUPS_DISABLED}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.CreateGroup(ctx, group); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestCreateGroupWithMissingFields(t *testing.T) {
 	db, cleanup := setup(t)
 	defer cleanup()
@@ -939,3 +993,351 @@ func TestGetGroups(t *testing.T) {
 		t.Errorf("mismatch (-wantGroups +gotGroups):\n%s", diff)
 	}
 }
+
+func TestProvisionGroup(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	admin := createFakeUser(t, db, 1)
+	course := pb.Course{OrganizationID: 1, OrganizationPath: "test-org"}
+	if err := db.CreateCourse(admin.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	var users []*pb.User
+	for i, login := range []string{"Member", "outsider"} {
+		user := createFakeUser(t, db, uint64(i+2))
+		user.Login = login
+		if err := db.UpdateUser(user); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+		users = append(users, user)
+	}
+	group := &pb.Group{Name: "group1", CourseID: course.ID, Users: users}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+
+	mockSCM := scm.NewMockSCMClient()
+	mockSCM.ListOrganizationMembersFunc = func(context.Context, *pb.Organization) ([]*scm.OrganizationMember, error) {
+		return []*scm.OrganizationMember{{ID: 1, Login: "admin"}, {ID: 2, Login: "member"}}, nil
+	}
+	var added []string
+	mockSCM.UpdateOrgMembershipFunc = func(_ context.Context, opt *scm.OrgMembershipOptions) error {
+		added = append(added, opt.Username)
+		return nil
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+
+	team, err := ags.ProvisionGroup(context.Background(), mockSCM, &course, group)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if team.Name != group.Name {
+		t.Errorf("have team name %q want %q", team.Name, group.Name)
+	}
+	if diff := cmp.Diff([]string{"outsider"}, added); diff != "" {
+		t.Errorf("mismatch in users added to organization (-want +got):\n%s", diff)
+	}
+	wantMethods := []string{"ListOrganizationMembers", "UpdateOrgMembership", "CreateRepository", "ProtectBranch", "CreateTeam", "AddTeamRepo"}
+	if diff := cmp.Diff(wantMethods, mockSCM.Methods()); diff != "" {
+		t.Errorf("mismatch in SCM calls (-want +got):\n%s", diff)
+	}
+	for _, call := range mockSCM.Calls() {
+		if call.Method == "ProtectBranch" && (call.Args[1] != "master" || call.Args[2] != false) {
+			t.Errorf("have ProtectBranch(%v) want default branch protected against force pushes", call.Args)
+		}
+	}
+	repos, err := db.GetRepositories(&pb.Repository{GroupID: group.ID, RepoType: pb.Repository_GROUP})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 1 || repos[0].GetOrganizationID() != course.OrganizationID {
+		t.Errorf("have group repositories %+v want one repository in organization %d", repos, course.OrganizationID)
+	}
+}
+
+func TestUpdateGroupTeamMembership(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	fakeGothProvider()
+
+	teacher := createFakeUser(t, db, 1)
+	course := *allCourses[0]
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	var students []*pb.User
+	for i, login := range []string{"stays", "leaves", "joins"} {
+		student := createFakeUser(t, db, uint64(i+2))
+		student.Login = login
+		if err := db.UpdateUser(student); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+		students = append(students, student)
+	}
+
+	mockSCM, scms := mockProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), teacher)
+
+	// approved group with an existing team and repository
+	group := &pb.Group{Name: "group1", CourseID: course.ID, TeamID: 1, Status: pb.Group_APPROVED, Users: students[:2]}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	repo, err := mockSCM.CreateRepository(ctx, &scm.CreateRepositoryOptions{
+		Organization: &pb.Organization{ID: course.OrganizationID, Path: "org"},
+		Path:         group.Name,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateRepository(&pb.Repository{
+		OrganizationID: course.OrganizationID,
+		RepositoryID:   repo.ID,
+		GroupID:        group.ID,
+		RepoType:       pb.Repository_GROUP,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	mockSCM.Reset()
+
+	var added, removed, revoked []string
+	mockSCM.AddTeamMemberFunc = func(_ context.Context, opt *scm.TeamMembershipOptions) error {
+		added = append(added, opt.Username)
+		return nil
+	}
+	mockSCM.RemoveTeamMemberFunc = func(_ context.Context, opt *scm.TeamMembershipOptions) error {
+		removed = append(removed, opt.Username)
+		return nil
+	}
+	mockSCM.RevokeRepoAccessFunc = func(_ context.Context, _ *scm.Repository, user string) error {
+		revoked = append(revoked, user)
+		return nil
+	}
+
+	if _, err := ags.UpdateGroup(ctx, &pb.Group{
+		ID:       group.ID,
+		Name:     group.Name,
+		CourseID: course.ID,
+		Users:    []*pb.User{students[0], students[2]},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	wantMethods := []string{"AddTeamMember", "RemoveTeamMember", "GetRepository", "RevokeRepoAccess"}
+	if diff := cmp.Diff(wantMethods, mockSCM.Methods()); diff != "" {
+		t.Errorf("mismatch in SCM calls (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"joins"}, added); diff != "" {
+		t.Errorf("mismatch in added team members (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"leaves"}, removed); diff != "" {
+		t.Errorf("mismatch in removed team members (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"leaves"}, revoked); diff != "" {
+		t.Errorf("mismatch in revoked repository access (-want +got):\n%s", diff)
+	}
+}
+
+func TestReassignGroup(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	fakeGothProvider()
+
+	teacher := createFakeUser(t, db, 1)
+	course := *allCourses[0]
+	course.MaxGroupSize = 3
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	var students []*pb.User
+	for i, login := range []string{"stays", "moves", "alone", "ungrouped"} {
+		student := createFakeUser(t, db, uint64(i+2))
+		student.Login = login
+		if err := db.UpdateUser(student); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+		students = append(students, student)
+	}
+
+	mockSCM, scms := mockProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), teacher)
+
+	// approved groups with existing teams and repositories
+	group1 := &pb.Group{Name: "group1", CourseID: course.ID, TeamID: 1, Status: pb.Group_APPROVED, Users: students[:2]}
+	group2 := &pb.Group{Name: "group2", CourseID: course.ID, TeamID: 2, Status: pb.Group_APPROVED, Users: students[2:3]}
+	for _, group := range []*pb.Group{group1, group2} {
+		if err := db.CreateGroup(group); err != nil {
+			t.Fatal(err)
+		}
+		repo, err := mockSCM.CreateRepository(ctx, &scm.CreateRepositoryOptions{
+			Organization: &pb.Organization{ID: course.OrganizationID, Path: "org"},
+			Path:         group.Name,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateRepository(&pb.Repository{
+			OrganizationID: course.OrganizationID,
+			RepositoryID:   repo.ID,
+			GroupID:        group.ID,
+			RepoType:       pb.Repository_GROUP,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var added, removed, revoked []string
+	mockSCM.AddTeamMemberFunc = func(_ context.Context, opt *scm.TeamMembershipOptions) error {
+		added = append(added, fmt.Sprintf("%s:%d", opt.Username, opt.TeamID))
+		return nil
+	}
+	mockSCM.RemoveTeamMemberFunc = func(_ context.Context, opt *scm.TeamMembershipOptions) error {
+		removed = append(removed, fmt.Sprintf("%s:%d", opt.Username, opt.TeamID))
+		return nil
+	}
+	mockSCM.RevokeRepoAccessFunc = func(_ context.Context, repo *scm.Repository, user string) error {
+		revoked = append(revoked, fmt.Sprintf("%s:%s", user, repo.Path))
+		return nil
+	}
+
+	// the only member of a group cannot leave it
+	if _, err := ags.ReassignGroup(ctx, &pb.GroupRequest{CourseID: course.ID, UserID: students[2].ID, GroupID: group1.ID}); !errors.Is(err, web.ErrLastGroupMember) {
+		t.Errorf("ReassignGroup(alone) = %v, want %v", err, web.ErrLastGroupMember)
+	}
+
+	if _, err := ags.ReassignGroup(ctx, &pb.GroupRequest{CourseID: course.ID, UserID: students[1].ID, GroupID: group2.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"moves:2"}, added); diff != "" {
+		t.Errorf("mismatch in added team members (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"moves:1"}, removed); diff != "" {
+		t.Errorf("mismatch in removed team members (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"moves:group1"}, revoked); diff != "" {
+		t.Errorf("mismatch in revoked repository access (-want +got):\n%s", diff)
+	}
+
+	// a student without a group only joins the new group
+	added, removed = nil, nil
+	if _, err := ags.ReassignGroup(ctx, &pb.GroupRequest{CourseID: course.ID, UserID: students[3].ID, GroupID: group2.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"ungrouped:2"}, added); diff != "" {
+		t.Errorf("mismatch in added team members (-want +got):\n%s", diff)
+	}
+	if len(removed) > 0 {
+		t.Errorf("have removed team members %v, want none", removed)
+	}
+
+	// group2 has reached the maximum group size
+	if _, err := ags.ReassignGroup(ctx, &pb.GroupRequest{CourseID: course.ID, UserID: students[0].ID, GroupID: group2.ID}); !errors.Is(err, web.ErrGroupFull) {
+		t.Errorf("ReassignGroup(stays) = %v, want %v", err, web.ErrGroupFull)
+	}
+
+	wantGroups := map[uint64][]uint64{
+		group1.ID: {students[0].ID},
+		group2.ID: {students[1].ID, students[2].ID, students[3].ID},
+	}
+	for groupID, wantMembers := range wantGroups {
+		group, err := db.GetGroup(groupID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var members []uint64
+		for _, user := range group.GetUsers() {
+			members = append(members, user.GetID())
+		}
+		if diff := cmp.Diff(wantMembers, members); diff != "" {
+			t.Errorf("mismatch in members of group %d (-want +got):\n%s", groupID, diff)
+		}
+	}
+}
+
+func TestDeleteGroupWithDeletedRepo(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	admin := createFakeUser(t, db, 1)
+	course := *allCourses[0]
+	if err := db.CreateCourse(admin.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+
+	fakeProvider, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), admin)
+	if _, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{
+		Name: course.Code,
+		Path: course.Code,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var users []*pb.User
+	for _, remoteID := range []uint64{2, 3} {
+		user := createFakeUser(t, db, remoteID)
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+		users = append(users, user)
+	}
+	group := &pb.Group{Name: "Test Group", CourseID: course.ID, Users: users}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	// approving the group creates the group repository and team
+	if _, err := ags.UpdateGroup(ctx, group); err != nil {
+		t.Fatal(err)
+	}
+	repos, err := db.GetRepositories(&pb.Repository{GroupID: group.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 1 {
+		t.Fatalf("have %d group repositories want 1", len(repos))
+	}
+
+	// the repository is removed on the SCM before the group is deleted
+	if err := fakeProvider.DeleteRepository(ctx, &scm.RepositoryOptions{ID: repos[0].GetRepositoryID()}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.DeleteGroup(ctx, &pb.GroupRequest{CourseID: course.ID, GroupID: group.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.GetGroup(group.ID); err == nil {
+		t.Error("expected group to be deleted")
+	}
+	if _, err := db.GetRepositoryByRemoteID(repos[0].GetRepositoryID()); err == nil {
+		t.Error("expected group repository record to be deleted")
+	}
+	if teams, _ := fakeProvider.GetTeams(ctx, &pb.Organization{Path: course.Code}); len(teams) != 0 {
+		t.Errorf("have teams %+v want none", teams)
+	}
+}
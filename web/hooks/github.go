@@ -1,7 +1,6 @@
 package hooks
 
 import (
-	"encoding/json"
 	"net/http"
 	"strings"
 	"time"
@@ -15,6 +14,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// branchRefPrefix is the prefix of the git references of branches in push events.
+const branchRefPrefix = "refs/heads/"
+
 // GitHubWebHook holds references and data for handling webhook events.
 type GitHubWebHook struct {
 	logger *zap.SugaredLogger
@@ -34,20 +36,23 @@ func NewGitHubWebHook(logger *zap.SugaredLogger, db database.Database, runner ci
 func (wh GitHubWebHook) Handle(w http.ResponseWriter, r *http.Request) {
 	payload, err := github.ValidatePayload(r, []byte(wh.secret))
 	if err != nil {
-		wh.logger.Errorf("Error in request body: %w", err)
+		wh.logger.Errorf("Error in request body: %v", err)
 		return
 	}
 	defer r.Body.Close()
 
 	event, err := github.ParseWebHook(github.WebHookType(r), payload)
 	if err != nil {
-		wh.logger.Errorf("Could not parse github webhook: %w", err)
+		wh.logger.Errorf("Could not parse github webhook: %v", err)
 		return
 	}
 	switch e := event.(type) {
 	case *github.PushEvent:
 		wh.logger.Debug(log.IndentJson(e))
 		wh.handlePush(e)
+	case *github.PullRequestEvent:
+		wh.logger.Debug(log.IndentJson(e))
+		wh.handlePullRequest(e)
 	default:
 		wh.logger.Debugf("Ignored event type %s", github.WebHookType(r))
 	}
@@ -56,30 +61,57 @@ func (wh GitHubWebHook) Handle(w http.ResponseWriter, r *http.Request) {
 func (wh GitHubWebHook) handlePush(payload *github.PushEvent) {
 	wh.logger.Debugf("Received push event for branch reference: %s (user's default branch: %s)",
 		payload.GetRef(), payload.GetRepo().GetDefaultBranch())
-	if !strings.HasSuffix(payload.GetRef(), payload.GetRepo().GetDefaultBranch()) {
-		wh.logger.Debugf("Ignoring push event for non-default branch: %s", payload.GetRef())
+	if !strings.HasPrefix(payload.GetRef(), branchRefPrefix) {
+		wh.logger.Debugf("Ignoring push event for non-branch reference: %s", payload.GetRef())
 		return
 	}
+	branch := strings.TrimPrefix(payload.GetRef(), branchRefPrefix)
 
 	repo, err := wh.db.GetRepositoryByRemoteID(uint64(payload.GetRepo().GetID()))
 	if err != nil {
-		wh.logger.Errorf("Failed to get repository from database: %w", err)
+		wh.logger.Errorf("Failed to get repository from database: %v", err)
 		return
 	}
 	wh.logger.Debugf("Received push event for repository %v", repo)
 
 	course, err := wh.db.GetCourseByOrganizationID(repo.OrganizationID)
 	if err != nil {
-		wh.logger.Errorf("Failed to get course from database: %w", err)
+		wh.logger.Errorf("Failed to get course from database: %v", err)
 		return
 	}
 	wh.logger.Debugf("For course(%d)=%v", course.GetID(), course.GetName())
 
+	defaultBranch := payload.GetRepo().GetDefaultBranch()
+	if repo.IsStudentRepo() {
+		if !course.GradesBranch(branch, defaultBranch) {
+			wh.logger.Debugf("Ignoring push event for branch %s of student repo %s: branch is not graded in course %s",
+				branch, payload.GetRepo().GetName(), course.GetName())
+			return
+		}
+	} else if branch != defaultBranch {
+		wh.logger.Debugf("Ignoring push event for non-default branch: %s", payload.GetRef())
+		return
+	}
+
 	switch {
 	case repo.IsTestsRepo():
 		// the push event is for the 'tests' repo, which means that we
 		// should update the course data (assignments) in the database
 		assignments.UpdateFromTestsRepo(wh.logger, wh.db, repo, course)
+		// submissions graded before this push predate the new tests
+		version, err := wh.db.BumpGradingConfigVersion(course.GetID())
+		if err != nil {
+			wh.logger.Errorf("Failed to update grading configuration version of course %s: %s", course.GetName(), err)
+			return
+		}
+		wh.logger.Debugf("Course %s grading configuration version is now %d", course.GetName(), version)
+
+	case repo.IsStudentRepo() && course.HasFeature(pb.Course_PULL_REQUEST_SUBMISSIONS):
+		// submissions are created from pull requests for this course
+		wh.logger.Debugf("Ignoring push event for student repo %s: course accepts pull request submissions only", payload.GetRepo().GetName())
+
+	case repo.IsStudentRepo() && !wh.acceptsSubmissions(course):
+		// the push is outside the course's start and end dates
 
 	case repo.IsUserRepo():
 		wh.logger.Debugf("Processing push event for user repo %s", payload.GetRepo().GetName())
@@ -117,6 +149,84 @@ func (wh GitHubWebHook) handlePush(payload *github.PushEvent) {
 	}
 }
 
+// handlePullRequest runs the tests for the assignment named by the source branch
+// of an opened or updated pull request, if the course accepts pull request submissions.
+// The head commit of the pull request is tested and recorded as the submission's commit;
+// like pushes, submissions for manually reviewed assignments are recorded without tests.
+func (wh GitHubWebHook) handlePullRequest(payload *github.PullRequestEvent) {
+	switch payload.GetAction() {
+	case "opened", "reopened", "synchronize":
+	default:
+		wh.logger.Debugf("Ignoring pull request event with action: %s", payload.GetAction())
+		return
+	}
+
+	repo, err := wh.db.GetRepositoryByRemoteID(uint64(payload.GetRepo().GetID()))
+	if err != nil {
+		wh.logger.Errorf("Failed to get repository from database: %v", err)
+		return
+	}
+	if !repo.IsStudentRepo() {
+		wh.logger.Debugf("Ignoring pull request event for non-student repo %s", payload.GetRepo().GetName())
+		return
+	}
+	course, err := wh.db.GetCourseByOrganizationID(repo.OrganizationID)
+	if err != nil {
+		wh.logger.Errorf("Failed to get course from database: %v", err)
+		return
+	}
+	if !course.HasFeature(pb.Course_PULL_REQUEST_SUBMISSIONS) {
+		wh.logger.Debugf("Ignoring pull request event: course %s does not accept pull request submissions", course.GetName())
+		return
+	}
+	if !wh.acceptsSubmissions(course) {
+		return
+	}
+
+	head := payload.GetPullRequest().GetHead()
+	assignments, err := wh.db.GetAssignmentsByCourse(course.GetID(), false)
+	if err != nil {
+		wh.logger.Errorf("Failed to get assignments for course %s: %v", course.GetName(), err)
+		return
+	}
+	for _, assignment := range assignments {
+		if assignment.MatchesBranch(head.GetRef()) && assignment.IsGroupLab == repo.IsGroupRepo() {
+			runData := &ci.RunData{
+				Course:     course,
+				Assignment: assignment,
+				Repo:       repo,
+				CommitID:   head.GetSHA(),
+				JobOwner:   payload.GetSender().GetLogin(),
+				Checkout:   head.GetSHA(),
+			}
+			if assignment.GetSkipTests() {
+				wh.logger.Debugf("Assignment %s for course %s is manually reviewed", assignment.GetName(), course.GetName())
+				ci.RecordWithoutTests(wh.logger, wh.db, runData)
+				return
+			}
+			ci.RunTests(wh.logger, wh.db, wh.runner, runData)
+			return
+		}
+	}
+	wh.logger.Debugf("Ignoring pull request from branch %s: no matching assignment", head.GetRef())
+}
+
+// acceptsSubmissions returns true if the course accepts submissions at this time.
+// Submissions outside the course's start and end dates are not graded; teachers
+// can still grade such submissions by rebuilding them.
+func (wh GitHubWebHook) acceptsSubmissions(course *pb.Course) bool {
+	ok, err := course.AcceptsSubmissionsAt(time.Now())
+	if err != nil {
+		// accept the submission rather than lose it because of invalid course dates
+		wh.logger.Errorf("Failed to check start and end dates for course %s: %v", course.GetName(), err)
+		return true
+	}
+	if !ok {
+		wh.logger.Debugf("Ignoring submission for course %s: outside course dates (%s - %s)", course.GetName(), course.GetStartDate(), course.GetEndDate())
+	}
+	return ok
+}
+
 // extractAssignments extracts information from the push payload from github
 // and determines the assignments that have been changed in this commit by
 // querying the database based on the lab name.
@@ -169,40 +279,12 @@ func (wh GitHubWebHook) runAssignmentTests(assignment *pb.Assignment, repo *pb.R
 	}
 	if assignment.SkipTests {
 		wh.logger.Debugf("Assignment %s for course %s is manually reviewed", assignment.Name, course.Name)
-		wh.recordSubmissionWithoutTests(runData)
+		ci.RecordWithoutTests(wh.logger, wh.db, runData)
 		return
 	}
 	ci.RunTests(wh.logger, wh.db, wh.runner, runData)
 }
 
-// recordSubmissionWithoutTests saves a new submission without running any tests
-// for a manually graded assignment.
-func (wh GitHubWebHook) recordSubmissionWithoutTests(data *ci.RunData) {
-	noTestBuildInfo, err := json.Marshal(&ci.BuildInfo{
-		BuildID:   0,
-		BuildDate: time.Now().Format("2006-01-02T15:04:05"),
-		BuildLog:  "No automated tests for this assignment",
-		ExecTime:  1,
-	})
-	if err != nil {
-		wh.logger.Errorf("Error marshalling build info for %s of course %s for student %s: %s", data.Course.Name, data.Assignment.Name, data.JobOwner, err)
-		return
-	}
-	newSubmission := &pb.Submission{
-		AssignmentID: data.Assignment.ID,
-		BuildInfo:    string(noTestBuildInfo),
-		CommitHash:   data.CommitID,
-		UserID:       data.Repo.UserID,
-		GroupID:      data.Repo.GroupID,
-	}
-	if err := wh.db.CreateSubmission(newSubmission); err != nil {
-		wh.logger.Errorf("Failed to save submission for user ID %s for assignment ID %d: %s", data.JobOwner, data.Assignment.ID, err)
-		return
-	}
-	wh.logger.Debugf("Saved manual review submission for user %s for assignment %d", data.JobOwner, data.Assignment.ID)
-
-}
-
 // updateLastActivityDate sets a current date as a last activity date of the student
 // on each new push to the student repository.
 func (wh GitHubWebHook) updateLastActivityDate(userID, courseID uint64) {
@@ -0,0 +1,74 @@
+package web
+
+import (
+	"time"
+
+	"github.com/autograde/quickfeed/ci"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// SubmissionQueueMetrics returns prometheus metrics reporting the number of
+// submissions waiting in the build queue and the age of the longest waiting one.
+// The metrics are computed from the build queue when scraped.
+func SubmissionQueueMetrics() []prometheus.Collector {
+	return []prometheus.Collector{
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "ag_submission_queue_depth",
+			Help: "Number of submissions queued for building.",
+		}, func() float64 {
+			depth, _ := ci.QueueStats()
+			return float64(depth)
+		}),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "ag_submission_queue_oldest_age_seconds",
+			Help: "Age of the oldest submission queued for building.",
+		}, func() float64 {
+			depth, oldest := ci.QueueStats()
+			if depth == 0 {
+				return 0
+			}
+			return time.Since(oldest).Seconds()
+		}),
+	}
+}
+
+// SCMMetrics exports calls to SCM clients as prometheus metrics.
+// It implements the scm.Metrics interface; use scm.SetMetrics to enable it.
+type SCMMetrics struct {
+	calls    *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// NewSCMMetrics returns SCM call metrics labeled by SCM method.
+func NewSCMMetrics() *SCMMetrics {
+	return &SCMMetrics{
+		calls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "ag_scm_calls_total",
+			Help: "Number of calls to SCM methods.",
+		}, []string{"method"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "ag_scm_call_errors_total",
+			Help: "Number of calls to SCM methods that returned an error.",
+		}, []string{"method"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "ag_scm_call_duration_seconds",
+			Help:    "Duration of calls to SCM methods.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method"}),
+	}
+}
+
+// ObserveSCMCall implements the scm.Metrics interface.
+func (m *SCMMetrics) ObserveSCMCall(method string, duration time.Duration, err error) {
+	m.calls.WithLabelValues(method).Inc()
+	if err != nil {
+		m.errors.WithLabelValues(method).Inc()
+	}
+	m.duration.WithLabelValues(method).Observe(duration.Seconds())
+}
+
+// Collectors returns the prometheus collectors for the SCM call metrics.
+func (m *SCMMetrics) Collectors() []prometheus.Collector {
+	return []prometheus.Collector{m.calls, m.errors, m.duration}
+}
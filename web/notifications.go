@@ -0,0 +1,51 @@
+package web
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"go.uber.org/zap"
+)
+
+// EnrollmentEvent is emitted when a student has been accepted into a course,
+// and the student's repository is ready to use.
+type EnrollmentEvent struct {
+	Course  *pb.Course
+	User    *pb.User
+	RepoURL string
+}
+
+// Notifier is notified about enrollment events.
+// Implementations should not block, since they are
+// called while handling the enrollment request.
+type Notifier interface {
+	StudentEnrolled(*EnrollmentEvent)
+}
+
+// logNotifier is the default notifier, which only logs enrollment events.
+type logNotifier struct {
+	logger *zap.SugaredLogger
+}
+
+// StudentEnrolled implements the Notifier interface.
+func (n *logNotifier) StudentEnrolled(event *EnrollmentEvent) {
+	n.logger.Infof("Student %s enrolled in course %s; repository ready at %s",
+		event.User.GetLogin(), event.Course.GetCode(), event.RepoURL)
+}
+
+// SetNotifier replaces the notifier receiving enrollment events.
+// Setting a nil notifier disables notifications.
+func (s *AutograderService) SetNotifier(notifier Notifier) {
+	s.notifier = notifier
+}
+
+// notifyStudentEnrolled notifies that the given student has been enrolled in the course.
+// Must only be called after the enrollment has been stored in the database.
+func (s *AutograderService) notifyStudentEnrolled(course *pb.Course, user *pb.User, repoURL string) {
+	if s.notifier == nil {
+		return
+	}
+	s.notifier.StudentEnrolled(&EnrollmentEvent{
+		Course:  course,
+		User:    user,
+		RepoURL: repoURL,
+	})
+}
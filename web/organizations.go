@@ -21,8 +21,8 @@ func (s *AutograderService) getOrganization(ctx context.Context, sc scm.SCM, org
 	if err != nil {
 		return nil, err
 	}
-	if isDirty(repos) {
-		return nil, ErrAlreadyExists
+	if err := s.checkCourseRepos(gitOrg, repos); err != nil {
+		return nil, err
 	}
 	return gitOrg, nil
 }
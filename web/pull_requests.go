@@ -0,0 +1,94 @@
+package web
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/jinzhu/gorm"
+)
+
+// ErrPullRequestsDisabled is returned when submitting pull requests for
+// a course that does not accept pull request submissions.
+var ErrPullRequestsDisabled = errors.New("course does not accept pull request submissions")
+
+// submitPullRequests creates submissions from the open pull requests on the student
+// and group repositories of the given course. A pull request is submitted for the
+// assignment named by its source branch, and its head commit is tested and recorded
+// as the submission's commit, unless the assignment is reviewed manually, in which
+// case the commit is recorded without tests. Pull requests whose head commit has already been
+// submitted are skipped, making it safe to call repeatedly.
+func (s *AutograderService) submitPullRequests(ctx context.Context, sc scm.SCM, courseID uint64) error {
+	course, err := s.getCourse(courseID)
+	if err != nil {
+		return err
+	}
+	if !course.HasFeature(pb.Course_PULL_REQUEST_SUBMISSIONS) {
+		return ErrPullRequestsDisabled
+	}
+	assignments, err := s.db.GetAssignmentsByCourse(courseID, false)
+	if err != nil {
+		return err
+	}
+	repos, err := s.db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
+	if err != nil {
+		return err
+	}
+
+	for _, repo := range repos {
+		if !repo.IsStudentRepo() {
+			continue
+		}
+		pullRequests, err := sc.ListPullRequests(ctx, &scm.RepositoryOptions{ID: repo.GetRepositoryID()})
+		if err != nil {
+			return err
+		}
+		for _, pr := range pullRequests {
+			assignment := pullRequestAssignment(assignments, repo, pr)
+			if assignment == nil {
+				continue
+			}
+			latest, err := s.db.GetSubmission(&pb.Submission{
+				AssignmentID: assignment.GetID(),
+				UserID:       repo.GetUserID(),
+				GroupID:      repo.GetGroupID(),
+			})
+			if err != nil && err != gorm.ErrRecordNotFound {
+				return err
+			}
+			if latest.GetCommitHash() == pr.SHA {
+				// already submitted
+				continue
+			}
+			s.scmLogger("submitPullRequests", course.GetID(), repo.GetUserID()).Debugf("Submitting pull request %d (%s) on repository %d for assignment %s",
+				pr.ID, pr.SHA, repo.GetRepositoryID(), assignment.GetName())
+			runData := &ci.RunData{
+				Course:     course,
+				Assignment: assignment,
+				Repo:       repo,
+				CommitID:   pr.SHA,
+				JobOwner:   pr.Author,
+				Checkout:   pr.SHA,
+			}
+			if assignment.GetSkipTests() {
+				ci.RecordWithoutTests(s.logger, s.db, runData)
+			} else {
+				ci.RunTests(s.logger, s.db, s.runner, runData)
+			}
+		}
+	}
+	return nil
+}
+
+// pullRequestAssignment returns the assignment submitted with the given pull request
+// on the given repository, or nil if the pull request does not match an assignment.
+func pullRequestAssignment(assignments []*pb.Assignment, repo *pb.Repository, pr *scm.PullRequest) *pb.Assignment {
+	for _, assignment := range assignments {
+		if assignment.MatchesBranch(pr.SourceBranch) && assignment.IsGroupLab == repo.IsGroupRepo() {
+			return assignment
+		}
+	}
+	return nil
+}
@@ -9,6 +9,8 @@ import (
 )
 
 // rebuildSubmission rebuilds the given assignment and submission.
+// Submissions are rebuilt even if made outside the course's start and end dates,
+// and even if the student or group has no attempts left for the assignment.
 func (s *AutograderService) rebuildSubmission(ctx context.Context, request *pb.RebuildRequest) (*pb.Submission, error) {
 	submission, err := s.db.GetSubmission(&pb.Submission{ID: request.GetSubmissionID()})
 	if err != nil {
@@ -40,6 +42,8 @@ func (s *AutograderService) rebuildSubmission(ctx context.Context, request *pb.R
 		Repo:       repo,
 		CommitID:   submission.GetCommitHash(),
 		JobOwner:   slug.Make(name),
+		Rebuild:    true,
+		Priority:   ci.PriorityHigh,
 	}
 	ci.RunTests(s.logger, s.db, s.runner, runData)
 	return s.db.GetSubmission(&pb.Submission{ID: request.GetSubmissionID()})
@@ -0,0 +1,116 @@
+package web
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/gosimple/slug"
+)
+
+// replayMissedSubmissions grades the commits pushed to the student and group repositories
+// of the given course since the given time, whose push events were never processed,
+// e.g., because the server was down. Commits that already have a submission record are
+// skipped. For each assignment, only the most recent commit changing the assignment is
+// graded, and only if no more recent commit has been graded already. Returns the number
+// of submissions that were graded.
+func (s *AutograderService) replayMissedSubmissions(ctx context.Context, sc scm.SCM, courseID uint64, since time.Time) (int, error) {
+	course, err := s.getCourse(courseID)
+	if err != nil {
+		return 0, err
+	}
+	if course.HasFeature(pb.Course_PULL_REQUEST_SUBMISSIONS) {
+		// submissions are created from pull requests for this course, not from pushes
+		return 0, nil
+	}
+	assignments, err := s.db.GetAssignmentsByCourse(courseID, false)
+	if err != nil {
+		return 0, err
+	}
+	repos, err := s.db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
+	if err != nil {
+		return 0, err
+	}
+
+	replayed := 0
+	for _, repo := range repos {
+		if !repo.IsStudentRepo() {
+			continue
+		}
+		commits, err := sc.ListCommits(ctx, repo.GetRepositoryID(), since)
+		if err != nil {
+			return replayed, err
+		}
+		owner := &pb.Submission{UserID: repo.GetUserID(), GroupID: repo.GetGroupID()}
+		submissions, err := s.db.GetSubmissions(owner)
+		if err != nil {
+			return replayed, err
+		}
+		// the submission records are looked up before grading any commits,
+		// since grading a commit replaces the commit of the assignment's record
+		recorded := make(map[string][]uint64)
+		for _, submission := range submissions {
+			recorded[submission.GetCommitHash()] = append(recorded[submission.GetCommitHash()], submission.GetAssignmentID())
+		}
+		jobOwner := slug.Make(s.lookupName(owner))
+		// assignments with a more recent graded commit than the current commit
+		graded := make(map[uint64]bool)
+		for _, commit := range commits {
+			if assignmentIDs, ok := recorded[commit.SHA]; ok {
+				for _, id := range assignmentIDs {
+					graded[id] = true
+				}
+				continue
+			}
+			if ok, err := course.AcceptsSubmissionsAt(commit.Date); err == nil && !ok {
+				continue
+			}
+			// the commits are listed without the files they change
+			changes, err := sc.GetCommit(ctx, repo.GetRepositoryID(), commit.SHA)
+			if err != nil {
+				return replayed, err
+			}
+			for _, assignment := range changedAssignments(assignments, changes.Files) {
+				if graded[assignment.GetID()] || assignment.GetIsGroupLab() != repo.IsGroupRepo() {
+					continue
+				}
+				graded[assignment.GetID()] = true
+				s.scmLogger("replayMissedSubmissions", course.GetID(), repo.GetUserID()).Debugf("Replaying commit %s on repository %d for assignment %s",
+					commit.SHA, repo.GetRepositoryID(), assignment.GetName())
+				runData := &ci.RunData{
+					Course:     course,
+					Assignment: assignment,
+					Repo:       repo,
+					CommitID:   commit.SHA,
+					JobOwner:   jobOwner,
+					Checkout:   commit.SHA,
+				}
+				if assignment.GetSkipTests() {
+					ci.RecordWithoutTests(s.logger, s.db, runData)
+				} else {
+					ci.RunTests(s.logger, s.db, s.runner, runData)
+				}
+				replayed++
+			}
+		}
+	}
+	return replayed, nil
+}
+
+// changedAssignments returns the assignments with files among the given changed files.
+// Like for push events, the first component of a file's path names its assignment.
+func changedAssignments(assignments []*pb.Assignment, files []string) []*pb.Assignment {
+	var changed []*pb.Assignment
+	for _, assignment := range assignments {
+		for _, file := range files {
+			if strings.HasPrefix(file, assignment.GetName()+"/") {
+				changed = append(changed, assignment)
+				break
+			}
+		}
+	}
+	return changed
+}
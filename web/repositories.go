@@ -1,11 +1,20 @@
 package web
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
 )
 
+// repositoryTokenLifetime is how long a repository access token issued to a teacher is valid.
+const repositoryTokenLifetime = 24 * time.Hour
+
+// repositoryTokenScopes only allow pulling the repository.
+var repositoryTokenScopes = []string{"read_repository"}
+
 func (s *AutograderService) getUserRepo(course *pb.Course, userID uint64) (*pb.Repository, error) {
 	repoQuery := &pb.Repository{
 		OrganizationID: course.GetOrganizationID(),
@@ -31,3 +40,37 @@ func (s *AutograderService) getGroupRepo(course *pb.Course, groupID uint64) (*pb
 	}
 	return repos[0], nil
 }
+
+// createRepositoryAccessToken issues a time-limited token for the current user, a teacher,
+// to pull the repository of the given student or group in the given course. The issuance
+// is recorded in the course's SCM audit log.
+func (s *AutograderService) createRepositoryAccessToken(ctx context.Context, sc scm.SCM, currentUser *pb.User, request *pb.RepositoryRequest) (*pb.RepositoryAccessToken, error) {
+	course, err := s.getCourse(request.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	var repo *pb.Repository
+	switch {
+	case request.GetGroupID() > 0:
+		repo, err = s.getGroupRepo(course, request.GetGroupID())
+	case request.GetUserID() > 0:
+		repo, err = s.getUserRepo(course, request.GetUserID())
+	default:
+		return nil, fmt.Errorf("missing user or group ID")
+	}
+	if err != nil {
+		return nil, err
+	}
+	expiry := time.Now().Add(repositoryTokenLifetime)
+	token, err := s.auditSCM(sc, course, currentUser.GetLogin()).CreateRepositoryAccessToken(ctx, repo.GetRepositoryID(), repositoryTokenScopes, expiry)
+	if err != nil {
+		return nil, err
+	}
+	s.scmLogger("createRepositoryAccessToken", course.GetID(), currentUser.GetID()).Infof("Issued access token to %s for repository %s, expiring %s",
+		currentUser.GetLogin(), repo.GetHTMLURL(), token.ExpiresAt.Format(layout))
+	return &pb.RepositoryAccessToken{
+		Token:         token.Token,
+		ExpiresAt:     token.ExpiresAt.Format(layout),
+		RepositoryURL: repo.GetHTMLURL(),
+	}, nil
+}
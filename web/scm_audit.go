@@ -0,0 +1,174 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// auditedSCM wraps an SCM client, recording each operation that changes the SCM
+// in the course's SCM audit log. Read-only operations are passed through unrecorded.
+type auditedSCM struct {
+	scm.SCM
+	s      *AutograderService
+	course *pb.Course
+	user   string // login of the student, or name of the group, being provisioned, or of the teacher issuing a token
+}
+
+// auditSCM returns an SCM client that records the operations changing the SCM,
+// performed for the given student login or group name in the given course.
+func (s *AutograderService) auditSCM(sc scm.SCM, course *pb.Course, user string) scm.SCM {
+	return &auditedSCM{SCM: sc, s: s, course: course, user: user}
+}
+
+// record stores the outcome of an SCM operation in the audit log. Failing to record
+// the operation is logged, but does not fail the operation itself.
+func (a *auditedSCM) record(method, resource string, err error) {
+	entry := &pb.SCMAuditEntry{
+		CourseID:     a.course.GetID(),
+		Method:       method,
+		Organization: a.course.GetOrganizationPath(),
+		User:         a.user,
+		Resource:     resource,
+		Date:         time.Now().Format(layout),
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	if dbErr := a.s.db.CreateSCMAuditEntry(entry); dbErr != nil {
+		a.s.logger.Errorf("Failed to record %s of %s for %s in course %d: %v", method, resource, a.user, a.course.GetID(), dbErr)
+	}
+}
+
+// CreateRepository implements the SCM interface.
+func (a *auditedSCM) CreateRepository(ctx context.Context, opt *scm.CreateRepositoryOptions) (*scm.Repository, error) {
+	repo, err := a.SCM.CreateRepository(ctx, opt)
+	a.record("CreateRepository", opt.Path, err)
+	return repo, err
+}
+
+// CreateRepositoryFromTemplate implements the SCM interface.
+func (a *auditedSCM) CreateRepositoryFromTemplate(ctx context.Context, opt *scm.CreateRepositoryOptions) (*scm.Repository, error) {
+	repo, err := a.SCM.CreateRepositoryFromTemplate(ctx, opt)
+	a.record("CreateRepositoryFromTemplate", opt.Path, err)
+	return repo, err
+}
+
+// DeleteRepository implements the SCM interface.
+func (a *auditedSCM) DeleteRepository(ctx context.Context, opt *scm.RepositoryOptions) error {
+	err := a.SCM.DeleteRepository(ctx, opt)
+	resource := opt.Path
+	if resource == "" {
+		resource = fmt.Sprintf("repository %d", opt.ID)
+	}
+	a.record("DeleteRepository", resource, err)
+	return err
+}
+
+// UpdateRepoAccess implements the SCM interface.
+func (a *auditedSCM) UpdateRepoAccess(ctx context.Context, repo *scm.Repository, user, permission string) error {
+	err := a.SCM.UpdateRepoAccess(ctx, repo, user, permission)
+	a.record("UpdateRepoAccess", fmt.Sprintf("%s: %s (%s)", repo.Path, user, permission), err)
+	return err
+}
+
+// RevokeRepoAccess implements the SCM interface.
+func (a *auditedSCM) RevokeRepoAccess(ctx context.Context, repo *scm.Repository, user string) error {
+	err := a.SCM.RevokeRepoAccess(ctx, repo, user)
+	a.record("RevokeRepoAccess", fmt.Sprintf("%s: %s", repo.Path, user), err)
+	return err
+}
+
+// ProtectBranch implements the SCM interface.
+func (a *auditedSCM) ProtectBranch(ctx context.Context, repoID uint64, branch string, allowForcePush bool) error {
+	err := a.SCM.ProtectBranch(ctx, repoID, branch, allowForcePush)
+	a.record("ProtectBranch", fmt.Sprintf("repository %d: %s", repoID, branch), err)
+	return err
+}
+
+// CreateRepositoryAccessToken implements the SCM interface.
+func (a *auditedSCM) CreateRepositoryAccessToken(ctx context.Context, repoID uint64, scopes []string, expiry time.Time) (*scm.AccessToken, error) {
+	token, err := a.SCM.CreateRepositoryAccessToken(ctx, repoID, scopes, expiry)
+	a.record("CreateRepositoryAccessToken", fmt.Sprintf("repository %d: %s until %s", repoID, strings.Join(scopes, ", "), expiry.Format(layout)), err)
+	return token, err
+}
+
+// CreateTeam implements the SCM interface.
+func (a *auditedSCM) CreateTeam(ctx context.Context, opt *scm.NewTeamOptions) (*scm.Team, error) {
+	team, err := a.SCM.CreateTeam(ctx, opt)
+	a.record("CreateTeam", opt.TeamName, err)
+	return team, err
+}
+
+// AddTeamRepo implements the SCM interface.
+func (a *auditedSCM) AddTeamRepo(ctx context.Context, opt *scm.AddTeamRepoOptions) error {
+	err := a.SCM.AddTeamRepo(ctx, opt)
+	a.record("AddTeamRepo", fmt.Sprintf("team %d: %s (%s)", opt.TeamID, opt.Repo, opt.Permission), err)
+	return err
+}
+
+// AddTeamMember implements the SCM interface.
+func (a *auditedSCM) AddTeamMember(ctx context.Context, opt *scm.TeamMembershipOptions) error {
+	err := a.SCM.AddTeamMember(ctx, opt)
+	a.record("AddTeamMember", teamMember(opt), err)
+	return err
+}
+
+// AddTeamMembers implements the SCM interface.
+func (a *auditedSCM) AddTeamMembers(ctx context.Context, opt *scm.TeamMembershipOptions, logins []string) error {
+	err := a.SCM.AddTeamMembers(ctx, opt, logins)
+	a.record("AddTeamMembers", teamMember(&scm.TeamMembershipOptions{
+		TeamName: opt.TeamName,
+		TeamID:   opt.TeamID,
+		Username: strings.Join(logins, ", "),
+		Role:     opt.Role,
+	}), err)
+	return err
+}
+
+// RemoveTeamMember implements the SCM interface.
+func (a *auditedSCM) RemoveTeamMember(ctx context.Context, opt *scm.TeamMembershipOptions) error {
+	err := a.SCM.RemoveTeamMember(ctx, opt)
+	a.record("RemoveTeamMember", teamMember(opt), err)
+	return err
+}
+
+// UpdateTeamMembership implements the SCM interface.
+func (a *auditedSCM) UpdateTeamMembership(ctx context.Context, opt *scm.TeamMembershipOptions) error {
+	err := a.SCM.UpdateTeamMembership(ctx, opt)
+	a.record("UpdateTeamMembership", teamMember(opt), err)
+	return err
+}
+
+// UpdateOrgMembership implements the SCM interface.
+func (a *auditedSCM) UpdateOrgMembership(ctx context.Context, opt *scm.OrgMembershipOptions) error {
+	err := a.SCM.UpdateOrgMembership(ctx, opt)
+	a.record("UpdateOrgMembership", fmt.Sprintf("%s (%s)", opt.Username, opt.Role), err)
+	return err
+}
+
+// teamMember describes the team member changed by a team membership operation.
+func teamMember(opt *scm.TeamMembershipOptions) string {
+	team := opt.TeamName
+	if team == "" {
+		team = fmt.Sprintf("team %d", opt.TeamID)
+	}
+	if opt.Role == "" {
+		return fmt.Sprintf("%s: %s", team, opt.Username)
+	}
+	return fmt.Sprintf("%s: %s (%s)", team, opt.Username, opt.Role)
+}
+
+// getSCMAuditLog returns the SCM operations performed when provisioning
+// students and groups in the given course, oldest first.
+func (s *AutograderService) getSCMAuditLog(courseID uint64) (*pb.SCMAuditLog, error) {
+	entries, err := s.db.GetSCMAuditLog(courseID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SCMAuditLog{Entries: entries}, nil
+}
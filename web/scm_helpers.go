@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/scm"
+	"github.com/gosimple/slug"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -21,6 +23,11 @@ var (
 	// ErrFreePlan indicates that payment plan for given organization does not allow provate
 	// repositories and must be upgraded
 	ErrFreePlan = errors.New("organization does not allow creation of private repositories")
+	// ErrMissingSCM indicates that an operation requiring an SCM client was attempted without one
+	ErrMissingSCM = errors.New("no SCM client available")
+	// ErrOrgInvitationPending indicates that the user has been invited to the course organization,
+	// but must accept the invitation before being enrolled
+	ErrOrgInvitationPending = errors.New("user must accept the invitation to the course organization before enrollment")
 	// ErrContextCanceled indicates that method failed because of scm interaction that took longer than expected
 	// and not because of some application error
 	ErrContextCanceled = "context canceled because the github interaction took too long. Please try again later"
@@ -28,9 +35,15 @@ var (
 	FreeOrgPlan = "free"
 )
 
+// defaultBranch is the branch protected in repositories
+// for which the SCM does not report a default branch.
+const defaultBranch = "master"
+
 // createRepoAndTeam invokes the SCM to create a repository and team for the
 // specified course (represented with organization ID). The SCM team name
 // is also used as the group name and repository path. The provided user names represent the SCM group members.
+// The repository's default branch is protected against force pushes, unless allowed by the course.
+// The repository is initialized with the content of the course's template repository, if any.
 // This function performs several sequential queries and updates on the SCM.
 // Ideally, we should provide corresponding rollbacks, but that is not supported yet.
 func createRepoAndTeam(ctx context.Context, sc scm.SCM, course *pb.Course, group *pb.Group) (*pb.Repository, *scm.Team, error) {
@@ -42,22 +55,27 @@ func createRepoAndTeam(ctx context.Context, sc scm.SCM, course *pb.Course, group
 		course.OrganizationPath = org.GetPath()
 	}
 	org := &pb.Organization{ID: course.GetOrganizationID(), Path: course.GetOrganizationPath()}
-	repo, err := sc.CreateRepository(ctx, &scm.CreateRepositoryOptions{
+	repo, err := createRepository(ctx, sc, &scm.CreateRepositoryOptions{
 		Organization: org,
 		Path:         group.GetName(),
 		Private:      true,
+		Template:     course.GetTemplateRepo(),
 	})
 	if err != nil {
 		return nil, nil, fmt.Errorf("createRepoAndTeam: failed to create repo: %w", err)
 	}
+	branch := repo.DefaultBranch
+	if branch == "" {
+		branch = defaultBranch
+	}
+	err = sc.ProtectBranch(ctx, repo.ID, branch, course.HasFeature(pb.Course_ALLOW_FORCE_PUSH))
+	if err != nil && !scm.IsNotSupported(err) {
+		return nil, nil, fmt.Errorf("createRepoAndTeam: failed to protect branch %s: %w", branch, err)
+	}
 
-	team, err := sc.CreateTeam(ctx, &scm.NewTeamOptions{
-		Organization: org.Path,
-		TeamName:     group.GetName(),
-		Users:        group.UserNames(),
-	})
+	team, err := createGroupTeam(ctx, sc, org.Path, group)
 	if err != nil {
-		return nil, nil, fmt.Errorf("createRepoAndTeam: failed to create team: %w", err)
+		return nil, nil, fmt.Errorf("createRepoAndTeam: %w", err)
 	}
 
 	err = sc.AddTeamRepo(ctx, &scm.AddTeamRepoOptions{
@@ -81,36 +99,66 @@ func createRepoAndTeam(ctx context.Context, sc scm.SCM, course *pb.Course, group
 	return groupRepo, team, nil
 }
 
-// deletes group repository and team
+// createRepository creates a repository from the options' template repository,
+// or an empty repository if no template is given.
+func createRepository(ctx context.Context, sc scm.SCM, opt *scm.CreateRepositoryOptions) (*scm.Repository, error) {
+	if opt.Template == "" {
+		return sc.CreateRepository(ctx, opt)
+	}
+	return sc.CreateRepositoryFromTemplate(ctx, opt)
+}
+
+// createGroupTeam creates a team for the given group with the group's members.
+// The team is not given access to any repository.
+func createGroupTeam(ctx context.Context, sc scm.SCM, orgPath string, group *pb.Group) (*scm.Team, error) {
+	team, err := sc.CreateTeam(ctx, &scm.NewTeamOptions{
+		Organization: orgPath,
+		TeamName:     group.GetName(),
+		Users:        group.UserNames(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create team: %w", err)
+	}
+	return team, nil
+}
+
+// deletes group repository and team; a repository or team that
+// has already been deleted on the SCM is ignored
 func deleteGroupRepoAndTeam(ctx context.Context, sc scm.SCM, repositoryID uint64, teamID, orgID uint64) error {
-	if err := sc.DeleteRepository(ctx, &scm.RepositoryOptions{ID: repositoryID}); err != nil {
+	if err := sc.DeleteRepository(ctx, &scm.RepositoryOptions{ID: repositoryID}); err != nil && !scm.IsNotFound(err) {
 		return fmt.Errorf("deleteGroupRepoAndTeam: failed to delete repository: %w", err)
 	}
 
-	if err := sc.DeleteTeam(ctx, &scm.TeamOptions{TeamID: teamID, OrganizationID: orgID}); err != nil {
+	if err := sc.DeleteTeam(ctx, &scm.TeamOptions{TeamID: teamID, OrganizationID: orgID}); err != nil && !scm.IsNotFound(err) {
 		return fmt.Errorf("deleteGroupRepoAndTeam: failed to delete team: %w", err)
 	}
 	return nil
 }
 
-// creates {username}-labs repository and provides pull/push access to it for the given student
-func createStudentRepo(ctx context.Context, sc scm.SCM, org *pb.Organization, path string, student string) (*scm.Repository, error) {
-	// we have to check that repository for given user has not already been created on github
-	// if repo is found, it is safe to reuse it
+// creates {username}-labs repository and provides pull/push access to it for the given student;
+// a new repository is initialized with the content of the template repository, unless template is empty
+func createStudentRepo(ctx context.Context, sc scm.SCM, org *pb.Organization, path, student, template string) (*scm.Repository, error) {
+	// we have to check that repository for given user has not already been created on github,
+	// e.g., by an earlier enrollment attempt that failed; if repo is found, it is safe to reuse it.
+	// Other lookup errors must not be mistaken for a missing repository, since creating it would fail.
 	repo, err := sc.GetRepository(ctx, &scm.RepositoryOptions{
 		Path:  path,
 		Owner: org.GetPath(),
 	})
 	if err != nil {
-		fmt.Println("createStudentRepo: repo not found (as expected). Error: ", err.Error())
+		if !scm.IsNotFound(err) {
+			return nil, fmt.Errorf("createStudentRepo: failed to look up repo: %w", err)
+		}
+		repo = nil
 	}
 
 	// if no github repository found, create it
 	if repo == nil {
-		repo, err = sc.CreateRepository(ctx, &scm.CreateRepositoryOptions{
+		repo, err = createRepository(ctx, sc, &scm.CreateRepositoryOptions{
 			Organization: org,
 			Path:         path,
 			Private:      true,
+			Template:     template,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("createStudentRepo: failed to create repo: %w", err)
@@ -118,12 +166,139 @@ func createStudentRepo(ctx context.Context, sc scm.SCM, org *pb.Organization, pa
 	}
 
 	// add push access to student repo
-	if err = sc.UpdateRepoAccess(ctx, &scm.Repository{Owner: repo.Owner, Path: repo.Path}, student, scm.RepoPush); err != nil {
+	if err := sc.UpdateRepoAccess(ctx, &scm.Repository{Owner: repo.Owner, Path: repo.Path}, student, scm.RepoPush); err != nil {
 		return nil, err
 	}
 	return repo, nil
 }
 
+// repoCreationTracker records the repositories created through it, such that
+// they can be deleted if a later step fails. Repositories that already existed
+// and were reused are not recorded.
+type repoCreationTracker struct {
+	scm.SCM
+	created []*scm.Repository
+}
+
+// CreateRepository implements the SCM interface.
+func (t *repoCreationTracker) CreateRepository(ctx context.Context, opt *scm.CreateRepositoryOptions) (*scm.Repository, error) {
+	repo, err := t.SCM.CreateRepository(ctx, opt)
+	if err == nil {
+		t.created = append(t.created, repo)
+	}
+	return repo, err
+}
+
+// CreateRepositoryFromTemplate implements the SCM interface.
+func (t *repoCreationTracker) CreateRepositoryFromTemplate(ctx context.Context, opt *scm.CreateRepositoryOptions) (*scm.Repository, error) {
+	repo, err := t.SCM.CreateRepositoryFromTemplate(ctx, opt)
+	if err == nil {
+		t.created = append(t.created, repo)
+	}
+	return repo, err
+}
+
+// addMissingOrgMembers adds the given users as members of the organization,
+// unless they are already members of it. Existing members keep their role.
+func addMissingOrgMembers(ctx context.Context, sc scm.SCM, org *pb.Organization, logins []string) error {
+	members, err := sc.ListOrganizationMembers(ctx, org)
+	if err != nil {
+		return fmt.Errorf("addMissingOrgMembers: failed to list organization members: %w", err)
+	}
+	isMember := make(map[string]bool)
+	for _, member := range members {
+		isMember[strings.ToLower(member.Login)] = true
+	}
+	for _, login := range logins {
+		if isMember[strings.ToLower(login)] {
+			continue
+		}
+		if err := sc.UpdateOrgMembership(ctx, &scm.OrgMembershipOptions{
+			Organization: org.GetPath(),
+			Username:     login,
+			Role:         scm.OrgMember,
+		}); err != nil {
+			return fmt.Errorf("addMissingOrgMembers: failed to add %s to organization: %w", login, err)
+		}
+	}
+	return nil
+}
+
+// CourseTeams holds the IDs of the default teams of a course organization.
+type CourseTeams struct {
+	TeachersTeamID uint64
+	StudentsTeamID uint64
+}
+
+// createCourseOrganization creates an organization with the given name, together with the
+// course's default teachers and students teams, which enrolling users are added to.
+// The organization's path is derived from its name. An existing organization with
+// the same path is reused.
+func createCourseOrganization(ctx context.Context, sc scm.SCM, name string) (*pb.Organization, *CourseTeams, error) {
+	org, err := sc.EnsureOrganization(ctx, &scm.OrganizationOptions{
+		Path:              slug.Make(name),
+		Name:              name,
+		DefaultPermission: scm.OrgNone,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("createCourseOrganization: failed to create organization %s: %w", name, err)
+	}
+	teams, err := createCourseTeams(ctx, sc, org)
+	if err != nil {
+		return nil, nil, err
+	}
+	return org, teams, nil
+}
+
+// createCourseTeams creates the default teachers and students teams in the given organization.
+// The given teachers are added to the teachers team, while the students team has no members.
+// Teams that already exist, e.g., created by an earlier attempt to set up the course, are reused.
+func createCourseTeams(ctx context.Context, sc scm.SCM, org *pb.Organization, teachers ...string) (*CourseTeams, error) {
+	existing, err := sc.GetTeams(ctx, org)
+	if err != nil && !scm.IsNotSupported(err) {
+		return nil, fmt.Errorf("failed to get teams: %w", err)
+	}
+	teams := make(map[string]*scm.Team)
+	for _, team := range existing {
+		teams[team.Name] = team
+	}
+
+	teachersTeam, ok := teams[scm.TeachersTeam]
+	if ok {
+		for _, teacher := range teachers {
+			if err := sc.AddTeamMember(ctx, &scm.TeamMembershipOptions{
+				Organization: org.GetPath(),
+				TeamID:       teachersTeam.ID,
+				TeamName:     scm.TeachersTeam,
+				Username:     teacher,
+				Role:         scm.TeamMaintainer,
+			}); err != nil {
+				return nil, fmt.Errorf("failed to add %s to teachers team: %w", teacher, err)
+			}
+		}
+	} else {
+		teachersTeam, err = sc.CreateTeam(ctx, &scm.NewTeamOptions{
+			Organization: org.GetPath(),
+			TeamName:     scm.TeachersTeam,
+			Users:        teachers,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create teachers team: %w", err)
+		}
+	}
+	studentsTeam, ok := teams[scm.StudentsTeam]
+	if !ok {
+		studentsTeam, err = sc.CreateTeam(ctx, &scm.NewTeamOptions{
+			Organization: org.GetPath(),
+			TeamName:     scm.StudentsTeam,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create students team: %w", err)
+		}
+	}
+	return &CourseTeams{TeachersTeamID: teachersTeam.ID, StudentsTeamID: studentsTeam.ID}, nil
+}
+
 // add user to the organization's "students" team.
 func addUserToStudentsTeam(ctx context.Context, sc scm.SCM, organizationPath string, userName string) error {
 	opt := &scm.TeamMembershipOptions{
@@ -161,7 +336,12 @@ func promoteUserToTeachersTeam(ctx context.Context, sc scm.SCM, organizationPath
 	return nil
 }
 
-func updateReposAndTeams(ctx context.Context, sc scm.SCM, course *pb.Course, login string, state pb.Enrollment_UserStatus) (*scm.Repository, error) {
+// updateReposAndTeams updates the user's organization and team memberships and repository access
+// for the given enrollment status, and returns the repository of an enrolled student.
+// Every step either checks whether it has been done or is safe to repeat, so that an enrollment
+// that failed partway through can be resumed by accepting the enrollment again.
+// A student already added to the students team, e.g., by a bulk enrollment, is not added again.
+func updateReposAndTeams(ctx context.Context, sc scm.SCM, course *pb.Course, login string, state pb.Enrollment_UserStatus, inStudentsTeam bool) (*scm.Repository, error) {
 	org, err := sc.GetOrganization(ctx, &scm.GetOrgOptions{ID: course.OrganizationID})
 	if err != nil {
 		return nil, err
@@ -169,17 +349,35 @@ func updateReposAndTeams(ctx context.Context, sc scm.SCM, course *pb.Course, log
 
 	switch state {
 	case pb.Enrollment_STUDENT:
+		// team membership requires organization membership; invite the user if not already a member
+		member, err := sc.IsOrgMember(ctx, org.GetPath(), login)
+		if err != nil {
+			return nil, fmt.Errorf("updateReposAndTeams: failed to check org membership for %s: %w", login, err)
+		}
+		if !member {
+			if err := sc.UpdateOrgMembership(ctx, &scm.OrgMembershipOptions{
+				Organization: org.GetPath(),
+				Username:     login,
+				Role:         scm.OrgMember,
+			}); err != nil {
+				return nil, fmt.Errorf("updateReposAndTeams: failed to invite %s to organization: %w", login, err)
+			}
+			return nil, ErrOrgInvitationPending
+		}
+
 		// give access to course-info and assignments repositories
 		if err := grantAccessToCourseRepos(ctx, sc, org.GetPath(), login); err != nil {
 			return nil, err
 		}
 
 		// add student to the organization's "students" team
-		if err = addUserToStudentsTeam(ctx, sc, org.GetPath(), login); err != nil {
-			return nil, err
+		if !inStudentsTeam {
+			if err = addUserToStudentsTeam(ctx, sc, org.GetPath(), login); err != nil {
+				return nil, err
+			}
 		}
 
-		return createStudentRepo(ctx, sc, org, pb.StudentRepoName(login), login)
+		return createStudentRepo(ctx, sc, org, pb.StudentRepoName(login), login, course.GetTemplateRepo())
 
 	case pb.Enrollment_TEACHER:
 		// if teacher, promote to owner, remove from students team, add to teachers team
@@ -208,13 +406,97 @@ func grantAccessToCourseRepos(ctx context.Context, sc scm.SCM, org, login string
 	return nil
 }
 
-func updateGroupTeam(ctx context.Context, sc scm.SCM, group *pb.Group, orgID uint64) error {
-	opt := &scm.UpdateTeamOptions{
-		TeamID:         group.TeamID,
-		OrganizationID: orgID,
-		Users:          group.UserNames(),
+// updateGroupTeam adds new group members to the group's SCM team, and removes former
+// group members from the team. Former members also lose direct access to the group repositories.
+func updateGroupTeam(ctx context.Context, sc scm.SCM, oldGroup, newGroup *pb.Group, orgID uint64, repos []*pb.Repository) error {
+	added, removed := oldGroup.MemberChanges(newGroup)
+	for _, user := range added {
+		if err := sc.AddTeamMember(ctx, &scm.TeamMembershipOptions{
+			OrganizationID: orgID,
+			TeamID:         newGroup.GetTeamID(),
+			Username:       user.GetLogin(),
+			Role:           scm.TeamMember,
+		}); err != nil {
+			return fmt.Errorf("updateGroupTeam: failed to add %s to team: %w", user.GetLogin(), err)
+		}
+	}
+	for _, user := range removed {
+		if err := sc.RemoveTeamMember(ctx, &scm.TeamMembershipOptions{
+			OrganizationID: orgID,
+			TeamID:         newGroup.GetTeamID(),
+			Username:       user.GetLogin(),
+		}); err != nil {
+			return fmt.Errorf("updateGroupTeam: failed to remove %s from team: %w", user.GetLogin(), err)
+		}
+	}
+	if len(removed) == 0 {
+		return nil
 	}
-	return sc.UpdateTeamMembers(ctx, opt)
+
+	for _, repo := range repos {
+		groupRepo, err := sc.GetRepository(ctx, &scm.RepositoryOptions{ID: repo.GetRepositoryID()})
+		if err != nil {
+			return fmt.Errorf("updateGroupTeam: failed to get group repository: %w", err)
+		}
+		for _, user := range removed {
+			if err := sc.RevokeRepoAccess(ctx, groupRepo, user.GetLogin()); err != nil {
+				return fmt.Errorf("updateGroupTeam: failed to revoke access to %s for %s: %w", groupRepo.Path, user.GetLogin(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// revokeStudentAccess removes the student from the organization's "students" team, and revokes the
+// student's access to the common course repositories and to the given repositories of the student.
+// The repositories themselves are kept. Operations that the SCM does not support are skipped.
+func revokeStudentAccess(ctx context.Context, sc scm.SCM, org, login string, userRepos []*pb.Repository) error {
+	err := sc.RemoveTeamMember(ctx, &scm.TeamMembershipOptions{
+		Organization: org,
+		TeamName:     scm.StudentsTeam,
+		Username:     login,
+	})
+	if err != nil && !scm.IsNotFound(err) && !scm.IsNotSupported(err) {
+		return fmt.Errorf("revokeStudentAccess: failed to remove %s from students team: %w", login, err)
+	}
+	repos := []*scm.Repository{{Owner: org, Path: pb.InfoRepo}, {Owner: org, Path: pb.AssignmentRepo}}
+	for _, repo := range userRepos {
+		scmRepo, err := sc.GetRepository(ctx, &scm.RepositoryOptions{ID: repo.GetRepositoryID()})
+		if err != nil {
+			return fmt.Errorf("revokeStudentAccess: failed to get repository %d: %w", repo.GetRepositoryID(), err)
+		}
+		repos = append(repos, scmRepo)
+	}
+	for _, repo := range repos {
+		if err := sc.RevokeRepoAccess(ctx, repo, login); err != nil && !scm.IsNotFound(err) && !scm.IsNotSupported(err) {
+			return fmt.Errorf("revokeStudentAccess: failed to revoke access to %s for %s: %w", repo.Path, login, err)
+		}
+	}
+	return nil
+}
+
+// restoreStudentAccess adds the student to the organization's "students" team, unless already
+// a member, and gives the student access to the common course repositories and push access to
+// the given existing repositories of the student, e.g., after the student left the course.
+func restoreStudentAccess(ctx context.Context, sc scm.SCM, org, login string, userRepos []*pb.Repository, inStudentsTeam bool) error {
+	if !inStudentsTeam {
+		if err := addUserToStudentsTeam(ctx, sc, org, login); err != nil {
+			return fmt.Errorf("restoreStudentAccess: failed to add %s to students team: %w", login, err)
+		}
+	}
+	if err := grantAccessToCourseRepos(ctx, sc, org, login); err != nil {
+		return err
+	}
+	for _, repo := range userRepos {
+		scmRepo, err := sc.GetRepository(ctx, &scm.RepositoryOptions{ID: repo.GetRepositoryID()})
+		if err != nil {
+			return fmt.Errorf("restoreStudentAccess: failed to get repository %d: %w", repo.GetRepositoryID(), err)
+		}
+		if err := sc.UpdateRepoAccess(ctx, &scm.Repository{Owner: scmRepo.Owner, Path: scmRepo.Path}, login, scm.RepoPush); err != nil {
+			return fmt.Errorf("restoreStudentAccess: failed to give %s access to %s: %w", login, scmRepo.Path, err)
+		}
+	}
+	return nil
 }
 
 // remove user from the organization, delete user repository
@@ -292,3 +574,16 @@ func parseSCMError(err error) (bool, error) {
 	}
 	return ok, nil
 }
+
+// replaceTeamMember replaces the old login with the new login in the given team.
+func replaceTeamMember(ctx context.Context, sc scm.SCM, team *scm.TeamMembershipOptions, oldLogin, newLogin string) error {
+	oldMember, newMember := *team, *team
+	oldMember.Username, newMember.Username = oldLogin, newLogin
+	if err := sc.RemoveTeamMember(ctx, &oldMember); err != nil && !scm.IsNotFound(err) {
+		return fmt.Errorf("failed to remove %s from team %s: %w", oldLogin, team.TeamName, err)
+	}
+	if err := sc.AddTeamMember(ctx, &newMember); err != nil {
+		return fmt.Errorf("failed to add %s to team %s: %w", newLogin, team.TeamName, err)
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+)
+
+const (
+	// similarityThreshold is the minimum similarity of a pair of submissions
+	// reported by getSubmissionSimilarity.
+	similarityThreshold = 0.7
+	// shingleSize is the number of consecutive tokens compared between submissions.
+	shingleSize = 5
+)
+
+// tokenPattern matches identifiers, keywords and numbers, and single punctuation characters.
+var tokenPattern = regexp.MustCompile(`\w+|[^\s\w]`)
+
+// getSubmissionSimilarity compares the latest submissions for the given assignment pairwise,
+// and returns the pairs whose similarity is at least similarityThreshold, ordered from the
+// most to the least similar pair. Submissions are compared on the files in the assignment's
+// directory at the submitted commit. Code handed out in the assignments repository is
+// ignored, since it is shared by all submissions.
+func (s *AutograderService) getSubmissionSimilarity(ctx context.Context, sc scm.SCM, courseID, assignmentID uint64) ([]*pb.SubmissionSimilarity, error) {
+	assignment, course, err := s.getAssignmentWithCourse(&pb.Assignment{CourseID: courseID, ID: assignmentID}, false)
+	if err != nil {
+		return nil, err
+	}
+	handout, err := fetchShingles(ctx, sc, &scm.FileOptions{
+		Owner:      course.GetOrganizationPath(),
+		Repository: pb.AssignmentRepo,
+		Path:       assignment.GetName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	submissions, err := s.db.GetSubmissions(&pb.Submission{AssignmentID: assignmentID})
+	if err != nil {
+		return nil, err
+	}
+
+	shingles := make([]map[uint64]bool, len(submissions))
+	for i, submission := range submissions {
+		repoName, err := s.getSubmissionRepoName(course, submission)
+		if err != nil {
+			return nil, err
+		}
+		shingles[i], err = fetchShingles(ctx, sc, &scm.FileOptions{
+			Owner:      course.GetOrganizationPath(),
+			Repository: repoName,
+			Path:       assignment.GetName(),
+			Ref:        submission.GetCommitHash(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for h := range handout {
+			delete(shingles[i], h)
+		}
+	}
+
+	var similar []*pb.SubmissionSimilarity
+	for i := range submissions {
+		for j := i + 1; j < len(submissions); j++ {
+			similarity := jaccard(shingles[i], shingles[j])
+			if similarity < similarityThreshold {
+				continue
+			}
+			similar = append(similar, &pb.SubmissionSimilarity{
+				SubmissionID1: submissions[i].GetID(),
+				SubmissionID2: submissions[j].GetID(),
+				Similarity:    similarity,
+			})
+		}
+	}
+	sort.SliceStable(similar, func(i, j int) bool {
+		return similar[i].Similarity > similar[j].Similarity
+	})
+	return similar, nil
+}
+
+// getSubmissionRepoName returns the name of the user or group repository
+// that the given submission was built from.
+func (s *AutograderService) getSubmissionRepoName(course *pb.Course, submission *pb.Submission) (string, error) {
+	query := &pb.Repository{
+		OrganizationID: course.GetOrganizationID(),
+		UserID:         submission.GetUserID(),
+		RepoType:       pb.Repository_USER,
+	}
+	if submission.GetGroupID() > 0 {
+		query.UserID = 0
+		query.GroupID = submission.GetGroupID()
+		query.RepoType = pb.Repository_GROUP
+	}
+	repos, err := s.db.GetRepositories(query)
+	if err != nil {
+		return "", err
+	}
+	if len(repos) != 1 {
+		return "", fmt.Errorf("found %d repositories for submission %d", len(repos), submission.GetID())
+	}
+	return path.Base(repos[0].GetHTMLURL()), nil
+}
+
+// fetchShingles returns the shingles of all files below the given path.
+func fetchShingles(ctx context.Context, sc scm.SCM, opt *scm.FileOptions) (map[uint64]bool, error) {
+	files, err := sc.ListFiles(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+	shingles := make(map[uint64]bool)
+	for _, file := range files {
+		content, err := sc.GetFileContent(ctx, &scm.FileOptions{
+			Owner:      opt.Owner,
+			Repository: opt.Repository,
+			Path:       file,
+			Ref:        opt.Ref,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for h := range shingle(tokenize(content)) {
+			shingles[h] = true
+		}
+	}
+	return shingles, nil
+}
+
+// tokenize splits source code into tokens, ignoring whitespace,
+// so that formatting does not affect the comparison.
+func tokenize(src string) []string {
+	return tokenPattern.FindAllString(src, -1)
+}
+
+// shingle returns the hashes of every shingleSize consecutive tokens.
+// Fewer tokens than shingleSize make up a single shingle.
+func shingle(tokens []string) map[uint64]bool {
+	shingles := make(map[uint64]bool)
+	n := len(tokens) - shingleSize + 1
+	if n < 1 && len(tokens) > 0 {
+		n = 1
+	}
+	for i := 0; i < n; i++ {
+		end := i + shingleSize
+		if end > len(tokens) {
+			end = len(tokens)
+		}
+		h := fnv.New64a()
+		h.Write([]byte(strings.Join(tokens[i:end], "\x00")))
+		shingles[h.Sum64()] = true
+	}
+	return shingles
+}
+
+// jaccard returns the size of the intersection of a and b divided by the size of
+// their union. Two empty sets have nothing in common, and their similarity is 0.
+func jaccard(a, b map[uint64]bool) float64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	common := 0
+	for k := range a {
+		if b[k] {
+			common++
+		}
+	}
+	union := len(a) + len(b) - common
+	if union == 0 {
+		return 0
+	}
+	return float64(common) / float64(union)
+}
@@ -2,14 +2,23 @@ package web_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/scm"
 	"github.com/autograde/quickfeed/web"
+	"github.com/autograde/quickfeed/web/auth"
+	"github.com/google/go-cmp/cmp"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -342,6 +351,101 @@ func TestApproveSubmission(t *testing.T) {
 	}
 }
 
+func TestApproveSubmissions(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	admin := createFakeUser(t, db, 1)
+	course := allCourses[0]
+	if err := db.CreateCourse(admin.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	var students []*pb.User
+	for i := 2; i <= 4; i++ {
+		student := createFakeUser(t, db, uint64(i))
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{
+			UserID:   student.ID,
+			CourseID: course.ID,
+			Status:   pb.Enrollment_STUDENT,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		students = append(students, student)
+	}
+
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1", ScriptFile: "go.sh", Order: 1}
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", ScriptFile: "go.sh", Order: 2}
+	for _, lab := range []*pb.Assignment{lab1, lab2} {
+		if err := db.CreateAssignment(lab); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pending := &pb.Submission{AssignmentID: lab1.ID, UserID: students[0].ID, Score: 80}
+	approved := &pb.Submission{AssignmentID: lab1.ID, UserID: students[1].ID, Score: 90, Status: pb.Submission_APPROVED, ApprovedDate: "2020-01-01T00:00:00"}
+	otherLab := &pb.Submission{AssignmentID: lab2.ID, UserID: students[2].ID, Score: 100}
+	for _, submission := range []*pb.Submission{pending, approved, otherLab} {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), admin)
+
+	approvals, err := ags.ApproveSubmissions(ctx, &pb.ApproveSubmissionsRequest{
+		CourseID:      course.ID,
+		AssignmentID:  lab1.ID,
+		SubmissionIDs: []uint64{approved.ID, otherLab.ID, pending.ID},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantApprovals := &pb.SubmissionApprovals{Approvals: []*pb.SubmissionApproval{
+		{SubmissionID: approved.ID, Approved: true},
+		{SubmissionID: otherLab.ID, Error: fmt.Sprintf("no submission %d for assignment %d", otherLab.ID, lab1.ID)},
+		{SubmissionID: pending.ID, Approved: true},
+	}}
+	if diff := cmp.Diff(wantApprovals, approvals); diff != "" {
+		t.Errorf("ApproveSubmissions() mismatch (-want +got):\n%s", diff)
+	}
+
+	for _, want := range []struct {
+		submission   *pb.Submission
+		status       pb.Submission_Status
+		approvedDate string
+	}{
+		{pending, pb.Submission_APPROVED, ""},
+		{approved, pb.Submission_APPROVED, approved.ApprovedDate},
+		{otherLab, pb.Submission_NONE, ""},
+	} {
+		got, err := db.GetSubmission(&pb.Submission{ID: want.submission.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.GetStatus() != want.status {
+			t.Errorf("submission %d: status = %v, want %v", got.GetID(), got.GetStatus(), want.status)
+		}
+		if want.approvedDate != "" && got.GetApprovedDate() != want.approvedDate {
+			t.Errorf("submission %d: approved date = %q, want %q", got.GetID(), got.GetApprovedDate(), want.approvedDate)
+		}
+		if want.status == pb.Submission_APPROVED && got.GetApprovedDate() == "" {
+			t.Errorf("submission %d: approved without approval date", got.GetID())
+		}
+	}
+
+	student := students[0]
+	if _, err := ags.ApproveSubmissions(withUserContext(context.Background(), student), &pb.ApproveSubmissionsRequest{
+		CourseID:      course.ID,
+		AssignmentID:  lab2.ID,
+		SubmissionIDs: []uint64{otherLab.ID},
+	}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ApproveSubmissions() by student: got %v, want PermissionDenied", err)
+	}
+}
+
 func TestGetCourseLabSubmissions(t *testing.T) {
 	db, cleanup := setup(t)
 	defer cleanup()
@@ -725,3 +829,1741 @@ func isApproved(requirements int, approved []bool) bool {
 	}
 	return requirements <= 0
 }
+
+func TestAutoApproveAfterDeadline(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	var course pb.Course
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{
+		CourseID:   course.ID,
+		Name:       "lab1",
+		Deadline:   "2020-01-10T12:00:00",
+		ScoreLimit: 80,
+		Order:      1,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+
+	buildInfo := func(buildDate string) string {
+		return `{"builddate": "` + buildDate + `"}`
+	}
+	submissions := []struct {
+		user   *pb.User
+		sub    *pb.Submission
+		wantOK bool
+	}{
+		// passing before deadline
+		{createFakeUser(t, db, 2), &pb.Submission{Score: 90, BuildInfo: buildInfo("2020-01-09T12:00:00")}, true},
+		// passing after deadline
+		{createFakeUser(t, db, 3), &pb.Submission{Score: 90, BuildInfo: buildInfo("2020-01-11T12:00:00")}, false},
+		// failing before deadline
+		{createFakeUser(t, db, 4), &pb.Submission{Score: 50, BuildInfo: buildInfo("2020-01-09T12:00:00")}, false},
+		// passing before deadline, but rejected by a teacher
+		{createFakeUser(t, db, 5), &pb.Submission{Score: 90, BuildInfo: buildInfo("2020-01-09T12:00:00"), Status: pb.Submission_REJECTED}, false},
+	}
+	for _, s := range submissions {
+		s.sub.AssignmentID = assignment.ID
+		s.sub.UserID = s.user.ID
+		if err := db.CreateSubmission(s.sub); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// approval must not replace the score with the score of the rubric reviews
+	if err := db.CreateReview(&pb.Review{SubmissionID: submissions[0].sub.ID, ReviewerID: teacher.ID, Ready: true, Score: 40}); err != nil {
+		t.Fatal(err)
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	request := &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID}
+	if _, err := ags.ApproveSubmissionsAfterDeadline(withUserContext(context.Background(), submissions[0].user), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ApproveSubmissionsAfterDeadline() by student = %v, want PermissionDenied", err)
+	}
+	// running twice must give the same result
+	ctx := withUserContext(context.Background(), teacher)
+	for i := 0; i < 2; i++ {
+		if _, err := ags.ApproveSubmissionsAfterDeadline(ctx, request); err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range submissions {
+			got, err := db.GetSubmission(&pb.Submission{ID: s.sub.ID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if approved := got.GetStatus() == pb.Submission_APPROVED; approved != s.wantOK {
+				t.Errorf("submission %+v: have approved %t want %t", got, approved, s.wantOK)
+			}
+			if !s.wantOK && got.GetStatus() != s.sub.GetStatus() {
+				t.Errorf("submission %+v: have status %v want %v", got, got.GetStatus(), s.sub.GetStatus())
+			}
+			if got.GetScore() != s.sub.GetScore() {
+				t.Errorf("submission %+v: have score %d want %d", got, got.GetScore(), s.sub.GetScore())
+			}
+		}
+	}
+}
+
+func TestApplyLatePenalty(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	var course pb.Course
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{
+		CourseID:       course.ID,
+		Name:           "lab1",
+		Deadline:       "2020-01-10T12:00:00",
+		Order:          1,
+		LatePenalty:    20,
+		MaxLatePenalty: 50,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+
+	buildInfo := func(buildDate string) string {
+		return `{"builddate": "` + buildDate + `"}`
+	}
+	submissions := []struct {
+		sub       *pb.Submission
+		wantScore uint32
+	}{
+		// before deadline
+		{&pb.Submission{Score: 90, BuildInfo: buildInfo("2020-01-09T12:00:00")}, 90},
+		// within the grace period
+		{&pb.Submission{Score: 90, BuildInfo: buildInfo("2020-01-10T13:00:00")}, 90},
+		// one day late
+		{&pb.Submission{Score: 90, BuildInfo: buildInfo("2020-01-11T12:00:00")}, 72},
+		// two days late, plus the grace period
+		{&pb.Submission{Score: 90, BuildInfo: buildInfo("2020-01-12T13:00:00")}, 54},
+		// maximum penalty
+		{&pb.Submission{Score: 90, BuildInfo: buildInfo("2020-01-20T12:00:00")}, 45},
+	}
+	for i, s := range submissions {
+		user := createFakeUser(t, db, uint64(i+2))
+		s.sub.AssignmentID = assignment.ID
+		s.sub.UserID = user.ID
+		if err := db.CreateSubmission(s.sub); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	// students cannot apply late penalties, not even to their own submissions
+	student, err := db.GetUser(submissions[2].sub.UserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ags.ApplyLatePenalty(withUserContext(context.Background(), student), &pb.SubmissionIDRequest{SubmissionID: submissions[2].sub.ID})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("have error %v want %v", err, codes.PermissionDenied)
+	}
+
+	ctx := withUserContext(context.Background(), teacher)
+	// applying the penalty twice must give the same result
+	for i := 0; i < 2; i++ {
+		for _, s := range submissions {
+			if _, err := ags.ApplyLatePenalty(ctx, &pb.SubmissionIDRequest{SubmissionID: s.sub.ID}); err != nil {
+				t.Fatal(err)
+			}
+			got, err := db.GetSubmission(&pb.Submission{ID: s.sub.ID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.GetScore() != s.wantScore || got.GetRawScore() != 90 {
+				t.Errorf("have score %d (raw %d) want %d (raw 90)", got.GetScore(), got.GetRawScore(), s.wantScore)
+			}
+		}
+	}
+}
+
+func TestGetSubmissionByCommit(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	student := createFakeUser(t, db, 2)
+	course := pb.Course{OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	repo := &pb.Repository{
+		OrganizationID: course.OrganizationID,
+		RepositoryID:   10,
+		UserID:         student.ID,
+		RepoType:       pb.Repository_USER,
+	}
+	if err := db.CreateRepository(repo); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{
+		AssignmentID: assignment.ID,
+		UserID:       student.ID,
+		CommitHash:   "abc123def456",
+		Score:        80,
+	}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), teacher)
+	for _, sha := range []string{"abc123def456", "abc123"} {
+		got, err := ags.GetSubmissionByCommit(ctx, &pb.CommitSubmissionRequest{CourseID: course.ID, RepositoryID: repo.RepositoryID, CommitHash: sha})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.GetID() != submission.GetID() {
+			t.Errorf("GetSubmissionByCommit(%q) = submission %d, want %d", sha, got.GetID(), submission.GetID())
+		}
+	}
+	if _, err := ags.GetSubmissionByCommit(ctx, &pb.CommitSubmissionRequest{CourseID: course.ID, RepositoryID: repo.RepositoryID, CommitHash: "fff000"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetSubmissionByCommit(unknown commit) = %v, want NotFound", err)
+	}
+	if _, err := ags.GetSubmissionByCommit(ctx, &pb.CommitSubmissionRequest{CourseID: course.ID, RepositoryID: repo.RepositoryID, CommitHash: "abc%"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetSubmissionByCommit(invalid commit) = %v, want NotFound", err)
+	}
+
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2}
+	if err := db.CreateAssignment(lab2); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateSubmission(&pb.Submission{AssignmentID: lab2.ID, UserID: student.ID, CommitHash: "abc124fed000"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.GetSubmissionByCommit(ctx, &pb.CommitSubmissionRequest{CourseID: course.ID, RepositoryID: repo.RepositoryID, CommitHash: "abc12"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetSubmissionByCommit(ambiguous commit) = %v, want InvalidArgument", err)
+	}
+	got, err := ags.GetSubmissionByCommit(ctx, &pb.CommitSubmissionRequest{CourseID: course.ID, RepositoryID: repo.RepositoryID, CommitHash: "abc123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetID() != submission.GetID() {
+		t.Errorf("GetSubmissionByCommit(%q) = submission %d, want %d", "abc123", got.GetID(), submission.GetID())
+	}
+	studentCtx := withUserContext(context.Background(), student)
+	if _, err := ags.GetSubmissionByCommit(studentCtx, &pb.CommitSubmissionRequest{CourseID: course.ID, RepositoryID: repo.RepositoryID, CommitHash: "abc123"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetSubmissionByCommit() by student = %v, want PermissionDenied", err)
+	}
+}
+
+func TestGetSubmissionByID(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := pb.Course{OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	var students []*pb.User
+	for i := 0; i < 3; i++ {
+		student := createFakeUser(t, db, uint64(2+i))
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+		students = append(students, student)
+	}
+	group := &pb.Group{Name: "group", CourseID: course.ID, Users: []*pb.User{students[1]}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	groupAssignment := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2, IsGroupLab: true}
+	for _, a := range []*pb.Assignment{assignment, groupAssignment} {
+		if err := db.CreateAssignment(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: students[0].ID, Score: 80}
+	groupSubmission := &pb.Submission{AssignmentID: groupAssignment.ID, GroupID: group.ID, Score: 60}
+	for _, sbm := range []*pb.Submission{submission, groupSubmission} {
+		if err := db.CreateSubmission(sbm); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	tests := []struct {
+		user       *pb.User
+		submission *pb.Submission
+		wantErr    bool
+	}{
+		{teacher, submission, false},
+		{teacher, groupSubmission, false},
+		{students[0], submission, false},
+		{students[0], groupSubmission, true},
+		{students[1], submission, true},
+		{students[1], groupSubmission, false},
+		{students[2], submission, true},
+		{students[2], groupSubmission, true},
+	}
+	for _, test := range tests {
+		ctx := withUserContext(context.Background(), test.user)
+		got, err := ags.GetSubmissionByID(ctx, &pb.SubmissionIDRequest{SubmissionID: test.submission.ID})
+		if test.wantErr {
+			if status.Code(err) != codes.PermissionDenied {
+				t.Errorf("GetSubmissionByID(user %d, %d) = %v, want %v", test.user.ID, test.submission.ID, err, codes.PermissionDenied)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.GetID() != test.submission.ID || got.GetScore() != test.submission.Score {
+			t.Errorf("GetSubmissionByID(user %d, %d) = %+v, want %+v", test.user.ID, test.submission.ID, got, test.submission)
+		}
+	}
+	if _, err := ags.GetSubmissionByID(withUserContext(context.Background(), teacher), &pb.SubmissionIDRequest{SubmissionID: 123}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetSubmissionByID(123) = %v, want %v", err, codes.NotFound)
+	}
+}
+
+func TestSubmitPullRequests(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	student := createFakeUser(t, db, 2)
+	course := pb.Course{OrganizationID: 1, Provider: "fake"}
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	reviewed := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2, SkipTests: true}
+	for _, a := range []*pb.Assignment{assignment, reviewed} {
+		if err := db.CreateAssignment(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, repo := range []*pb.Repository{
+		{OrganizationID: course.OrganizationID, RepositoryID: 1, RepoType: pb.Repository_TESTS},
+		{OrganizationID: course.OrganizationID, RepositoryID: 2, UserID: student.ID, RepoType: pb.Repository_USER},
+	} {
+		if err := db.CreateRepository(repo); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the pull request's head commit has already been submitted
+	if err := db.CreateSubmission(&pb.Submission{
+		AssignmentID: assignment.ID,
+		UserID:       student.ID,
+		CommitHash:   "abc123",
+		Score:        80,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	fakeGothProvider()
+	mockSCM, scms := mockProviderMap(t)
+	mockSCM.ListPullRequestsFunc = func(_ context.Context, opt *scm.RepositoryOptions) ([]*scm.PullRequest, error) {
+		return []*scm.PullRequest{
+			{ID: 1, SourceBranch: "Lab1", SHA: "abc123"},
+			{ID: 2, SourceBranch: "feature", SHA: "def456"},
+			{ID: 3, SourceBranch: "lab2", SHA: "fed789"},
+		}, nil
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), teacher)
+	request := &pb.CourseRequest{CourseID: course.ID}
+
+	if _, err := ags.SubmitPullRequests(ctx, request); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("SubmitPullRequests() = %v, want FailedPrecondition for course without pull request submissions", err)
+	}
+	if err := db.UpdateCourseFeatures(course.ID, uint32(pb.Course_PULL_REQUEST_SUBMISSIONS)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.SubmitPullRequests(withUserContext(context.Background(), student), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("SubmitPullRequests() by student = %v, want PermissionDenied", err)
+	}
+	if _, err := ags.SubmitPullRequests(ctx, request); err != nil {
+		t.Fatal(err)
+	}
+
+	// only the student repository is checked for pull requests
+	calls := mockSCM.Calls()
+	if len(calls) != 1 || calls[0].Args[0].(*scm.RepositoryOptions).ID != 2 {
+		t.Errorf("have SCM calls %+v want a single ListPullRequests call for repository 2", calls)
+	}
+	submissions, err := db.GetSubmissions(&pb.Submission{AssignmentID: assignment.ID, UserID: student.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(submissions) != 1 || submissions[0].GetCommitHash() != "abc123" || submissions[0].GetScore() != 80 {
+		t.Errorf("have submissions %+v want the existing submission unchanged", submissions)
+	}
+	// the manually reviewed assignment's pull request is recorded without running tests
+	submissions, err = db.GetSubmissions(&pb.Submission{AssignmentID: reviewed.ID, UserID: student.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(submissions) != 1 || submissions[0].GetCommitHash() != "fed789" || !strings.Contains(submissions[0].GetBuildInfo(), "No automated tests") {
+		t.Errorf("have submissions %+v want a submission of commit fed789 recorded without tests", submissions)
+	}
+}
+
+func TestGetSubmissionHistory(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	var course pb.Course
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Deadline: "2020-01-10T12:00:00"}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	other := createFakeUser(t, db, 3)
+
+	// each build updates the student's submission and is added to its history
+	first := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, Score: 40, CommitHash: "abc"}
+	second := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, Score: 90, CommitHash: "def"}
+	for _, submission := range []*pb.Submission{first, second} {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.CreateSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: other.ID, Score: 70}); err != nil {
+		t.Fatal(err)
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	request := &pb.SubmissionHistoryRequest{CourseID: course.ID, UserID: student.ID, AssignmentID: assignment.ID}
+	// both the student and the teacher can get the student's submission history
+	for _, user := range []*pb.User{student, teacher} {
+		history, err := ags.GetSubmissionHistory(withUserContext(context.Background(), user), request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var gotScores []uint32
+		var gotCommits []string
+		for _, build := range history.GetBuilds() {
+			if build.GetSubmissionID() != first.GetID() {
+				t.Errorf("have build %+v of submission %d want submission %d", build, build.GetSubmissionID(), first.GetID())
+			}
+			gotScores = append(gotScores, build.GetScore())
+			gotCommits = append(gotCommits, build.GetCommitHash())
+		}
+		if wantScores := []uint32{40, 90}; !reflect.DeepEqual(wantScores, gotScores) {
+			t.Errorf("have submission history scores %v want %v", gotScores, wantScores)
+		}
+		if wantCommits := []string{"abc", "def"}; !reflect.DeepEqual(wantCommits, gotCommits) {
+			t.Errorf("have submission history commits %v want %v", gotCommits, wantCommits)
+		}
+	}
+
+	// other students cannot get the student's submission history
+	_, err := ags.GetSubmissionHistory(withUserContext(context.Background(), other), request)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("have error %v want %v", err, codes.PermissionDenied)
+	}
+
+	request.CourseID = course.ID + 1
+	if _, err := ags.GetSubmissionHistory(withUserContext(context.Background(), student), request); err == nil {
+		t.Error("expected error for assignment in another course")
+	}
+}
+
+func TestGetSubmissionsByCategory(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	var course pb.Course
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+		t.Fatal(err)
+	}
+	assignments := []*pb.Assignment{
+		{CourseID: course.ID, Name: "lab1", Order: 1, Category: "labs"},
+		{CourseID: course.ID, Name: "lab2", Order: 2, Category: "labs"},
+		{CourseID: course.ID, Name: "project", Order: 3, Category: "projects"},
+	}
+	for _, assignment := range assignments {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: student.ID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), teacher)
+	tests := []struct {
+		category string
+		want     []uint64
+	}{
+		{category: "", want: []uint64{assignments[0].ID, assignments[1].ID, assignments[2].ID}},
+		{category: "labs", want: []uint64{assignments[0].ID, assignments[1].ID}},
+		{category: "Projects", want: []uint64{assignments[2].ID}},
+		{category: "exams", want: nil},
+	}
+	for _, test := range tests {
+		submissions, err := ags.GetSubmissions(ctx, &pb.SubmissionRequest{
+			CourseID: course.ID,
+			UserID:   student.ID,
+			Order:    pb.SubmissionRequest_OLDEST,
+			Category: test.category,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []uint64
+		for _, submission := range submissions.GetSubmissions() {
+			got = append(got, submission.GetAssignmentID())
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("GetSubmissions(category=%q) mismatch (-want +got):\n%s", test.category, diff)
+		}
+
+		courseSubmissions, err := ags.GetSubmissionsByCourse(ctx, &pb.SubmissionsForCourseRequest{
+			CourseID: course.ID,
+			Type:     pb.SubmissionsForCourseRequest_INDIVIDUAL,
+			Category: test.category,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		found := false
+		for _, link := range courseSubmissions.GetLinks() {
+			if link.GetEnrollment().GetUserID() != student.ID {
+				continue
+			}
+			found = true
+			got = nil
+			for _, submissionLink := range link.GetSubmissions() {
+				got = append(got, submissionLink.GetAssignment().GetID())
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("GetSubmissionsByCourse(category=%q) mismatch (-want +got):\n%s", test.category, diff)
+			}
+		}
+		if !found {
+			t.Errorf("GetSubmissionsByCourse(category=%q) has no results for the student", test.category)
+		}
+	}
+}
+
+func TestGetCourseProgress(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	var course pb.Course
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	var students []*pb.User
+	for i := 0; i < 2; i++ {
+		student := createFakeUser(t, db, uint64(i+2))
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+		students = append(students, student)
+	}
+	student, other := students[0], students[1]
+	group := &pb.Group{Name: "group1", CourseID: course.ID, Users: []*pb.User{student}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	otherGroup := &pb.Group{Name: "group2", CourseID: course.ID, Users: []*pb.User{other}}
+	if err := db.CreateGroup(otherGroup); err != nil {
+		t.Fatal(err)
+	}
+
+	// created out of order to check that assignments are ordered by assignment order
+	lab3 := &pb.Assignment{CourseID: course.ID, Name: "lab3", Order: 3, Deadline: "2020-03-10T12:00:00"}
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Deadline: "2020-01-10T12:00:00"}
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2, Deadline: "2020-02-10T12:00:00", IsGroupLab: true}
+	for _, assignment := range []*pb.Assignment{lab3, lab1, lab2} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	submissions := []*pb.Submission{
+		{AssignmentID: lab1.ID, UserID: student.ID, Score: 40},
+		{AssignmentID: lab1.ID, UserID: student.ID, Score: 90, Status: pb.Submission_APPROVED},
+		{AssignmentID: lab1.ID, UserID: other.ID, Score: 100},
+		{AssignmentID: lab2.ID, GroupID: group.ID, Score: 60},
+		{AssignmentID: lab2.ID, GroupID: otherGroup.ID, Score: 70},
+	}
+	for _, submission := range submissions {
+		// UpdateSubmission saves a new record when the submission ID is unset
+		if err := db.UpdateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	progress, err := ags.GetCourseProgress(withUserContext(context.Background(), student), &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if progress.GetEnrollment().GetUserID() != student.ID {
+		t.Errorf("have progress of user %d, want %d", progress.GetEnrollment().GetUserID(), student.ID)
+	}
+	want := []struct {
+		assignmentID uint64
+		submissionID uint64
+	}{
+		{assignmentID: lab1.ID, submissionID: submissions[1].ID},
+		{assignmentID: lab2.ID, submissionID: submissions[3].ID},
+		{assignmentID: lab3.ID, submissionID: 0},
+	}
+	if len(progress.GetSubmissions()) != len(want) {
+		t.Fatalf("have %d assignments, want %d", len(progress.GetSubmissions()), len(want))
+	}
+	for i, link := range progress.GetSubmissions() {
+		if link.GetAssignment().GetID() != want[i].assignmentID {
+			t.Errorf("have assignment %d at position %d, want %d", link.GetAssignment().GetID(), i, want[i].assignmentID)
+		}
+		if link.GetSubmission().GetID() != want[i].submissionID {
+			t.Errorf("have submission %d for assignment %s, want %d", link.GetSubmission().GetID(), link.GetAssignment().GetName(), want[i].submissionID)
+		}
+	}
+	if deadline := progress.GetSubmissions()[0].GetAssignment().GetDeadline(); deadline != lab1.Deadline {
+		t.Errorf("have deadline %q, want %q", deadline, lab1.Deadline)
+	}
+
+	outsider := createFakeUser(t, db, 4)
+	if _, err := ags.GetCourseProgress(withUserContext(context.Background(), outsider), &pb.CourseRequest{CourseID: course.ID}); err == nil {
+		t.Error("expected error for user not enrolled in the course")
+	}
+}
+
+func TestUpdateAutoApprove(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	var course pb.Course
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, ScoreLimit: 80}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	tests := []struct {
+		enabled        bool
+		scoreLimit     uint32
+		wantScoreLimit uint32
+	}{
+		{true, 0, 100},
+		{true, 90, 90},
+		{false, 90, 90},
+	}
+	ctx := withUserContext(context.Background(), teacher)
+	for _, test := range tests {
+		request := &pb.AutoApproveRequest{CourseID: course.ID, AssignmentID: assignment.ID, Enabled: test.enabled, ScoreLimit: test.scoreLimit}
+		if _, err := ags.UpdateAutoApprove(ctx, request); err != nil {
+			t.Fatal(err)
+		}
+		got, err := db.GetAssignment(&pb.Assignment{ID: assignment.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.GetAutoApprove() != test.enabled || got.GetScoreLimit() != test.wantScoreLimit {
+			t.Errorf("have autoApprove %t, scoreLimit %d want %t, %d", got.GetAutoApprove(), got.GetScoreLimit(), test.enabled, test.wantScoreLimit)
+		}
+	}
+
+	if (&pb.AutoApproveRequest{CourseID: course.ID, AssignmentID: assignment.ID, Enabled: true, ScoreLimit: 101}).IsValid() {
+		t.Error("expected invalid request for score limit above 100")
+	}
+
+	// the teacher of another course cannot update the assignment
+	otherTeacher := createFakeUser(t, db, 2)
+	otherCourse := pb.Course{OrganizationID: 2}
+	if err := db.CreateCourse(teacher.ID, &otherCourse); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: otherTeacher.ID, CourseID: otherCourse.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: otherTeacher.ID, CourseID: otherCourse.ID, Status: pb.Enrollment_TEACHER}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := ags.UpdateAutoApprove(withUserContext(context.Background(), otherTeacher), &pb.AutoApproveRequest{CourseID: course.ID, AssignmentID: assignment.ID, Enabled: true, ScoreLimit: 80})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("have error %v want %v", err, codes.PermissionDenied)
+	}
+	_, err = ags.UpdateAutoApprove(withUserContext(context.Background(), otherTeacher), &pb.AutoApproveRequest{CourseID: otherCourse.ID, AssignmentID: assignment.ID, Enabled: true, ScoreLimit: 80})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("have error %v want %v for assignment in another course", err, codes.InvalidArgument)
+	}
+}
+
+func TestGetSubmissionBuildLog(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	var course pb.Course
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	other := createFakeUser(t, db, 3)
+	for _, user := range []*pb.User{student, other} {
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	const (
+		fullLog    = "Cloning into '/quickfeed/tests'...\n--- FAIL: TestFibonacci (0.00s)"
+		studentLog = "--- FAIL: TestFibonacci (0.00s)"
+	)
+	buildInfo, err := json.Marshal(&ci.BuildInfo{BuildDate: "2020-01-09T12:00:00", BuildLog: fullLog})
+	if err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, BuildInfo: string(buildInfo)}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	tests := []struct {
+		user    *pb.User
+		wantLog string
+		wantErr bool
+	}{
+		{teacher, fullLog, false},
+		{student, studentLog, false},
+		{other, "", true},
+	}
+	for _, test := range tests {
+		buildLog, err := ags.GetSubmissionBuildLog(withUserContext(context.Background(), test.user), &pb.SubmissionIDRequest{SubmissionID: submission.ID})
+		if (err != nil) != test.wantErr {
+			t.Errorf("user %d: have error %v, want error %t", test.user.ID, err, test.wantErr)
+		}
+		if test.wantErr && status.Code(err) != codes.PermissionDenied {
+			t.Errorf("user %d: have error %v want %v", test.user.ID, err, codes.PermissionDenied)
+		}
+		if gotLog := buildLog.GetLog(); gotLog != test.wantLog {
+			t.Errorf("user %d: have build log %q want %q", test.user.ID, gotLog, test.wantLog)
+		}
+	}
+}
+
+func TestExportCourseGrades(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	var students []*pb.User
+	for i, name := range []string{"Alice", "Bob", "Carol"} {
+		student := createNamedUser(t, db, uint64(i+2), name)
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+		students = append(students, student)
+	}
+	group := &pb.Group{Name: "group1", CourseID: course.ID, Users: students[:2]}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2, IsGroupLab: true}
+	for _, assignment := range []*pb.Assignment{lab1, lab2} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, submission := range []*pb.Submission{
+		{AssignmentID: lab1.ID, UserID: students[0].ID, Score: 80, Status: pb.Submission_APPROVED},
+		{AssignmentID: lab1.ID, UserID: students[2].ID, Score: 40},
+		{AssignmentID: lab2.ID, GroupID: group.ID, Score: 90, Status: pb.Submission_APPROVED},
+	} {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	if _, err := ags.ExportCourseGrades(withUserContext(context.Background(), students[0]), &pb.CourseRequest{CourseID: course.ID}); err == nil {
+		t.Error("expected students not to be allowed to export grades")
+	}
+	grades, err := ags.ExportCourseGrades(withUserContext(context.Background(), teacher), &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantCSV := `Name,Student ID,Login,lab1 score,lab1 status,lab2 score,lab2 status
+Alice,,,80,APPROVED,90,APPROVED
+Bob,,,,,90,APPROVED
+Carol,,,40,NONE,,
+`
+	if diff := cmp.Diff(wantCSV, grades.GetCsv()); diff != "" {
+		t.Errorf("mismatch in exported grades (-want +got):\n%s", diff)
+	}
+}
+
+func TestExportEnrollments(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	var students []*pb.User
+	for i, name := range []string{"Alice", "Bob", "Carol"} {
+		student := createNamedUser(t, db, uint64(i+2), name)
+		student.Email = strings.ToLower(name) + "@example.com"
+		if err := db.UpdateUser(student); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+		students = append(students, student)
+	}
+	group := &pb.Group{Name: "group1", CourseID: course.ID, Users: students[:2]}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2, IsGroupLab: true}
+	for _, assignment := range []*pb.Assignment{lab1, lab2} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, submission := range []*pb.Submission{
+		{AssignmentID: lab1.ID, UserID: students[0].ID, Score: 80},
+		{AssignmentID: lab1.ID, UserID: students[2].ID, Score: 40},
+		{AssignmentID: lab2.ID, GroupID: group.ID, Score: 90},
+	} {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	tests := []struct {
+		user    *pb.User
+		wantCSV string
+	}{
+		{teacher, `Name,Login,Email,Status,Group,Score
+,,,TEACHER,,
+Alice,,alice@example.com,STUDENT,group1,85
+Bob,,bob@example.com,STUDENT,group1,45
+Carol,,carol@example.com,STUDENT,,20
+`},
+		// students only see their own email and score
+		{students[2], `Name,Login,Email,Status,Group,Score
+,,,TEACHER,,
+Alice,,,STUDENT,group1,
+Bob,,,STUDENT,group1,
+Carol,,carol@example.com,STUDENT,,20
+`},
+	}
+	for _, test := range tests {
+		roster, err := ags.ExportEnrollments(withUserContext(context.Background(), test.user), &pb.CourseRequest{CourseID: course.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(test.wantCSV, roster.GetCsv()); diff != "" {
+			t.Errorf("mismatch in enrollments exported by %s (-want +got):\n%s", test.user.GetName(), diff)
+		}
+	}
+
+	outsider := createFakeUser(t, db, 10)
+	if _, err := ags.ExportEnrollments(withUserContext(context.Background(), outsider), &pb.CourseRequest{CourseID: course.ID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ExportEnrollments(outsider) = %v, want %v", err, codes.PermissionDenied)
+	}
+}
+
+func TestGetCourseCalendar(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := allCourses[0]
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+		t.Fatal(err)
+	}
+	outsider := createFakeUser(t, db, 3)
+
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1, part 1", Order: 1, Deadline: "2020-02-10T23:59:00"}
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2, Deadline: "2020-03-01T12:00:00"}
+	lab3 := &pb.Assignment{CourseID: course.ID, Name: "lab3", Order: 3}
+	for _, lab := range []*pb.Assignment{lab1, lab2, lab3} {
+		if err := db.CreateAssignment(lab); err != nil {
+			t.Fatal(err)
+		}
+	}
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateSlipDays([]*pb.UsedSlipDays{{EnrollmentID: enrollment.ID, AssignmentID: lab2.ID, UsedSlipDays: 2}}); err != nil {
+		t.Fatal(err)
+	}
+
+	utc := func(date string) string {
+		deadline, err := time.ParseInLocation("2006-01-02T15:04:05", date, time.Local)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return deadline.UTC().Format("20060102T150405Z")
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	tests := []struct {
+		name string
+		user *pb.User
+		want []string
+	}{
+		{"teacher", teacher, []string{
+			"DTSTART:" + utc(lab1.Deadline),
+			`SUMMARY:DAT520: lab1\, part 1`,
+			`DESCRIPTION:Deadline for lab1\, part 1`,
+			"DTSTART:" + utc(lab2.Deadline),
+			"SUMMARY:DAT520: lab2",
+			"DESCRIPTION:Deadline for lab2",
+		}},
+		{"student", student, []string{
+			"DTSTART:" + utc(lab1.Deadline),
+			`SUMMARY:DAT520: lab1\, part 1`,
+			`DESCRIPTION:Deadline for lab1\, part 1`,
+			"DTSTART:" + utc("2020-03-03T12:00:00"),
+			"SUMMARY:DAT520: lab2",
+			`DESCRIPTION:Deadline for lab2\, extended by 2 slip days`,
+		}},
+	}
+	for _, test := range tests {
+		calendar, err := ags.GetCourseCalendar(withUserContext(context.Background(), test.user), &pb.CourseRequest{CourseID: course.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines := strings.Split(calendar.GetIcs(), "\r\n")
+		if lines[0] != "BEGIN:VCALENDAR" || lines[len(lines)-2] != "END:VCALENDAR" {
+			t.Errorf("%s: calendar not enclosed in VCALENDAR:\n%s", test.name, calendar.GetIcs())
+		}
+		var got []string
+		for _, line := range lines {
+			if strings.HasPrefix(line, "DTSTART:") || strings.HasPrefix(line, "SUMMARY:") || strings.HasPrefix(line, "DESCRIPTION:") {
+				got = append(got, line)
+			}
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("%s: GetCourseCalendar() mismatch (-want +got):\n%s", test.name, diff)
+		}
+	}
+
+	if _, err := ags.GetCourseCalendar(withUserContext(context.Background(), outsider), &pb.CourseRequest{CourseID: course.ID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetCourseCalendar(outsider) = %v, want %v", err, codes.PermissionDenied)
+	}
+}
+
+func TestSubmissionsNeedingReview(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	enrollStudent(t, db, student, course)
+	flagged := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, Score: 100}
+	other := &pb.Submission{AssignmentID: assignment.ID, UserID: createFakeUser(t, db, 3).ID, Score: 100}
+	for _, submission := range []*pb.Submission{flagged, other} {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), student)
+	courseRequest := &pb.CourseRequest{CourseID: course.ID}
+
+	// students can neither flag submissions nor get the review queue
+	if _, err := ags.FlagForReview(studentCtx, &pb.SubmissionIDRequest{SubmissionID: flagged.ID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("have error %v want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.GetSubmissionsNeedingReview(studentCtx, courseRequest); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("have error %v want %v", err, codes.PermissionDenied)
+	}
+
+	if _, err := ags.FlagForReview(ctx, &pb.SubmissionIDRequest{SubmissionID: flagged.ID}); err != nil {
+		t.Fatal(err)
+	}
+	queue, err := ags.GetSubmissionsNeedingReview(ctx, courseRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queue.GetSubmissions()) != 1 || queue.GetSubmissions()[0].GetID() != flagged.ID {
+		t.Fatalf("have review queue %v want submission %d", queue.GetSubmissions(), flagged.ID)
+	}
+
+	// rebuilding the submission keeps it in the queue
+	if err := db.CreateSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: flagged.UserID, Score: 90}); err != nil {
+		t.Fatal(err)
+	}
+	if queue, err := ags.GetSubmissionsNeedingReview(ctx, courseRequest); err != nil || len(queue.GetSubmissions()) != 1 {
+		t.Fatalf("have review queue %v (err: %v) after rebuild want one submission", queue.GetSubmissions(), err)
+	}
+
+	// reviewing the submission removes it from the queue
+	if _, err := ags.CreateReview(ctx, &pb.ReviewRequest{
+		CourseID: course.ID,
+		Review:   &pb.Review{SubmissionID: flagged.ID, ReviewerID: teacher.ID},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if queue, err := ags.GetSubmissionsNeedingReview(ctx, courseRequest); err != nil || len(queue.GetSubmissions()) != 0 {
+		t.Errorf("have review queue %v (err: %v) after review want empty queue", queue.GetSubmissions(), err)
+	}
+}
+
+func TestGetGroupSubmissions(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	groupLab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, IsGroupLab: true}
+	userLab := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2}
+	for _, assignment := range []*pb.Assignment{groupLab, userLab} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var groups []*pb.Group
+	for i := 0; i < 3; i++ {
+		user := createFakeUser(t, db, uint64(10+i))
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+		group := &pb.Group{Name: "group" + strconv.Itoa(i), CourseID: course.ID, Users: []*pb.User{user}}
+		if err := db.CreateGroup(group); err != nil {
+			t.Fatal(err)
+		}
+		groups = append(groups, group)
+	}
+	// the last group has not submitted anything
+	var wantIDs []uint64
+	for _, group := range groups[:2] {
+		submission := &pb.Submission{AssignmentID: groupLab.ID, GroupID: group.ID, Score: 50}
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+		wantIDs = append(wantIDs, submission.ID)
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), teacher)
+	if _, err := ags.GetGroupSubmissions(withUserContext(context.Background(), groups[0].Users[0]), &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: groupLab.ID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("have error %v want %v", err, codes.PermissionDenied)
+	}
+	submissions, err := ags.GetGroupSubmissions(ctx, &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: groupLab.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotIDs []uint64
+	for _, submission := range submissions.GetSubmissions() {
+		gotIDs = append(gotIDs, submission.GetID())
+	}
+	if diff := cmp.Diff(wantIDs, gotIDs); diff != "" {
+		t.Errorf("GetGroupSubmissions() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := ags.GetGroupSubmissions(ctx, &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: userLab.ID}); status.Code(err) != codes.NotFound {
+		t.Errorf("have error %v for individual assignment want %v", err, codes.NotFound)
+	}
+}
+
+func TestSubmissionComments(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	other := createFakeUser(t, db, 3)
+	for _, user := range []*pb.User{student, other} {
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, Score: 80}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	thread := []struct {
+		user *pb.User
+		text string
+	}{
+		{student, "Why did the last test fail?"},
+		{teacher, "Check the edge case for empty input."},
+		{student, "Thanks, fixed it."},
+	}
+	for _, c := range thread {
+		ctx := withUserContext(context.Background(), c.user)
+		if _, err := ags.AddSubmissionComment(ctx, &pb.SubmissionComment{SubmissionID: submission.ID, Text: c.text}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, user := range []*pb.User{student, teacher} {
+		ctx := withUserContext(context.Background(), user)
+		comments, err := ags.GetSubmissionComments(ctx, &pb.SubmissionIDRequest{SubmissionID: submission.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(comments.GetComments()) != len(thread) {
+			t.Fatalf("have %d comments, want %d", len(comments.GetComments()), len(thread))
+		}
+		for i, comment := range comments.GetComments() {
+			if comment.GetUserID() != thread[i].user.ID || comment.GetText() != thread[i].text || comment.GetDate() == "" {
+				t.Errorf("comment %d: have %+v, want %q by user %d", i, comment, thread[i].text, thread[i].user.ID)
+			}
+		}
+	}
+
+	// other students can neither read nor write comments
+	otherCtx := withUserContext(context.Background(), other)
+	if _, err := ags.AddSubmissionComment(otherCtx, &pb.SubmissionComment{SubmissionID: submission.ID, Text: "Me too!"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("AddSubmissionComment(other student) = %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.GetSubmissionComments(otherCtx, &pb.SubmissionIDRequest{SubmissionID: submission.ID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetSubmissionComments(other student) = %v, want %v", err, codes.PermissionDenied)
+	}
+	if (&pb.SubmissionComment{SubmissionID: submission.ID, Text: " "}).IsValid() {
+		t.Error("expected empty comment to be invalid")
+	}
+}
+
+func TestGetSubmissionSimilarity(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{OrganizationID: 1, OrganizationPath: "test", Provider: "fake"}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+
+	handout := "package lab1\n\n// Sum returns the sum of the given numbers.\nfunc Sum(numbers []int) int {\n\treturn 0\n}\n"
+	solution := `func Sum(numbers []int) int {
+	total := 0
+	for _, n := range numbers {
+		total += n
+	}
+	return total
+}`
+	// the same solution, formatted differently
+	copied := `func Sum(numbers []int) int { total := 0
+	for _, n := range numbers { total += n }
+	return total }`
+	other := `func Sum(numbers []int) (sum int) {
+	for i := 0; i < len(numbers); i++ {
+		sum = sum + numbers[i]
+	}
+	return
+}`
+	sc := scm.NewFakeSCMClient()
+	sc.Files["test/"+pb.AssignmentRepo] = map[string]string{"lab1/sum.go": handout}
+
+	var users []*pb.User
+	var submissions []*pb.Submission
+	for i, code := range []string{solution, copied, other} {
+		user := createFakeUser(t, db, uint64(10+i))
+		users = append(users, user)
+		repoName := pb.StudentRepoName("student" + strconv.Itoa(i))
+		repo := &pb.Repository{
+			OrganizationID: course.OrganizationID,
+			RepositoryID:   uint64(10 + i),
+			UserID:         user.ID,
+			RepoType:       pb.Repository_USER,
+			HTMLURL:        "https://example.com/test/" + repoName,
+		}
+		if err := db.CreateRepository(repo); err != nil {
+			t.Fatal(err)
+		}
+		sc.Files["test/"+repoName] = map[string]string{
+			"lab1/sum.go": handout + code,
+			"README.md":   "not part of the assignment",
+		}
+		submission := &pb.Submission{AssignmentID: assignment.ID, UserID: user.ID, CommitHash: "abc"}
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+		submissions = append(submissions, submission)
+	}
+
+	fakeGothProvider()
+	mockSCM, scms := mockProviderMap(t)
+	mockSCM.ListFilesFunc = sc.ListFiles
+	mockSCM.GetFileContentFunc = sc.GetFileContent
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	request := &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID}
+	if _, err := ags.GetSubmissionSimilarity(withUserContext(context.Background(), users[0]), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("have error %v want %v", err, codes.PermissionDenied)
+	}
+	similar, err := ags.GetSubmissionSimilarity(withUserContext(context.Background(), teacher), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.SubmissionSimilarity{
+		{SubmissionID1: submissions[0].ID, SubmissionID2: submissions[1].ID, Similarity: 1},
+	}
+	if diff := cmp.Diff(want, similar.GetSimilarities()); diff != "" {
+		t.Errorf("GetSubmissionSimilarity() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestScoreSubmissionByRubric(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), teacher)
+	score := func(grades map[uint64]pb.GradingCriterion_Grade) (*pb.Review, error) {
+		return ags.ScoreSubmissionByRubric(ctx, &pb.RubricScoreRequest{SubmissionID: submission.ID, Grades: grades})
+	}
+	if _, err := score(nil); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("have error %v for assignment without rubric want %v", err, codes.InvalidArgument)
+	}
+	rubric, err := ags.CreateRubric(assignment.ID, []*pb.GradingBenchmark{
+		{Heading: "Code", Criteria: []*pb.GradingCriterion{
+			{Description: "Compiles", Points: 20},
+			{Description: "Readable", Points: 30},
+		}},
+		{Heading: "Report", Criteria: []*pb.GradingCriterion{
+			{Description: "Complete", Points: 50},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	compiles, readable, complete := rubric[0].Criteria[0].ID, rubric[0].Criteria[1].ID, rubric[1].Criteria[0].ID
+
+	// students cannot review submissions
+	studentRequest := &pb.RubricScoreRequest{SubmissionID: submission.ID, Grades: map[uint64]pb.GradingCriterion_Grade{
+		compiles: pb.GradingCriterion_PASSED,
+		readable: pb.GradingCriterion_PASSED,
+		complete: pb.GradingCriterion_PASSED,
+	}}
+	if _, err := ags.ScoreSubmissionByRubric(withUserContext(context.Background(), student), studentRequest); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("have error %v want %v", err, codes.PermissionDenied)
+	}
+
+	if _, err := score(map[uint64]pb.GradingCriterion_Grade{
+		compiles: pb.GradingCriterion_PASSED,
+	}); err == nil {
+		t.Error("expected error for criteria without grades")
+	}
+	if _, err := score(map[uint64]pb.GradingCriterion_Grade{
+		compiles: pb.GradingCriterion_PASSED,
+		readable: pb.GradingCriterion_FAILED,
+		complete: pb.GradingCriterion_PASSED,
+		123:      pb.GradingCriterion_PASSED,
+	}); err == nil {
+		t.Error("expected error for grade of unknown criterion")
+	}
+	review, err := score(map[uint64]pb.GradingCriterion_Grade{
+		compiles: pb.GradingCriterion_PASSED,
+		readable: pb.GradingCriterion_FAILED,
+		complete: pb.GradingCriterion_PASSED,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if review.GetScore() != 70 || !review.GetReady() || review.GetReviewerID() != teacher.ID {
+		t.Errorf("ScoreSubmissionByRubric() = %+v, want ready review with score 70", review)
+	}
+
+	// approving without a score uses the score of the rubric review
+	if _, err := ags.UpdateSubmission(ctx, &pb.UpdateSubmissionRequest{
+		SubmissionID: submission.ID,
+		CourseID:     course.ID,
+		Status:       pb.Submission_APPROVED,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	approved, err := db.GetSubmission(&pb.Submission{ID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if approved.GetScore() != 70 {
+		t.Errorf("approved submission score = %d, want 70", approved.GetScore())
+	}
+}
+
+func TestBumpGradingConfigVersion(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, GradingConfigVersion: course.GetGradingConfigVersion()}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	version, err := ags.BumpGradingConfigVersion(course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != 1 {
+		t.Errorf("BumpGradingConfigVersion() = %d, want 1", version)
+	}
+	course, err = db.GetCourse(course.ID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	submission, err = db.GetSubmission(&pb.Submission{ID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !submission.PredatesGradingConfig(course) {
+		t.Error("expected submission to predate the course's new grading configuration")
+	}
+}
+
+func TestGetSubmissionForGroupAssignment(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	groupLab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, IsGroupLab: true}
+	userLab := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2}
+	for _, assignment := range []*pb.Assignment{groupLab, userLab} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var students []*pb.User
+	for i := 0; i < 2; i++ {
+		student := createFakeUser(t, db, uint64(10+i))
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+		students = append(students, student)
+	}
+	member, loner := students[0], students[1]
+	group := &pb.Group{Name: "group", CourseID: course.ID, Users: []*pb.User{member}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	groupSubmission := &pb.Submission{AssignmentID: groupLab.ID, GroupID: group.ID, Score: 60}
+	userSubmission := &pb.Submission{AssignmentID: userLab.ID, UserID: member.ID, Score: 80}
+	for _, submission := range []*pb.Submission{groupSubmission, userSubmission} {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), member)
+	for _, want := range []*pb.Submission{groupSubmission, userSubmission} {
+		got, err := ags.GetSubmission(ctx, &pb.AssignmentSubmissionRequest{CourseID: course.ID, AssignmentID: want.AssignmentID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.GetID() != want.GetID() {
+			t.Errorf("GetSubmission(assignment %d) = submission %d, want %d", want.AssignmentID, got.GetID(), want.GetID())
+		}
+	}
+
+	// a student without a group has no group submission
+	if _, err := ags.GetSubmission(withUserContext(context.Background(), loner), &pb.AssignmentSubmissionRequest{CourseID: course.ID, AssignmentID: groupLab.ID}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetSubmission() for student without group: have error %v, want %s", err, codes.NotFound)
+	}
+	// a user not enrolled in the course cannot get submissions
+	other := createFakeUser(t, db, 20)
+	if _, err := ags.GetSubmission(withUserContext(context.Background(), other), &pb.AssignmentSubmissionRequest{CourseID: course.ID, AssignmentID: userLab.ID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetSubmission() for user not enrolled: have error %v, want %s", err, codes.PermissionDenied)
+	}
+}
+
+func TestReplayMissedSubmissions(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	student := createFakeUser(t, db, 2)
+	course := pb.Course{OrganizationID: 1, Provider: "fake"}
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	manualLab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, SkipTests: true}
+	limitedLab := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2, MaxAttempts: 1}
+	for _, assignment := range []*pb.Assignment{manualLab, limitedLab} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, repo := range []*pb.Repository{
+		{OrganizationID: course.OrganizationID, RepositoryID: 1, RepoType: pb.Repository_TESTS},
+		{OrganizationID: course.OrganizationID, RepositoryID: 2, UserID: student.ID, RepoType: pb.Repository_USER},
+	} {
+		if err := db.CreateRepository(repo); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the oldest commit was graded before the outage; the limited lab has no attempts
+	// left, so that replaying it records the commit without building it
+	if err := db.CreateSubmission(&pb.Submission{
+		AssignmentID: limitedLab.ID,
+		UserID:       student.ID,
+		CommitHash:   "c1",
+		Attempts:     1,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	since := time.Date(2021, 3, 1, 12, 0, 0, 0, time.Local)
+	files := map[string][]string{
+		"c4": {"lab1/a.go"},
+		"c3": {"lab1/b.go"},
+		"c2": {"lab2/x.go", "README.md"},
+		"c1": {"lab2/x.go"},
+	}
+	fakeGothProvider()
+	mockSCM, scms := mockProviderMap(t)
+	mockSCM.ListCommitsFunc = func(_ context.Context, repoID uint64, listSince time.Time) ([]*scm.Commit, error) {
+		if !listSince.Equal(since) {
+			t.Errorf("have commits listed since %v, want %v", listSince, since)
+		}
+		return []*scm.Commit{
+			{SHA: "c4", Date: since.Add(4 * time.Hour)},
+			{SHA: "c3", Date: since.Add(3 * time.Hour)},
+			{SHA: "c2", Date: since.Add(2 * time.Hour)},
+			{SHA: "c1", Date: since.Add(1 * time.Hour)},
+		}, nil
+	}
+	mockSCM.GetCommitFunc = func(_ context.Context, repoID uint64, sha string) (*scm.Commit, error) {
+		return &scm.Commit{SHA: sha, Files: files[sha]}, nil
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), teacher)
+	request := &pb.ReplayRequest{CourseID: course.ID, Since: since.Format("2006-01-02T15:04:05")}
+
+	// students cannot replay submissions
+	if _, err := ags.ReplayMissedSubmissions(withUserContext(context.Background(), student), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("have error %v want %v", err, codes.PermissionDenied)
+	}
+
+	replayed, err := ags.ReplayMissedSubmissions(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed.GetCount() != 2 {
+		t.Errorf("ReplayMissedSubmissions() replayed %d submissions, want 2", replayed.GetCount())
+	}
+	// only the student repository is replayed, and the graded commit is not fetched
+	var fetched []string
+	for _, call := range mockSCM.Calls() {
+		if call.Args[0].(uint64) != 2 {
+			t.Errorf("have %s call for repository %d, want only repository 2", call.Method, call.Args[0])
+		}
+		if call.Method == "GetCommit" {
+			fetched = append(fetched, call.Args[1].(string))
+		}
+	}
+	if diff := cmp.Diff([]string{"c4", "c3", "c2"}, fetched); diff != "" {
+		t.Errorf("GetCommit() calls mismatch (-want +got):\n%s", diff)
+	}
+	// each assignment is graded at its most recent commit
+	for assignmentID, wantCommit := range map[uint64]string{manualLab.ID: "c4", limitedLab.ID: "c2"} {
+		submission, err := db.GetSubmission(&pb.Submission{AssignmentID: assignmentID, UserID: student.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if submission.GetCommitHash() != wantCommit {
+			t.Errorf("have submission for assignment %d at commit %s, want %s", assignmentID, submission.GetCommitHash(), wantCommit)
+		}
+	}
+
+	// replaying again finds nothing missing
+	replayed, err = ags.ReplayMissedSubmissions(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed.GetCount() != 0 {
+		t.Errorf("ReplayMissedSubmissions() replayed %d submissions again, want 0", replayed.GetCount())
+	}
+}
+
+func TestBaselineRuns(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	student := createFakeUser(t, db, 2)
+	student.Login = "Late Joiner"
+	course := pb.Course{OrganizationID: 1, Features: uint32(pb.Course_GRADE_ON_ENROLL)}
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	lab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	manualLab := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2, SkipTests: true}
+	groupLab := &pb.Assignment{CourseID: course.ID, Name: "lab3", Order: 3, IsGroupLab: true}
+	for _, assignment := range []*pb.Assignment{lab, manualLab, groupLab} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	repo := &pb.Repository{OrganizationID: course.OrganizationID, RepositoryID: 2, UserID: student.ID, RepoType: pb.Repository_USER}
+
+	var commits []*scm.Commit
+	mockSCM := scm.NewMockSCMClient()
+	mockSCM.ListCommitsFunc = func(_ context.Context, _ uint64, _ time.Time) ([]*scm.Commit, error) {
+		return commits, nil
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	ctx := context.Background()
+
+	// an empty repository has no starter code to grade
+	runs, err := ags.BaselineRuns(ctx, mockSCM, &course, student, repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) > 0 {
+		t.Errorf("have %d runs for empty repository, want none", len(runs))
+	}
+
+	commits = []*scm.Commit{{SHA: "starter2"}, {SHA: "starter1"}}
+	runs, err = ags.BaselineRuns(ctx, mockSCM, &course, student, repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// only the individual assignment with tests is graded, at the head commit
+	if len(runs) != 1 {
+		t.Fatalf("have %d runs, want 1", len(runs))
+	}
+	run := runs[0]
+	if run.Assignment.GetID() != lab.ID || run.Repo != repo || run.CommitID != "starter2" || run.Checkout != "starter2" || run.JobOwner != "late-joiner" {
+		t.Errorf("have run of assignment %d in repository %d at commit %s (checkout %s) for %s, want assignment %d at commit starter2 for late-joiner",
+			run.Assignment.GetID(), run.Repo.GetRepositoryID(), run.CommitID, run.Checkout, run.JobOwner, lab.ID)
+	}
+
+	// courses that have ended, or that grade pull requests, do not grade starter code
+	for _, c := range []*pb.Course{
+		{ID: course.ID, OrganizationID: 1, EndDate: "2020-01-01T00:00:00"},
+		{ID: course.ID, OrganizationID: 1, Features: uint32(pb.Course_PULL_REQUEST_SUBMISSIONS)},
+	} {
+		runs, err = ags.BaselineRuns(ctx, mockSCM, c, student, repo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(runs) > 0 {
+			t.Errorf("have %d runs for course %+v, want none", len(runs), c)
+		}
+	}
+}
+
+func TestAssignGrader(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	enroll := func(user *pb.User, status pb.Enrollment_UserStatus) {
+		t.Helper()
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID, Status: status}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assistant := createFakeUser(t, db, 2)
+	enroll(assistant, pb.Enrollment_TEACHER)
+	var students []*pb.User
+	for i := 0; i < 3; i++ {
+		student := createFakeUser(t, db, uint64(10+i))
+		enroll(student, pb.Enrollment_STUDENT)
+		students = append(students, student)
+	}
+	group := &pb.Group{Name: "group", CourseID: course.ID, Status: pb.Group_APPROVED, Users: students[:2]}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	teacherCtx := withUserContext(context.Background(), teacher)
+	assign := func(grader *pb.User, students ...*pb.User) error {
+		request := &pb.AssignGraderRequest{CourseID: course.ID, GraderID: grader.ID}
+		for _, student := range students {
+			request.StudentIDs = append(request.StudentIDs, student.ID)
+		}
+		_, err := ags.AssignGrader(teacherCtx, request)
+		return err
+	}
+	if err := assign(assistant, students[1], students[2]); err != nil {
+		t.Fatal(err)
+	}
+	// the last student is reassigned to the teacher
+	if err := assign(teacher, students[2]); err != nil {
+		t.Fatal(err)
+	}
+	if err := assign(students[0], students[1]); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("AssignGrader() with student as grader: have error %v, want %s", err, codes.InvalidArgument)
+	}
+	if _, err := ags.AssignGrader(withUserContext(context.Background(), students[0]), &pb.AssignGraderRequest{
+		CourseID: course.ID, GraderID: assistant.ID, StudentIDs: []uint64{students[0].ID},
+	}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("AssignGrader() by student: have error %v, want %s", err, codes.PermissionDenied)
+	}
+
+	assistantCtx := withUserContext(context.Background(), assistant)
+	tests := []struct {
+		typ      pb.SubmissionsForCourseRequest_Type
+		graderID uint64
+		want     []uint64
+	}{
+		{pb.SubmissionsForCourseRequest_INDIVIDUAL, assistant.ID, []uint64{students[1].ID}},
+		{pb.SubmissionsForCourseRequest_INDIVIDUAL, teacher.ID, []uint64{students[2].ID}},
+		{pb.SubmissionsForCourseRequest_GROUP, assistant.ID, []uint64{group.ID}},
+		{pb.SubmissionsForCourseRequest_GROUP, teacher.ID, nil},
+	}
+	for _, test := range tests {
+		submissions, err := ags.GetSubmissionsByCourse(assistantCtx, &pb.SubmissionsForCourseRequest{
+			CourseID: course.ID,
+			Type:     test.typ,
+			GraderID: test.graderID,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []uint64
+		for _, link := range submissions.GetLinks() {
+			if test.typ == pb.SubmissionsForCourseRequest_GROUP {
+				got = append(got, link.GetEnrollment().GetGroupID())
+			} else {
+				got = append(got, link.GetEnrollment().GetUserID())
+			}
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("GetSubmissionsByCourse(%s, grader %d) mismatch (-want +got):\n%s", test.typ, test.graderID, diff)
+		}
+	}
+}
@@ -1,10 +1,13 @@
 package web
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/scm"
 	"github.com/jinzhu/gorm"
 	"github.com/labstack/echo/v4"
 )
@@ -98,3 +101,78 @@ func (s *AutograderService) updateUser(curUser *pb.User, request *pb.User) (*pb.
 	err = s.db.UpdateUser(updateUser)
 	return updateUser, err
 }
+
+// handleUserRename renames the student repositories of the user with the given ID
+// and replaces the user's old login with the new one in the user's teams. The SCM
+// changes for each course are applied with the course's SCM client, since the user's
+// own client cannot manage the course's repositories and teams. Team memberships are
+// updated before repositories are renamed, and the user's login is updated once all
+// SCM changes have been applied, so that a failed rename can be retried.
+func (s *AutograderService) handleUserRename(ctx context.Context, userID uint64, newLogin string) error {
+	user, err := s.db.GetUser(userID)
+	if err != nil {
+		return fmt.Errorf("handleUserRename: failed to get user %d: %w", userID, err)
+	}
+	oldLogin := user.GetLogin()
+
+	enrollments, err := s.db.GetEnrollmentsByUser(user.ID, pb.Enrollment_STUDENT, pb.Enrollment_TEACHER)
+	if err != nil {
+		return err
+	}
+	for _, enrollment := range enrollments {
+		// the course's access token is cached when the course is fetched
+		course, err := s.getCourse(enrollment.GetCourseID())
+		if err != nil {
+			return err
+		}
+		sc, ok := s.scms.GetSCM(course.GetAccessToken())
+		if !ok {
+			return fmt.Errorf("handleUserRename: no SCM client for course %d", course.GetID())
+		}
+		org, err := sc.GetOrganization(ctx, &scm.GetOrgOptions{ID: course.GetOrganizationID()})
+		if err != nil {
+			return err
+		}
+
+		teamName, role := scm.StudentsTeam, scm.TeamMember
+		if enrollment.IsTeacher() {
+			teamName, role = scm.TeachersTeam, scm.TeamMaintainer
+		}
+		teams := []*scm.TeamMembershipOptions{{Organization: org.GetPath(), TeamName: teamName, Role: role}}
+		if group := enrollment.GetGroup(); group != nil && group.GetTeamID() > 0 {
+			teams = append(teams, &scm.TeamMembershipOptions{
+				Organization:   org.GetPath(),
+				OrganizationID: course.GetOrganizationID(),
+				TeamID:         group.GetTeamID(),
+				Role:           scm.TeamMember,
+			})
+		}
+		for _, team := range teams {
+			if err := replaceTeamMember(ctx, sc, team, oldLogin, newLogin); err != nil {
+				return err
+			}
+		}
+
+		repos, err := s.db.GetRepositories(&pb.Repository{
+			OrganizationID: course.GetOrganizationID(),
+			UserID:         user.ID,
+			RepoType:       pb.Repository_USER,
+		})
+		if err != nil {
+			return err
+		}
+		for _, repo := range repos {
+			scmRepo, err := sc.RenameRepository(ctx, repo.RepositoryID, pb.StudentRepoName(newLogin))
+			if err != nil {
+				return fmt.Errorf("handleUserRename: failed to rename repository %d: %w", repo.RepositoryID, err)
+			}
+			repo.HTMLURL = scmRepo.WebURL
+			if err := s.db.UpdateRepository(repo); err != nil {
+				return err
+			}
+		}
+	}
+
+	user.Login = newLogin
+	return s.db.UpdateUser(user)
+}
@@ -2,6 +2,8 @@ package web_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -9,6 +11,7 @@ import (
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/scm"
 	"github.com/autograde/quickfeed/web"
 	"github.com/autograde/quickfeed/web/auth"
 	"github.com/google/go-cmp/cmp"
@@ -270,6 +273,198 @@ func TestEnrollmentsWithoutGroupMembership(t *testing.T) {
 
 }
 
+func TestGetEnrollmentsByCourseOnlyGroupMembers(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := allCourses[0]
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	var students []*pb.User
+	for i := 0; i < 5; i++ {
+		student := createFakeUser(t, db, uint64(10+i))
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+		students = append(students, student)
+	}
+	group1 := &pb.Group{Name: "group1", CourseID: course.ID, Users: []*pb.User{students[3], students[0]}}
+	group2 := &pb.Group{Name: "group2", CourseID: course.ID, Users: []*pb.User{students[1]}}
+	for _, group := range []*pb.Group{group1, group2} {
+		if err := db.CreateGroup(group); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), teacher)
+	type member struct{ UserID, GroupID uint64 }
+	want := []member{
+		{students[0].ID, group1.ID},
+		{students[3].ID, group1.ID},
+		{students[1].ID, group2.ID},
+	}
+	for _, withActivity := range []bool{false, true} {
+		enrollments, err := ags.GetEnrollmentsByCourse(ctx, &pb.EnrollmentRequest{
+			CourseID:         course.ID,
+			OnlyGroupMembers: true,
+			WithActivity:     withActivity,
+			Statuses:         []pb.Enrollment_UserStatus{pb.Enrollment_STUDENT},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []member
+		for _, enrollment := range enrollments.GetEnrollments() {
+			if enrollment.GetGroup().GetID() != enrollment.GetGroupID() {
+				t.Errorf("enrollment %d: group %d not loaded", enrollment.GetID(), enrollment.GetGroupID())
+			}
+			got = append(got, member{enrollment.GetUserID(), enrollment.GetGroupID()})
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("GetEnrollmentsByCourse(onlyGroupMembers, withActivity=%t) mismatch (-want +got):\n%s", withActivity, diff)
+		}
+	}
+
+	both := &pb.EnrollmentRequest{CourseID: course.ID, OnlyGroupMembers: true, IgnoreGroupMembers: true}
+	if both.IsValid() {
+		t.Error("EnrollmentRequest with both onlyGroupMembers and ignoreGroupMembers is valid")
+	}
+}
+
+func TestGetEnrollmentsByCourseLastActivity(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := allCourses[0]
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	active := createFakeUser(t, db, 2)
+	inactive := createFakeUser(t, db, 3)
+	for _, student := range []*pb.User{active, inactive} {
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i, buildDate := range []string{"2021-01-20T12:00:00", "2021-02-03T09:30:00"} {
+		assignment := &pb.Assignment{CourseID: course.ID, Name: fmt.Sprintf("lab%d", i+1), Order: uint32(i + 1)}
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateSubmission(&pb.Submission{
+			AssignmentID: assignment.ID,
+			UserID:       active.ID,
+			BuildInfo:    `{"builddate": "` + buildDate + `"}`,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, auth.NewScms(), web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), teacher)
+	enrollments, err := ags.GetEnrollmentsByCourse(ctx, &pb.EnrollmentRequest{
+		CourseID:     course.ID,
+		WithActivity: true,
+		Statuses:     []pb.Enrollment_UserStatus{pb.Enrollment_STUDENT},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[uint64]string{
+		active.ID:   "2021-02-03T09:30:00",
+		inactive.ID: "",
+	}
+	got := make(map[uint64]string)
+	for _, enrollment := range enrollments.GetEnrollments() {
+		if enrollment.GetStatus() == pb.Enrollment_STUDENT {
+			got[enrollment.GetUserID()] = enrollment.GetLastActivityAt()
+		}
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetEnrollmentsByCourse() last activity mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetEnrollmentsByCourseRedactsUsers(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := allCourses[0]
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	var students []*pb.User
+	for i := uint64(2); i <= 3; i++ {
+		student := createFakeUser(t, db, i)
+		student.Name = fmt.Sprintf("student%d", i)
+		student.Login = fmt.Sprintf("login%d", i)
+		student.Email = fmt.Sprintf("student%d@example.com", i)
+		student.StudentID = fmt.Sprintf("%d", 1000+i)
+		if err := db.UpdateUser(student); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+		students = append(students, student)
+	}
+
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	request := &pb.EnrollmentRequest{CourseID: course.ID}
+
+	// teachers see all user information
+	enrollments, err := ags.GetEnrollmentsByCourse(withUserContext(context.Background(), teacher), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, enrollment := range enrollments.GetEnrollments() {
+		if enrollment.GetUserID() != teacher.ID && enrollment.GetUser().GetEmail() == "" {
+			t.Errorf("expected teacher to see email of user %d", enrollment.GetUserID())
+		}
+	}
+
+	// students see only names and logins of other users
+	me := students[0]
+	enrollments, err = ags.GetEnrollmentsByCourse(withUserContext(context.Background(), me), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, enrollment := range enrollments.GetEnrollments() {
+		user := enrollment.GetUser()
+		if enrollment.GetUserID() == me.ID {
+			if user.GetEmail() != me.Email || user.GetStudentID() != me.StudentID {
+				t.Errorf("have own user %+v want unredacted %+v", user, me)
+			}
+			continue
+		}
+		if user.GetEmail() != "" || user.GetStudentID() != "" || len(user.GetRemoteIdentities()) > 0 {
+			t.Errorf("have user %+v want only ID, name and login", user)
+		}
+		if user.GetID() != enrollment.GetUserID() {
+			t.Errorf("have user ID %d want %d", user.GetID(), enrollment.GetUserID())
+		}
+	}
+	other := enrollments.GetEnrollments()[2].GetUser()
+	if other.GetName() != students[1].Name || other.GetLogin() != students[1].Login {
+		t.Errorf("have user %+v want name %q and login %q", other, students[1].Name, students[1].Login)
+	}
+}
+
 func TestUpdateUser(t *testing.T) {
 	db, cleanup := setup(t)
 	defer cleanup()
@@ -448,3 +643,178 @@ func enrollStudent(t *testing.T, db database.Database, student *pb.User, course
 		t.Fatal(err)
 	}
 }
+
+func TestHandleUserRename(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := *allCourses[0]
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	student.Login = "old"
+	if err := db.UpdateUser(student); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+		t.Fatal(err)
+	}
+
+	mockSCM, scms := mockProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := context.Background()
+
+	org, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "org"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := mockSCM.CreateRepository(ctx, &scm.CreateRepositoryOptions{Organization: org, Path: pb.StudentRepoName("old")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateRepository(&pb.Repository{
+		OrganizationID: course.OrganizationID,
+		RepositoryID:   repo.ID,
+		UserID:         student.ID,
+		HTMLURL:        repo.WebURL,
+		RepoType:       pb.Repository_USER,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	mockSCM.Reset()
+
+	var added, removed []string
+	mockSCM.AddTeamMemberFunc = func(_ context.Context, opt *scm.TeamMembershipOptions) error {
+		added = append(added, opt.Username)
+		return nil
+	}
+	mockSCM.RemoveTeamMemberFunc = func(_ context.Context, opt *scm.TeamMembershipOptions) error {
+		removed = append(removed, opt.Username)
+		return nil
+	}
+
+	if err := ags.HandleUserRename(ctx, student.ID, "new"); err != nil {
+		t.Fatal(err)
+	}
+
+	wantMethods := []string{"GetOrganization", "RemoveTeamMember", "AddTeamMember", "RenameRepository"}
+	if diff := cmp.Diff(wantMethods, mockSCM.Methods()); diff != "" {
+		t.Errorf("mismatch in SCM calls (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"old"}, removed); diff != "" {
+		t.Errorf("mismatch in removed team members (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"new"}, added); diff != "" {
+		t.Errorf("mismatch in added team members (-want +got):\n%s", diff)
+	}
+
+	repos, err := db.GetRepositories(&pb.Repository{UserID: student.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "https://example.com/org/" + pb.StudentRepoName("new"); repos[0].HTMLURL != want {
+		t.Errorf("HTMLURL = %s, want %s", repos[0].HTMLURL, want)
+	}
+	gotUser, err := db.GetUser(student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotUser.Login != "new" {
+		t.Errorf("Login = %s, want new", gotUser.Login)
+	}
+
+	if err := ags.HandleUserRename(ctx, 1000, "new"); err == nil {
+		t.Error("expected error when renaming unknown user")
+	}
+}
+
+func TestHandleUserRenameGroupMember(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := *allCourses[0]
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	student.Login = "old"
+	if err := db.UpdateUser(student); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+		t.Fatal(err)
+	}
+	group := &pb.Group{Name: "group", CourseID: course.ID, TeamID: 5, Status: pb.Group_APPROVED, Users: []*pb.User{student}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+
+	mockSCM, scms := mockProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := context.Background()
+	if _, err := mockSCM.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "org"}); err != nil {
+		t.Fatal(err)
+	}
+	repo, err := mockSCM.CreateRepository(ctx, &scm.CreateRepositoryOptions{Organization: &pb.Organization{Path: "org"}, Path: pb.StudentRepoName("old")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateRepository(&pb.Repository{
+		OrganizationID: course.OrganizationID,
+		RepositoryID:   repo.ID,
+		UserID:         student.ID,
+		HTMLURL:        repo.WebURL,
+		RepoType:       pb.Repository_USER,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	mockSCM.Reset()
+
+	// like the SCM clients, reject team memberships without the team's organization
+	var teamIDs []uint64
+	mockSCM.AddTeamMemberFunc = func(_ context.Context, opt *scm.TeamMembershipOptions) error {
+		if opt.TeamID > 0 && opt.OrganizationID == 0 {
+			return errors.New("missing organization ID")
+		}
+		teamIDs = append(teamIDs, opt.TeamID)
+		return nil
+	}
+
+	if err := ags.HandleUserRename(ctx, student.ID, "new"); err != nil {
+		t.Fatal(err)
+	}
+	// the students team is given by name, and the group team by ID
+	if diff := cmp.Diff([]uint64{0, group.TeamID}, teamIDs); diff != "" {
+		t.Errorf("mismatch in teams (-want +got):\n%s", diff)
+	}
+	gotUser, err := db.GetUser(student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotUser.Login != "new" {
+		t.Errorf("Login = %s, want new", gotUser.Login)
+	}
+
+	// the repository is not renamed when a team cannot be updated
+	mockSCM.Reset()
+	mockSCM.AddTeamMemberFunc = func(context.Context, *scm.TeamMembershipOptions) error {
+		return errors.New("team update failed")
+	}
+	if err := ags.HandleUserRename(ctx, student.ID, "newer"); err == nil {
+		t.Error("expected error when the team cannot be updated")
+	}
+	for _, method := range mockSCM.Methods() {
+		if method == "RenameRepository" {
+			t.Error("repository renamed after failed team update")
+		}
+	}
+}
@@ -145,7 +145,7 @@ func registerAuth(ags *AutograderService, e *echo.Echo) {
 
 	oauth2 := e.Group("/auth/:provider", withProvider, auth.PreAuth(logger, ags.db))
 	oauth2.GET("", auth.OAuth2Login(logger, ags.db))
-	oauth2.GET("/callback", auth.OAuth2Callback(logger, ags.db))
+	oauth2.GET("/callback", auth.OAuth2Callback(logger, ags.db, ags.scms, ags.handleUserRename))
 	e.GET("/logout", auth.OAuth2Logout(logger))
 
 	api := e.Group("/api/v1")